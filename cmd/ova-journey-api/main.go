@@ -9,6 +9,8 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// embedded IANA time zone database for converting journey times to their time zones
+	_ "time/tzdata"
 
 	_ "github.com/jackc/pgx/v4"
 	_ "github.com/jackc/pgx/v4/stdlib"
@@ -16,11 +18,18 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/rs/zerolog/log"
 
+	"github.com/ozonva/ova-journey-api/internal/advancer"
+	"github.com/ozonva/ova-journey-api/internal/api"
+	"github.com/ozonva/ova-journey-api/internal/auth"
 	"github.com/ozonva/ova-journey-api/internal/config"
 	"github.com/ozonva/ova-journey-api/internal/kafka"
 	"github.com/ozonva/ova-journey-api/internal/metrics"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/purger"
+	"github.com/ozonva/ova-journey-api/internal/repo"
 	"github.com/ozonva/ova-journey-api/internal/server"
 	"github.com/ozonva/ova-journey-api/internal/tracer"
+	"github.com/ozonva/ova-journey-api/internal/watch"
 )
 
 //ConfigFile - application configuration file path
@@ -36,8 +45,12 @@ var (
 	healthChecker *server.HealthServer
 	tracerCloser  io.Closer
 	producer      kafka.Producer
+	consumer      kafka.Consumer
+	purgeJob      purger.Purger
+	advanceJob    advancer.Advancer
 	metricServer  *server.MetricsServer
 	metric        metrics.Metrics
+	hub           watch.Hub
 )
 
 func main() {
@@ -52,6 +65,8 @@ func main() {
 	log.Info().Str("version", configuration.Project.Version).Msg("Starting ova-journey-api")
 
 	metric = metrics.NewMetrics("ova_journey_api", "gRPC_server")
+	// hub is not recreated on restart of service, so subscribers can resume watching with their tokens
+	hub = watch.NewHub(configuration.Watch.GetBufferSize(), configuration.Watch.GetHistorySize())
 
 	startApp(configuration, errChan)
 
@@ -93,18 +108,58 @@ func startApp(c *config.Configuration, errChan chan<- error) {
 		log.Fatal().Err(err).Msg("Cannot establish connection to database")
 	}
 
+	repository := repo.NewRepo(db)
+	consumer, err = kafka.NewConsumer(c.Kafka, repository, repo.NewOperationRepo(db), hub)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Cannot create Kafka consumer")
+	}
+
+	purgeJob = purger.NewPurger(repository, repo.NewIdempotencyRepo(db), c.Purge)
+	advanceJob = advancer.NewAdvancer(repository, hub, c.Advance)
+
+	overlapPolicy, err := models.ParseOverlapPolicy(c.Overlap.GetPolicy())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Invalid overlap policy in configuration")
+	}
+
 	healthChecker = server.NewHealthServer(c.HealthCheck, producer, db)
 	metricServer = server.NewMetricsServer(c.Prometheus)
-	grpc = server.NewGrpcServer(c.GRPC, producer, db, metric, c.ChunkSize, errChan)
-	gateway = server.NewGatewayServer(c.Gateway, c.GRPC, errChan)
+	var authenticator *auth.Authenticator
+	if secret := c.Auth.GetSecret(); secret != "" {
+		authenticator = auth.NewAuthenticator(secret)
+	} else {
+		log.Warn().Msg("Authentication is disabled, requests are not restricted to acting user")
+	}
+
+	grpc = server.NewGrpcServer(c.GRPC, db, api.Options{
+		IdempotencyTTL: c.Idempotency.GetTTL(),
+		Producer:       producer,
+		Metrics:        metric,
+		Hub:            hub,
+		ChunkSize:      c.ChunkSize,
+		OverlapPolicy:  overlapPolicy,
+		AdminUserIDs:   c.Access.GetAdminUserIDs(),
+		Authenticator:  authenticator,
+	}, authenticator, errChan)
+	gateway = server.NewGatewayServer(c.Gateway, c.GRPC, authenticator, errChan)
 
 	healthChecker.Start()
 	metricServer.Start()
 	grpc.Start()
 	gateway.Start()
+	consumer.Start()
+	purgeJob.Start()
+	advanceJob.Start()
 }
 
 func stopApp() {
+	purgeJob.Close()
+	advanceJob.Close()
+
+	if err := consumer.Close(); err != nil {
+		log.Fatal().Err(err).Msg("Kafka consumer close error")
+	}
+
 	gateway.Stop()
 	grpc.Stop()
 	metricServer.Stop()
@@ -0,0 +1,107 @@
+package advancer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozonva/ova-journey-api/internal/config"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+)
+
+// Advancer - background job for starting planned journeys after their start time
+// and completing journeys in progress after their end time
+type Advancer interface {
+	// Start - start advancing in background until Advancer.Close() is called
+	Start()
+	// Close - stop advancing and wait for current attempt to finish
+	Close()
+}
+
+type advancer struct {
+	repo      repo.Repo
+	hub       watch.Publisher
+	interval  time.Duration
+	batchSize uint64
+	now       func() time.Time
+	cancel    context.CancelFunc
+	wg        *sync.WaitGroup
+}
+
+// NewAdvancer - creates new Advancer using repo.Repo, watch.Publisher for publishing changed journeys and configuration.
+// Advancer does nothing if configuration is nil or interval is not positive.
+func NewAdvancer(repo repo.Repo, hub watch.Publisher, configuration *config.AdvanceConfiguration) Advancer {
+	a := &advancer{repo: repo, hub: hub, now: time.Now}
+	if configuration != nil {
+		a.interval = configuration.Interval
+		a.batchSize = configuration.BatchSize
+	}
+	return a
+}
+
+func (a *advancer) Start() {
+	if a.interval <= 0 || a.batchSize == 0 {
+		log.Debug().Msg("Advancer: disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancel = cancel
+	a.wg = &sync.WaitGroup{}
+	a.wg.Add(1)
+
+	go func() {
+		defer a.wg.Done()
+		log.Debug().Dur("interval", a.interval).Msg("Advancer: starting")
+
+		ticker := time.NewTicker(a.interval)
+		defer ticker.Stop()
+		for {
+			a.advance(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (a *advancer) Close() {
+	if a.cancel != nil {
+		a.cancel()
+		a.wg.Wait()
+	}
+}
+
+// advance - changes statuses of journeys by batches until there are no more of them
+// and publishes changed journeys to watchers, returns count of changed journeys
+func (a *advancer) advance(ctx context.Context) uint64 {
+	now := a.now()
+
+	var total uint64
+	for ctx.Err() == nil {
+		journeys, err := a.repo.AdvanceJourneyStatuses(ctx, now, a.batchSize)
+		if err != nil {
+			log.Error().Err(err).Time("now", now).Msg("Advancer: failed to advance journeys")
+			break
+		}
+		events := make([]watch.Event, len(journeys))
+		for i, journey := range journeys {
+			events[i] = watch.Updated(journey)
+		}
+		a.hub.Publish(watch.Load(ctx, a.repo, events...)...)
+		total += uint64(len(journeys))
+		if uint64(len(journeys)) < a.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Info().Uint64("count", total).Msg("Advancer: journey statuses advanced")
+	}
+	return total
+}
@@ -0,0 +1,13 @@
+package advancer
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAdvancer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Advancer Suite")
+}
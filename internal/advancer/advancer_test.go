@@ -0,0 +1,103 @@
+package advancer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ozonva/ova-journey-api/internal/config"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+)
+
+var _ = Describe("Advancer", func() {
+	var (
+		ctrl     *gomock.Controller
+		mockRepo *mocks.MockRepo
+		hub      watch.Hub
+		a        *advancer
+		ctx      context.Context
+
+		now     = time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+		errRepo = errors.New("repo error")
+		started = models.Journey{JourneyID: 1, UserID: 1, StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour),
+			Revision: 2, Status: models.StatusInProgress}
+		completed = models.Journey{JourneyID: 2, UserID: 1, StartTime: now.Add(-2 * time.Hour), EndTime: now.Add(-time.Hour),
+			Revision: 3, Status: models.StatusCompleted}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		hub = watch.NewHub(10, 10)
+		ctx = context.Background()
+		a = NewAdvancer(mockRepo, hub, &config.AdvanceConfiguration{
+			Interval:  time.Minute,
+			BatchSize: 2,
+		}).(*advancer)
+		a.now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("advance", func() {
+		It("should advance journeys by batches until batch is not full and publish them", func() {
+			subscription, err := hub.Subscribe(watch.Filter{}, 0)
+			Expect(err).Should(BeNil())
+			defer subscription.Close()
+
+			gomock.InOrder(
+				mockRepo.EXPECT().AdvanceJourneyStatuses(ctx, now, uint64(2)).Return([]models.Journey{started, completed}, nil),
+				mockRepo.EXPECT().DescribeJourney(ctx, started.JourneyID).Return(&started, nil),
+				mockRepo.EXPECT().DescribeJourney(ctx, completed.JourneyID).Return(&completed, nil),
+				mockRepo.EXPECT().GetJourneyParticipantIDs(ctx, []uint64{1, 2}).Return(map[uint64][]uint64{2: {3}}, nil),
+				mockRepo.EXPECT().AdvanceJourneyStatuses(ctx, now, uint64(2)).Return(nil, nil),
+			)
+
+			Expect(a.advance(ctx)).Should(Equal(uint64(2)))
+
+			event := <-subscription.Events()
+			Expect(event.Type).Should(Equal(watch.JourneyUpdated))
+			Expect(*event.Journey).Should(Equal(started))
+			event = <-subscription.Events()
+			Expect(*event.Journey).Should(Equal(completed))
+			Expect(event.ParticipantIDs).Should(Equal([]uint64{3}))
+		})
+
+		It("should stop on repo error", func() {
+			mockRepo.EXPECT().AdvanceJourneyStatuses(ctx, now, uint64(2)).Return(nil, errRepo).Times(1)
+
+			Expect(a.advance(ctx)).Should(Equal(uint64(0)))
+		})
+	})
+
+	Context("Start and Close", func() {
+		It("should advance on start and stop after closing", func() {
+			done := make(chan struct{})
+			mockRepo.EXPECT().AdvanceJourneyStatuses(gomock.Any(), now, uint64(2)).
+				DoAndReturn(func(context.Context, time.Time, uint64) ([]models.Journey, error) {
+					close(done)
+					return nil, nil
+				}).Times(1)
+
+			a.Start()
+			Eventually(done).Should(BeClosed())
+			a.Close()
+		})
+
+		It("should do nothing if interval is not set", func() {
+			mockRepo.EXPECT().AdvanceJourneyStatuses(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			disabled := NewAdvancer(mockRepo, hub, nil)
+			disabled.Start()
+			disabled.Close()
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+// toStatusError - converts error of repo or another dependency to gRPC status error with code matching its apperrors.Kind,
+// gateway returns them as 404 (NotFound), 409 (AlreadyExists, Conflict), 400 (InvalidArgument, FailedPrecondition),
+// 401 (Unauthenticated), 403 (PermissionDenied) and 503 (Unavailable)
+func toStatusError(err error) error {
+	return status.Error(statusCode(err), err.Error())
+}
+
+func statusCode(err error) codes.Code {
+	switch apperrors.KindOf(err) {
+	case apperrors.NotFound:
+		return codes.NotFound
+	case apperrors.AlreadyExists:
+		return codes.AlreadyExists
+	case apperrors.Conflict:
+		return codes.Aborted
+	case apperrors.InvalidArgument:
+		return codes.InvalidArgument
+	case apperrors.Unavailable:
+		return codes.Unavailable
+	case apperrors.FailedPrecondition:
+		return codes.FailedPrecondition
+	case apperrors.PermissionDenied:
+		return codes.PermissionDenied
+	case apperrors.Unauthenticated:
+		return codes.Unauthenticated
+	}
+	return codes.Internal
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+var _ = Describe("Errors", func() {
+	DescribeTable("toStatusError",
+		func(err error, code codes.Code, httpStatus int) {
+			result := toStatusError(err)
+
+			Expect(status.Code(result)).Should(Equal(code))
+			Expect(status.Convert(result).Message()).Should(Equal(err.Error()))
+			Expect(runtime.HTTPStatusFromCode(status.Code(result))).Should(Equal(httpStatus))
+		},
+		Entry("not found", apperrors.New(apperrors.NotFound, "journey 1 not found"), codes.NotFound, http.StatusNotFound),
+		Entry("already exists", apperrors.New(apperrors.AlreadyExists, "exists"), codes.AlreadyExists, http.StatusConflict),
+		Entry("conflict", apperrors.New(apperrors.Conflict, "revision"), codes.Aborted, http.StatusConflict),
+		Entry("invalid argument", apperrors.New(apperrors.InvalidArgument, "invalid"), codes.InvalidArgument, http.StatusBadRequest),
+		Entry("unavailable", apperrors.Wrap(apperrors.Unavailable, errors.New("conn"), "db"), codes.Unavailable, http.StatusServiceUnavailable),
+		Entry("failed precondition", apperrors.New(apperrors.FailedPrecondition, "overlaps"), codes.FailedPrecondition, http.StatusBadRequest),
+		Entry("permission denied", apperrors.New(apperrors.PermissionDenied, "viewer"), codes.PermissionDenied, http.StatusForbidden),
+		Entry("unauthenticated", apperrors.New(apperrors.Unauthenticated, "no user"), codes.Unauthenticated, http.StatusUnauthorized),
+		Entry("unknown", errors.New("unknown"), codes.Internal, http.StatusInternalServerError),
+	)
+})
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// IfMatchMetadataKey - key of gRPC metadata with expected ETag of journey, gateway passes If-Match header there
+const IfMatchMetadataKey = "if-match"
+
+var errInvalidETag = errors.New("invalid ETag in If-Match, expected quoted journey revision")
+
+// FormatETag - returns strong ETag for journey revision
+func FormatETag(revision uint64) string {
+	return strconv.Quote(strconv.FormatUint(revision, 10))
+}
+
+// parseETag - returns journey revision from ETag, "*" means any revision
+func parseETag(etag string) (uint64, error) {
+	etag = strings.TrimSpace(etag)
+	if etag == "*" {
+		return 0, nil
+	}
+	if len(etag) < 2 || etag[0] != '"' || etag[len(etag)-1] != '"' {
+		return 0, errInvalidETag
+	}
+	revision, err := strconv.ParseUint(etag[1:len(etag)-1], 10, 64)
+	if err != nil || revision == 0 {
+		return 0, errInvalidETag
+	}
+	return revision, nil
+}
+
+// expectedRevision - returns expected revision from request field or, if it is not set, from If-Match metadata
+func expectedRevision(ctx context.Context, requestRevision uint64) (uint64, error) {
+	if requestRevision > 0 {
+		return requestRevision, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	values := md.Get(IfMatchMetadataKey)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	return parseETag(values[0])
+}
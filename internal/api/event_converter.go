@@ -0,0 +1,25 @@
+package api
+
+import (
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var eventTypes = map[watch.EventType]desc.JourneyEventType{
+	watch.JourneyCreated:  desc.JourneyEventType_JOURNEY_EVENT_TYPE_CREATED,
+	watch.JourneyUpdated:  desc.JourneyEventType_JOURNEY_EVENT_TYPE_UPDATED,
+	watch.JourneyDeleted:  desc.JourneyEventType_JOURNEY_EVENT_TYPE_DELETED,
+	watch.JourneyRestored: desc.JourneyEventType_JOURNEY_EVENT_TYPE_RESTORED,
+}
+
+// eventToProto - convert watch.Event to JourneyEvent proto message
+func eventToProto(event watch.Event) *desc.JourneyEvent {
+	result := &desc.JourneyEvent{
+		Type:      eventTypes[event.Type],
+		JourneyId: event.JourneyID,
+	}
+	if event.Journey != nil {
+		result.Journey = journeyToProto(*event.Journey)
+	}
+	return result
+}
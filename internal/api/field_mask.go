@@ -0,0 +1,83 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var errEmptyUpdateMask = errors.New("update mask must contain at least one path")
+
+// journeyMaskPaths - paths of desc.Journey that can be used in update mask and corresponding repo fields
+var journeyMaskPaths = map[string][]repo.JourneyField{
+	"user_id":     {repo.JourneyFieldUserID},
+	"address":     {repo.JourneyFieldAddress},
+	"description": {repo.JourneyFieldDescription},
+	"start_time":  {repo.JourneyFieldStartTime},
+	"end_time":    {repo.JourneyFieldEndTime},
+	"time_zone":   {repo.JourneyFieldTimeZone},
+	// coordinates are changed together, missing coordinates in journey clears them
+	"coordinates": {repo.JourneyFieldLatitude, repo.JourneyFieldLongitude},
+	// tags are replaced, missing tags in journey clears them
+	"tags": {repo.JourneyFieldTags},
+}
+
+// journeyFieldsFromMask - converts update mask to the list of repo fields,
+// returns error if mask contains unknown or read-only paths or required values are missing in journey
+func journeyFieldsFromMask(mask *fieldmaskpb.FieldMask, journey *desc.Journey) ([]repo.JourneyField, error) {
+	paths := append([]string(nil), mask.GetPaths()...)
+	normalized := &fieldmaskpb.FieldMask{Paths: paths}
+	normalized.Normalize()
+	if len(normalized.Paths) == 0 {
+		return nil, errEmptyUpdateMask
+	}
+
+	fields := make([]repo.JourneyField, 0, len(normalized.Paths))
+	for _, path := range normalized.Paths {
+		pathFields, ok := journeyMaskPaths[path]
+		if !ok {
+			return nil, fmt.Errorf("unknown or read-only field in update mask: %q", path)
+		}
+
+		switch {
+		case path == "user_id" && journey.UserId == 0:
+			return nil, errors.New("user_id must be greater than 0")
+		case path == "start_time" && journey.StartTime == nil:
+			return nil, errors.New("start_time is required when listed in update mask")
+		case path == "end_time" && journey.EndTime == nil:
+			return nil, errors.New("end_time is required when listed in update mask")
+		}
+		fields = append(fields, pathFields...)
+	}
+
+	return fields, nil
+}
+
+// applyJourneyFields - copies values of fields from src to dst
+func applyJourneyFields(dst *models.Journey, src models.Journey, fields []repo.JourneyField) {
+	for _, field := range fields {
+		switch field {
+		case repo.JourneyFieldUserID:
+			dst.UserID = src.UserID
+		case repo.JourneyFieldAddress:
+			dst.Address = src.Address
+		case repo.JourneyFieldDescription:
+			dst.Description = src.Description
+		case repo.JourneyFieldStartTime:
+			dst.StartTime = src.StartTime
+		case repo.JourneyFieldEndTime:
+			dst.EndTime = src.EndTime
+		case repo.JourneyFieldTimeZone:
+			dst.TimeZone = src.TimeZone
+		case repo.JourneyFieldLatitude, repo.JourneyFieldLongitude:
+			dst.Coordinates = src.Coordinates
+		case repo.JourneyFieldTags:
+			dst.Tags = src.Tags
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"context"
+
+	"github.com/ozonva/ova-journey-api/internal/auth"
+)
+
+// actingUser - returns context of request authenticated as user, requests with context.Background() are internal
+// and are not restricted to journeys of any user
+func actingUser(userID uint64) context.Context {
+	return auth.WithUserID(context.Background(), userID)
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// idempotent - calls fn once for idempotency key of method and acting user and stores response filled by fn for idempotencyTTL,
+// so keys of different users do not intersect.
+// Request with stored key gets stored response without calling fn, request with the same key and another payload
+// gets apperrors.AlreadyExists error. Empty key means request is not idempotent and fn is always called.
+func (api *JourneyAPI) idempotent(ctx context.Context, method, key string, req, resp proto.Message, fn func() error) error {
+	if key == "" {
+		return fn()
+	}
+
+	requestHash, err := hashRequest(req)
+	if err != nil {
+		return err
+	}
+
+	userID := actingUserID(ctx)
+	stored, reserved, err := api.idempotencyRepo.ReserveIdempotencyKey(ctx, models.IdempotencyKey{
+		UserID:      userID,
+		Method:      method,
+		Key:         key,
+		RequestHash: requestHash,
+		ExpiresAt:   time.Now().Add(api.idempotencyTTL),
+	})
+	if err != nil {
+		return err
+	}
+	if !reserved {
+		switch {
+		case stored.RequestHash != requestHash:
+			return apperrors.New(apperrors.AlreadyExists, "idempotency key %q is used by another request", key)
+		case stored.IsPending():
+			return apperrors.New(apperrors.Conflict, "request with idempotency key %q is in progress", key)
+		}
+		log.Debug().Str("idempotencyKey", key).Msg(method + ": replayed.")
+		return proto.Unmarshal(stored.Response, resp)
+	}
+
+	// key is completed or released even if request is cancelled by client, otherwise client retrying request
+	// after timeout gets conflict until the key is abandoned
+	if err := fn(); err != nil {
+		if releaseErr := api.idempotencyRepo.ReleaseIdempotencyKey(context.Background(), userID, method, key); releaseErr != nil {
+			log.Error().Err(releaseErr).Str("idempotencyKey", key).Msg(method + ": failed to release idempotency key.")
+		}
+		return err
+	}
+
+	response, err := proto.Marshal(resp)
+	if err == nil {
+		if response == nil {
+			// nil response means pending key
+			response = []byte{}
+		}
+		err = api.idempotencyRepo.CompleteIdempotencyKey(context.Background(), userID, method, key, response)
+	}
+	if err != nil {
+		// request is done, so its response is returned anyway
+		log.Error().Err(err).Str("idempotencyKey", key).Msg(method + ": failed to save idempotency key.")
+	}
+	return nil
+}
+
+// hashRequest - returns hex encoded SHA-256 hash of deterministic serialization of request
+func hashRequest(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
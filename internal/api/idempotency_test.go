@@ -0,0 +1,212 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/auth"
+	"github.com/ozonva/ova-journey-api/internal/kafka"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("Idempotency", func() {
+	var (
+		ctrl                *gomock.Controller
+		mockRepo            *mocks.MockRepo
+		mockOpRepo          *mocks.MockOperationRepo
+		mockIdempotencyRepo *mocks.MockIdempotencyRepo
+		mockProducer        *mocks.MockProducer
+		mockMetrics         *mocks.MockMetrics
+		hub                 watch.Hub
+		api                 desc.JourneyApiV1Server
+		ctx                 context.Context
+
+		key       = "retry-key"
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		journey   = models.Journey{UserID: 1, Address: "Воронеж", StartTime: timeStart, EndTime: timeStart.Add(time.Hour)}
+		errRepo   = errors.New("repo error")
+	)
+
+	createRequest := func(address string) *desc.CreateJourneyRequestV1 {
+		return &desc.CreateJourneyRequestV1{
+			UserId:         journey.UserID,
+			Address:        address,
+			StartTime:      timestamppb.New(journey.StartTime),
+			EndTime:        timestamppb.New(journey.EndTime),
+			IdempotencyKey: key,
+		}
+	}
+
+	storedKey := func(req proto.Message, resp proto.Message) models.IdempotencyKey {
+		requestHash, err := hashRequest(req)
+		Expect(err).Should(BeNil())
+		stored := models.IdempotencyKey{Method: "CreateJourneyV1", Key: key, RequestHash: requestHash}
+		if resp != nil {
+			stored.Response, err = proto.Marshal(resp)
+			Expect(err).Should(BeNil())
+		}
+		return stored
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockOpRepo = mocks.NewMockOperationRepo(ctrl)
+		mockIdempotencyRepo = mocks.NewMockIdempotencyRepo(ctrl)
+		mockProducer = mocks.NewMockProducer(ctrl)
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		hub = watch.NewHub(10, 10)
+		ctx = context.Background()
+		api = NewJourneyAPI(Options{
+			Repo:            mockRepo,
+			OperationRepo:   mockOpRepo,
+			IdempotencyRepo: mockIdempotencyRepo,
+			Producer:        mockProducer,
+			Metrics:         mockMetrics,
+			Hub:             hub,
+			ChunkSize:       2,
+			IdempotencyTTL:  time.Hour,
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("CreateJourneyV1", func() {
+		It("should create journey and store response by new key of acting user", func() {
+			ctx = auth.WithUserID(ctx, journey.UserID)
+			req := createRequest(journey.Address)
+			resp, _ := proto.Marshal(&desc.CreateJourneyResponseV1{JourneyId: 5})
+
+			gomock.InOrder(
+				mockIdempotencyRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, reserved models.IdempotencyKey) (models.IdempotencyKey, bool, error) {
+						Expect(reserved.UserID).Should(Equal(journey.UserID))
+						Expect(reserved.Method).Should(Equal("CreateJourneyV1"))
+						Expect(reserved.Key).Should(Equal(key))
+						Expect(reserved.ExpiresAt).Should(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+						return reserved, true, nil
+					}),
+				mockRepo.EXPECT().AddJourney(ctx, journey).Return(uint64(5), nil),
+				mockIdempotencyRepo.EXPECT().CompleteIdempotencyKey(gomock.Any(), journey.UserID, "CreateJourneyV1", key, resp).Return(nil),
+			)
+			mockMetrics.EXPECT().CreateJourneyCounterInc().Times(1)
+
+			result, err := api.CreateJourneyV1(ctx, req)
+
+			Expect(err).Should(BeNil())
+			Expect(result.JourneyId).Should(Equal(uint64(5)))
+		})
+
+		It("should return stored response on replay without creating journey", func() {
+			req := createRequest(journey.Address)
+			mockIdempotencyRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).
+				Return(storedKey(req, &desc.CreateJourneyResponseV1{JourneyId: 5}), false, nil).Times(1)
+			mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Times(0)
+			mockMetrics.EXPECT().CreateJourneyCounterInc().Times(1)
+
+			result, err := api.CreateJourneyV1(ctx, req)
+
+			Expect(err).Should(BeNil())
+			Expect(result.JourneyId).Should(Equal(uint64(5)))
+		})
+
+		It("should return AlreadyExists error if key is used with another payload", func() {
+			stored := storedKey(createRequest("Уфа"), &desc.CreateJourneyResponseV1{JourneyId: 5})
+			mockIdempotencyRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(stored, false, nil).Times(1)
+			mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.CreateJourneyV1(ctx, createRequest(journey.Address))
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+		})
+
+		It("should return Aborted error if request with the key is in progress", func() {
+			req := createRequest(journey.Address)
+			mockIdempotencyRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(storedKey(req, nil), false, nil).Times(1)
+			mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.CreateJourneyV1(ctx, req)
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.Aborted))
+		})
+
+		It("should release key if journey is not created", func() {
+			gomock.InOrder(
+				mockIdempotencyRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(models.IdempotencyKey{}, true, nil),
+				mockRepo.EXPECT().AddJourney(ctx, journey).Return(uint64(0), errRepo),
+				mockIdempotencyRepo.EXPECT().ReleaseIdempotencyKey(gomock.Any(), uint64(0), "CreateJourneyV1", key).Return(nil),
+			)
+
+			result, err := api.CreateJourneyV1(ctx, createRequest(journey.Address))
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.Internal))
+		})
+
+		It("should not use idempotency repo without key", func() {
+			req := createRequest(journey.Address)
+			req.IdempotencyKey = ""
+			mockIdempotencyRepo.EXPECT().ReserveIdempotencyKey(gomock.Any(), gomock.Any()).Times(0)
+			mockRepo.EXPECT().AddJourney(ctx, journey).Return(uint64(5), nil).Times(1)
+			mockMetrics.EXPECT().CreateJourneyCounterInc().Times(1)
+
+			result, err := api.CreateJourneyV1(ctx, req)
+
+			Expect(err).Should(BeNil())
+			Expect(result.JourneyId).Should(Equal(uint64(5)))
+		})
+	})
+
+	Context("CreateJourneyTaskV1", func() {
+		It("should send journey to producer once for the same key", func() {
+			req := &desc.CreateJourneyTaskRequestV1{
+				UserId:         journey.UserID,
+				Address:        journey.Address,
+				StartTime:      timestamppb.New(journey.StartTime),
+				EndTime:        timestamppb.New(journey.EndTime),
+				IdempotencyKey: key,
+			}
+			resp, _ := proto.Marshal(&desc.CreateJourneyTaskResponseV1{OperationId: 7})
+			requestHash, _ := hashRequest(req)
+			stored := models.IdempotencyKey{Method: "CreateJourneyTaskV1", Key: key, RequestHash: requestHash, Response: resp}
+
+			gomock.InOrder(
+				mockIdempotencyRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(models.IdempotencyKey{}, true, nil),
+				mockOpRepo.EXPECT().AddOperation(ctx, models.Operation{Type: models.CreateOperation, PendingChunks: 1}).Return(uint64(7), nil),
+				mockProducer.EXPECT().Send(kafka.Message{
+					MessageType:     kafka.CreateJourney,
+					OperationID:     7,
+					OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow},
+					Value:           journey,
+				}).Return(nil),
+				mockIdempotencyRepo.EXPECT().CompleteIdempotencyKey(gomock.Any(), uint64(0), "CreateJourneyTaskV1", key, resp).Return(nil),
+				mockIdempotencyRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(stored, false, nil),
+			)
+			mockMetrics.EXPECT().CreateJourneyCounterInc().Times(2)
+
+			first, err := api.CreateJourneyTaskV1(ctx, req)
+			Expect(err).Should(BeNil())
+			replayed, err := api.CreateJourneyTaskV1(ctx, req)
+			Expect(err).Should(BeNil())
+
+			Expect(first.OperationId).Should(Equal(uint64(7)))
+			Expect(replayed.OperationId).Should(Equal(uint64(7)))
+		})
+	})
+})
@@ -3,35 +3,78 @@ package api
 import (
 	"context"
 	"github.com/opentracing/opentracing-go"
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/auth"
 	"github.com/ozonva/ova-journey-api/internal/kafka"
 	"github.com/ozonva/ova-journey-api/internal/metrics"
 	"github.com/ozonva/ova-journey-api/internal/models"
 	"github.com/ozonva/ova-journey-api/internal/repo"
 	"github.com/ozonva/ova-journey-api/internal/utils"
+	"github.com/ozonva/ova-journey-api/internal/watch"
 	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
 // JourneyAPI - gRPC API implementation for working with journeys
 type JourneyAPI struct {
 	desc.UnimplementedJourneyApiV1Server
-	repo      repo.Repo
-	producer  kafka.Producer
-	metric    metrics.Metrics
-	chunkSize int
+	repo            repo.Repo
+	operationRepo   repo.OperationRepo
+	idempotencyRepo repo.IdempotencyRepo
+	producer        kafka.Producer
+	metric          metrics.Metrics
+	hub             watch.Hub
+	chunkSize       int
+	idempotencyTTL  time.Duration
+	overlapPolicy   models.OverlapPolicy
+	admins          map[uint64]bool
+	authenticator   *auth.Authenticator
 }
 
-// NewJourneyAPI returns JourneyAPI
-func NewJourneyAPI(repo repo.Repo, producer kafka.Producer, metric metrics.Metrics, chunkSize int) desc.JourneyApiV1Server {
+// Options - dependencies and settings of JourneyAPI, new ones are added here without changing NewJourneyAPI
+type Options struct {
+	Repo          repo.Repo
+	OperationRepo repo.OperationRepo
+	// IdempotencyRepo - storage of results of create requests with idempotency keys, they are stored for IdempotencyTTL
+	IdempotencyRepo repo.IdempotencyRepo
+	IdempotencyTTL  time.Duration
+	Producer        kafka.Producer
+	Metrics         metrics.Metrics
+	// Hub - changes of journeys are published to it
+	Hub watch.Hub
+	// ChunkSize - size of chunks of multi requests
+	ChunkSize int
+	// OverlapPolicy - used for create and update requests without their own policy
+	OverlapPolicy models.OverlapPolicy
+	// AdminUserIDs - users who can read data of all users
+	AdminUserIDs []uint64
+	// Authenticator - issues tokens of calendar feeds, feeds have no tokens if authentication is disabled
+	Authenticator *auth.Authenticator
+}
+
+// NewJourneyAPI returns JourneyAPI with dependencies and settings of options
+func NewJourneyAPI(options Options) desc.JourneyApiV1Server {
+	admins := make(map[uint64]bool, len(options.AdminUserIDs))
+	for _, userID := range options.AdminUserIDs {
+		admins[userID] = true
+	}
 	return &JourneyAPI{
-		repo:      repo,
-		producer:  producer,
-		chunkSize: chunkSize,
-		metric:    metric,
+		repo:            options.Repo,
+		operationRepo:   options.OperationRepo,
+		idempotencyRepo: options.IdempotencyRepo,
+		producer:        options.Producer,
+		chunkSize:       options.ChunkSize,
+		metric:          options.Metrics,
+		hub:             options.Hub,
+		idempotencyTTL:  options.IdempotencyTTL,
+		overlapPolicy:   options.OverlapPolicy,
+		admins:          admins,
+		authenticator:   options.Authenticator,
 	}
 }
 
@@ -42,211 +85,550 @@ func (api *JourneyAPI) CreateJourneyV1(ctx context.Context, req *desc.CreateJour
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	journey := models.Journey{
-		UserID:      req.UserId,
-		Address:     req.Address,
-		Description: req.Description,
-		StartTime:   req.StartTime.AsTime(),
-		EndTime:     req.EndTime.AsTime(),
+	journey := journeyFromCreateRequest(req)
+	if err := journey.Validate(); err != nil {
+		log.Error().Err(err).Str("journey", journey.String()).Msg("CreateJourneyV1: invalid request.")
+		return nil, toStatusError(err)
+	}
+	if err := checkActingUser(ctx, journey.UserID); err != nil {
+		log.Error().Err(err).Uint64("userId", journey.UserID).Msg("CreateJourneyV1: failed.")
+		return nil, toStatusError(err)
 	}
 
-	journeyID, err := api.repo.AddJourney(ctx, journey)
+	resp := &desc.CreateJourneyResponseV1{}
+	err := api.idempotent(ctx, "CreateJourneyV1", req.IdempotencyKey, req, resp, func() error {
+		overlapping, err := api.saveWithOverlapPolicy(ctx, journey, api.requestOverlapPolicy(req.OverlapPolicy), func(r repo.Repo) error {
+			journeyID, err := r.AddJourney(ctx, journey)
+			journey.JourneyID = journeyID
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		resp.JourneyId = journey.JourneyID
+		resp.OverlappingJourneyIds = overlapping
+		api.hub.Publish(watch.Created(journey))
+		return nil
+	})
 	if err != nil {
 		log.Error().Err(err).Str("journey", journey.String()).Msg("CreateJourneyV1: failed.")
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, toStatusError(err)
 	}
 
 	log.Debug().Str("journey", journey.String()).Msg("CreateJourneyV1: success.")
 	api.metric.CreateJourneyCounterInc()
 
-	return &desc.CreateJourneyResponseV1{JourneyId: journeyID}, nil
+	return resp, nil
 }
 
-// MultiCreateJourneyV1 - create new journeys using chunks and return added journeys ids.
-// If there is error for any chunk returns already added ids and error.
+// MultiCreateJourneyV1 - create new journeys using chunks, every journey is checked by its overlap policy.
+// In atomic mode all chunks are added in one transaction and any error (including rejected overlap) fails the whole request.
+// Otherwise invalid journeys, rejected journeys and journeys of failed chunks are skipped and response contains result
+// for every journey.
 func (api *JourneyAPI) MultiCreateJourneyV1(ctx context.Context, req *desc.MultiCreateJourneyRequestV1) (*desc.MultiCreateJourneyResponseV1, error) {
 	if err := req.Validate(); err != nil {
 		log.Error().Err(err).Msg("MultiCreateJourneyV1: invalid request.")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if api.chunkSize < 1 {
+		log.Error().Err(utils.ErrIncorrectChunkSize).Msg("MultiCreateJourneyV1: failed.")
+		return nil, toStatusError(utils.ErrIncorrectChunkSize)
+	}
 
 	tracer := opentracing.GlobalTracer()
 	span := tracer.StartSpan("MultiCreateJourneyV1")
 	defer span.Finish()
 
-	journeys := make([]models.Journey, len(req.Journeys))
+	resp := &desc.MultiCreateJourneyResponseV1{}
+	err := api.idempotent(ctx, "MultiCreateJourneyV1", req.IdempotencyKey, req, resp, func() error {
+		if req.Atomic {
+			return api.multiCreateJourneyAtomic(ctx, req, resp, span)
+		}
+
+		batch := newJourneyBatch(api.repo, api.hub, api.chunkSize, "MultiCreateJourneyV1", span)
+		for _, reqJourney := range req.Journeys {
+			if err := checkActingUser(ctx, reqJourney.UserId); err != nil {
+				batch.skip(err)
+				continue
+			}
+			batch.add(ctx, journeyFromCreateRequest(reqJourney), api.requestOverlapPolicy(reqJourney.OverlapPolicy))
+		}
+		batch.flush(ctx)
+		resp.JourneyIds, resp.Results = batch.journeyIDs(), batch.results
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Bool("atomic", req.Atomic).Msg("MultiCreateJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Int("created", len(resp.JourneyIds)).Bool("atomic", req.Atomic).Msg("MultiCreateJourneyV1: success.")
+	api.metric.MultiCreateJourneyCounterInc()
+
+	return resp, nil
+}
 
+// multiCreateJourneyAtomic - create all journeys of request in one transaction and fill resp,
+// journeys of users are locked once for overlap checks of all journeys
+func (api *JourneyAPI) multiCreateJourneyAtomic(
+	ctx context.Context,
+	req *desc.MultiCreateJourneyRequestV1,
+	resp *desc.MultiCreateJourneyResponseV1,
+	span opentracing.Span,
+) error {
+	journeys := make([]models.Journey, len(req.Journeys))
 	for i, reqJourney := range req.Journeys {
-		journeys[i] = models.Journey{
-			UserID:      reqJourney.UserId,
-			Address:     reqJourney.Address,
-			Description: reqJourney.Description,
-			StartTime:   reqJourney.StartTime.AsTime(),
-			EndTime:     reqJourney.EndTime.AsTime(),
+		journeys[i] = journeyFromCreateRequest(reqJourney)
+		if err := journeys[i].Validate(); err != nil {
+			err = apperrors.Wrap(apperrors.InvalidArgument, err, "journeys[%d]", i)
+			log.Error().Err(err).Msg("MultiCreateJourneyV1: invalid request.")
+			return err
+		}
+		if err := checkActingUser(ctx, journeys[i].UserID); err != nil {
+			err = apperrors.Wrap(apperrors.KindOf(err), err, "journeys[%d]", i)
+			log.Error().Err(err).Msg("MultiCreateJourneyV1: failed.")
+			return err
 		}
 	}
 
 	journeysChunks, err := utils.SplitToChunks(journeys, api.chunkSize)
 	if err != nil {
 		log.Error().Err(err).Msg("MultiCreateJourneyV1: failed.")
-		return nil, status.Error(codes.Internal, err.Error())
+		return err
 	}
 
-	resp := &desc.MultiCreateJourneyResponseV1{}
-	for _, chunk := range journeysChunks {
-		ids, err := api.repo.MultiAddJourneys(ctx, chunk)
-		if err != nil {
-			log.Error().Err(err).Msg("MultiCreateJourneyV1: failed.")
-			return resp, status.Error(codes.Internal, err.Error())
+	policies := api.requestOverlapPolicies(req.Journeys)
+	var checks []repo.OverlapCheck
+	journeyIDs := make([]uint64, 0, len(journeys))
+	events := make([]watch.Event, 0, len(journeys))
+	err = api.repo.WithTx(ctx, func(tx repo.Repo) error {
+		var err error
+		if checks, err = repo.CheckOverlaps(ctx, tx, journeys, policies); err != nil {
+			return err
+		}
+		for i, check := range checks {
+			if check.Err != nil {
+				return apperrors.Wrap(apperrors.KindOf(check.Err), check.Err, "journeys[%d]", i)
+			}
 		}
-		resp.JourneyIds = append(resp.JourneyIds, ids...)
 
-		childSpan := tracer.StartSpan(
-			"MultiCreateJourneyV1: chunk",
-			opentracing.Tag{Key: "chunkSize", Value: len(chunk)},
-			opentracing.ChildOf(span.Context()),
-		)
-		childSpan.Finish()
+		for _, chunk := range journeysChunks {
+			ids, err := tx.MultiAddJourneys(ctx, chunk)
+			if err != nil {
+				return err
+			}
+			journeyIDs = append(journeyIDs, ids...)
+			for i, journey := range chunk {
+				journey.JourneyID = ids[i]
+				events = append(events, watch.Created(journey))
+			}
+
+			childSpan := opentracing.GlobalTracer().StartSpan(
+				"MultiCreateJourneyV1: chunk",
+				opentracing.Tag{Key: "chunkSize", Value: len(chunk)},
+				opentracing.ChildOf(span.Context()),
+			)
+			childSpan.Finish()
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("MultiCreateJourneyV1: failed, transaction is rolled back.")
+		return err
 	}
-
-	log.Debug().Msg("MultiCreateJourneyV1: success.")
-	api.metric.MultiCreateJourneyCounterInc()
-
-	return resp, nil
+	api.hub.Publish(events...)
+
+	resp.JourneyIds = journeyIDs
+	resp.Results = make([]*desc.JourneyResultV1, len(journeyIDs))
+	for i, journeyID := range journeyIDs {
+		resp.Results[i] = &desc.JourneyResultV1{
+			Index:                 uint64(i),
+			JourneyId:             journeyID,
+			OverlappingJourneyIds: checks[i].Overlapping(journeyIDs),
+		}
+	}
+	return nil
 }
 
-// DescribeJourneyV1 - get journey description by journeyID
+// DescribeJourneyV1 - get journey description by journeyID, any participant can get it
 func (api *JourneyAPI) DescribeJourneyV1(ctx context.Context, req *desc.DescribeJourneyRequestV1) (*desc.DescribeJourneyResponseV1, error) {
 	if err := req.Validate(); err != nil {
 		log.Error().Err(err).Msg("DescribeJourneyV1: invalid request.")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if _, err := api.checkJourneyAccess(ctx, req.JourneyId, models.RoleViewer); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("DescribeJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
 	journey, err := api.repo.DescribeJourney(ctx, req.JourneyId)
 	if err != nil {
 		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("DescribeJourneyV1: failed.")
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, toStatusError(err)
 	}
 
 	log.Debug().Uint64("journeyId", req.JourneyId).Msg("DescribeJourneyV1: success.")
 	return &desc.DescribeJourneyResponseV1{
-		Journey: &desc.Journey{
-			JourneyId:   journey.JourneyID,
-			UserId:      journey.UserID,
-			Address:     journey.Address,
-			Description: journey.Description,
-			StartTime:   timestamppb.New(journey.StartTime),
-			EndTime:     timestamppb.New(journey.EndTime),
-		},
+		Journey: journeyToProto(*journey),
 	}, nil
 }
 
-// ListJourneysV1 - get list of journey with offset and limit
+// ListJourneysV1 - get list of journey with offset and limit or with page token from previous response.
+// Journeys can be filtered by users (including journeys shared with them), overlapping time range,
+// substring of address or description, tags and statuses. Acting user can list only own and shared journeys.
+// Response contains next page token if page is full. The first page with time range also contains occurrences
+// of recurring journeys of users overlapping it.
 func (api *JourneyAPI) ListJourneysV1(ctx context.Context, req *desc.ListJourneysRequestV1) (*desc.ListJourneysResponseV1, error) {
 	if err := req.Validate(); err != nil {
 		log.Error().Err(err).Msg("ListJourneysV1: invalid request.")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	journeys, err := api.repo.ListJourneys(ctx, req.Limit, req.Offset)
+	filter, err := journeyFilterFromRequest(req)
+	if err != nil {
+		log.Error().Err(err).Msg("ListJourneysV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if filter.UserIDs, err = scopeUserIDs(ctx, filter.UserIDs); err != nil {
+		log.Error().Err(err).Uints64("userIds", req.UserIds).Msg("ListJourneysV1: failed.")
+		return nil, toStatusError(err)
+	}
+	filter.Participating = len(filter.UserIDs) > 0
+	filter.AnyTags = models.NormalizeTags(req.AnyTags)
+	filter.AllTags = models.NormalizeTags(req.AllTags)
+	for _, journeyStatus := range req.Statuses {
+		filter.Statuses = append(filter.Statuses, journeyStatusFromProto(journeyStatus))
+	}
+
+	var journeys []models.Journey
+	if req.PageToken != "" {
+		if req.Offset > 0 {
+			log.Error().Msg("ListJourneysV1: invalid request, page token cannot be used with offset.")
+			return nil, status.Error(codes.InvalidArgument, "page token cannot be used with offset")
+		}
+
+		token, err := decodePageToken(req.PageToken)
+		if err == nil && token.FilterHash != journeyFilterHash(filter) {
+			err = errPageTokenFilterMismatch
+		}
+		if err != nil {
+			log.Error().Err(err).Str("pageToken", req.PageToken).Msg("ListJourneysV1: invalid request.")
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		journeys, err = api.repo.ListJourneysAfter(ctx, filter, token.LastJourneyID, req.Limit)
+		if err != nil {
+			log.Error().Err(err).Str("pageToken", req.PageToken).Uint64("limit", req.Limit).Interface("filter", filter).Msg("ListJourneysV1: failed.")
+			return nil, toStatusError(err)
+		}
+	} else {
+		journeys, err = api.repo.ListJourneys(ctx, filter, req.Limit, req.Offset)
+		if err != nil {
+			log.Error().Err(err).Uint64("offset", req.Offset).Uint64("limit", req.Limit).Interface("filter", filter).Msg("ListJourneysV1: failed.")
+			return nil, toStatusError(err)
+		}
+	}
+
+	occurrences, err := api.listOccurrences(ctx, req, filter)
 	if err != nil {
-		log.Error().Err(err).Uint64("offset", req.Offset).Uint64("limit", req.Limit).Msg("ListJourneysV1: failed.")
-		return nil, status.Error(codes.Internal, err.Error())
+		log.Error().Err(err).Interface("filter", filter).Msg("ListJourneysV1: failed.")
+		return nil, toStatusError(err)
 	}
 
 	resp := &desc.ListJourneysResponseV1{Journeys: make([]*desc.Journey, len(journeys))}
 	for i, journey := range journeys {
-		resp.Journeys[i] = &desc.Journey{
-			JourneyId:   journey.JourneyID,
-			UserId:      journey.UserID,
-			Address:     journey.Address,
-			Description: journey.Description,
-			StartTime:   timestamppb.New(journey.StartTime),
-			EndTime:     timestamppb.New(journey.EndTime),
-		}
+		resp.Journeys[i] = journeyToProto(journey)
+	}
+	for _, occurrence := range occurrences {
+		resp.Occurrences = append(resp.Occurrences, journeyToProto(occurrence))
+	}
+	if len(journeys) > 0 && uint64(len(journeys)) == req.Limit {
+		resp.NextPageToken = encodePageToken(pageToken{
+			LastJourneyID: journeys[len(journeys)-1].JourneyID,
+			FilterHash:    journeyFilterHash(filter),
+		})
 	}
 
 	log.Debug().Uint64("offset", req.Offset).Uint64("limit", req.Limit).Msg("ListJourneysV1: success.")
 	return resp, nil
 }
 
-// RemoveJourneyV1 - remove journey
+// RemoveJourneyV1 - remove journey, if expected revision is set (in request or If-Match metadata)
+// journey is removed only if it was not changed since this revision. Only owner can remove journey
 func (api *JourneyAPI) RemoveJourneyV1(ctx context.Context, req *desc.RemoveJourneyRequestV1) (*emptypb.Empty, error) {
 	if err := req.Validate(); err != nil {
 		log.Error().Err(err).Msg("RemoveJourneyV1: invalid request.")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := api.repo.RemoveJourney(ctx, req.JourneyId); err != nil {
+	revision, err := expectedRevision(ctx, req.ExpectedRevision)
+	if err != nil {
+		log.Error().Err(err).Msg("RemoveJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	access, err := api.checkJourneyAccess(ctx, req.JourneyId, models.RoleOwner)
+	if err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("RemoveJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+	ownerID, err := api.journeyOwner(ctx, req.JourneyId, access)
+	if err != nil {
 		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("RemoveJourneyV1: failed.")
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, toStatusError(err)
+	}
+
+	if err := api.repo.RemoveJourney(ctx, req.JourneyId, revision); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Uint64("expectedRevision", revision).Msg("RemoveJourneyV1: failed.")
+		return nil, toStatusError(err)
 	}
 
 	log.Debug().Uint64("journeyId", req.JourneyId).Msg("RemoveJourneyV1: success.")
 	api.metric.DeleteJourneyCounterInc()
+	api.hub.Publish(watch.Load(ctx, api.repo, watch.Deleted(req.JourneyId, ownerID))...)
 
 	return &emptypb.Empty{}, nil
 }
 
-// UpdateJourneyV1 - find journey by id and update another fields
-func (api *JourneyAPI) UpdateJourneyV1(ctx context.Context, req *desc.UpdateJourneyRequestV1) (*emptypb.Empty, error) {
+// UpdateJourneyV1 - find journey by id and update another fields, returns new revision of journey.
+// If expected revision is set (in request or If-Match metadata) journey is updated only if it was not changed since this revision.
+// Editor role is required, only owner can change user of journey
+func (api *JourneyAPI) UpdateJourneyV1(ctx context.Context, req *desc.UpdateJourneyRequestV1) (*desc.UpdateJourneyResponseV1, error) {
 	if err := req.Validate(); err != nil {
 		log.Error().Err(err).Msg("UpdateJourneyV1: invalid request.")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	journey := models.Journey{
-		JourneyID:   req.Journey.JourneyId,
-		UserID:      req.Journey.UserId,
-		Address:     req.Journey.Address,
-		Description: req.Journey.Description,
-		StartTime:   req.Journey.StartTime.AsTime(),
-		EndTime:     req.Journey.EndTime.AsTime(),
+	if err := checkNoWaypoints(req.Journey); err != nil {
+		log.Error().Err(err).Msg("UpdateJourneyV1: invalid request.")
+		return nil, toStatusError(err)
 	}
-	if err := api.repo.UpdateJourney(ctx, journey); err != nil {
-		log.Error().Err(err).Str("journey", req.Journey.String()).Msg("UpdateJourneyV1: failed.")
-		return nil, status.Error(codes.Internal, err.Error())
+
+	revision, err := expectedRevision(ctx, req.ExpectedRevision)
+	if err != nil {
+		log.Error().Err(err).Msg("UpdateJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	log.Debug().Uint64("journeyId", req.Journey.JourneyId).Msg("UpdateJourneyV1: success.")
+	journey := journeyFromProto(req.Journey)
+	journey.Revision = revision
+	if err := journey.Validate(); err != nil {
+		log.Error().Err(err).Str("journey", journey.String()).Msg("UpdateJourneyV1: invalid request.")
+		return nil, toStatusError(err)
+	}
+
+	if err := api.checkJourneyUpdate(ctx, journey); err != nil {
+		log.Error().Err(err).Uint64("journeyId", journey.JourneyID).Msg("UpdateJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	var newRevision uint64
+	overlapping, err := api.saveWithOverlapPolicy(ctx, journey, api.requestOverlapPolicy(req.OverlapPolicy), func(r repo.Repo) error {
+		newRevision, err = r.UpdateJourney(ctx, journey)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Str("journey", req.Journey.String()).Uint64("expectedRevision", revision).Msg("UpdateJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("journeyId", req.Journey.JourneyId).Uint64("revision", newRevision).Msg("UpdateJourneyV1: success.")
 	api.metric.UpdateJourneyCounterInc()
+	api.publishJourneyChanged(ctx, journey.JourneyID)
 
-	return &emptypb.Empty{}, nil
+	return &desc.UpdateJourneyResponseV1{Revision: newRevision, OverlappingJourneyIds: overlapping}, nil
 }
 
-// CreateJourneyTaskV1 - create new journey using producer
-func (api *JourneyAPI) CreateJourneyTaskV1(ctx context.Context, req *desc.CreateJourneyTaskRequestV1) (*emptypb.Empty, error) {
+// PatchJourneyV1 - find journey by id and update only fields listed in update mask, returns new revision of journey.
+// Expected revision and permissions are checked in the same way as in UpdateJourneyV1
+func (api *JourneyAPI) PatchJourneyV1(ctx context.Context, req *desc.PatchJourneyRequestV1) (*desc.PatchJourneyResponseV1, error) {
 	if err := req.Validate(); err != nil {
-		log.Error().Err(err).Msg("CreateJourneyTaskV1: invalid request.")
+		log.Error().Err(err).Msg("PatchJourneyV1: invalid request.")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	journey := models.Journey{
-		UserID:      req.UserId,
-		Address:     req.Address,
-		Description: req.Description,
-		StartTime:   req.StartTime.AsTime(),
-		EndTime:     req.EndTime.AsTime(),
+	fields, err := journeyFieldsFromMask(req.UpdateMask, req.Journey)
+	if err != nil {
+		log.Error().Err(err).Strs("paths", req.UpdateMask.GetPaths()).Msg("PatchJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err := api.producer.Send(kafka.Message{
-		MessageType: kafka.CreateJourney,
-		Value:       journey,
+	revision, err := expectedRevision(ctx, req.ExpectedRevision)
+	if err != nil {
+		log.Error().Err(err).Msg("PatchJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	access, err := api.checkJourneyAccess(ctx, req.Journey.JourneyId, models.RoleEditor)
+	if err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.Journey.JourneyId).Msg("PatchJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	// current journey is needed to validate it with new values of fields,
+	// its revision guarantees that journey is not changed between validation and saving
+	journey, err := api.repo.DescribeJourney(ctx, req.Journey.JourneyId)
+	if err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.Journey.JourneyId).Msg("PatchJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+	if revision > 0 && journey.Revision != revision {
+		err = repo.ErrRevisionConflict
+		log.Error().Err(err).Uint64("journeyId", req.Journey.JourneyId).Uint64("expectedRevision", revision).Msg("PatchJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	applyJourneyFields(journey, journeyFromProto(req.Journey), fields)
+	if err := repo.CheckOwnerChange(access, journey.UserID); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.Journey.JourneyId).Msg("PatchJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+	if err := journey.Validate(); err != nil {
+		log.Error().Err(err).Str("journey", journey.String()).Msg("PatchJourneyV1: invalid request.")
+		return nil, toStatusError(err)
+	}
+
+	var newRevision uint64
+	overlapping, err := api.saveWithOverlapPolicy(ctx, *journey, api.requestOverlapPolicy(req.OverlapPolicy), func(r repo.Repo) error {
+		newRevision, err = r.PatchJourney(ctx, *journey, fields)
+		return err
 	})
+	if err != nil {
+		log.Error().Err(err).Str("journey", req.Journey.String()).Strs("paths", req.UpdateMask.GetPaths()).Uint64("expectedRevision", revision).Msg("PatchJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("journeyId", req.Journey.JourneyId).Strs("paths", req.UpdateMask.GetPaths()).Uint64("revision", newRevision).Msg("PatchJourneyV1: success.")
+	api.metric.UpdateJourneyCounterInc()
+	api.publishJourneyChanged(ctx, journey.JourneyID)
+
+	return &desc.PatchJourneyResponseV1{Revision: newRevision, OverlappingJourneyIds: overlapping}, nil
+}
 
+// RestoreJourneyV1 - restore removed journey if it is not purged yet, returns new revision of journey.
+// Only owner can restore journey
+func (api *JourneyAPI) RestoreJourneyV1(ctx context.Context, req *desc.RestoreJourneyRequestV1) (*desc.RestoreJourneyResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("RestoreJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ownerID, err := api.removedJourneyOwner(ctx, req.JourneyId)
 	if err != nil {
-		log.Error().Err(err).Str("journey", journey.String()).Msg("CreateJourneyTaskV1: failed.")
-		return nil, status.Error(codes.Internal, err.Error())
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("RestoreJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	revision, err := api.repo.RestoreJourney(ctx, req.JourneyId)
+	if err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("RestoreJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("journeyId", req.JourneyId).Uint64("revision", revision).Msg("RestoreJourneyV1: success.")
+	api.hub.Publish(watch.Load(ctx, api.repo, watch.Restored(req.JourneyId, ownerID))...)
+	return &desc.RestoreJourneyResponseV1{Revision: revision}, nil
+}
+
+// ListDeletedJourneysV1 - get list of removed journeys which are not purged yet with offset and limit,
+// only admins can list them
+func (api *JourneyAPI) ListDeletedJourneysV1(ctx context.Context, req *desc.ListDeletedJourneysRequestV1) (*desc.ListDeletedJourneysResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("ListDeletedJourneysV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := api.checkAdmin(ctx); err != nil {
+		log.Error().Err(err).Msg("ListDeletedJourneysV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	journeys, err := api.repo.ListDeletedJourneys(ctx, req.Limit, req.Offset)
+	if err != nil {
+		log.Error().Err(err).Uint64("offset", req.Offset).Uint64("limit", req.Limit).Msg("ListDeletedJourneysV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	resp := &desc.ListDeletedJourneysResponseV1{Journeys: make([]*desc.DeletedJourney, len(journeys))}
+	for i, journey := range journeys {
+		resp.Journeys[i] = &desc.DeletedJourney{
+			Journey:   journeyToProto(journey),
+			DeletedAt: timestamppb.New(journey.DeletedAt),
+		}
 	}
 
-	log.Debug().Str("journey", journey.String()).Msg("CreateJourneyTaskV1: success.")
+	log.Debug().Uint64("offset", req.Offset).Uint64("limit", req.Limit).Msg("ListDeletedJourneysV1: success.")
+	return resp, nil
+}
+
+// CreateJourneyTaskV1 - create new journey using producer and return id of operation for tracking
+func (api *JourneyAPI) CreateJourneyTaskV1(ctx context.Context, req *desc.CreateJourneyTaskRequestV1) (*desc.CreateJourneyTaskResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("CreateJourneyTaskV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	journey := journeyFromCreateTaskRequest(req)
+	if err := journey.Validate(); err != nil {
+		log.Error().Err(err).Str("journey", journey.String()).Msg("CreateJourneyTaskV1: invalid request.")
+		return nil, toStatusError(err)
+	}
+
+	userID := actingUserID(ctx)
+	if err := checkActingUser(ctx, journey.UserID); err != nil {
+		log.Error().Err(err).Uint64("userId", journey.UserID).Msg("CreateJourneyTaskV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	resp := &desc.CreateJourneyTaskResponseV1{}
+	err := api.idempotent(ctx, "CreateJourneyTaskV1", req.IdempotencyKey, req, resp, func() error {
+		operationID, err := api.createJourneyTask(ctx, userID, journey, api.requestOverlapPolicy(req.OverlapPolicy))
+		resp.OperationId = operationID
+		return err
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Str("journey", journey.String()).Uint64("operationId", resp.OperationId).Msg("CreateJourneyTaskV1: success.")
 	api.metric.CreateJourneyCounterInc()
 
-	return &emptypb.Empty{}, nil
+	return resp, nil
 }
 
-// MultiCreateJourneyTaskV1 - create new journeys using producer and splitting on chunks
-func (api *JourneyAPI) MultiCreateJourneyTaskV1(ctx context.Context, req *desc.MultiCreateJourneyTaskRequestV1) (*emptypb.Empty, error) {
+// createJourneyTask - creates operation and sends journey with acting user and overlap policy to producer,
+// returns id of operation
+func (api *JourneyAPI) createJourneyTask(ctx context.Context, userID uint64, journey models.Journey, policy models.OverlapPolicy) (uint64, error) {
+	operationID, err := api.operationRepo.AddOperation(ctx, models.Operation{
+		UserID:        userID,
+		Type:          models.CreateOperation,
+		PendingChunks: 1,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("journey", journey.String()).Msg("CreateJourneyTaskV1: failed to create operation.")
+		return 0, err
+	}
+
+	err = api.producer.Send(kafka.Message{
+		MessageType:     kafka.CreateJourney,
+		OperationID:     operationID,
+		UserID:          userID,
+		OverlapPolicies: []models.OverlapPolicy{policy},
+		Value:           journey,
+	})
+
+	if err != nil {
+		log.Error().Err(err).Str("journey", journey.String()).Msg("CreateJourneyTaskV1: failed.")
+		api.failOperation(ctx, operationID, err)
+		return 0, err
+	}
+	return operationID, nil
+}
+
+// MultiCreateJourneyTaskV1 - create new journeys using producer and splitting on chunks,
+// all chunks are tracked by one operation, chunk with journey rejected by its overlap policy fails the operation
+func (api *JourneyAPI) MultiCreateJourneyTaskV1(ctx context.Context, req *desc.MultiCreateJourneyTaskRequestV1) (*desc.MultiCreateJourneyTaskResponseV1, error) {
 	if err := req.Validate(); err != nil {
 		log.Error().Err(err).Msg("MultiCreateJourneyTaskV1: invalid request.")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -256,15 +638,20 @@ func (api *JourneyAPI) MultiCreateJourneyTaskV1(ctx context.Context, req *desc.M
 	span := tracer.StartSpan("MultiCreateJourneyTaskV1")
 	defer span.Finish()
 
+	userID := actingUserID(ctx)
 	journeys := make([]models.Journey, len(req.Journeys))
 
 	for i, reqJourney := range req.Journeys {
-		journeys[i] = models.Journey{
-			UserID:      reqJourney.UserId,
-			Address:     reqJourney.Address,
-			Description: reqJourney.Description,
-			StartTime:   reqJourney.StartTime.AsTime(),
-			EndTime:     reqJourney.EndTime.AsTime(),
+		journeys[i] = journeyFromCreateRequest(reqJourney)
+		if err := journeys[i].Validate(); err != nil {
+			err = apperrors.Wrap(apperrors.InvalidArgument, err, "journeys[%d]", i)
+			log.Error().Err(err).Msg("MultiCreateJourneyTaskV1: invalid request.")
+			return nil, toStatusError(err)
+		}
+		if err := checkActingUser(ctx, journeys[i].UserID); err != nil {
+			err = apperrors.Wrap(apperrors.KindOf(err), err, "journeys[%d]", i)
+			log.Error().Err(err).Msg("MultiCreateJourneyTaskV1: failed.")
+			return nil, toStatusError(err)
 		}
 	}
 
@@ -272,82 +659,223 @@ func (api *JourneyAPI) MultiCreateJourneyTaskV1(ctx context.Context, req *desc.M
 	if err != nil {
 		if err != nil {
 			log.Error().Err(err).Msg("MultiCreateJourneyTaskV1: failed.")
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, toStatusError(err)
 		}
 	}
 
+	resp := &desc.MultiCreateJourneyTaskResponseV1{}
+	err = api.idempotent(ctx, "MultiCreateJourneyTaskV1", req.IdempotencyKey, req, resp, func() error {
+		operationID, err := api.multiCreateJourneyTask(ctx, userID, journeysChunks, api.requestOverlapPolicies(req.Journeys), span)
+		resp.OperationId = operationID
+		return err
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("operationId", resp.OperationId).Msg("MultiCreateJourneyTaskV1: success send to producer.")
+	api.metric.MultiCreateJourneyCounterInc()
+
+	return resp, nil
+}
+
+// multiCreateJourneyTask - creates operation and sends chunks of journeys with acting user and overlap policies
+// of journeys to producer, returns id of operation
+func (api *JourneyAPI) multiCreateJourneyTask(
+	ctx context.Context,
+	userID uint64,
+	journeysChunks [][]models.Journey,
+	policies []models.OverlapPolicy,
+	span opentracing.Span,
+) (uint64, error) {
+	operationID, err := api.operationRepo.AddOperation(ctx, models.Operation{
+		UserID:        userID,
+		Type:          models.MultiCreateOperation,
+		PendingChunks: uint(len(journeysChunks)),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("MultiCreateJourneyTaskV1: failed to create operation.")
+		return 0, err
+	}
+
 	for _, chunk := range journeysChunks {
 		err = api.producer.Send(kafka.Message{
-			MessageType: kafka.MultiCreateJourney,
-			Value:       chunk,
+			MessageType:     kafka.MultiCreateJourney,
+			OperationID:     operationID,
+			UserID:          userID,
+			OverlapPolicies: policies[:len(chunk)],
+			Value:           chunk,
 		})
+		policies = policies[len(chunk):]
 		if err != nil {
 			log.Error().Err(err).Msg("MultiCreateJourneyTaskV1: failed.")
-			return nil, status.Error(codes.Internal, err.Error())
+			api.failOperation(ctx, operationID, err)
+			return 0, err
 		}
 
-		childSpan := tracer.StartSpan(
+		childSpan := opentracing.GlobalTracer().StartSpan(
 			"MultiCreateJourneyTaskV1: chunk",
 			opentracing.Tag{Key: "chunkSize", Value: len(chunk)},
 			opentracing.ChildOf(span.Context()),
 		)
 		childSpan.Finish()
 	}
-
-	log.Debug().Msg("MultiCreateJourneyTaskV1: success send to producer.")
-	api.metric.MultiCreateJourneyCounterInc()
-
-	return &emptypb.Empty{}, nil
+	return operationID, nil
 }
 
-// RemoveJourneyTaskV1 - remove journey using producer
-func (api *JourneyAPI) RemoveJourneyTaskV1(ctx context.Context, req *desc.RemoveJourneyTaskRequestV1) (*emptypb.Empty, error) {
+// RemoveJourneyTaskV1 - remove journey using producer and return id of operation for tracking.
+// Only owner can remove journey
+func (api *JourneyAPI) RemoveJourneyTaskV1(ctx context.Context, req *desc.RemoveJourneyTaskRequestV1) (*desc.RemoveJourneyTaskResponseV1, error) {
 	if err := req.Validate(); err != nil {
 		log.Error().Err(err).Msg("RemoveJourneyTaskV1: invalid request.")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err := api.producer.Send(kafka.Message{
+	userID := actingUserID(ctx)
+	if _, err := api.checkJourneyAccess(ctx, req.JourneyId, models.RoleOwner); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("RemoveJourneyTaskV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	operationID, err := api.operationRepo.AddOperation(ctx, models.Operation{
+		UserID:        userID,
+		Type:          models.RemoveOperation,
+		PendingChunks: 1,
+	})
+	if err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("RemoveJourneyTaskV1: failed to create operation.")
+		return nil, toStatusError(err)
+	}
+
+	err = api.producer.Send(kafka.Message{
 		MessageType: kafka.DeleteJourney,
+		OperationID: operationID,
+		UserID:      userID,
 		Value:       req.JourneyId,
 	})
 	if err != nil {
 		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("RemoveJourneyTaskV1: failed.")
-		return nil, status.Error(codes.Internal, err.Error())
+		api.failOperation(ctx, operationID, err)
+		return nil, toStatusError(err)
 	}
 
-	log.Debug().Msg("RemoveJourneyTaskV1: success.")
+	log.Debug().Uint64("operationId", operationID).Msg("RemoveJourneyTaskV1: success.")
 	api.metric.DeleteJourneyCounterInc()
 
-	return &emptypb.Empty{}, nil
+	return &desc.RemoveJourneyTaskResponseV1{OperationId: operationID}, nil
 }
 
-// UpdateJourneyTaskV1 - find journey by id and update another fields using producer
-func (api *JourneyAPI) UpdateJourneyTaskV1(ctx context.Context, req *desc.UpdateJourneyTaskRequestV1) (*emptypb.Empty, error) {
+// UpdateJourneyTaskV1 - find journey by id and update another fields using producer,
+// returns id of operation for tracking. Permissions are checked in the same way as in UpdateJourneyV1,
+// journey rejected by its overlap policy fails the operation
+func (api *JourneyAPI) UpdateJourneyTaskV1(ctx context.Context, req *desc.UpdateJourneyTaskRequestV1) (*desc.UpdateJourneyTaskResponseV1, error) {
 	if err := req.Validate(); err != nil {
 		log.Error().Err(err).Msg("UpdateJourneyTaskV1: invalid request.")
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := checkNoWaypoints(req.Journey); err != nil {
+		log.Error().Err(err).Msg("UpdateJourneyTaskV1: invalid request.")
+		return nil, toStatusError(err)
+	}
 
-	journey := models.Journey{
-		JourneyID:   req.Journey.JourneyId,
-		UserID:      req.Journey.UserId,
-		Address:     req.Journey.Address,
-		Description: req.Journey.Description,
-		StartTime:   req.Journey.StartTime.AsTime(),
-		EndTime:     req.Journey.EndTime.AsTime(),
+	journey := journeyFromProto(req.Journey)
+	if err := journey.Validate(); err != nil {
+		log.Error().Err(err).Str("journey", journey.String()).Msg("UpdateJourneyTaskV1: invalid request.")
+		return nil, toStatusError(err)
 	}
-	err := api.producer.Send(kafka.Message{
-		MessageType: kafka.UpdateJourney,
-		Value:       journey,
+
+	userID := actingUserID(ctx)
+	if err := api.checkJourneyUpdate(ctx, journey); err != nil {
+		log.Error().Err(err).Uint64("journeyId", journey.JourneyID).Msg("UpdateJourneyTaskV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	operationID, err := api.operationRepo.AddOperation(ctx, models.Operation{
+		UserID:        userID,
+		Type:          models.UpdateOperation,
+		PendingChunks: 1,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("journey", req.Journey.String()).Msg("UpdateJourneyTaskV1: failed to create operation.")
+		return nil, toStatusError(err)
+	}
+
+	err = api.producer.Send(kafka.Message{
+		MessageType:     kafka.UpdateJourney,
+		OperationID:     operationID,
+		UserID:          userID,
+		OverlapPolicies: []models.OverlapPolicy{api.requestOverlapPolicy(req.OverlapPolicy)},
+		Value:           journey,
 	})
 	if err != nil {
 		log.Error().Err(err).Str("journey", req.Journey.String()).Msg("UpdateJourneyTaskV1: failed.")
-		return nil, status.Error(codes.Internal, err.Error())
+		api.failOperation(ctx, operationID, err)
+		return nil, toStatusError(err)
 	}
 
-	log.Debug().Uint64("journeyId", req.Journey.JourneyId).Msg("UpdateJourneyTaskV1: success.")
+	log.Debug().Uint64("journeyId", req.Journey.JourneyId).Uint64("operationId", operationID).Msg("UpdateJourneyTaskV1: success.")
 	api.metric.UpdateJourneyCounterInc()
 
-	return &emptypb.Empty{}, nil
+	return &desc.UpdateJourneyTaskResponseV1{OperationId: operationID}, nil
+}
+
+// GetJourneyTaskStatusV1 - get state of operation created by one of *TaskV1 methods.
+// Only user who created operation can get its state
+func (api *JourneyAPI) GetJourneyTaskStatusV1(ctx context.Context, req *desc.GetJourneyTaskStatusRequestV1) (*desc.GetJourneyTaskStatusResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("GetJourneyTaskStatusV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	operation, err := api.operationRepo.DescribeOperation(ctx, req.OperationId)
+	if err == nil {
+		err = checkOperationOwner(ctx, operation)
+	}
+	if err != nil {
+		log.Error().Err(err).Uint64("operationId", req.OperationId).Msg("GetJourneyTaskStatusV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("operationId", req.OperationId).Msg("GetJourneyTaskStatusV1: success.")
+	return &desc.GetJourneyTaskStatusResponseV1{Task: operationToJourneyTask(operation)}, nil
+}
+
+// ListJourneyTasksV1 - get list of operations created by acting user with *TaskV1 methods with offset and limit,
+// newest first. Requests without acting user get operations of all users
+func (api *JourneyAPI) ListJourneyTasksV1(ctx context.Context, req *desc.ListJourneyTasksRequestV1) (*desc.ListJourneyTasksResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("ListJourneyTasksV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	operations, err := api.operationRepo.ListOperations(ctx, actingUserID(ctx), req.Limit, req.Offset)
+	if err != nil {
+		log.Error().Err(err).Uint64("offset", req.Offset).Uint64("limit", req.Limit).Msg("ListJourneyTasksV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	resp := &desc.ListJourneyTasksResponseV1{Tasks: make([]*desc.JourneyTask, len(operations))}
+	for i := range operations {
+		resp.Tasks[i] = operationToJourneyTask(&operations[i])
+	}
+
+	log.Debug().Uint64("offset", req.Offset).Uint64("limit", req.Limit).Msg("ListJourneyTasksV1: success.")
+	return resp, nil
+}
+
+// checkOperationOwner - returns PermissionDenied error if operation is not created by acting user,
+// requests without acting user can access any operation
+func checkOperationOwner(ctx context.Context, operation *models.Operation) error {
+	userID := actingUserID(ctx)
+	if userID == 0 || operation.UserID == userID {
+		return nil
+	}
+	return apperrors.New(apperrors.PermissionDenied, "user %d is not owner of operation %d", userID, operation.OperationID)
+}
+
+// failOperation - mark operation as failed when message cannot be sent to producer
+func (api *JourneyAPI) failOperation(ctx context.Context, operationID uint64, reason error) {
+	if err := api.operationRepo.FailOperation(ctx, operationID, reason.Error()); err != nil {
+		log.Error().Err(err).Uint64("operationId", operationID).Msg("Failed to mark operation as failed.")
+	}
 }
@@ -5,14 +5,23 @@ import (
 	"errors"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/auth"
 	"github.com/ozonva/ova-journey-api/internal/kafka"
 	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"time"
 
 	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/watch"
 	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
 )
 
@@ -20,8 +29,10 @@ var _ = Describe("JourneyApi", func() {
 	var (
 		ctrl         *gomock.Controller
 		mockRepo     *mocks.MockRepo
+		mockOpRepo   *mocks.MockOperationRepo
 		mockProducer *mocks.MockProducer
 		mockMetrics  *mocks.MockMetrics
+		hub          watch.Hub
 		api          desc.JourneyApiV1Server
 		ctx          context.Context
 
@@ -33,6 +44,7 @@ var _ = Describe("JourneyApi", func() {
 			{JourneyID: 1, UserID: 1, Address: "Уфа", Description: "", StartTime: timeStart, EndTime: timeEnd},
 			{JourneyID: 2, UserID: 2, Address: "Москва", Description: "", StartTime: timeStart, EndTime: timeEnd},
 		}
+		operationID = uint64(7)
 		errRepo     = errors.New("repo error")
 		errProducer = errors.New("producer error")
 	)
@@ -40,13 +52,24 @@ var _ = Describe("JourneyApi", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockRepo = mocks.NewMockRepo(ctrl)
+		mockOpRepo = mocks.NewMockOperationRepo(ctrl)
 		mockProducer = mocks.NewMockProducer(ctrl)
 		mockMetrics = mocks.NewMockMetrics(ctrl)
+		hub = watch.NewHub(10, 10)
 		ctx = context.Background()
+		// participants of changed journeys are loaded for watchers
+		mockRepo.EXPECT().GetJourneyParticipantIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	})
 
 	JustBeforeEach(func() {
-		api = NewJourneyAPI(mockRepo, mockProducer, mockMetrics, chunkSize)
+		api = NewJourneyAPI(Options{
+			Repo:          mockRepo,
+			OperationRepo: mockOpRepo,
+			Producer:      mockProducer,
+			Metrics:       mockMetrics,
+			Hub:           hub,
+			ChunkSize:     chunkSize,
+		})
 	})
 
 	AfterEach(func() {
@@ -89,6 +112,38 @@ var _ = Describe("JourneyApi", func() {
 				})
 			})
 
+			Context("Journey with unknown time zone", func() {
+				It("should return invalid argument error without calling repo", func() {
+					mockRepo.EXPECT().AddJourney(ctx, gomock.Any()).Times(0)
+
+					result, err := api.CreateJourneyV1(ctx, &desc.CreateJourneyRequestV1{
+						UserId:    journeysTable[0].UserID,
+						Address:   journeysTable[0].Address,
+						StartTime: timestamppb.New(journeysTable[0].StartTime),
+						EndTime:   timestamppb.New(journeysTable[0].EndTime),
+						TimeZone:  "Europe/Atlantis",
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+				})
+			})
+
+			Context("Journey without start time", func() {
+				It("should return invalid argument error without calling repo", func() {
+					mockRepo.EXPECT().AddJourney(ctx, gomock.Any()).Times(0)
+
+					result, err := api.CreateJourneyV1(ctx, &desc.CreateJourneyRequestV1{
+						UserId:  journeysTable[0].UserID,
+						Address: journeysTable[0].Address,
+						EndTime: timestamppb.New(journeysTable[0].EndTime),
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+				})
+			})
+
 			Context("Error in repo", func() {
 				It("should return error", func() {
 					mockRepo.EXPECT().AddJourney(ctx, journeysTable[0]).Return(uint64(0), errRepo).Times(1)
@@ -153,14 +208,111 @@ var _ = Describe("JourneyApi", func() {
 				})
 			})
 
+			Context("One of journeys is invalid", func() {
+				var req *desc.MultiCreateJourneyRequestV1
+
+				BeforeEach(func() {
+					req = &desc.MultiCreateJourneyRequestV1{
+						Journeys: []*desc.CreateJourneyRequestV1{
+							{
+								UserId:    journeysTable[0].UserID,
+								Address:   journeysTable[0].Address,
+								StartTime: timestamppb.New(journeysTable[0].StartTime),
+								EndTime:   timestamppb.New(journeysTable[0].EndTime),
+							},
+							{
+								UserId:    journeysTable[1].UserID,
+								Address:   journeysTable[1].Address,
+								StartTime: timestamppb.New(journeysTable[1].EndTime),
+								EndTime:   timestamppb.New(journeysTable[1].StartTime),
+							},
+						},
+					}
+				})
+
+				It("should create valid journeys and return result for every journey", func() {
+					mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTableZeroIds[0:1]).Return([]uint64{1}, nil).Times(1)
+					mockMetrics.EXPECT().MultiCreateJourneyCounterInc().Times(1)
+
+					result, err := api.MultiCreateJourneyV1(ctx, req)
+
+					Expect(err).Should(BeNil())
+					Expect(result.JourneyIds).Should(Equal([]uint64{1}))
+					Expect(result.Results).Should(HaveLen(2))
+					Expect(result.Results[0].JourneyId).Should(Equal(uint64(1)))
+					Expect(result.Results[1].JourneyId).Should(BeZero())
+					Expect(result.Results[1].Code).Should(Equal(uint32(codes.InvalidArgument)))
+				})
+
+				It("should return invalid argument error without calling repo in atomic mode", func() {
+					mockRepo.EXPECT().WithTx(ctx, gomock.Any()).Times(0)
+					mockRepo.EXPECT().MultiAddJourneys(ctx, gomock.Any()).Times(0)
+					req.Atomic = true
+
+					result, err := api.MultiCreateJourneyV1(ctx, req)
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+				})
+			})
+
 			Context("Error in repo", func() {
-				It("should return error with added ids", func() {
+				var req *desc.MultiCreateJourneyRequestV1
+
+				BeforeEach(func() {
+					req = &desc.MultiCreateJourneyRequestV1{}
+					for _, journey := range journeysTable {
+						req.Journeys = append(req.Journeys, &desc.CreateJourneyRequestV1{
+							UserId:    journey.UserID,
+							Address:   journey.Address,
+							StartTime: timestamppb.New(journey.StartTime),
+							EndTime:   timestamppb.New(journey.EndTime),
+						})
+					}
+				})
+
+				It("should return added ids and error status for journeys of failed chunk", func() {
 					newJourneyIDs := []uint64{1, 2, 3}
 
 					mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTableZeroIds[0:2]).Return(newJourneyIDs[0:2], nil).Times(1)
 					mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTableZeroIds[2:]).Return(nil, errRepo).Times(1)
+					mockMetrics.EXPECT().MultiCreateJourneyCounterInc().Times(1)
 
-					req := &desc.MultiCreateJourneyRequestV1{}
+					result, err := api.MultiCreateJourneyV1(ctx, req)
+
+					Expect(err).Should(BeNil())
+					Expect(result.JourneyIds).Should(Equal(newJourneyIDs[0:2]))
+					Expect(result.Results[2].Index).Should(Equal(uint64(2)))
+					Expect(result.Results[2].Code).Should(Equal(uint32(codes.Internal)))
+				})
+
+				It("should return error without ids in atomic mode", func() {
+					mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+						func(_ context.Context, fn func(repo.Repo) error) error {
+							return fn(mockRepo)
+						}).Times(1)
+					mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTableZeroIds[0:2]).Return([]uint64{1, 2}, nil).Times(1)
+					mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTableZeroIds[2:]).Return(nil, errRepo).Times(1)
+					req.Atomic = true
+
+					result, err := api.MultiCreateJourneyV1(ctx, req)
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.Internal))
+				})
+			})
+
+			Context("Success create journeys in atomic mode", func() {
+				It("should add all chunks in one transaction", func() {
+					mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+						func(_ context.Context, fn func(repo.Repo) error) error {
+							return fn(mockRepo)
+						}).Times(1)
+					mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTableZeroIds[0:2]).Return([]uint64{1, 2}, nil).Times(1)
+					mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTableZeroIds[2:]).Return([]uint64{3}, nil).Times(1)
+					mockMetrics.EXPECT().MultiCreateJourneyCounterInc().Times(1)
+
+					req := &desc.MultiCreateJourneyRequestV1{Atomic: true}
 					for _, journey := range journeysTable {
 						req.Journeys = append(req.Journeys, &desc.CreateJourneyRequestV1{
 							UserId:    journey.UserID,
@@ -172,8 +324,9 @@ var _ = Describe("JourneyApi", func() {
 
 					result, err := api.MultiCreateJourneyV1(ctx, req)
 
-					Expect(result.JourneyIds).Should(Equal(newJourneyIDs[0:2]))
-					Expect(err).Should(HaveOccurred())
+					Expect(err).Should(BeNil())
+					Expect(result.JourneyIds).Should(Equal([]uint64{1, 2, 3}))
+					Expect(result.Results).Should(HaveLen(3))
 				})
 			})
 
@@ -181,7 +334,13 @@ var _ = Describe("JourneyApi", func() {
 				It("should return error without calling repo", func() {
 					mockRepo.EXPECT().MultiAddJourneys(ctx, gomock.Any()).Times(0)
 
-					newAPI := NewJourneyAPI(mockRepo, mockProducer, mockMetrics, 0)
+					newAPI := NewJourneyAPI(Options{
+						Repo:          mockRepo,
+						OperationRepo: mockOpRepo,
+						Producer:      mockProducer,
+						Metrics:       mockMetrics,
+						Hub:           hub,
+					})
 
 					result, err := newAPI.MultiCreateJourneyV1(ctx, &desc.MultiCreateJourneyRequestV1{
 						Journeys: []*desc.CreateJourneyRequestV1{
@@ -218,6 +377,24 @@ var _ = Describe("JourneyApi", func() {
 				})
 			})
 
+			Context("Journey with time zone", func() {
+				It("should return exact times and time zone of journey", func() {
+					location, _ := time.LoadLocation("Asia/Yekaterinburg")
+					journey := journeysTable[1]
+					journey.StartTime = time.Date(2021, 8, 14, 9, 45, 30, 123456000, location)
+					journey.EndTime = journey.StartTime.Add(90 * time.Minute)
+					journey.TimeZone = location.String()
+					mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+
+					result, err := api.DescribeJourneyV1(ctx, &desc.DescribeJourneyRequestV1{JourneyId: journey.JourneyID})
+
+					Expect(err).Should(BeNil())
+					Expect(result.Journey.TimeZone).Should(Equal("Asia/Yekaterinburg"))
+					Expect(result.Journey.StartTime.AsTime().Equal(journey.StartTime)).Should(BeTrue())
+					Expect(result.Journey.EndTime.AsTime().Equal(journey.EndTime)).Should(BeTrue())
+				})
+			})
+
 			Context("Incorrect journeyId in request", func() {
 				It("should return error without calling repo", func() {
 					journeyID := uint64(0)
@@ -241,6 +418,19 @@ var _ = Describe("JourneyApi", func() {
 					Expect(err).Should(HaveOccurred())
 				})
 			})
+
+			Context("Journey not found", func() {
+				It("should return not found error", func() {
+					journeyID := uint64(100)
+					mockRepo.EXPECT().DescribeJourney(ctx, journeyID).
+						Return(nil, apperrors.New(apperrors.NotFound, "journey 100 not found")).Times(1)
+
+					result, err := api.DescribeJourneyV1(ctx, &desc.DescribeJourneyRequestV1{JourneyId: journeyID})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.NotFound))
+				})
+			})
 		})
 
 		Context("ListJourneysV1", func() {
@@ -248,7 +438,7 @@ var _ = Describe("JourneyApi", func() {
 				It("should return success result with journeys", func() {
 					limit := uint64(2)
 					offset := uint64(1)
-					mockRepo.EXPECT().ListJourneys(ctx, limit, offset).Return(journeysTable[1:3], nil).Times(1)
+					mockRepo.EXPECT().ListJourneys(ctx, repo.JourneyFilter{}, limit, offset).Return(journeysTable[1:3], nil).Times(1)
 
 					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Offset: offset, Limit: limit})
 
@@ -261,7 +451,7 @@ var _ = Describe("JourneyApi", func() {
 				It("should return error without calling repo", func() {
 					limit := uint64(0)
 					offset := uint64(1)
-					mockRepo.EXPECT().ListJourneys(ctx, gomock.Any(), gomock.Any()).Times(0)
+					mockRepo.EXPECT().ListJourneys(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Offset: offset, Limit: limit})
 
@@ -274,7 +464,7 @@ var _ = Describe("JourneyApi", func() {
 				It("should return error", func() {
 					limit := uint64(1)
 					offset := uint64(1)
-					mockRepo.EXPECT().ListJourneys(ctx, limit, offset).Return(nil, errRepo).Times(1)
+					mockRepo.EXPECT().ListJourneys(ctx, repo.JourneyFilter{}, limit, offset).Return(nil, errRepo).Times(1)
 
 					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Offset: offset, Limit: limit})
 
@@ -284,12 +474,162 @@ var _ = Describe("JourneyApi", func() {
 			})
 		})
 
+		Context("ListJourneysV1 with filters", func() {
+			Context("Success get filtered list of journeys", func() {
+				It("should pass filter to repo", func() {
+					limit := uint64(2)
+					filter := repo.JourneyFilter{
+						UserIDs:       []uint64{2},
+						Participating: true,
+						From:          timeStart,
+						To:            timeStart.Add(24 * time.Hour),
+						Text:          "Москва",
+					}
+					mockRepo.EXPECT().ListJourneys(ctx, filter, limit, uint64(0)).Return(journeysTable[1:2], nil).Times(1)
+					mockRepo.EXPECT().ListRecurringJourneys(ctx, filter).Return(nil, nil).Times(1)
+
+					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+						Limit:    limit,
+						UserIds:  []uint64{2},
+						FromTime: timestamppb.New(timeStart),
+						ToTime:   timestamppb.New(timeStart.Add(24 * time.Hour)),
+						Text:     "Москва",
+					})
+
+					Expect(result.Journeys).Should(HaveLen(1))
+					Expect(err).Should(BeNil())
+				})
+
+				It("should pass filter to repo with page token", func() {
+					limit := uint64(1)
+					filter := repo.JourneyFilter{UserIDs: []uint64{2}, Participating: true}
+					mockRepo.EXPECT().ListJourneysAfter(ctx, filter, uint64(1), limit).Return(journeysTable[1:2], nil).Times(1)
+
+					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+						Limit:     limit,
+						UserIds:   []uint64{2},
+						PageToken: encodePageToken(pageToken{LastJourneyID: 1, FilterHash: journeyFilterHash(filter)}),
+					})
+
+					Expect(result.Journeys).Should(HaveLen(1))
+					Expect(err).Should(BeNil())
+				})
+			})
+
+			Context("Incorrect time range in request", func() {
+				It("should return error without calling repo", func() {
+					mockRepo.EXPECT().ListJourneys(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+						Limit:    1,
+						FromTime: timestamppb.New(timeStart),
+						ToTime:   timestamppb.New(timeStart),
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+
+			Context("Incorrect user id in request", func() {
+				It("should return error without calling repo", func() {
+					mockRepo.EXPECT().ListJourneys(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: 1, UserIds: []uint64{0}})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+		})
+
+		Context("ListJourneysV1 with page token", func() {
+			Context("Success get next page", func() {
+				It("should return journeys after journey from page token", func() {
+					limit := uint64(2)
+					mockRepo.EXPECT().ListJourneys(ctx, repo.JourneyFilter{}, limit, uint64(0)).Return(journeysTable[0:2], nil).Times(1)
+					mockRepo.EXPECT().ListJourneysAfter(ctx, repo.JourneyFilter{}, journeysTable[1].JourneyID, limit).Return(journeysTable[2:], nil).Times(1)
+
+					firstPage, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: limit})
+					Expect(err).Should(BeNil())
+					Expect(firstPage.NextPageToken).ShouldNot(BeEmpty())
+
+					secondPage, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: limit, PageToken: firstPage.NextPageToken})
+					Expect(err).Should(BeNil())
+					Expect(secondPage.Journeys).Should(HaveLen(1))
+					Expect(secondPage.NextPageToken).Should(BeEmpty())
+				})
+			})
+
+			Context("Incorrect page token in request", func() {
+				It("should return error without calling repo", func() {
+					mockRepo.EXPECT().ListJourneysAfter(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: 1, PageToken: "incorrect token"})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+
+			Context("Page token of other filter in request", func() {
+				It("should return error without calling repo", func() {
+					limit := uint64(2)
+					mockRepo.EXPECT().ListJourneys(ctx, repo.JourneyFilter{}, limit, uint64(0)).Return(journeysTable[0:2], nil).Times(1)
+					mockRepo.EXPECT().ListJourneysAfter(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+					firstPage, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: limit})
+					Expect(err).Should(BeNil())
+
+					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+						Limit:     limit,
+						Text:      "Москва",
+						PageToken: firstPage.NextPageToken,
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+				})
+			})
+
+			Context("Page token with offset in request", func() {
+				It("should return error without calling repo", func() {
+					mockRepo.EXPECT().ListJourneysAfter(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+						Limit:     1,
+						Offset:    1,
+						PageToken: encodePageToken(pageToken{LastJourneyID: 1}),
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+
+			Context("Error in repo", func() {
+				It("should return error", func() {
+					mockRepo.EXPECT().ListJourneysAfter(ctx, repo.JourneyFilter{}, uint64(1), uint64(1)).Return(nil, errRepo).Times(1)
+
+					result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+						Limit:     1,
+						PageToken: encodePageToken(pageToken{LastJourneyID: 1, FilterHash: journeyFilterHash(repo.JourneyFilter{})}),
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+		})
+
 		Context("RemoveJourneyV1", func() {
 			Context("Success remove journey", func() {
 				It("should return success empty result", func() {
 					journeyID := uint64(1)
 
-					mockRepo.EXPECT().RemoveJourney(ctx, journeyID).Return(nil).Times(1)
+					mockRepo.EXPECT().GetJourneyOwners(ctx, []uint64{journeyID}).
+						Return(map[uint64]uint64{journeyID: 2}, nil).Times(1)
+					mockRepo.EXPECT().RemoveJourney(ctx, journeyID, uint64(0)).Return(nil).Times(1)
 					mockMetrics.EXPECT().DeleteJourneyCounterInc().Times(1)
 
 					result, err := api.RemoveJourneyV1(ctx, &desc.RemoveJourneyRequestV1{JourneyId: journeyID})
@@ -302,7 +642,7 @@ var _ = Describe("JourneyApi", func() {
 			Context("Incorrect journeyId in request", func() {
 				It("should return error without calling repo", func() {
 					journeyID := uint64(0)
-					mockRepo.EXPECT().RemoveJourney(ctx, gomock.Any()).Times(0)
+					mockRepo.EXPECT().RemoveJourney(ctx, gomock.Any(), gomock.Any()).Times(0)
 
 					result, err := api.RemoveJourneyV1(ctx, &desc.RemoveJourneyRequestV1{JourneyId: journeyID})
 
@@ -311,9 +651,22 @@ var _ = Describe("JourneyApi", func() {
 				})
 			})
 
+			Context("Journey not found", func() {
+				It("should return not found error without removing journey", func() {
+					mockRepo.EXPECT().GetJourneyOwners(ctx, []uint64{1}).Return(map[uint64]uint64{}, nil).Times(1)
+					mockRepo.EXPECT().RemoveJourney(ctx, gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.RemoveJourneyV1(ctx, &desc.RemoveJourneyRequestV1{JourneyId: 1})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.NotFound))
+				})
+			})
+
 			Context("Error in repo", func() {
 				It("should return error", func() {
-					mockRepo.EXPECT().RemoveJourney(ctx, gomock.Any()).Return(errRepo).Times(1)
+					mockRepo.EXPECT().GetJourneyOwners(ctx, []uint64{1}).Return(map[uint64]uint64{1: 2}, nil).Times(1)
+					mockRepo.EXPECT().RemoveJourney(ctx, gomock.Any(), gomock.Any()).Return(errRepo).Times(1)
 
 					result, err := api.RemoveJourneyV1(ctx, &desc.RemoveJourneyRequestV1{JourneyId: 1})
 
@@ -321,12 +674,39 @@ var _ = Describe("JourneyApi", func() {
 					Expect(err).Should(HaveOccurred())
 				})
 			})
+
+			Context("Expected revision does not match", func() {
+				It("should return aborted error", func() {
+					mockRepo.EXPECT().GetJourneyOwners(ctx, []uint64{1}).Return(map[uint64]uint64{1: 2}, nil).Times(1)
+					mockRepo.EXPECT().RemoveJourney(ctx, uint64(1), uint64(2)).Return(repo.ErrRevisionConflict).Times(1)
+
+					result, err := api.RemoveJourneyV1(ctx, &desc.RemoveJourneyRequestV1{JourneyId: 1, ExpectedRevision: 2})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.Aborted))
+				})
+			})
+
+			Context("Expected revision in If-Match metadata", func() {
+				It("should pass revision from metadata to repo", func() {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(IfMatchMetadataKey, `"5"`))
+					mockRepo.EXPECT().GetJourneyOwners(ctx, []uint64{1}).Return(map[uint64]uint64{1: 2}, nil).Times(1)
+					mockRepo.EXPECT().RemoveJourney(ctx, uint64(1), uint64(5)).Return(nil).Times(1)
+					mockMetrics.EXPECT().DeleteJourneyCounterInc().Times(1)
+
+					result, err := api.RemoveJourneyV1(ctx, &desc.RemoveJourneyRequestV1{JourneyId: 1})
+
+					Expect(result).Should(Equal(&emptypb.Empty{}))
+					Expect(err).Should(BeNil())
+				})
+			})
 		})
 
 		Context("UpdateJourneyV1", func() {
 			Context("Success update journey", func() {
 				It("should return success empty result", func() {
-					mockRepo.EXPECT().UpdateJourney(ctx, journeysTable[2]).Return(nil).Times(1)
+					mockRepo.EXPECT().UpdateJourney(ctx, journeysTable[2]).Return(uint64(3), nil).Times(1)
+					mockRepo.EXPECT().DescribeJourney(ctx, journeysTable[2].JourneyID).Return(&journeysTable[2], nil).Times(1)
 					mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
 
 					result, err := api.UpdateJourneyV1(ctx, &desc.UpdateJourneyRequestV1{
@@ -339,7 +719,7 @@ var _ = Describe("JourneyApi", func() {
 						},
 					})
 
-					Expect(result).Should(Equal(&emptypb.Empty{}))
+					Expect(result).Should(Equal(&desc.UpdateJourneyResponseV1{Revision: 3}))
 					Expect(err).Should(BeNil())
 				})
 			})
@@ -365,7 +745,7 @@ var _ = Describe("JourneyApi", func() {
 
 			Context("Error in repo", func() {
 				It("should return error", func() {
-					mockRepo.EXPECT().UpdateJourney(ctx, journeysTable[2]).Return(errRepo).Times(1)
+					mockRepo.EXPECT().UpdateJourney(ctx, journeysTable[2]).Return(uint64(0), errRepo).Times(1)
 
 					result, err := api.UpdateJourneyV1(ctx, &desc.UpdateJourneyRequestV1{
 						Journey: &desc.Journey{
@@ -382,15 +762,304 @@ var _ = Describe("JourneyApi", func() {
 				})
 			})
 		})
+
+		Context("UpdateJourneyV1 with expected revision", func() {
+			var request *desc.UpdateJourneyRequestV1
+			var journey models.Journey
+
+			BeforeEach(func() {
+				journey = journeysTable[2]
+				journey.Revision = 2
+				request = &desc.UpdateJourneyRequestV1{
+					Journey: &desc.Journey{
+						JourneyId: journey.JourneyID,
+						UserId:    journey.UserID,
+						Address:   journey.Address,
+						StartTime: timestamppb.New(journey.StartTime),
+						EndTime:   timestamppb.New(journey.EndTime),
+					},
+				}
+			})
+
+			Context("Revision from request matches", func() {
+				It("should return new revision", func() {
+					request.ExpectedRevision = 2
+					mockRepo.EXPECT().UpdateJourney(ctx, journey).Return(uint64(3), nil).Times(1)
+					mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+					mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+					result, err := api.UpdateJourneyV1(ctx, request)
+
+					Expect(result.Revision).Should(Equal(uint64(3)))
+					Expect(err).Should(BeNil())
+				})
+			})
+
+			Context("Revision from If-Match metadata does not match", func() {
+				It("should return aborted error", func() {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(IfMatchMetadataKey, `"2"`))
+					mockRepo.EXPECT().UpdateJourney(ctx, journey).Return(uint64(0), repo.ErrRevisionConflict).Times(1)
+
+					result, err := api.UpdateJourneyV1(ctx, request)
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.Aborted))
+				})
+			})
+
+			Context("Invalid If-Match metadata", func() {
+				It("should return error without calling repo", func() {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(IfMatchMetadataKey, "2"))
+					mockRepo.EXPECT().UpdateJourney(ctx, gomock.Any()).Times(0)
+
+					result, err := api.UpdateJourneyV1(ctx, request)
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+				})
+			})
+		})
+
+		Context("PatchJourneyV1", func() {
+			var current models.Journey
+
+			BeforeEach(func() {
+				current = journeysTable[2]
+				current.Revision = 2
+			})
+
+			Context("Success patch journey", func() {
+				It("should update only fields from update mask", func() {
+					patched := current
+					patched.Description = "new description"
+					// journey is loaded before patching and for watchers after it
+					mockRepo.EXPECT().DescribeJourney(ctx, current.JourneyID).Return(&current, nil).Times(2)
+					mockRepo.EXPECT().
+						PatchJourney(ctx, patched, []repo.JourneyField{repo.JourneyFieldDescription}).
+						Return(uint64(3), nil).Times(1)
+					mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+					result, err := api.PatchJourneyV1(ctx, &desc.PatchJourneyRequestV1{
+						Journey:    &desc.Journey{JourneyId: current.JourneyID, Description: "new description"},
+						UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "description"}},
+					})
+
+					Expect(result).Should(Equal(&desc.PatchJourneyResponseV1{Revision: 3}))
+					Expect(err).Should(BeNil())
+				})
+
+				It("should update latitude and longitude together by coordinates path", func() {
+					patched := current
+					patched.Coordinates = &models.Coordinates{Latitude: 51.6720, Longitude: 39.1843}
+					// journey is loaded before patching and for watchers after it
+					mockRepo.EXPECT().DescribeJourney(ctx, current.JourneyID).Return(&current, nil).Times(2)
+					mockRepo.EXPECT().
+						PatchJourney(ctx, patched, []repo.JourneyField{repo.JourneyFieldLatitude, repo.JourneyFieldLongitude}).
+						Return(uint64(3), nil).Times(1)
+					mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+					result, err := api.PatchJourneyV1(ctx, &desc.PatchJourneyRequestV1{
+						Journey: &desc.Journey{
+							JourneyId:   current.JourneyID,
+							Coordinates: &desc.Coordinates{Latitude: 51.6720, Longitude: 39.1843},
+						},
+						UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"coordinates"}},
+					})
+
+					Expect(result).Should(Equal(&desc.PatchJourneyResponseV1{Revision: 3}))
+					Expect(err).Should(BeNil())
+				})
+			})
+
+			Context("Patched journey is invalid", func() {
+				It("should return error without patching", func() {
+					mockRepo.EXPECT().DescribeJourney(ctx, current.JourneyID).Return(&current, nil).Times(1)
+					mockRepo.EXPECT().PatchJourney(ctx, gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.PatchJourneyV1(ctx, &desc.PatchJourneyRequestV1{
+						Journey: &desc.Journey{
+							JourneyId: current.JourneyID,
+							EndTime:   timestamppb.New(current.StartTime.Add(-time.Hour)),
+						},
+						UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"end_time"}},
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+				})
+			})
+
+			Context("Expected revision does not match", func() {
+				It("should return aborted error without patching", func() {
+					mockRepo.EXPECT().DescribeJourney(ctx, current.JourneyID).Return(&current, nil).Times(1)
+					mockRepo.EXPECT().PatchJourney(ctx, gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.PatchJourneyV1(ctx, &desc.PatchJourneyRequestV1{
+						Journey:          &desc.Journey{JourneyId: current.JourneyID, Address: "new address"},
+						UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"address"}},
+						ExpectedRevision: 1,
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.Aborted))
+				})
+			})
+
+			DescribeTable("Incorrect update mask in request",
+				func(journey *desc.Journey, paths []string) {
+					mockRepo.EXPECT().PatchJourney(ctx, gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.PatchJourneyV1(ctx, &desc.PatchJourneyRequestV1{
+						Journey:    journey,
+						UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+				},
+				Entry("empty mask", &desc.Journey{JourneyId: 1}, []string{}),
+				Entry("unknown path", &desc.Journey{JourneyId: 1}, []string{"address", "unknown"}),
+				Entry("read-only path", &desc.Journey{JourneyId: 1}, []string{"journey_id"}),
+				Entry("nested path", &desc.Journey{JourneyId: 1}, []string{"start_time.seconds"}),
+				Entry("missing time value", &desc.Journey{JourneyId: 1}, []string{"start_time"}),
+				Entry("zero user id", &desc.Journey{JourneyId: 1}, []string{"user_id"}),
+			)
+
+			Context("Error in repo", func() {
+				It("should return error", func() {
+					mockRepo.EXPECT().DescribeJourney(ctx, current.JourneyID).Return(&current, nil).Times(1)
+					mockRepo.EXPECT().PatchJourney(ctx, gomock.Any(), gomock.Any()).Return(uint64(0), errRepo).Times(1)
+
+					result, err := api.PatchJourneyV1(ctx, &desc.PatchJourneyRequestV1{
+						Journey:    &desc.Journey{JourneyId: journeysTable[2].JourneyID, Address: "new address"},
+						UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"address"}},
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+		})
+
+		Context("RestoreJourneyV1", func() {
+			Context("Success restore journey", func() {
+				It("should return new revision", func() {
+					mockRepo.EXPECT().GetRemovedJourneyOwner(ctx, uint64(1)).Return(uint64(2), nil).Times(1)
+					mockRepo.EXPECT().RestoreJourney(ctx, uint64(1)).Return(uint64(4), nil).Times(1)
+
+					result, err := api.RestoreJourneyV1(ctx, &desc.RestoreJourneyRequestV1{JourneyId: 1})
+
+					Expect(result.Revision).Should(Equal(uint64(4)))
+					Expect(err).Should(BeNil())
+				})
+			})
+
+			Context("Incorrect journey id in request", func() {
+				It("should return error without calling repo", func() {
+					mockRepo.EXPECT().RestoreJourney(ctx, gomock.Any()).Times(0)
+
+					result, err := api.RestoreJourneyV1(ctx, &desc.RestoreJourneyRequestV1{JourneyId: 0})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+				})
+			})
+
+			Context("Removed journey not found", func() {
+				It("should return not found error", func() {
+					mockRepo.EXPECT().GetRemovedJourneyOwner(ctx, uint64(1)).
+						Return(uint64(0), apperrors.New(apperrors.NotFound, "removed journey 1 not found")).Times(1)
+					mockRepo.EXPECT().RestoreJourney(ctx, gomock.Any()).Times(0)
+
+					result, err := api.RestoreJourneyV1(ctx, &desc.RestoreJourneyRequestV1{JourneyId: 1})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.NotFound))
+				})
+			})
+		})
+
+		Context("ListDeletedJourneysV1", func() {
+			Context("Success get list of removed journeys", func() {
+				It("should return journeys with time of removing", func() {
+					deleted := journeysTable[1]
+					deleted.DeletedAt = timeEnd
+					mockRepo.EXPECT().ListDeletedJourneys(ctx, uint64(10), uint64(0)).Return([]models.Journey{deleted}, nil).Times(1)
+
+					result, err := api.ListDeletedJourneysV1(ctx, &desc.ListDeletedJourneysRequestV1{Limit: 10})
+
+					Expect(err).Should(BeNil())
+					Expect(result.Journeys).Should(HaveLen(1))
+					Expect(result.Journeys[0].Journey.JourneyId).Should(Equal(deleted.JourneyID))
+					Expect(result.Journeys[0].DeletedAt.AsTime()).Should(Equal(timeEnd))
+				})
+			})
+
+			Context("Incorrect limit in request", func() {
+				It("should return error without calling repo", func() {
+					mockRepo.EXPECT().ListDeletedJourneys(ctx, gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.ListDeletedJourneysV1(ctx, &desc.ListDeletedJourneysRequestV1{Limit: 0})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+
+			Context("Acting user", func() {
+				It("should return journeys to admin", func() {
+					ctx = auth.WithUserID(context.Background(), 9)
+					api = NewJourneyAPI(Options{
+						Repo:          mockRepo,
+						OperationRepo: mockOpRepo,
+						Producer:      mockProducer,
+						Metrics:       mockMetrics,
+						Hub:           hub,
+						ChunkSize:     chunkSize,
+						AdminUserIDs:  []uint64{9},
+					})
+					mockRepo.EXPECT().ListDeletedJourneys(ctx, uint64(10), uint64(0)).Return(nil, nil).Times(1)
+
+					result, err := api.ListDeletedJourneysV1(ctx, &desc.ListDeletedJourneysRequestV1{Limit: 10})
+
+					Expect(err).Should(BeNil())
+					Expect(result.Journeys).Should(BeEmpty())
+				})
+
+				It("should return permission denied without calling repo to not admin", func() {
+					ctx = auth.WithUserID(context.Background(), 1)
+					mockRepo.EXPECT().ListDeletedJourneys(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.ListDeletedJourneysV1(ctx, &desc.ListDeletedJourneysRequestV1{Limit: 10})
+
+					Expect(result).Should(BeNil())
+					Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+				})
+			})
+
+			Context("Error in repo", func() {
+				It("should return error", func() {
+					mockRepo.EXPECT().ListDeletedJourneys(ctx, uint64(10), uint64(0)).Return(nil, errRepo).Times(1)
+
+					result, err := api.ListDeletedJourneysV1(ctx, &desc.ListDeletedJourneysRequestV1{Limit: 10})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+		})
 	})
 
 	Context("Using producer", func() {
 		Context("CreateJourneyTaskV1", func() {
 			Context("Create journey", func() {
-				It("should return empty result with calling producer", func() {
+				It("should return operation id with calling producer", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, models.Operation{Type: models.CreateOperation, PendingChunks: 1}).Return(operationID, nil).Times(1)
 					mockProducer.EXPECT().Send(kafka.Message{
-						MessageType: kafka.CreateJourney,
-						Value:       journeysTable[0],
+						MessageType:     kafka.CreateJourney,
+						OperationID:     operationID,
+						OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow},
+						Value:           journeysTable[0],
 					}).Times(1)
 					mockMetrics.EXPECT().CreateJourneyCounterInc().Times(1)
 
@@ -401,13 +1070,14 @@ var _ = Describe("JourneyApi", func() {
 						EndTime:   timestamppb.New(journeysTable[0].EndTime),
 					})
 
-					Expect(result).Should(Equal(&emptypb.Empty{}))
+					Expect(result.OperationId).Should(Equal(operationID))
 					Expect(err).Should(BeNil())
 				})
 			})
 
 			Context("Incorrect journey in request", func() {
 				It("should return error without calling producer", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, gomock.Any()).Times(0)
 					mockProducer.EXPECT().Send(gomock.Any()).Times(0)
 
 					result, err := api.CreateJourneyTaskV1(ctx, &desc.CreateJourneyTaskRequestV1{
@@ -422,12 +1092,33 @@ var _ = Describe("JourneyApi", func() {
 				})
 			})
 
+			Context("Error in operation repo", func() {
+				It("should return error without calling producer", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, gomock.Any()).Return(uint64(0), errRepo).Times(1)
+					mockProducer.EXPECT().Send(gomock.Any()).Times(0)
+
+					result, err := api.CreateJourneyTaskV1(ctx, &desc.CreateJourneyTaskRequestV1{
+						UserId:    journeysTable[0].UserID,
+						Address:   journeysTable[0].Address,
+						StartTime: timestamppb.New(journeysTable[0].StartTime),
+						EndTime:   timestamppb.New(journeysTable[0].EndTime),
+					})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+
 			Context("Error in producer", func() {
-				It("should return error", func() {
+				It("should return error and mark operation as failed", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, gomock.Any()).Return(operationID, nil).Times(1)
 					mockProducer.EXPECT().Send(kafka.Message{
-						MessageType: kafka.CreateJourney,
-						Value:       journeysTable[0],
+						MessageType:     kafka.CreateJourney,
+						OperationID:     operationID,
+						OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow},
+						Value:           journeysTable[0],
 					}).Times(1).Return(errProducer)
+					mockOpRepo.EXPECT().FailOperation(ctx, operationID, errProducer.Error()).Times(1)
 
 					result, err := api.CreateJourneyTaskV1(ctx, &desc.CreateJourneyTaskRequestV1{
 						UserId:    journeysTable[0].UserID,
@@ -454,14 +1145,19 @@ var _ = Describe("JourneyApi", func() {
 			})
 
 			Context("Success create journeys", func() {
-				It("should return success empty result with calling producer", func() {
+				It("should return operation id for all chunks with calling producer", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, models.Operation{Type: models.MultiCreateOperation, PendingChunks: 2}).Return(operationID, nil).Times(1)
 					mockProducer.EXPECT().Send(kafka.Message{
-						MessageType: kafka.MultiCreateJourney,
-						Value:       journeysTableZeroIds[0:2],
+						MessageType:     kafka.MultiCreateJourney,
+						OperationID:     operationID,
+						OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow, models.OverlapAllow},
+						Value:           journeysTableZeroIds[0:2],
 					}).Times(1)
 					mockProducer.EXPECT().Send(kafka.Message{
-						MessageType: kafka.MultiCreateJourney,
-						Value:       journeysTableZeroIds[2:],
+						MessageType:     kafka.MultiCreateJourney,
+						OperationID:     operationID,
+						OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow},
+						Value:           journeysTableZeroIds[2:],
 					}).Times(1)
 					mockMetrics.EXPECT().MultiCreateJourneyCounterInc().Times(1)
 
@@ -477,13 +1173,14 @@ var _ = Describe("JourneyApi", func() {
 
 					result, err := api.MultiCreateJourneyTaskV1(ctx, req)
 
-					Expect(result).Should(Equal(&emptypb.Empty{}))
+					Expect(result.OperationId).Should(Equal(operationID))
 					Expect(err).Should(BeNil())
 				})
 			})
 
 			Context("Incorrect journeys count in request", func() {
 				It("should return error without calling producer", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, gomock.Any()).Times(0)
 					mockProducer.EXPECT().Send(gomock.Any()).Times(0)
 
 					result, err := api.MultiCreateJourneyTaskV1(ctx, &desc.MultiCreateJourneyTaskRequestV1{})
@@ -494,15 +1191,21 @@ var _ = Describe("JourneyApi", func() {
 			})
 
 			Context("Error in producer", func() {
-				It("should return error", func() {
+				It("should return error and mark operation as failed", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, gomock.Any()).Return(operationID, nil).Times(1)
 					mockProducer.EXPECT().Send(kafka.Message{
-						MessageType: kafka.MultiCreateJourney,
-						Value:       journeysTableZeroIds[0:2],
+						MessageType:     kafka.MultiCreateJourney,
+						OperationID:     operationID,
+						OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow, models.OverlapAllow},
+						Value:           journeysTableZeroIds[0:2],
 					}).Times(1)
 					mockProducer.EXPECT().Send(kafka.Message{
-						MessageType: kafka.MultiCreateJourney,
-						Value:       journeysTableZeroIds[2:],
+						MessageType:     kafka.MultiCreateJourney,
+						OperationID:     operationID,
+						OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow},
+						Value:           journeysTableZeroIds[2:],
 					}).Return(errProducer).Times(1)
+					mockOpRepo.EXPECT().FailOperation(ctx, operationID, errProducer.Error()).Times(1)
 
 					req := &desc.MultiCreateJourneyTaskRequestV1{}
 					for _, journey := range journeysTable {
@@ -524,8 +1227,15 @@ var _ = Describe("JourneyApi", func() {
 			Context("Incorrect chunk size", func() {
 				It("should return error without calling repo", func() {
 					mockRepo.EXPECT().MultiAddJourneys(ctx, gomock.Any()).Times(0)
-
-					newAPI := NewJourneyAPI(mockRepo, mockProducer, mockMetrics, 0)
+					mockOpRepo.EXPECT().AddOperation(ctx, gomock.Any()).Times(0)
+
+					newAPI := NewJourneyAPI(Options{
+						Repo:          mockRepo,
+						OperationRepo: mockOpRepo,
+						Producer:      mockProducer,
+						Metrics:       mockMetrics,
+						Hub:           hub,
+					})
 
 					result, err := newAPI.MultiCreateJourneyTaskV1(ctx, &desc.MultiCreateJourneyTaskRequestV1{
 						Journeys: []*desc.CreateJourneyRequestV1{
@@ -546,30 +1256,29 @@ var _ = Describe("JourneyApi", func() {
 
 		Context("RemoveJourneyTaskV1", func() {
 			Context("Success remove journey with calling producer", func() {
-				It("should return empty result", func() {
+				It("should return operation id", func() {
 					journeyID := uint64(1)
+					mockOpRepo.EXPECT().AddOperation(ctx, models.Operation{Type: models.RemoveOperation, PendingChunks: 1}).Return(operationID, nil).Times(1)
 					mockProducer.EXPECT().Send(kafka.Message{
 						MessageType: kafka.DeleteJourney,
+						OperationID: operationID,
 						Value:       journeyID,
 					}).Times(1)
 					mockMetrics.EXPECT().DeleteJourneyCounterInc().Times(1)
 
 					result, err := api.RemoveJourneyTaskV1(ctx, &desc.RemoveJourneyTaskRequestV1{JourneyId: journeyID})
 
-					Expect(result).Should(Equal(&emptypb.Empty{}))
+					Expect(result.OperationId).Should(Equal(operationID))
 					Expect(err).Should(BeNil())
 				})
 			})
 
 			Context("Incorrect journeyId in request", func() {
 				It("should return error without calling producer", func() {
-					journeyID := uint64(0)
-					mockProducer.EXPECT().Send(kafka.Message{
-						MessageType: kafka.DeleteJourney,
-						Value:       journeyID,
-					}).Times(0)
+					mockOpRepo.EXPECT().AddOperation(ctx, gomock.Any()).Times(0)
+					mockProducer.EXPECT().Send(gomock.Any()).Times(0)
 
-					result, err := api.RemoveJourneyTaskV1(ctx, &desc.RemoveJourneyTaskRequestV1{JourneyId: journeyID})
+					result, err := api.RemoveJourneyTaskV1(ctx, &desc.RemoveJourneyTaskRequestV1{JourneyId: 0})
 
 					Expect(result).Should(BeNil())
 					Expect(err).Should(HaveOccurred())
@@ -577,8 +1286,10 @@ var _ = Describe("JourneyApi", func() {
 			})
 
 			Context("Error in producer", func() {
-				It("should return error", func() {
+				It("should return error and mark operation as failed", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, gomock.Any()).Return(operationID, nil).Times(1)
 					mockProducer.EXPECT().Send(gomock.Any()).Return(errProducer).Times(1)
+					mockOpRepo.EXPECT().FailOperation(ctx, operationID, errProducer.Error()).Times(1)
 
 					result, err := api.RemoveJourneyTaskV1(ctx, &desc.RemoveJourneyTaskRequestV1{JourneyId: 1})
 
@@ -590,10 +1301,13 @@ var _ = Describe("JourneyApi", func() {
 
 		Context("UpdateJourneyTaskV1", func() {
 			Context("Success update journey", func() {
-				It("should return success empty result", func() {
+				It("should return operation id", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, models.Operation{Type: models.UpdateOperation, PendingChunks: 1}).Return(operationID, nil).Times(1)
 					mockProducer.EXPECT().Send(kafka.Message{
-						MessageType: kafka.UpdateJourney,
-						Value:       journeysTable[2],
+						MessageType:     kafka.UpdateJourney,
+						OperationID:     operationID,
+						OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow},
+						Value:           journeysTable[2],
 					}).Times(1)
 					mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
 
@@ -607,13 +1321,14 @@ var _ = Describe("JourneyApi", func() {
 						},
 					})
 
-					Expect(result).Should(Equal(&emptypb.Empty{}))
+					Expect(result.OperationId).Should(Equal(operationID))
 					Expect(err).Should(BeNil())
 				})
 			})
 
 			Context("Incorrect journey id in request", func() {
 				It("should return error without calling producer", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, gomock.Any()).Times(0)
 					mockProducer.EXPECT().Send(gomock.Any()).Times(0)
 
 					result, err := api.UpdateJourneyTaskV1(ctx, &desc.UpdateJourneyTaskRequestV1{
@@ -632,11 +1347,15 @@ var _ = Describe("JourneyApi", func() {
 			})
 
 			Context("Error in producer", func() {
-				It("should return error", func() {
+				It("should return error and mark operation as failed", func() {
+					mockOpRepo.EXPECT().AddOperation(ctx, gomock.Any()).Return(operationID, nil).Times(1)
 					mockProducer.EXPECT().Send(kafka.Message{
-						MessageType: kafka.UpdateJourney,
-						Value:       journeysTable[2],
+						MessageType:     kafka.UpdateJourney,
+						OperationID:     operationID,
+						OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow},
+						Value:           journeysTable[2],
 					}).Return(errProducer).Times(1)
+					mockOpRepo.EXPECT().FailOperation(ctx, operationID, errProducer.Error()).Times(1)
 
 					result, err := api.UpdateJourneyTaskV1(ctx, &desc.UpdateJourneyTaskRequestV1{
 						Journey: &desc.Journey{
@@ -653,6 +1372,78 @@ var _ = Describe("JourneyApi", func() {
 				})
 			})
 		})
+
+		Context("GetJourneyTaskStatusV1", func() {
+			Context("Success get operation", func() {
+				It("should return operation state", func() {
+					mockOpRepo.EXPECT().DescribeOperation(ctx, operationID).Return(&models.Operation{
+						OperationID: operationID,
+						Type:        models.MultiCreateOperation,
+						Status:      models.OperationSucceeded,
+						JourneyIDs:  []uint64{1, 2},
+					}, nil).Times(1)
+
+					result, err := api.GetJourneyTaskStatusV1(ctx, &desc.GetJourneyTaskStatusRequestV1{OperationId: operationID})
+
+					Expect(err).Should(BeNil())
+					Expect(result.Task.OperationId).Should(Equal(operationID))
+					Expect(result.Task.Type).Should(Equal(desc.JourneyTaskType_JOURNEY_TASK_TYPE_MULTI_CREATE))
+					Expect(result.Task.Status).Should(Equal(desc.JourneyTaskStatus_JOURNEY_TASK_STATUS_SUCCEEDED))
+					Expect(result.Task.JourneyIds).Should(Equal([]uint64{1, 2}))
+				})
+			})
+
+			Context("Incorrect operation id in request", func() {
+				It("should return error without calling repo", func() {
+					mockOpRepo.EXPECT().DescribeOperation(ctx, gomock.Any()).Times(0)
+
+					result, err := api.GetJourneyTaskStatusV1(ctx, &desc.GetJourneyTaskStatusRequestV1{OperationId: 0})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+
+			Context("Error in repo", func() {
+				It("should return error", func() {
+					mockOpRepo.EXPECT().DescribeOperation(ctx, operationID).Return(nil, errRepo).Times(1)
+
+					result, err := api.GetJourneyTaskStatusV1(ctx, &desc.GetJourneyTaskStatusRequestV1{OperationId: operationID})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+		})
+
+		Context("ListJourneyTasksV1", func() {
+			Context("Success get list of operations", func() {
+				It("should return operations", func() {
+					mockOpRepo.EXPECT().ListOperations(ctx, uint64(0), uint64(2), uint64(0)).Return([]models.Operation{
+						{OperationID: 2, Type: models.CreateOperation, Status: models.OperationPending},
+						{OperationID: 1, Type: models.RemoveOperation, Status: models.OperationFailed, Error: "error"},
+					}, nil).Times(1)
+
+					result, err := api.ListJourneyTasksV1(ctx, &desc.ListJourneyTasksRequestV1{Limit: 2})
+
+					Expect(err).Should(BeNil())
+					Expect(result.Tasks).Should(HaveLen(2))
+					Expect(result.Tasks[1].Status).Should(Equal(desc.JourneyTaskStatus_JOURNEY_TASK_STATUS_FAILED))
+					Expect(result.Tasks[1].Error).Should(Equal("error"))
+				})
+			})
+
+			Context("Incorrect limit in request", func() {
+				It("should return error without calling repo", func() {
+					mockOpRepo.EXPECT().ListOperations(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+					result, err := api.ListJourneyTasksV1(ctx, &desc.ListJourneyTasksRequestV1{Limit: 0})
+
+					Expect(result).Should(BeNil())
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+		})
 	})
 
 })
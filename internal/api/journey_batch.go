@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// journeyBatch - adds journeys to the repo in chunks of chunkSize and collects result for every journey,
+// so invalid journeys, journeys rejected by their overlap policies and journeys of failed chunks do not prevent
+// adding of other journeys. Chunk is checked and added in one transaction only if overlap policies require checks
+type journeyBatch struct {
+	repo      repo.Repo
+	hub       watch.Publisher
+	chunkSize int
+	// method - name of API method for logs and tracing
+	method string
+	span   opentracing.Span
+
+	results []*desc.JourneyResultV1
+	added   uint64
+	failed  uint64
+
+	chunk    []models.Journey
+	policies []models.OverlapPolicy
+	// pending - results of journeys from chunk, they are filled when chunk is flushed
+	pending []*desc.JourneyResultV1
+}
+
+// newJourneyBatch - creates journeyBatch publishing created journeys to hub,
+// span is optional parent span for tracing of chunks
+func newJourneyBatch(repo repo.Repo, hub watch.Publisher, chunkSize int, method string, span opentracing.Span) *journeyBatch {
+	return &journeyBatch{repo: repo, hub: hub, chunkSize: chunkSize, method: method, span: span}
+}
+
+// add - validates journey and adds it with its overlap policy to the current chunk, chunk is flushed when it is full
+func (b *journeyBatch) add(ctx context.Context, journey models.Journey, policy models.OverlapPolicy) {
+	result := b.next()
+	if err := journey.Validate(); err != nil {
+		b.fail(result, err)
+		return
+	}
+
+	b.chunk = append(b.chunk, journey)
+	b.policies = append(b.policies, policy)
+	b.pending = append(b.pending, result)
+	if len(b.chunk) >= b.chunkSize {
+		b.flush(ctx)
+	}
+}
+
+// skip - saves error result for journey which cannot be added
+func (b *journeyBatch) skip(err error) {
+	b.fail(b.next(), err)
+}
+
+// flush - adds journeys of the current chunk to the repo and saves their results
+func (b *journeyBatch) flush(ctx context.Context) {
+	if len(b.chunk) == 0 {
+		return
+	}
+
+	if b.span != nil {
+		childSpan := opentracing.GlobalTracer().StartSpan(
+			b.method+": chunk",
+			opentracing.Tag{Key: "chunkSize", Value: len(b.chunk)},
+			opentracing.ChildOf(b.span.Context()),
+		)
+		defer childSpan.Finish()
+	}
+
+	ids, checks, err := b.save(ctx)
+	if err != nil {
+		log.Error().Err(err).Int("chunkSize", len(b.chunk)).Msg(b.method + ": failed to add chunk.")
+	}
+
+	events := make([]watch.Event, 0, len(b.chunk))
+	for i, result := range b.pending {
+		switch {
+		case err != nil:
+			b.fail(result, err)
+		case checks[i].Err != nil:
+			b.fail(result, checks[i].Err)
+		default:
+			b.added++
+			result.JourneyId = ids[i]
+			result.OverlappingJourneyIds = checks[i].Overlapping(ids)
+			b.chunk[i].JourneyID = ids[i]
+			events = append(events, watch.Created(b.chunk[i]))
+		}
+	}
+	b.hub.Publish(events...)
+
+	b.chunk = b.chunk[:0]
+	b.policies = b.policies[:0]
+	b.pending = b.pending[:0]
+}
+
+// save - adds journeys of the current chunk which are not rejected by their overlap policies,
+// returns ids and overlap checks of journeys by their positions in chunk, ids of rejected journeys are 0
+func (b *journeyBatch) save(ctx context.Context) ([]uint64, []repo.OverlapCheck, error) {
+	checks := make([]repo.OverlapCheck, len(b.chunk))
+	if !repo.NeedOverlapCheck(b.policies) {
+		ids, err := addJourneys(ctx, b.repo, b.chunk)
+		return ids, checks, err
+	}
+
+	ids := make([]uint64, len(b.chunk))
+	err := b.repo.WithTx(ctx, func(tx repo.Repo) error {
+		var err error
+		if checks, err = repo.CheckOverlaps(ctx, tx, b.chunk, b.policies); err != nil {
+			return err
+		}
+		accepted := notRejected(b.chunk, checks)
+		if len(accepted) == 0 {
+			return nil
+		}
+		acceptedIDs, err := addJourneys(ctx, tx, accepted)
+		if err != nil {
+			return err
+		}
+		for i := range b.chunk {
+			if checks[i].Err == nil {
+				ids[i], acceptedIDs = acceptedIDs[0], acceptedIDs[1:]
+			}
+		}
+		return nil
+	})
+	return ids, checks, err
+}
+
+// addJourneys - adds journeys with one query and checks that repo returned id for every journey
+func addJourneys(ctx context.Context, r repo.Repo, journeys []models.Journey) ([]uint64, error) {
+	ids, err := r.MultiAddJourneys(ctx, journeys)
+	if err == nil && len(ids) != len(journeys) {
+		err = apperrors.New(apperrors.Unknown, "repo returned %d ids for %d journeys", len(ids), len(journeys))
+	}
+	return ids, err
+}
+
+// journeyIDs - returns ids of added journeys in order of adding
+func (b *journeyBatch) journeyIDs() []uint64 {
+	ids := make([]uint64, 0, b.added)
+	for _, result := range b.results {
+		if result.JourneyId > 0 {
+			ids = append(ids, result.JourneyId)
+		}
+	}
+	return ids
+}
+
+// next - creates result for the next journey
+func (b *journeyBatch) next() *desc.JourneyResultV1 {
+	result := &desc.JourneyResultV1{Index: uint64(len(b.results))}
+	b.results = append(b.results, result)
+	return result
+}
+
+// fail - saves error to the result of journey
+func (b *journeyBatch) fail(result *desc.JourneyResultV1, err error) {
+	b.failed++
+	setResultError(result, err)
+}
+
+// setResultError - saves gRPC status code and description of error to the result of journey
+func setResultError(result *desc.JourneyResultV1, err error) {
+	result.Code = uint32(statusCode(err))
+	result.Error = err.Error()
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/auth"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// CalendarFeedTokenParam - query parameter of iCalendar feed served by gateway with token of auth.ScopeCalendarFeed
+const CalendarFeedTokenParam = "token"
+
+// calendarFeedPathFormat - path of iCalendar feed of user served by gateway
+const calendarFeedPathFormat = "/v1/users/%d/journeys.ics"
+
+// GetCalendarFeedV1 - returns path of iCalendar feed of acting user with token which does not expire,
+// calendar apps poll the feed by it without Authorization header
+func (api *JourneyAPI) GetCalendarFeedV1(ctx context.Context, req *desc.GetCalendarFeedRequestV1) (*desc.GetCalendarFeedResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("GetCalendarFeedV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userID := actingUserID(ctx)
+	if api.authenticator == nil || userID == 0 {
+		err := apperrors.New(apperrors.FailedPrecondition, "authentication is disabled, calendar feed is available without token")
+		log.Error().Err(err).Msg("GetCalendarFeedV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	token := api.authenticator.Token(userID, auth.ScopeCalendarFeed, time.Time{})
+	path := fmt.Sprintf(calendarFeedPathFormat, userID) + "?" + url.Values{CalendarFeedTokenParam: {token}}.Encode()
+
+	log.Debug().Uint64("userId", userID).Msg("GetCalendarFeedV1: success.")
+	return &desc.GetCalendarFeedResponseV1{Path: path, Token: token}, nil
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/auth"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("JourneyCalendarApi", func() {
+	var (
+		authenticator = auth.NewAuthenticator("secret")
+		api           desc.JourneyApiV1Server
+	)
+
+	BeforeEach(func() {
+		api = NewJourneyAPI(Options{Authenticator: authenticator})
+	})
+
+	Context("GetCalendarFeedV1", func() {
+		It("should return feed path of acting user with calendar token", func() {
+			result, err := api.GetCalendarFeedV1(auth.WithUserID(context.Background(), 3), &desc.GetCalendarFeedRequestV1{})
+
+			Expect(err).Should(BeNil())
+			Expect(strings.HasPrefix(result.Path, "/v1/users/3/journeys.ics?")).Should(BeTrue())
+			feedURL, err := url.Parse(result.Path)
+			Expect(err).Should(BeNil())
+			Expect(feedURL.Query().Get(CalendarFeedTokenParam)).Should(Equal(result.Token))
+
+			userID, err := authenticator.Verify(result.Token, auth.ScopeCalendarFeed, time.Now())
+			Expect(err).Should(BeNil())
+			Expect(userID).Should(Equal(uint64(3)))
+			_, err = authenticator.Verify(result.Token, auth.ScopeAPI, time.Now())
+			Expect(err).ShouldNot(BeNil())
+		})
+
+		It("should return failed precondition if authentication is disabled", func() {
+			result, err := NewJourneyAPI(Options{}).GetCalendarFeedV1(context.Background(), &desc.GetCalendarFeedRequestV1{})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+})
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// timeFromProto - convert Timestamp proto message to time.Time, nil is converted to zero time
+// (unlike Timestamp.AsTime() that returns Unix epoch) so that models.Journey.Validate() can detect it
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// timeToProto - convert time.Time to Timestamp proto message, zero time is converted to nil
+func timeToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// journeyToProto - convert models.Journey to Journey proto message
+func journeyToProto(journey models.Journey) *desc.Journey {
+	return &desc.Journey{
+		JourneyId:          journey.JourneyID,
+		UserId:             journey.UserID,
+		Address:            journey.Address,
+		Description:        journey.Description,
+		StartTime:          timestamppb.New(journey.StartTime),
+		EndTime:            timestamppb.New(journey.EndTime),
+		Revision:           journey.Revision,
+		TimeZone:           journey.TimeZone,
+		Coordinates:        coordinatesToProto(journey.Coordinates),
+		Waypoints:          waypointsToProto(journey.Waypoints),
+		Tags:               journey.Tags,
+		Status:             journeyStatusToProto(journey.Status),
+		RecurringJourneyId: journey.RecurringJourneyID,
+		OccurrenceTime:     timeToProto(journey.OccurrenceTime),
+	}
+}
+
+// journeyFromProto - convert Journey proto message to models.Journey, revision, waypoints and status are not converted
+// because they are ignored in requests, tags are normalized
+func journeyFromProto(journey *desc.Journey) models.Journey {
+	return models.Journey{
+		JourneyID:   journey.JourneyId,
+		UserID:      journey.UserId,
+		Address:     journey.Address,
+		Description: journey.Description,
+		StartTime:   timeFromProto(journey.StartTime),
+		EndTime:     timeFromProto(journey.EndTime),
+		TimeZone:    journey.TimeZone,
+		Coordinates: coordinatesFromProto(journey.Coordinates),
+		Tags:        models.NormalizeTags(journey.Tags),
+	}
+}
+
+// journeyFromUpdateRequest - convert UpdateJourneyRequestV1 proto message to models.Journey
+// with expected revision of request
+func journeyFromUpdateRequest(req *desc.UpdateJourneyRequestV1) models.Journey {
+	journey := journeyFromProto(req.Journey)
+	journey.Revision = req.ExpectedRevision
+	return journey
+}
+
+// journeyFromCreateRequest - convert CreateJourneyRequestV1 proto message to new models.Journey
+func journeyFromCreateRequest(req *desc.CreateJourneyRequestV1) models.Journey {
+	return models.Journey{
+		UserID:      req.UserId,
+		Address:     req.Address,
+		Description: req.Description,
+		StartTime:   timeFromProto(req.StartTime),
+		EndTime:     timeFromProto(req.EndTime),
+		TimeZone:    req.TimeZone,
+		Coordinates: coordinatesFromProto(req.Coordinates),
+		Waypoints:   waypointsFromProto(req.Waypoints),
+		Tags:        models.NormalizeTags(req.Tags),
+	}
+}
+
+// journeyFromCreateTaskRequest - convert CreateJourneyTaskRequestV1 proto message to new models.Journey
+func journeyFromCreateTaskRequest(req *desc.CreateJourneyTaskRequestV1) models.Journey {
+	return models.Journey{
+		UserID:      req.UserId,
+		Address:     req.Address,
+		Description: req.Description,
+		StartTime:   timeFromProto(req.StartTime),
+		EndTime:     timeFromProto(req.EndTime),
+		TimeZone:    req.TimeZone,
+		Coordinates: coordinatesFromProto(req.Coordinates),
+		Waypoints:   waypointsFromProto(req.Waypoints),
+		Tags:        models.NormalizeTags(req.Tags),
+	}
+}
+
+// coordinatesToProto - convert models.Coordinates to Coordinates proto message, nil is kept
+func coordinatesToProto(coordinates *models.Coordinates) *desc.Coordinates {
+	if coordinates == nil {
+		return nil
+	}
+	return &desc.Coordinates{Latitude: coordinates.Latitude, Longitude: coordinates.Longitude}
+}
+
+// coordinatesFromProto - convert Coordinates proto message to models.Coordinates, nil is kept
+func coordinatesFromProto(coordinates *desc.Coordinates) *models.Coordinates {
+	if coordinates == nil {
+		return nil
+	}
+	return &models.Coordinates{Latitude: coordinates.Latitude, Longitude: coordinates.Longitude}
+}
+
+// waypointToProto - convert models.Waypoint to Waypoint proto message, unknown times are not set
+func waypointToProto(waypoint models.Waypoint) *desc.Waypoint {
+	return &desc.Waypoint{
+		WaypointId:    waypoint.WaypointID,
+		Address:       waypoint.Address,
+		Coordinates:   coordinatesToProto(waypoint.Coordinates),
+		ArrivalTime:   timeToProto(waypoint.ArrivalTime),
+		DepartureTime: timeToProto(waypoint.DepartureTime),
+	}
+}
+
+// waypointFromProto - convert Waypoint proto message to new models.Waypoint, id is ignored in requests
+func waypointFromProto(waypoint *desc.Waypoint) models.Waypoint {
+	return models.Waypoint{
+		Address:       waypoint.Address,
+		Coordinates:   coordinatesFromProto(waypoint.Coordinates),
+		ArrivalTime:   timeFromProto(waypoint.ArrivalTime),
+		DepartureTime: timeFromProto(waypoint.DepartureTime),
+	}
+}
+
+// waypointsToProto - convert waypoints to Waypoint proto messages
+func waypointsToProto(waypoints []models.Waypoint) []*desc.Waypoint {
+	if len(waypoints) == 0 {
+		return nil
+	}
+	result := make([]*desc.Waypoint, len(waypoints))
+	for i, waypoint := range waypoints {
+		result[i] = waypointToProto(waypoint)
+	}
+	return result
+}
+
+// waypointsFromProto - convert Waypoint proto messages to new waypoints, nil is returned for empty list
+func waypointsFromProto(waypoints []*desc.Waypoint) []models.Waypoint {
+	if len(waypoints) == 0 {
+		return nil
+	}
+	result := make([]models.Waypoint, len(waypoints))
+	for i, waypoint := range waypoints {
+		result[i] = waypointFromProto(waypoint)
+	}
+	return result
+}
+
+// participantRoleToProto - convert models.ParticipantRole to ParticipantRole proto enum, values of roles are the same
+func participantRoleToProto(role models.ParticipantRole) desc.ParticipantRole {
+	return desc.ParticipantRole(role)
+}
+
+// participantRoleFromProto - convert ParticipantRole proto enum to models.ParticipantRole, unspecified role is RoleNone
+func participantRoleFromProto(role desc.ParticipantRole) models.ParticipantRole {
+	return models.ParticipantRole(role)
+}
+
+// participantToProto - convert models.Participant to Participant proto message, invited time is not set for owner
+func participantToProto(participant models.Participant) *desc.Participant {
+	return &desc.Participant{
+		UserId:    participant.UserID,
+		Role:      participantRoleToProto(participant.Role),
+		InvitedAt: timeToProto(participant.InvitedAt),
+	}
+}
+
+// journeyStatusToProto - convert models.JourneyStatus to JourneyStatus proto enum,
+// proto values are shifted by one because zero value of proto enum is unspecified
+func journeyStatusToProto(journeyStatus models.JourneyStatus) desc.JourneyStatus {
+	return desc.JourneyStatus(journeyStatus + 1)
+}
+
+// journeyStatusFromProto - convert JourneyStatus proto enum to models.JourneyStatus, unspecified status is not expected
+func journeyStatusFromProto(journeyStatus desc.JourneyStatus) models.JourneyStatus {
+	return models.JourneyStatus(journeyStatus - 1)
+}
+
+// recurringJourneyToProto - convert models.RecurringJourney to RecurringJourney proto message
+func recurringJourneyToProto(recurringJourney models.RecurringJourney) *desc.RecurringJourney {
+	result := &desc.RecurringJourney{
+		RecurringJourneyId: recurringJourney.RecurringJourneyID,
+		UserId:             recurringJourney.UserID,
+		Address:            recurringJourney.Address,
+		Description:        recurringJourney.Description,
+		StartTime:          timestamppb.New(recurringJourney.StartTime),
+		EndTime:            timestamppb.New(recurringJourney.EndTime),
+		TimeZone:           recurringJourney.TimeZone,
+		Coordinates:        coordinatesToProto(recurringJourney.Coordinates),
+		Rule:               recurringJourney.Rule,
+		Revision:           recurringJourney.Revision,
+	}
+	for _, exception := range recurringJourney.Exceptions {
+		result.Exceptions = append(result.Exceptions, occurrenceExceptionToProto(exception))
+	}
+	return result
+}
+
+// recurringJourneyFromProto - convert RecurringJourney proto message to models.RecurringJourney, revision and exceptions
+// are not converted because they are ignored in requests
+func recurringJourneyFromProto(recurringJourney *desc.RecurringJourney) models.RecurringJourney {
+	return models.RecurringJourney{
+		RecurringJourneyID: recurringJourney.RecurringJourneyId,
+		UserID:             recurringJourney.UserId,
+		Address:            recurringJourney.Address,
+		Description:        recurringJourney.Description,
+		StartTime:          timeFromProto(recurringJourney.StartTime),
+		EndTime:            timeFromProto(recurringJourney.EndTime),
+		TimeZone:           recurringJourney.TimeZone,
+		Coordinates:        coordinatesFromProto(recurringJourney.Coordinates),
+		Rule:               recurringJourney.Rule,
+	}
+}
+
+// occurrenceExceptionToProto - convert models.OccurrenceException to OccurrenceException proto message,
+// times of cancelled occurrence are not set
+func occurrenceExceptionToProto(exception models.OccurrenceException) *desc.OccurrenceException {
+	return &desc.OccurrenceException{
+		OccurrenceTime: timestamppb.New(exception.OccurrenceTime),
+		Cancelled:      exception.Cancelled,
+		Address:        exception.Address,
+		Description:    exception.Description,
+		StartTime:      timeToProto(exception.StartTime),
+		EndTime:        timeToProto(exception.EndTime),
+	}
+}
+
+// occurrenceExceptionFromProto - convert OccurrenceException proto message to models.OccurrenceException,
+// fields of cancelled occurrence are not converted
+func occurrenceExceptionFromProto(exception *desc.OccurrenceException) models.OccurrenceException {
+	result := models.OccurrenceException{
+		OccurrenceTime: timeFromProto(exception.OccurrenceTime),
+		Cancelled:      exception.Cancelled,
+	}
+	if !exception.Cancelled {
+		result.Address = exception.Address
+		result.Description = exception.Description
+		result.StartTime = timeFromProto(exception.StartTime)
+		result.EndTime = timeFromProto(exception.EndTime)
+	}
+	return result
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/repo"
+)
+
+var errInvalidTimeRange = errors.New("from_time must be before to_time")
+
+// journeyFilterRequest - request with optional filter fields (ListJourneysRequestV1, ExportJourneysRequestV1)
+type journeyFilterRequest interface {
+	GetUserIds() []uint64
+	GetFromTime() *timestamppb.Timestamp
+	GetToTime() *timestamppb.Timestamp
+	GetText() string
+}
+
+// journeyFilterFromRequest - creates repo.JourneyFilter from optional filter fields of request
+func journeyFilterFromRequest(req journeyFilterRequest) (repo.JourneyFilter, error) {
+	filter := repo.JourneyFilter{
+		UserIDs: req.GetUserIds(),
+		Text:    req.GetText(),
+		From:    timeFromProto(req.GetFromTime()),
+		To:      timeFromProto(req.GetToTime()),
+	}
+	if err := checkTimeRange(filter); err != nil {
+		return repo.JourneyFilter{}, err
+	}
+	return filter, nil
+}
+
+// checkTimeRange - checks that time range of filter is not empty if both bounds are set
+func checkTimeRange(filter repo.JourneyFilter) error {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return errInvalidTimeRange
+	}
+	return nil
+}
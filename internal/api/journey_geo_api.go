@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// FindJourneysNearV1 - returns journeys within radius of the point ordered by distance, journeys shared with users
+// of request are also returned. Journeys are selected and ordered by distance in database.
+func (api *JourneyAPI) FindJourneysNearV1(ctx context.Context, req *desc.FindJourneysNearRequestV1) (*desc.FindJourneysNearResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("FindJourneysNearV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := repo.JourneyFilter{
+		UserIDs: req.UserIds,
+		From:    timeFromProto(req.FromTime),
+		To:      timeFromProto(req.ToTime),
+	}
+	if err := checkTimeRange(filter); err != nil {
+		log.Error().Err(err).Msg("FindJourneysNearV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var err error
+	if filter.UserIDs, err = scopeUserIDs(ctx, filter.UserIDs); err != nil {
+		log.Error().Err(err).Uints64("userIds", req.UserIds).Msg("FindJourneysNearV1: failed.")
+		return nil, toStatusError(err)
+	}
+	filter.Participating = len(filter.UserIDs) > 0
+
+	point := *coordinatesFromProto(req.Point)
+	journeys, err := api.repo.ListJourneysNear(ctx, point, req.RadiusMeters, filter, req.Limit)
+	if err != nil {
+		log.Error().Err(err).Interface("point", point).Float64("radius", req.RadiusMeters).Interface("filter", filter).
+			Msg("FindJourneysNearV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	found := make([]*desc.NearJourneyV1, len(journeys))
+	for i, journey := range journeys {
+		found[i] = &desc.NearJourneyV1{Journey: journeyToProto(journey), DistanceMeters: point.DistanceTo(*journey.Coordinates)}
+	}
+
+	log.Debug().Int("found", len(found)).Msg("FindJourneysNearV1: success.")
+	return &desc.FindJourneysNearResponseV1{Journeys: found}, nil
+}
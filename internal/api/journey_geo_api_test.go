@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("JourneyGeoApi", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRepo    *mocks.MockRepo
+		mockMetrics *mocks.MockMetrics
+		api         desc.JourneyApiV1Server
+		ctx         context.Context
+
+		moscow    = &desc.Coordinates{Latitude: 55.7558, Longitude: 37.6173}
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		journey   = func(journeyID uint64, latitude, longitude float64) models.Journey {
+			return models.Journey{
+				JourneyID:   journeyID,
+				UserID:      1,
+				Address:     "Москва",
+				StartTime:   timeStart,
+				EndTime:     timeStart.Add(time.Hour),
+				Coordinates: &models.Coordinates{Latitude: latitude, Longitude: longitude},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		ctx = context.Background()
+		api = NewJourneyAPI(Options{
+			Repo:      mockRepo,
+			Metrics:   mockMetrics,
+			Hub:       watch.NewHub(10, 10),
+			ChunkSize: 2,
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should return journeys of repo with distances", func() {
+		point := models.Coordinates{Latitude: moscow.Latitude, Longitude: moscow.Longitude}
+		filter := repo.JourneyFilter{UserIDs: []uint64{1}, Participating: true}
+		mockRepo.EXPECT().ListJourneysNear(ctx, point, float64(20000), filter, uint64(2)).Return([]models.Journey{
+			journey(3, 55.76, 37.62), // ~0.5 km
+			journey(1, 55.80, 37.70), // ~7 km
+		}, nil).Times(1)
+
+		resp, err := api.FindJourneysNearV1(ctx, &desc.FindJourneysNearRequestV1{
+			Point:        moscow,
+			RadiusMeters: 20000,
+			Limit:        2,
+			UserIds:      []uint64{1},
+		})
+
+		Expect(err).Should(BeNil())
+		Expect(resp.Journeys).Should(HaveLen(2))
+		Expect(resp.Journeys[0].Journey.JourneyId).Should(Equal(uint64(3)))
+		Expect(resp.Journeys[0].DistanceMeters).Should(BeNumerically("~", 500, 50))
+		Expect(resp.Journeys[0].Journey.Coordinates.Latitude).Should(Equal(55.76))
+		Expect(resp.Journeys[1].Journey.JourneyId).Should(Equal(uint64(1)))
+		Expect(resp.Journeys[1].DistanceMeters).Should(BeNumerically("~", 6900, 300))
+	})
+
+	It("should find only journeys of acting user and shared with it if request has no users", func() {
+		ctx = actingUser(2)
+		filter := repo.JourneyFilter{UserIDs: []uint64{2}, Participating: true}
+		mockRepo.EXPECT().ListJourneysNear(ctx, gomock.Any(), float64(1000), filter, uint64(10)).Return(nil, nil).Times(1)
+
+		resp, err := api.FindJourneysNearV1(ctx, &desc.FindJourneysNearRequestV1{Point: moscow, RadiusMeters: 1000, Limit: 10})
+
+		Expect(err).Should(BeNil())
+		Expect(resp.Journeys).Should(BeEmpty())
+	})
+
+	It("should return permission denied without calling repo for journeys of another user", func() {
+		ctx = actingUser(2)
+		mockRepo.EXPECT().ListJourneysNear(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := api.FindJourneysNearV1(ctx, &desc.FindJourneysNearRequestV1{
+			Point:        moscow,
+			RadiusMeters: 1000,
+			Limit:        10,
+			UserIds:      []uint64{1},
+		})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+	})
+
+	It("should return error without calling repo for invalid point", func() {
+		mockRepo.EXPECT().ListJourneysNear(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := api.FindJourneysNearV1(ctx, &desc.FindJourneysNearRequestV1{
+			Point:        &desc.Coordinates{Latitude: 91},
+			RadiusMeters: 1000,
+			Limit:        10,
+		})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should return error of repo", func() {
+		mockRepo.EXPECT().ListJourneysNear(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("repo error")).Times(1)
+
+		resp, err := api.FindJourneysNearV1(ctx, &desc.FindJourneysNearRequestV1{Point: moscow, RadiusMeters: 1000, Limit: 10})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.Internal))
+	})
+})
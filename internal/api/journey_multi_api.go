@@ -0,0 +1,417 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/kafka"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/utils"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// MultiUpdateJourneyV1 - update journeys using chunks, every chunk is updated with one query.
+// Invalid journeys, journeys which acting user cannot update (see UpdateJourneyV1), journeys rejected by their overlap
+// policies and journeys of failed chunks are skipped, response contains result for every journey.
+func (api *JourneyAPI) MultiUpdateJourneyV1(ctx context.Context, req *desc.MultiUpdateJourneyRequestV1) (*desc.MultiUpdateJourneyResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("MultiUpdateJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := checkUniqueJourneyIDs(req.Journeys); err != nil {
+		log.Error().Err(err).Msg("MultiUpdateJourneyV1: invalid request.")
+		return nil, toStatusError(err)
+	}
+
+	resp := &desc.MultiUpdateJourneyResponseV1{Results: make([]*desc.JourneyResultV1, len(req.Journeys))}
+	journeys := make([]models.Journey, 0, len(req.Journeys))
+	policies := make([]models.OverlapPolicy, 0, len(req.Journeys))
+	pending := make([]*desc.JourneyResultV1, 0, len(req.Journeys))
+	for i, reqJourney := range req.Journeys {
+		journey := journeyFromUpdateRequest(reqJourney)
+		resp.Results[i] = &desc.JourneyResultV1{Index: uint64(i), JourneyId: journey.JourneyID}
+		if err := checkNoWaypoints(reqJourney.Journey); err != nil {
+			setResultError(resp.Results[i], err)
+			continue
+		}
+		if err := journey.Validate(); err != nil {
+			setResultError(resp.Results[i], err)
+			continue
+		}
+		if err := api.checkJourneyUpdate(ctx, journey); err != nil {
+			setResultError(resp.Results[i], err)
+			continue
+		}
+		journeys = append(journeys, journey)
+		policies = append(policies, api.requestOverlapPolicy(reqJourney.OverlapPolicy))
+		pending = append(pending, resp.Results[i])
+	}
+
+	journeysChunks, err := utils.SplitToChunks(journeys, api.chunkSize)
+	if err != nil {
+		log.Error().Err(err).Msg("MultiUpdateJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	for _, chunk := range journeysChunks {
+		api.multiUpdateChunk(ctx, chunk, policies[:len(chunk)], pending[:len(chunk)])
+		policies = policies[len(chunk):]
+		pending = pending[len(chunk):]
+	}
+
+	log.Debug().Int("count", len(req.Journeys)).Msg("MultiUpdateJourneyV1: success.")
+	api.metric.UpdateJourneyCounterInc()
+
+	return resp, nil
+}
+
+// multiUpdateChunk - update chunk of journeys with their overlap policies and save revisions, overlapping journeys
+// or errors to their results
+func (api *JourneyAPI) multiUpdateChunk(
+	ctx context.Context,
+	chunk []models.Journey,
+	policies []models.OverlapPolicy,
+	results []*desc.JourneyResultV1,
+) {
+	revisions, checks, err := api.updateWithOverlapPolicies(ctx, chunk, policies)
+	if err != nil {
+		log.Error().Err(err).Int("chunkSize", len(chunk)).Msg("MultiUpdateJourneyV1: failed to update chunk.")
+		for _, result := range results {
+			setResultError(result, err)
+		}
+		return
+	}
+
+	chunkIDs := make([]uint64, len(chunk))
+	for i, journey := range chunk {
+		chunkIDs[i] = journey.JourneyID
+	}
+
+	var notUpdatedIDs []uint64
+	events := make([]watch.Event, 0, len(revisions))
+	for i, journey := range chunk {
+		if checks[i].Err != nil {
+			setResultError(results[i], checks[i].Err)
+			continue
+		}
+		if revision, ok := revisions[journey.JourneyID]; ok {
+			results[i].Revision = revision
+			results[i].OverlappingJourneyIds = checks[i].Overlapping(chunkIDs)
+			events = append(events, watch.Updated(journey))
+			continue
+		}
+		notUpdatedIDs = append(notUpdatedIDs, journey.JourneyID)
+	}
+	api.hub.Publish(watch.Load(ctx, api.repo, events...)...)
+	if len(notUpdatedIDs) == 0 {
+		return
+	}
+
+	// journeys are not updated because they are not found or have another revision, existing ones are conflicted
+	existing, err := api.repo.BatchGetJourneys(ctx, notUpdatedIDs)
+	existingIDs := make(map[uint64]bool, len(existing))
+	for _, journey := range existing {
+		existingIDs[journey.JourneyID] = true
+	}
+	for i, journey := range chunk {
+		if _, ok := revisions[journey.JourneyID]; ok || checks[i].Err != nil {
+			continue
+		}
+		switch {
+		case err != nil:
+			setResultError(results[i], err)
+		case existingIDs[journey.JourneyID]:
+			setResultError(results[i], repo.ErrRevisionConflict)
+		default:
+			setResultError(results[i], apperrors.New(apperrors.NotFound, "journey %d not found", journey.JourneyID))
+		}
+	}
+}
+
+// updateWithOverlapPolicies - updates journeys which are not rejected by their overlap policies, returns new revisions
+// by ids and overlap checks of journeys by their positions. Journeys are checked and updated in one transaction
+// only if policies require checks
+func (api *JourneyAPI) updateWithOverlapPolicies(
+	ctx context.Context,
+	journeys []models.Journey,
+	policies []models.OverlapPolicy,
+) (map[uint64]uint64, []repo.OverlapCheck, error) {
+	checks := make([]repo.OverlapCheck, len(journeys))
+	if !repo.NeedOverlapCheck(policies) {
+		revisions, err := api.repo.MultiUpdateJourneys(ctx, journeys)
+		return revisions, checks, err
+	}
+
+	var revisions map[uint64]uint64
+	err := api.repo.WithTx(ctx, func(tx repo.Repo) error {
+		var err error
+		if checks, err = repo.CheckOverlaps(ctx, tx, journeys, policies); err != nil {
+			return err
+		}
+		accepted := notRejected(journeys, checks)
+		if len(accepted) == 0 {
+			return nil
+		}
+		revisions, err = tx.MultiUpdateJourneys(ctx, accepted)
+		return err
+	})
+	return revisions, checks, err
+}
+
+// MultiRemoveJourneyV1 - remove journeys using chunks, every chunk is removed with one query.
+// Response contains result for every journey, journeys which acting user does not own
+// and journeys of failed chunks are not removed.
+func (api *JourneyAPI) MultiRemoveJourneyV1(ctx context.Context, req *desc.MultiRemoveJourneyRequestV1) (*desc.MultiRemoveJourneyResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("MultiRemoveJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &desc.MultiRemoveJourneyResponseV1{Results: make([]*desc.JourneyResultV1, len(req.JourneyIds))}
+	journeyIDs := make([]uint64, 0, len(req.JourneyIds))
+	pending := make([]*desc.JourneyResultV1, 0, len(req.JourneyIds))
+	for i, journeyID := range req.JourneyIds {
+		resp.Results[i] = &desc.JourneyResultV1{Index: uint64(i), JourneyId: journeyID}
+		if _, err := api.checkJourneyAccess(ctx, journeyID, models.RoleOwner); err != nil {
+			setResultError(resp.Results[i], err)
+			continue
+		}
+		journeyIDs = append(journeyIDs, journeyID)
+		pending = append(pending, resp.Results[i])
+	}
+
+	idsChunks, err := utils.SplitIDsToChunks(journeyIDs, api.chunkSize)
+	if err != nil {
+		log.Error().Err(err).Msg("MultiRemoveJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	for _, chunk := range idsChunks {
+		// owners are loaded before removal to filter subscriptions of events
+		owners, err := api.repo.GetJourneyOwners(ctx, chunk)
+		var removedIDs []uint64
+		if err == nil {
+			removedIDs, err = api.repo.MultiRemoveJourneys(ctx, chunk)
+		}
+		if err != nil {
+			log.Error().Err(err).Int("chunkSize", len(chunk)).Msg("MultiRemoveJourneyV1: failed to remove chunk.")
+		}
+		removed := make(map[uint64]bool, len(removedIDs))
+		events := make([]watch.Event, len(removedIDs))
+		for i, journeyID := range removedIDs {
+			removed[journeyID] = true
+			events[i] = watch.Deleted(journeyID, owners[journeyID])
+		}
+		api.hub.Publish(watch.Load(ctx, api.repo, events...)...)
+
+		for i, journeyID := range chunk {
+			switch {
+			case err != nil:
+				setResultError(pending[i], err)
+			case !removed[journeyID]:
+				setResultError(pending[i], apperrors.New(apperrors.NotFound, "journey %d not found", journeyID))
+			}
+		}
+		pending = pending[len(chunk):]
+	}
+
+	log.Debug().Int("count", len(req.JourneyIds)).Msg("MultiRemoveJourneyV1: success.")
+	api.metric.DeleteJourneyCounterInc()
+
+	return resp, nil
+}
+
+// BatchGetJourneysV1 - get journeys by ids using chunks, response contains found journeys in order of request
+// and ids of journeys which are not found. Acting user must participate in every found journey
+func (api *JourneyAPI) BatchGetJourneysV1(ctx context.Context, req *desc.BatchGetJourneysRequestV1) (*desc.BatchGetJourneysResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("BatchGetJourneysV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for _, journeyID := range req.JourneyIds {
+		_, err := api.checkJourneyAccess(ctx, journeyID, models.RoleViewer)
+		if err != nil && !apperrors.Is(err, apperrors.NotFound) {
+			log.Error().Err(err).Uint64("journeyId", journeyID).Msg("BatchGetJourneysV1: failed.")
+			return nil, toStatusError(err)
+		}
+	}
+
+	idsChunks, err := utils.SplitIDsToChunks(req.JourneyIds, api.chunkSize)
+	if err != nil {
+		log.Error().Err(err).Msg("BatchGetJourneysV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	found := make(map[uint64]models.Journey, len(req.JourneyIds))
+	for _, chunk := range idsChunks {
+		journeys, err := api.repo.BatchGetJourneys(ctx, chunk)
+		if err != nil {
+			log.Error().Err(err).Int("chunkSize", len(chunk)).Msg("BatchGetJourneysV1: failed.")
+			return nil, toStatusError(err)
+		}
+		for _, journey := range journeys {
+			found[journey.JourneyID] = journey
+		}
+	}
+
+	resp := &desc.BatchGetJourneysResponseV1{Journeys: make([]*desc.Journey, 0, len(found))}
+	for _, journeyID := range req.JourneyIds {
+		journey, ok := found[journeyID]
+		if !ok {
+			resp.MissingJourneyIds = append(resp.MissingJourneyIds, journeyID)
+			continue
+		}
+		resp.Journeys = append(resp.Journeys, journeyToProto(journey))
+	}
+
+	log.Debug().Int("count", len(req.JourneyIds)).Int("missing", len(resp.MissingJourneyIds)).Msg("BatchGetJourneysV1: success.")
+	return resp, nil
+}
+
+// MultiUpdateJourneyTaskV1 - update journeys using producer and splitting on chunks,
+// all chunks are tracked by one operation, every chunk is applied in one transaction.
+// Permissions are checked in the same way as in UpdateJourneyV1, chunk with journey rejected by its overlap policy
+// fails the operation
+func (api *JourneyAPI) MultiUpdateJourneyTaskV1(ctx context.Context, req *desc.MultiUpdateJourneyTaskRequestV1) (*desc.MultiUpdateJourneyTaskResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("MultiUpdateJourneyTaskV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := checkUniqueJourneyIDs(req.Journeys); err != nil {
+		log.Error().Err(err).Msg("MultiUpdateJourneyTaskV1: invalid request.")
+		return nil, toStatusError(err)
+	}
+
+	userID := actingUserID(ctx)
+	journeys := make([]models.Journey, len(req.Journeys))
+	policies := make([]models.OverlapPolicy, len(req.Journeys))
+	for i, reqJourney := range req.Journeys {
+		journeys[i] = journeyFromUpdateRequest(reqJourney)
+		policies[i] = api.requestOverlapPolicy(reqJourney.OverlapPolicy)
+		if err := checkNoWaypoints(reqJourney.Journey); err != nil {
+			err = apperrors.Wrap(apperrors.InvalidArgument, err, "journeys[%d]", i)
+			log.Error().Err(err).Msg("MultiUpdateJourneyTaskV1: invalid request.")
+			return nil, toStatusError(err)
+		}
+		if err := journeys[i].Validate(); err != nil {
+			err = apperrors.Wrap(apperrors.InvalidArgument, err, "journeys[%d]", i)
+			log.Error().Err(err).Msg("MultiUpdateJourneyTaskV1: invalid request.")
+			return nil, toStatusError(err)
+		}
+		if err := api.checkJourneyUpdate(ctx, journeys[i]); err != nil {
+			err = apperrors.Wrap(apperrors.KindOf(err), err, "journeys[%d]", i)
+			log.Error().Err(err).Msg("MultiUpdateJourneyTaskV1: failed.")
+			return nil, toStatusError(err)
+		}
+	}
+
+	journeysChunks, err := utils.SplitToChunks(journeys, api.chunkSize)
+	if err != nil {
+		log.Error().Err(err).Msg("MultiUpdateJourneyTaskV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	operationID, err := api.operationRepo.AddOperation(ctx, models.Operation{
+		UserID:        userID,
+		Type:          models.MultiUpdateOperation,
+		PendingChunks: uint(len(journeysChunks)),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("MultiUpdateJourneyTaskV1: failed to create operation.")
+		return nil, toStatusError(err)
+	}
+
+	for _, chunk := range journeysChunks {
+		err = api.producer.Send(kafka.Message{
+			MessageType:     kafka.MultiUpdateJourney,
+			OperationID:     operationID,
+			UserID:          userID,
+			OverlapPolicies: policies[:len(chunk)],
+			Value:           chunk,
+		})
+		policies = policies[len(chunk):]
+		if err != nil {
+			log.Error().Err(err).Msg("MultiUpdateJourneyTaskV1: failed.")
+			api.failOperation(ctx, operationID, err)
+			return nil, toStatusError(err)
+		}
+	}
+
+	log.Debug().Uint64("operationId", operationID).Msg("MultiUpdateJourneyTaskV1: success send to producer.")
+	api.metric.UpdateJourneyCounterInc()
+
+	return &desc.MultiUpdateJourneyTaskResponseV1{OperationId: operationID}, nil
+}
+
+// MultiRemoveJourneyTaskV1 - remove journeys using producer and splitting on chunks,
+// all chunks are tracked by one operation, every chunk is applied in one transaction. Only owner can remove journeys
+func (api *JourneyAPI) MultiRemoveJourneyTaskV1(ctx context.Context, req *desc.MultiRemoveJourneyTaskRequestV1) (*desc.MultiRemoveJourneyTaskResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("MultiRemoveJourneyTaskV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userID := actingUserID(ctx)
+	for i, journeyID := range req.JourneyIds {
+		if _, err := api.checkJourneyAccess(ctx, journeyID, models.RoleOwner); err != nil {
+			err = apperrors.Wrap(apperrors.KindOf(err), err, "journey_ids[%d]", i)
+			log.Error().Err(err).Msg("MultiRemoveJourneyTaskV1: failed.")
+			return nil, toStatusError(err)
+		}
+	}
+
+	idsChunks, err := utils.SplitIDsToChunks(req.JourneyIds, api.chunkSize)
+	if err != nil {
+		log.Error().Err(err).Msg("MultiRemoveJourneyTaskV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	operationID, err := api.operationRepo.AddOperation(ctx, models.Operation{
+		UserID:        userID,
+		Type:          models.MultiRemoveOperation,
+		PendingChunks: uint(len(idsChunks)),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("MultiRemoveJourneyTaskV1: failed to create operation.")
+		return nil, toStatusError(err)
+	}
+
+	for _, chunk := range idsChunks {
+		err = api.producer.Send(kafka.Message{
+			MessageType: kafka.MultiDeleteJourney,
+			OperationID: operationID,
+			UserID:      userID,
+			Value:       chunk,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("MultiRemoveJourneyTaskV1: failed.")
+			api.failOperation(ctx, operationID, err)
+			return nil, toStatusError(err)
+		}
+	}
+
+	log.Debug().Uint64("operationId", operationID).Msg("MultiRemoveJourneyTaskV1: success send to producer.")
+	api.metric.DeleteJourneyCounterInc()
+
+	return &desc.MultiRemoveJourneyTaskResponseV1{OperationId: operationID}, nil
+}
+
+// checkUniqueJourneyIDs - checks that every journey is listed in request only once
+func checkUniqueJourneyIDs(reqJourneys []*desc.UpdateJourneyRequestV1) error {
+	seen := make(map[uint64]bool, len(reqJourneys))
+	for i, reqJourney := range reqJourneys {
+		journeyID := reqJourney.GetJourney().GetJourneyId()
+		if seen[journeyID] {
+			return apperrors.New(apperrors.InvalidArgument, "journeys[%d]: journey %d is listed more than once", i, journeyID)
+		}
+		seen[journeyID] = true
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/kafka"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("JourneyMultiApi", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockRepo     *mocks.MockRepo
+		mockOpRepo   *mocks.MockOperationRepo
+		mockProducer *mocks.MockProducer
+		mockMetrics  *mocks.MockMetrics
+		hub          watch.Hub
+		api          desc.JourneyApiV1Server
+		ctx          context.Context
+
+		timeStart     = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd       = time.Date(2021, 01, 02, 0, 0, 0, 0, time.UTC)
+		journeysTable = []models.Journey{
+			{JourneyID: 1, UserID: 1, Address: "Воронеж", StartTime: timeStart, EndTime: timeEnd},
+			{JourneyID: 2, UserID: 1, Address: "Уфа", StartTime: timeStart, EndTime: timeEnd},
+			{JourneyID: 3, UserID: 2, Address: "Москва", StartTime: timeStart, EndTime: timeEnd},
+		}
+		operationID = uint64(7)
+		errRepo     = errors.New("repo error")
+	)
+
+	updateRequests := func(journeys ...models.Journey) []*desc.UpdateJourneyRequestV1 {
+		reqs := make([]*desc.UpdateJourneyRequestV1, len(journeys))
+		for i, journey := range journeys {
+			reqs[i] = &desc.UpdateJourneyRequestV1{
+				Journey: &desc.Journey{
+					JourneyId: journey.JourneyID,
+					UserId:    journey.UserID,
+					Address:   journey.Address,
+					StartTime: timestamppb.New(journey.StartTime),
+					EndTime:   timestamppb.New(journey.EndTime),
+				},
+				ExpectedRevision: journey.Revision,
+			}
+		}
+		return reqs
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		// participants of changed journeys are loaded for watchers
+		mockRepo.EXPECT().GetJourneyParticipantIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockOpRepo = mocks.NewMockOperationRepo(ctrl)
+		mockProducer = mocks.NewMockProducer(ctrl)
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		hub = watch.NewHub(10, 10)
+		ctx = context.Background()
+		api = NewJourneyAPI(Options{
+			Repo:          mockRepo,
+			OperationRepo: mockOpRepo,
+			Producer:      mockProducer,
+			Metrics:       mockMetrics,
+			Hub:           hub,
+			ChunkSize:     2,
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("MultiUpdateJourneyV1", func() {
+		It("should update journeys by chunks and return result for every journey", func() {
+			invalid := journeysTable[1]
+			invalid.Address = ""
+			conflicted := journeysTable[2]
+			conflicted.Revision = 5
+			missing := models.Journey{JourneyID: 4, UserID: 1, Address: "Тула", StartTime: timeStart, EndTime: timeEnd}
+
+			gomock.InOrder(
+				mockRepo.EXPECT().MultiUpdateJourneys(ctx, []models.Journey{journeysTable[0], conflicted}).
+					Return(map[uint64]uint64{1: 2}, nil),
+				mockRepo.EXPECT().DescribeJourney(ctx, uint64(1)).Return(&journeysTable[0], nil),
+				mockRepo.EXPECT().BatchGetJourneys(ctx, []uint64{3}).Return([]models.Journey{journeysTable[2]}, nil),
+				mockRepo.EXPECT().MultiUpdateJourneys(ctx, []models.Journey{missing}).Return(map[uint64]uint64{}, nil),
+				mockRepo.EXPECT().BatchGetJourneys(ctx, []uint64{4}).Return(nil, nil),
+			)
+			mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+			result, err := api.MultiUpdateJourneyV1(ctx, &desc.MultiUpdateJourneyRequestV1{
+				Journeys: updateRequests(journeysTable[0], invalid, conflicted, missing),
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Results).Should(HaveLen(4))
+			Expect(result.Results[0].Revision).Should(Equal(uint64(2)))
+			Expect(result.Results[0].Code).Should(Equal(uint32(codes.OK)))
+			Expect(result.Results[1].Code).Should(Equal(uint32(codes.InvalidArgument)))
+			Expect(result.Results[2].Code).Should(Equal(uint32(codes.Aborted)))
+			Expect(result.Results[3].Code).Should(Equal(uint32(codes.NotFound)))
+		})
+
+		It("should return error status for journeys of failed chunk", func() {
+			mockRepo.EXPECT().MultiUpdateJourneys(ctx, journeysTable[:2]).Return(nil, errRepo).Times(1)
+			mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+			result, err := api.MultiUpdateJourneyV1(ctx, &desc.MultiUpdateJourneyRequestV1{
+				Journeys: updateRequests(journeysTable[:2]...),
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Results[0].Code).Should(Equal(uint32(codes.Internal)))
+			Expect(result.Results[1].Code).Should(Equal(uint32(codes.Internal)))
+		})
+
+		It("should return error without calling repo if journey is listed twice", func() {
+			mockRepo.EXPECT().MultiUpdateJourneys(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.MultiUpdateJourneyV1(ctx, &desc.MultiUpdateJourneyRequestV1{
+				Journeys: updateRequests(journeysTable[0], journeysTable[0]),
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("MultiRemoveJourneyV1", func() {
+		It("should remove journeys by chunks and return result for every journey", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().GetJourneyOwners(ctx, []uint64{1, 2}).Return(map[uint64]uint64{1: 1}, nil),
+				mockRepo.EXPECT().MultiRemoveJourneys(ctx, []uint64{1, 2}).Return([]uint64{1}, nil),
+				mockRepo.EXPECT().GetJourneyOwners(ctx, []uint64{3}).Return(map[uint64]uint64{3: 1}, nil),
+				mockRepo.EXPECT().MultiRemoveJourneys(ctx, []uint64{3}).Return(nil, errRepo),
+			)
+			mockMetrics.EXPECT().DeleteJourneyCounterInc().Times(1)
+
+			result, err := api.MultiRemoveJourneyV1(ctx, &desc.MultiRemoveJourneyRequestV1{JourneyIds: []uint64{1, 2, 3}})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Results).Should(HaveLen(3))
+			Expect(result.Results[0].Code).Should(Equal(uint32(codes.OK)))
+			Expect(result.Results[1].Code).Should(Equal(uint32(codes.NotFound)))
+			Expect(result.Results[2].Index).Should(Equal(uint64(2)))
+			Expect(result.Results[2].Code).Should(Equal(uint32(codes.Internal)))
+		})
+
+		It("should return error without calling repo for duplicated ids", func() {
+			mockRepo.EXPECT().MultiRemoveJourneys(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.MultiRemoveJourneyV1(ctx, &desc.MultiRemoveJourneyRequestV1{JourneyIds: []uint64{1, 1}})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("BatchGetJourneysV1", func() {
+		It("should return found journeys in order of request and missing ids", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().BatchGetJourneys(ctx, []uint64{3, 5}).Return([]models.Journey{journeysTable[2]}, nil),
+				mockRepo.EXPECT().BatchGetJourneys(ctx, []uint64{1}).Return([]models.Journey{journeysTable[0]}, nil),
+			)
+
+			result, err := api.BatchGetJourneysV1(ctx, &desc.BatchGetJourneysRequestV1{JourneyIds: []uint64{3, 5, 1}})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Journeys).Should(HaveLen(2))
+			Expect(result.Journeys[0].JourneyId).Should(Equal(uint64(3)))
+			Expect(result.Journeys[1].JourneyId).Should(Equal(uint64(1)))
+			Expect(result.MissingJourneyIds).Should(Equal([]uint64{5}))
+		})
+
+		It("should return error of repo", func() {
+			mockRepo.EXPECT().BatchGetJourneys(ctx, []uint64{1}).Return(nil, errRepo).Times(1)
+
+			result, err := api.BatchGetJourneysV1(ctx, &desc.BatchGetJourneysRequestV1{JourneyIds: []uint64{1}})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.Internal))
+		})
+	})
+
+	Context("MultiUpdateJourneyTaskV1", func() {
+		It("should send chunks to producer tracked by one operation", func() {
+			gomock.InOrder(
+				mockOpRepo.EXPECT().AddOperation(ctx, models.Operation{Type: models.MultiUpdateOperation, PendingChunks: 2}).
+					Return(operationID, nil),
+				mockProducer.EXPECT().Send(kafka.Message{
+					MessageType:     kafka.MultiUpdateJourney,
+					OperationID:     operationID,
+					OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow, models.OverlapAllow},
+					Value:           journeysTable[:2],
+				}).
+					Return(nil),
+				mockProducer.EXPECT().Send(kafka.Message{
+					MessageType:     kafka.MultiUpdateJourney,
+					OperationID:     operationID,
+					OverlapPolicies: []models.OverlapPolicy{models.OverlapAllow},
+					Value:           journeysTable[2:],
+				}).
+					Return(nil),
+			)
+			mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+			result, err := api.MultiUpdateJourneyTaskV1(ctx, &desc.MultiUpdateJourneyTaskRequestV1{
+				Journeys: updateRequests(journeysTable...),
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.OperationId).Should(Equal(operationID))
+		})
+
+		It("should return error without creating operation for invalid journey", func() {
+			invalid := journeysTable[0]
+			invalid.EndTime = invalid.StartTime.Add(-time.Hour)
+			mockOpRepo.EXPECT().AddOperation(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.MultiUpdateJourneyTaskV1(ctx, &desc.MultiUpdateJourneyTaskRequestV1{
+				Journeys: updateRequests(invalid),
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("MultiRemoveJourneyTaskV1", func() {
+		It("should send chunks to producer tracked by one operation", func() {
+			gomock.InOrder(
+				mockOpRepo.EXPECT().AddOperation(ctx, models.Operation{Type: models.MultiRemoveOperation, PendingChunks: 2}).
+					Return(operationID, nil),
+				mockProducer.EXPECT().Send(kafka.Message{MessageType: kafka.MultiDeleteJourney, OperationID: operationID, Value: []uint64{1, 2}}).
+					Return(nil),
+				mockProducer.EXPECT().Send(kafka.Message{MessageType: kafka.MultiDeleteJourney, OperationID: operationID, Value: []uint64{3}}).
+					Return(errRepo),
+				mockOpRepo.EXPECT().FailOperation(ctx, operationID, errRepo.Error()).Return(nil),
+			)
+
+			result, err := api.MultiRemoveJourneyTaskV1(ctx, &desc.MultiRemoveJourneyTaskRequestV1{JourneyIds: []uint64{1, 2, 3}})
+
+			Expect(result).Should(BeNil())
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var overlapPolicies = map[desc.OverlapPolicy]models.OverlapPolicy{
+	desc.OverlapPolicy_OVERLAP_POLICY_ALLOW:  models.OverlapAllow,
+	desc.OverlapPolicy_OVERLAP_POLICY_WARN:   models.OverlapWarn,
+	desc.OverlapPolicy_OVERLAP_POLICY_REJECT: models.OverlapReject,
+}
+
+// requestOverlapPolicy - returns policy of request or configured policy if request does not set it
+func (api *JourneyAPI) requestOverlapPolicy(requestPolicy desc.OverlapPolicy) models.OverlapPolicy {
+	if policy, ok := overlapPolicies[requestPolicy]; ok {
+		return policy
+	}
+	return api.overlapPolicy
+}
+
+// saveWithOverlapPolicy - calls save with repo and returns ids of other journeys of the user overlapping journey.
+// If policy is not OverlapAllow, journeys of the user are locked in one transaction with save,
+// so concurrent requests cannot create overlapping journeys, and save is not called if policy is OverlapReject
+// and overlapping journeys are found. Ids are not looked up with OverlapAllow.
+func (api *JourneyAPI) saveWithOverlapPolicy(
+	ctx context.Context,
+	journey models.Journey,
+	policy models.OverlapPolicy,
+	save func(r repo.Repo) error,
+) ([]uint64, error) {
+	if policy == models.OverlapAllow {
+		return nil, save(api.repo)
+	}
+
+	var overlapping []uint64
+	err := api.repo.WithTx(ctx, func(tx repo.Repo) error {
+		checks, err := repo.CheckOverlaps(ctx, tx, []models.Journey{journey}, []models.OverlapPolicy{policy})
+		if err != nil {
+			return err
+		}
+		if checks[0].Err != nil {
+			return checks[0].Err
+		}
+		overlapping = checks[0].JourneyIDs
+		return save(tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(overlapping) > 0 {
+		log.Warn().Uint64("journeyId", journey.JourneyID).Uint64("userId", journey.UserID).Uints64("overlapping", overlapping).
+			Msg("Journey overlaps other journeys of the user")
+	}
+	return overlapping, nil
+}
+
+// requestOverlapPolicies - returns policies of journeys of batch request in the same order
+func (api *JourneyAPI) requestOverlapPolicies(reqJourneys []*desc.CreateJourneyRequestV1) []models.OverlapPolicy {
+	policies := make([]models.OverlapPolicy, len(reqJourneys))
+	for i, reqJourney := range reqJourneys {
+		policies[i] = api.requestOverlapPolicy(reqJourney.OverlapPolicy)
+	}
+	return policies
+}
+
+// notRejected - returns journeys which are not rejected by their overlap checks
+func notRejected(journeys []models.Journey, checks []repo.OverlapCheck) []models.Journey {
+	accepted := make([]models.Journey, 0, len(journeys))
+	for i, journey := range journeys {
+		if checks[i].Err == nil {
+			accepted = append(accepted, journey)
+		}
+	}
+	return accepted
+}
+
+// FindOverlappingJourneysV1 - returns pairs of journeys of the user overlapping in time within optional time range
+func (api *JourneyAPI) FindOverlappingJourneysV1(ctx context.Context, req *desc.FindOverlappingJourneysRequestV1) (*desc.FindOverlappingJourneysResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("FindOverlappingJourneysV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := repo.JourneyFilter{From: timeFromProto(req.FromTime), To: timeFromProto(req.ToTime)}
+	if err := checkTimeRange(filter); err != nil {
+		log.Error().Err(err).Msg("FindOverlappingJourneysV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := checkActingUser(ctx, req.UserId); err != nil {
+		log.Error().Err(err).Uint64("userId", req.UserId).Msg("FindOverlappingJourneysV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	overlaps, err := api.repo.FindOverlappingJourneys(ctx, req.UserId, filter.From, filter.To, req.Limit)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", req.UserId).Msg("FindOverlappingJourneysV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	resp := &desc.FindOverlappingJourneysResponseV1{Overlaps: make([]*desc.JourneyOverlapV1, len(overlaps))}
+	for i, overlap := range overlaps {
+		resp.Overlaps[i] = &desc.JourneyOverlapV1{
+			First:     journeyToProto(overlap.First),
+			Second:    journeyToProto(overlap.Second),
+			StartTime: timestamppb.New(overlap.Start),
+			EndTime:   timestamppb.New(overlap.End),
+		}
+	}
+
+	log.Debug().Uint64("userId", req.UserId).Int("overlaps", len(overlaps)).Msg("FindOverlappingJourneysV1: success.")
+	return resp, nil
+}
@@ -0,0 +1,347 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("JourneyOverlapApi", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRepo    *mocks.MockRepo
+		mockMetrics *mocks.MockMetrics
+		policy      models.OverlapPolicy
+		api         desc.JourneyApiV1Server
+		ctx         context.Context
+
+		timeStart  = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd    = time.Date(2021, 01, 03, 0, 0, 0, 0, time.UTC)
+		journey    = models.Journey{JourneyID: 5, UserID: 1, Address: "Казань", StartTime: timeStart, EndTime: timeEnd}
+		newJourney = models.Journey{UserID: 1, Address: "Казань", StartTime: timeStart, EndTime: timeEnd}
+		errRepo    = errors.New("repo error")
+	)
+
+	createReq := func(policy desc.OverlapPolicy) *desc.CreateJourneyRequestV1 {
+		return &desc.CreateJourneyRequestV1{
+			UserId:        newJourney.UserID,
+			Address:       newJourney.Address,
+			StartTime:     timestamppb.New(newJourney.StartTime),
+			EndTime:       timestamppb.New(newJourney.EndTime),
+			OverlapPolicy: policy,
+		}
+	}
+
+	expectTx := func() {
+		mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, fn func(repo.Repo) error) error {
+				return fn(mockRepo)
+			}).Times(1)
+		mockRepo.EXPECT().LockUserJourneys(ctx, uint64(1)).Return(nil).Times(1)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		// participants of changed journeys are loaded for watchers
+		mockRepo.EXPECT().GetJourneyParticipantIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		ctx = context.Background()
+		policy = models.OverlapReject
+	})
+
+	JustBeforeEach(func() {
+		api = NewJourneyAPI(Options{
+			Repo:          mockRepo,
+			Metrics:       mockMetrics,
+			Hub:           watch.NewHub(10, 10),
+			ChunkSize:     2,
+			OverlapPolicy: policy,
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("CreateJourneyV1", func() {
+		It("should reject overlapping journey with failed precondition", func() {
+			expectTx()
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, newJourney).Return([]uint64{2, 3}, nil).Times(1)
+			mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.CreateJourneyV1(ctx, createReq(desc.OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED))
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should add journey in transaction if there are no overlapping journeys", func() {
+			expectTx()
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, newJourney).Return(nil, nil).Times(1)
+			mockRepo.EXPECT().AddJourney(ctx, newJourney).Return(uint64(5), nil).Times(1)
+			mockMetrics.EXPECT().CreateJourneyCounterInc().Times(1)
+
+			result, err := api.CreateJourneyV1(ctx, createReq(desc.OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED))
+
+			Expect(err).Should(BeNil())
+			Expect(result.JourneyId).Should(Equal(uint64(5)))
+			Expect(result.OverlappingJourneyIds).Should(BeEmpty())
+		})
+
+		It("should add journey and return overlapping journeys with warn policy from request", func() {
+			expectTx()
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, newJourney).Return([]uint64{2}, nil).Times(1)
+			mockRepo.EXPECT().AddJourney(ctx, newJourney).Return(uint64(5), nil).Times(1)
+			mockMetrics.EXPECT().CreateJourneyCounterInc().Times(1)
+
+			result, err := api.CreateJourneyV1(ctx, createReq(desc.OverlapPolicy_OVERLAP_POLICY_WARN))
+
+			Expect(err).Should(BeNil())
+			Expect(result.JourneyId).Should(Equal(uint64(5)))
+			Expect(result.OverlappingJourneyIds).Should(Equal([]uint64{2}))
+		})
+
+		It("should add journey without transaction with allow policy from request", func() {
+			mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).Times(0)
+			mockRepo.EXPECT().AddJourney(ctx, newJourney).Return(uint64(5), nil).Times(1)
+			mockMetrics.EXPECT().CreateJourneyCounterInc().Times(1)
+
+			result, err := api.CreateJourneyV1(ctx, createReq(desc.OverlapPolicy_OVERLAP_POLICY_ALLOW))
+
+			Expect(err).Should(BeNil())
+			Expect(result.JourneyId).Should(Equal(uint64(5)))
+		})
+
+		It("should return error of lock", func() {
+			mockRepo.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, fn func(repo.Repo) error) error {
+					return fn(mockRepo)
+				}).Times(1)
+			mockRepo.EXPECT().LockUserJourneys(ctx, uint64(1)).Return(errRepo).Times(1)
+			mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.CreateJourneyV1(ctx, createReq(desc.OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED))
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.Internal))
+		})
+	})
+
+	Context("UpdateJourneyV1", func() {
+		It("should reject overlapping journey with failed precondition", func() {
+			expectTx()
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, journey).Return([]uint64{2}, nil).Times(1)
+			mockRepo.EXPECT().UpdateJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.UpdateJourneyV1(ctx, &desc.UpdateJourneyRequestV1{Journey: journeyToProto(journey)})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		Context("Warn policy is configured", func() {
+			BeforeEach(func() {
+				policy = models.OverlapWarn
+			})
+
+			It("should update journey and return overlapping journeys", func() {
+				expectTx()
+				mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, journey).Return([]uint64{2, 4}, nil).Times(1)
+				mockRepo.EXPECT().UpdateJourney(ctx, journey).Return(uint64(2), nil).Times(1)
+				mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+				mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+				result, err := api.UpdateJourneyV1(ctx, &desc.UpdateJourneyRequestV1{Journey: journeyToProto(journey)})
+
+				Expect(err).Should(BeNil())
+				Expect(result).Should(Equal(&desc.UpdateJourneyResponseV1{Revision: 2, OverlappingJourneyIds: []uint64{2, 4}}))
+			})
+		})
+	})
+
+	Context("PatchJourneyV1", func() {
+		It("should check overlapping of patched journey", func() {
+			patched := journey
+			patched.EndTime = timeEnd.AddDate(0, 0, 1)
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+			expectTx()
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, patched).Return([]uint64{7}, nil).Times(1)
+			mockRepo.EXPECT().PatchJourney(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.PatchJourneyV1(ctx, &desc.PatchJourneyRequestV1{
+				Journey:    &desc.Journey{JourneyId: journey.JourneyID, EndTime: timestamppb.New(patched.EndTime)},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"end_time"}},
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Context("MultiCreateJourneyV1", func() {
+		var later models.Journey
+
+		BeforeEach(func() {
+			later = newJourney
+			later.StartTime, later.EndTime = timeEnd, timeEnd.AddDate(0, 0, 1)
+		})
+
+		It("should lock user once and skip only rejected journey", func() {
+			laterReq := createReq(desc.OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED)
+			laterReq.StartTime, laterReq.EndTime = timestamppb.New(later.StartTime), timestamppb.New(later.EndTime)
+			expectTx()
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, newJourney).Return([]uint64{2}, nil).Times(1)
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, later).Return(nil, nil).Times(1)
+			mockRepo.EXPECT().MultiAddJourneys(ctx, []models.Journey{later}).Return([]uint64{8}, nil).Times(1)
+			mockMetrics.EXPECT().MultiCreateJourneyCounterInc().Times(1)
+
+			result, err := api.MultiCreateJourneyV1(ctx, &desc.MultiCreateJourneyRequestV1{
+				Journeys: []*desc.CreateJourneyRequestV1{createReq(desc.OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED), laterReq},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.JourneyIds).Should(Equal([]uint64{8}))
+			Expect(result.Results[0].Code).Should(Equal(uint32(codes.FailedPrecondition)))
+			Expect(result.Results[1].JourneyId).Should(Equal(uint64(8)))
+		})
+
+		It("should report journeys of the same request overlapping each other with warn policy", func() {
+			expectTx()
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, newJourney).Return(nil, nil).Times(2)
+			mockRepo.EXPECT().MultiAddJourneys(ctx, []models.Journey{newJourney, newJourney}).Return([]uint64{8, 9}, nil).Times(1)
+			mockMetrics.EXPECT().MultiCreateJourneyCounterInc().Times(1)
+
+			result, err := api.MultiCreateJourneyV1(ctx, &desc.MultiCreateJourneyRequestV1{
+				Journeys: []*desc.CreateJourneyRequestV1{
+					createReq(desc.OverlapPolicy_OVERLAP_POLICY_WARN),
+					createReq(desc.OverlapPolicy_OVERLAP_POLICY_WARN),
+				},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Results[0].OverlappingJourneyIds).Should(Equal([]uint64{9}))
+			Expect(result.Results[1].OverlappingJourneyIds).Should(Equal([]uint64{8}))
+		})
+
+		It("should fail atomic request if journey overlaps earlier journey of request", func() {
+			expectTx()
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, newJourney).Return(nil, nil).Times(2)
+			mockRepo.EXPECT().MultiAddJourneys(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.MultiCreateJourneyV1(ctx, &desc.MultiCreateJourneyRequestV1{
+				Journeys: []*desc.CreateJourneyRequestV1{
+					createReq(desc.OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED),
+					createReq(desc.OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED),
+				},
+				Atomic: true,
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Context("MultiUpdateJourneyV1", func() {
+		var other models.Journey
+
+		BeforeEach(func() {
+			other = journey
+			other.JourneyID = 6
+		})
+
+		It("should not update rejected journey", func() {
+			expectTx()
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, journey).Return([]uint64{2}, nil).Times(1)
+			mockRepo.EXPECT().MultiUpdateJourneys(gomock.Any(), gomock.Any()).Times(0)
+			mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+			result, err := api.MultiUpdateJourneyV1(ctx, &desc.MultiUpdateJourneyRequestV1{
+				Journeys: []*desc.UpdateJourneyRequestV1{{Journey: journeyToProto(journey)}},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Results[0].Code).Should(Equal(uint32(codes.FailedPrecondition)))
+		})
+
+		It("should report stored and updated journeys overlapping journey with warn policy", func() {
+			expectTx()
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, journey).Return([]uint64{6, 7}, nil).Times(1)
+			mockRepo.EXPECT().ListOverlappingJourneyIDs(ctx, other).Return([]uint64{5}, nil).Times(1)
+			mockRepo.EXPECT().MultiUpdateJourneys(ctx, []models.Journey{journey, other}).
+				Return(map[uint64]uint64{5: 2, 6: 3}, nil).Times(1)
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+			mockRepo.EXPECT().DescribeJourney(ctx, other.JourneyID).Return(&other, nil).Times(1)
+			mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+			result, err := api.MultiUpdateJourneyV1(ctx, &desc.MultiUpdateJourneyRequestV1{
+				Journeys: []*desc.UpdateJourneyRequestV1{
+					{Journey: journeyToProto(journey), OverlapPolicy: desc.OverlapPolicy_OVERLAP_POLICY_WARN},
+					{Journey: journeyToProto(other), OverlapPolicy: desc.OverlapPolicy_OVERLAP_POLICY_WARN},
+				},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Results[0].Revision).Should(Equal(uint64(2)))
+			Expect(result.Results[0].OverlappingJourneyIds).Should(Equal([]uint64{7, 6}))
+			Expect(result.Results[1].OverlappingJourneyIds).Should(Equal([]uint64{5}))
+		})
+	})
+
+	Context("FindOverlappingJourneysV1", func() {
+		It("should return overlapping pairs of journeys", func() {
+			second := journey
+			second.JourneyID = 6
+			second.StartTime = timeStart.AddDate(0, 0, 1)
+			second.EndTime = timeEnd.AddDate(0, 0, 1)
+			mockRepo.EXPECT().FindOverlappingJourneys(ctx, uint64(1), timeStart, time.Time{}, uint64(10)).
+				Return([]repo.JourneyOverlap{{First: journey, Second: second, Start: second.StartTime, End: timeEnd}}, nil).Times(1)
+
+			result, err := api.FindOverlappingJourneysV1(ctx, &desc.FindOverlappingJourneysRequestV1{
+				UserId:   1,
+				FromTime: timestamppb.New(timeStart),
+				Limit:    10,
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Overlaps).Should(HaveLen(1))
+			Expect(result.Overlaps[0].First.JourneyId).Should(Equal(uint64(5)))
+			Expect(result.Overlaps[0].Second.JourneyId).Should(Equal(uint64(6)))
+			Expect(result.Overlaps[0].StartTime.AsTime()).Should(Equal(second.StartTime))
+			Expect(result.Overlaps[0].EndTime.AsTime()).Should(Equal(timeEnd))
+		})
+
+		It("should return error without calling repo for incorrect user id", func() {
+			mockRepo.EXPECT().FindOverlappingJourneys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.FindOverlappingJourneysV1(ctx, &desc.FindOverlappingJourneysRequestV1{Limit: 10})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should return error of repo", func() {
+			mockRepo.EXPECT().FindOverlappingJourneys(ctx, uint64(1), time.Time{}, time.Time{}, uint64(10)).
+				Return(nil, errRepo).Times(1)
+
+			result, err := api.FindOverlappingJourneysV1(ctx, &desc.FindOverlappingJourneysRequestV1{UserId: 1, Limit: 10})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.Internal))
+		})
+	})
+})
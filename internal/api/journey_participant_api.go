@@ -0,0 +1,184 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/auth"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// actingUserID - returns id of user authenticated by auth interceptors, 0 is returned when authentication
+// is disabled, then requests are not restricted like before participants were added
+func actingUserID(ctx context.Context) uint64 {
+	return auth.UserID(ctx)
+}
+
+// checkJourneyAccess - returns access of acting user to journey or PermissionDenied error if its role is less than required,
+// owner role is returned without loading journey for requests without acting user
+func (api *JourneyAPI) checkJourneyAccess(ctx context.Context, journeyID uint64, required models.ParticipantRole) (repo.JourneyAccess, error) {
+	userID := actingUserID(ctx)
+	if userID == 0 {
+		return repo.JourneyAccess{Role: models.RoleOwner}, nil
+	}
+	return repo.CheckJourneyAccess(ctx, api.repo, journeyID, userID, required)
+}
+
+// checkJourneyUpdate - returns PermissionDenied error if acting user is not editor of journey
+// or changes its user without being owner
+func (api *JourneyAPI) checkJourneyUpdate(ctx context.Context, journey models.Journey) error {
+	access, err := api.checkJourneyAccess(ctx, journey.JourneyID, models.RoleEditor)
+	if err != nil {
+		return err
+	}
+	return repo.CheckOwnerChange(access, journey.UserID)
+}
+
+// journeyOwner - returns owner of journey from access checked by checkJourneyAccess,
+// owner is loaded for requests without acting user because their access is not checked
+func (api *JourneyAPI) journeyOwner(ctx context.Context, journeyID uint64, access repo.JourneyAccess) (uint64, error) {
+	if access.OwnerID > 0 {
+		return access.OwnerID, nil
+	}
+	owners, err := api.repo.GetJourneyOwners(ctx, []uint64{journeyID})
+	if err != nil {
+		return 0, err
+	}
+	ownerID, ok := owners[journeyID]
+	if !ok {
+		return 0, apperrors.New(apperrors.NotFound, "journey %d not found", journeyID)
+	}
+	return ownerID, nil
+}
+
+// removedJourneyOwner - returns owner of removed journey or PermissionDenied error if acting user is not its owner,
+// requests without acting user are allowed
+func (api *JourneyAPI) removedJourneyOwner(ctx context.Context, journeyID uint64) (uint64, error) {
+	userID := actingUserID(ctx)
+	ownerID, err := api.repo.GetRemovedJourneyOwner(ctx, journeyID)
+	if err != nil {
+		return 0, err
+	}
+	if userID != 0 && ownerID != userID {
+		return 0, apperrors.New(apperrors.PermissionDenied, "user %d is not owner of journey %d", userID, journeyID)
+	}
+	return ownerID, nil
+}
+
+// checkActingUser - returns PermissionDenied error if acting user is not userID, e.g. when journey is created for another user.
+// Requests without acting user are allowed
+func checkActingUser(ctx context.Context, userID uint64) error {
+	actingID := actingUserID(ctx)
+	if actingID != 0 && actingID != userID {
+		return apperrors.New(apperrors.PermissionDenied, "user %d cannot access journeys of user %d", actingID, userID)
+	}
+	return nil
+}
+
+// checkAdmin - returns PermissionDenied error if acting user is not admin, requests without acting user are allowed
+func (api *JourneyAPI) checkAdmin(ctx context.Context) error {
+	userID := actingUserID(ctx)
+	if userID == 0 || api.admins[userID] {
+		return nil
+	}
+	return apperrors.New(apperrors.PermissionDenied, "user %d is not admin", userID)
+}
+
+// scopeUserIDs - returns ids of users whose journeys are read by request: acting user if request has no ids,
+// otherwise ids of request if they contain only acting user. Requests without acting user can read
+// journeys of any users, their ids are returned as is
+func scopeUserIDs(ctx context.Context, userIDs []uint64) ([]uint64, error) {
+	actingID := actingUserID(ctx)
+	if actingID == 0 {
+		return userIDs, nil
+	}
+	if len(userIDs) == 0 {
+		return []uint64{actingID}, nil
+	}
+	for _, userID := range userIDs {
+		if userID != actingID {
+			return nil, apperrors.New(apperrors.PermissionDenied, "user %d cannot access journeys of user %d", actingID, userID)
+		}
+	}
+	return userIDs, nil
+}
+
+// InviteParticipantV1 - share journey with user as viewer or editor, role of already invited user is changed.
+// Only owner of journey can invite users
+func (api *JourneyAPI) InviteParticipantV1(ctx context.Context, req *desc.InviteParticipantRequestV1) (*desc.InviteParticipantResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("InviteParticipantV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := api.checkJourneyAccess(ctx, req.JourneyId, models.RoleOwner); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("InviteParticipantV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	participant := models.Participant{UserID: req.UserId, Role: participantRoleFromProto(req.Role)}
+	if err := api.repo.AddParticipant(ctx, req.JourneyId, participant); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Uint64("userId", req.UserId).Msg("InviteParticipantV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("journeyId", req.JourneyId).Uint64("userId", req.UserId).Stringer("role", participant.Role).Msg("InviteParticipantV1: success.")
+	return &desc.InviteParticipantResponseV1{}, nil
+}
+
+// RemoveParticipantV1 - stop sharing journey with user, owner can remove any participant
+// and participant can leave journey
+func (api *JourneyAPI) RemoveParticipantV1(ctx context.Context, req *desc.RemoveParticipantRequestV1) (*desc.RemoveParticipantResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("RemoveParticipantV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if actingUserID(ctx) != req.UserId {
+		if _, err := api.checkJourneyAccess(ctx, req.JourneyId, models.RoleOwner); err != nil {
+			log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("RemoveParticipantV1: failed.")
+			return nil, toStatusError(err)
+		}
+	}
+
+	if err := api.repo.RemoveParticipant(ctx, req.JourneyId, req.UserId); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Uint64("userId", req.UserId).Msg("RemoveParticipantV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("journeyId", req.JourneyId).Uint64("userId", req.UserId).Msg("RemoveParticipantV1: success.")
+	return &desc.RemoveParticipantResponseV1{}, nil
+}
+
+// ListParticipantsV1 - get owner and invited users of journey, any participant can list them
+func (api *JourneyAPI) ListParticipantsV1(ctx context.Context, req *desc.ListParticipantsRequestV1) (*desc.ListParticipantsResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("ListParticipantsV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := api.checkJourneyAccess(ctx, req.JourneyId, models.RoleViewer); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("ListParticipantsV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	participants, err := api.repo.ListParticipants(ctx, req.JourneyId)
+	if err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("ListParticipantsV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	resp := &desc.ListParticipantsResponseV1{Participants: make([]*desc.Participant, len(participants))}
+	for i, participant := range participants {
+		resp.Participants[i] = participantToProto(participant)
+	}
+
+	log.Debug().Uint64("journeyId", req.JourneyId).Int("count", len(participants)).Msg("ListParticipantsV1: success.")
+	return resp, nil
+}
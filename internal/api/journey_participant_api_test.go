@@ -0,0 +1,393 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/kafka"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("JourneyParticipantApi", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockRepo     *mocks.MockRepo
+		mockOpRepo   *mocks.MockOperationRepo
+		mockProducer *mocks.MockProducer
+		mockMetrics  *mocks.MockMetrics
+		api          desc.JourneyApiV1Server
+		ctx          context.Context
+
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd   = time.Date(2021, 01, 03, 0, 0, 0, 0, time.UTC)
+		journey   = models.Journey{JourneyID: 5, UserID: 1, Address: "Курск", StartTime: timeStart, EndTime: timeEnd, Revision: 4}
+
+		updateReq = func(userID uint64) *desc.UpdateJourneyRequestV1 {
+			return &desc.UpdateJourneyRequestV1{Journey: &desc.Journey{
+				JourneyId: journey.JourneyID,
+				UserId:    userID,
+				Address:   journey.Address,
+				StartTime: timestamppb.New(timeStart),
+				EndTime:   timestamppb.New(timeEnd),
+			}}
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		// participants of changed journeys are loaded for watchers
+		mockRepo.EXPECT().GetJourneyParticipantIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockOpRepo = mocks.NewMockOperationRepo(ctrl)
+		mockProducer = mocks.NewMockProducer(ctrl)
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		ctx = actingUser(2)
+		api = NewJourneyAPI(Options{
+			Repo:          mockRepo,
+			OperationRepo: mockOpRepo,
+			Producer:      mockProducer,
+			Metrics:       mockMetrics,
+			Hub:           watch.NewHub(10, 10),
+			ChunkSize:     2,
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("InviteParticipantV1", func() {
+		It("should add participant if acting user is owner", func() {
+			ctx = actingUser(1)
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(1)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleOwner}, nil).Times(1)
+			mockRepo.EXPECT().AddParticipant(ctx, journey.JourneyID, models.Participant{UserID: 2, Role: models.RoleEditor}).Return(nil).Times(1)
+
+			result, err := api.InviteParticipantV1(ctx, &desc.InviteParticipantRequestV1{
+				JourneyId: journey.JourneyID, UserId: 2, Role: desc.ParticipantRole_PARTICIPANT_ROLE_EDITOR,
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result).ShouldNot(BeNil())
+		})
+
+		It("should return permission denied if acting user is editor", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleEditor}, nil).Times(1)
+			mockRepo.EXPECT().AddParticipant(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.InviteParticipantV1(ctx, &desc.InviteParticipantRequestV1{
+				JourneyId: journey.JourneyID, UserId: 3, Role: desc.ParticipantRole_PARTICIPANT_ROLE_VIEWER,
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should return error without calling repo for owner role", func() {
+			mockRepo.EXPECT().GetJourneyAccess(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.InviteParticipantV1(ctx, &desc.InviteParticipantRequestV1{
+				JourneyId: journey.JourneyID, UserId: 3, Role: desc.ParticipantRole_PARTICIPANT_ROLE_OWNER,
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should not check access of request without acting user", func() {
+			ctx = context.Background()
+			mockRepo.EXPECT().GetJourneyAccess(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			mockRepo.EXPECT().AddParticipant(ctx, journey.JourneyID, models.Participant{UserID: 3, Role: models.RoleViewer}).Return(nil).Times(1)
+
+			result, err := api.InviteParticipantV1(ctx, &desc.InviteParticipantRequestV1{
+				JourneyId: journey.JourneyID, UserId: 3, Role: desc.ParticipantRole_PARTICIPANT_ROLE_VIEWER,
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result).ShouldNot(BeNil())
+		})
+	})
+
+	Context("RemoveParticipantV1", func() {
+		It("should let participant leave journey without checking role", func() {
+			mockRepo.EXPECT().GetJourneyAccess(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			mockRepo.EXPECT().RemoveParticipant(ctx, journey.JourneyID, uint64(2)).Return(nil).Times(1)
+
+			_, err := api.RemoveParticipantV1(ctx, &desc.RemoveParticipantRequestV1{JourneyId: journey.JourneyID, UserId: 2})
+
+			Expect(err).Should(BeNil())
+		})
+
+		It("should return permission denied if viewer removes another participant", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleViewer}, nil).Times(1)
+			mockRepo.EXPECT().RemoveParticipant(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := api.RemoveParticipantV1(ctx, &desc.RemoveParticipantRequestV1{JourneyId: journey.JourneyID, UserId: 3})
+
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+	})
+
+	Context("ListParticipantsV1", func() {
+		It("should return participants if acting user is viewer", func() {
+			invitedAt := timeStart.Add(-time.Hour)
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleViewer}, nil).Times(1)
+			mockRepo.EXPECT().ListParticipants(ctx, journey.JourneyID).Return([]models.Participant{
+				{UserID: 1, Role: models.RoleOwner},
+				{UserID: 2, Role: models.RoleViewer, InvitedAt: invitedAt},
+			}, nil).Times(1)
+
+			result, err := api.ListParticipantsV1(ctx, &desc.ListParticipantsRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Participants).Should(HaveLen(2))
+			Expect(result.Participants[0].Role).Should(Equal(desc.ParticipantRole_PARTICIPANT_ROLE_OWNER))
+			Expect(result.Participants[0].InvitedAt).Should(BeNil())
+			Expect(result.Participants[1].Role).Should(Equal(desc.ParticipantRole_PARTICIPANT_ROLE_VIEWER))
+			Expect(result.Participants[1].InvitedAt.AsTime()).Should(Equal(invitedAt))
+		})
+
+		It("should return permission denied if acting user does not participate", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleNone}, nil).Times(1)
+			mockRepo.EXPECT().ListParticipants(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ListParticipantsV1(ctx, &desc.ListParticipantsRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+	})
+
+	Context("Permissions", func() {
+		It("should let editor update journey", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleEditor}, nil).Times(1)
+			mockRepo.EXPECT().UpdateJourney(ctx, gomock.Any()).Return(uint64(5), nil).Times(1)
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+			mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+			result, err := api.UpdateJourneyV1(ctx, updateReq(1))
+
+			Expect(err).Should(BeNil())
+			Expect(result.Revision).Should(Equal(uint64(5)))
+		})
+
+		It("should not let viewer update journey", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleViewer}, nil).Times(1)
+			mockRepo.EXPECT().UpdateJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.UpdateJourneyV1(ctx, updateReq(1))
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should not let editor give journey to another user", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleEditor}, nil).Times(1)
+			mockRepo.EXPECT().UpdateJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.UpdateJourneyV1(ctx, updateReq(2))
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should not let editor patch user of journey", func() {
+			current := journey
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleEditor}, nil).Times(1)
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&current, nil).Times(1)
+			mockRepo.EXPECT().PatchJourney(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.PatchJourneyV1(ctx, &desc.PatchJourneyRequestV1{
+				Journey:    &desc.Journey{JourneyId: journey.JourneyID, UserId: 2},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user_id"}},
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should not let editor remove journey", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleEditor}, nil).Times(1)
+			mockRepo.EXPECT().RemoveJourney(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.RemoveJourneyV1(ctx, &desc.RemoveJourneyRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should not let viewer remove waypoint", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleViewer}, nil).Times(1)
+			mockRepo.EXPECT().RemoveWaypoint(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.RemoveWaypointV1(ctx, &desc.RemoveWaypointRequestV1{JourneyId: journey.JourneyID, WaypointId: 3})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should return journeys shared with user when listing by user", func() {
+			filter := repo.JourneyFilter{UserIDs: []uint64{2}, Participating: true}
+			mockRepo.EXPECT().ListJourneys(ctx, filter, uint64(10), uint64(0)).Return([]models.Journey{journey}, nil).Times(1)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: 10, UserIds: []uint64{2}})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Journeys).Should(HaveLen(1))
+		})
+	})
+
+	Context("acting user", func() {
+		It("should not describe journey to user who does not participate in it", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleNone}, nil).Times(1)
+			mockRepo.EXPECT().DescribeJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.DescribeJourneyV1(ctx, &desc.DescribeJourneyRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should list own and shared journeys if users are not set", func() {
+			filter := repo.JourneyFilter{UserIDs: []uint64{2}, Participating: true}
+			mockRepo.EXPECT().ListJourneys(ctx, filter, uint64(10), uint64(0)).Return([]models.Journey{journey}, nil).Times(1)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: 10})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Journeys).Should(HaveLen(1))
+		})
+
+		It("should not list journeys of another user", func() {
+			mockRepo.EXPECT().ListJourneys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: 10, UserIds: []uint64{2, 1}})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should not create journey of another user", func() {
+			mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.CreateJourneyV1(ctx, &desc.CreateJourneyRequestV1{
+				UserId: 1, Address: journey.Address, StartTime: timestamppb.New(timeStart), EndTime: timestamppb.New(timeEnd),
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should not let editor restore journey", func() {
+			mockRepo.EXPECT().GetRemovedJourneyOwner(ctx, journey.JourneyID).Return(uint64(1), nil).Times(1)
+			mockRepo.EXPECT().RestoreJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.RestoreJourneyV1(ctx, &desc.RestoreJourneyRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should remove only journeys owned by acting user", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, uint64(5), uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleEditor}, nil).Times(1)
+			mockRepo.EXPECT().GetJourneyAccess(ctx, uint64(6), uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 2, Role: models.RoleOwner}, nil).Times(1)
+			mockRepo.EXPECT().GetJourneyOwners(ctx, []uint64{6}).Return(map[uint64]uint64{6: 2}, nil).Times(1)
+			mockRepo.EXPECT().MultiRemoveJourneys(ctx, []uint64{6}).Return([]uint64{6}, nil).Times(1)
+			mockMetrics.EXPECT().DeleteJourneyCounterInc().Times(1)
+
+			result, err := api.MultiRemoveJourneyV1(ctx, &desc.MultiRemoveJourneyRequestV1{JourneyIds: []uint64{5, 6}})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Results).Should(HaveLen(2))
+			Expect(result.Results[0].Code).Should(Equal(uint32(codes.PermissionDenied)))
+			Expect(result.Results[1].Index).Should(Equal(uint64(1)))
+			Expect(result.Results[1].JourneyId).Should(Equal(uint64(6)))
+			Expect(result.Results[1].Code).Should(Equal(uint32(codes.OK)))
+		})
+
+		It("should not return journeys of batch if user does not participate in one of them", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, uint64(5), uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleNone}, nil).Times(1)
+			mockRepo.EXPECT().BatchGetJourneys(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.BatchGetJourneysV1(ctx, &desc.BatchGetJourneysRequestV1{JourneyIds: []uint64{5}})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should check permissions before sending update task", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleViewer}, nil).Times(1)
+			mockOpRepo.EXPECT().AddOperation(gomock.Any(), gomock.Any()).Times(0)
+			mockProducer.EXPECT().Send(gomock.Any()).Times(0)
+
+			result, err := api.UpdateJourneyTaskV1(ctx, &desc.UpdateJourneyTaskRequestV1{Journey: updateReq(1).Journey})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should send acting user with remove task", func() {
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 2, Role: models.RoleOwner}, nil).Times(1)
+			mockOpRepo.EXPECT().AddOperation(ctx, models.Operation{UserID: 2, Type: models.RemoveOperation, PendingChunks: 1}).
+				Return(uint64(7), nil).Times(1)
+			mockProducer.EXPECT().Send(kafka.Message{
+				MessageType: kafka.DeleteJourney, OperationID: 7, UserID: 2, Value: journey.JourneyID,
+			}).Return(nil).Times(1)
+			mockMetrics.EXPECT().DeleteJourneyCounterInc().Times(1)
+
+			result, err := api.RemoveJourneyTaskV1(ctx, &desc.RemoveJourneyTaskRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(err).Should(BeNil())
+			Expect(result.OperationId).Should(Equal(uint64(7)))
+		})
+
+		It("should list only operations created by acting user", func() {
+			mockOpRepo.EXPECT().ListOperations(ctx, uint64(2), uint64(10), uint64(0)).
+				Return([]models.Operation{{OperationID: 7, UserID: 2, Type: models.RemoveOperation}}, nil).Times(1)
+
+			result, err := api.ListJourneyTasksV1(ctx, &desc.ListJourneyTasksRequestV1{Limit: 10})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Tasks).Should(HaveLen(1))
+			Expect(result.Tasks[0].OperationId).Should(Equal(uint64(7)))
+		})
+
+		It("should not return state of operation created by another user", func() {
+			mockOpRepo.EXPECT().DescribeOperation(ctx, uint64(7)).
+				Return(&models.Operation{OperationID: 7, UserID: 1, Type: models.RemoveOperation}, nil).Times(1)
+
+			result, err := api.GetJourneyTaskStatusV1(ctx, &desc.GetJourneyTaskStatusRequestV1{OperationId: 7})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// SearchJourneysV1 - full-text search of journeys by address and description with Russian and English stemming,
+// results are ordered by relevance and contain snippets with highlighted matched words
+func (api *JourneyAPI) SearchJourneysV1(ctx context.Context, req *desc.SearchJourneysRequestV1) (*desc.SearchJourneysResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("SearchJourneysV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := repo.JourneyFilter{
+		UserIDs: req.UserIds,
+		From:    timeFromProto(req.FromTime),
+		To:      timeFromProto(req.ToTime),
+	}
+	if err := checkTimeRange(filter); err != nil {
+		log.Error().Err(err).Msg("SearchJourneysV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var err error
+	if filter.UserIDs, err = scopeUserIDs(ctx, filter.UserIDs); err != nil {
+		log.Error().Err(err).Uints64("userIds", req.UserIds).Msg("SearchJourneysV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	results, err := api.repo.SearchJourneys(ctx, req.Query, filter, req.Limit, req.Offset)
+	if err != nil {
+		log.Error().Err(err).Str("query", req.Query).Interface("filter", filter).Msg("SearchJourneysV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	resp := &desc.SearchJourneysResponseV1{Results: make([]*desc.FoundJourneyV1, len(results))}
+	for i, result := range results {
+		resp.Results[i] = &desc.FoundJourneyV1{
+			Journey: journeyToProto(result.Journey),
+			Rank:    result.Rank,
+			Snippet: result.Snippet,
+		}
+	}
+
+	log.Debug().Str("query", req.Query).Int("found", len(results)).Msg("SearchJourneysV1: success.")
+	return resp, nil
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("JourneySearchApi", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRepo    *mocks.MockRepo
+		mockMetrics *mocks.MockMetrics
+		api         desc.JourneyApiV1Server
+		ctx         context.Context
+
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd   = time.Date(2021, 01, 02, 0, 0, 0, 0, time.UTC)
+		found     = []repo.JourneySearchResult{
+			{
+				Journey: models.Journey{JourneyID: 2, UserID: 1, Address: "Сочи", Description: "поездка на море", StartTime: timeStart, EndTime: timeEnd},
+				Rank:    0.5,
+				Snippet: "Сочи поездка на <b>море</b>",
+			},
+			{
+				Journey: models.Journey{JourneyID: 1, UserID: 1, Address: "Анапа", Description: "морской берег", StartTime: timeStart, EndTime: timeEnd},
+				Rank:    0.1,
+				Snippet: "Анапа <b>морской</b> берег",
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		ctx = context.Background()
+		api = NewJourneyAPI(Options{
+			Repo:      mockRepo,
+			Metrics:   mockMetrics,
+			Hub:       watch.NewHub(10, 10),
+			ChunkSize: 2,
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should return found journeys with rank and snippet in order of repo", func() {
+		filter := repo.JourneyFilter{UserIDs: []uint64{1}, From: timeStart, To: timeEnd}
+		mockRepo.EXPECT().SearchJourneys(ctx, "море", filter, uint64(10), uint64(5)).Return(found, nil).Times(1)
+
+		resp, err := api.SearchJourneysV1(ctx, &desc.SearchJourneysRequestV1{
+			Query:    "море",
+			Offset:   5,
+			Limit:    10,
+			UserIds:  []uint64{1},
+			FromTime: timestamppb.New(timeStart),
+			ToTime:   timestamppb.New(timeEnd),
+		})
+
+		Expect(err).Should(BeNil())
+		Expect(resp.Results).Should(HaveLen(2))
+		Expect(resp.Results[0].Journey.JourneyId).Should(Equal(uint64(2)))
+		Expect(resp.Results[0].Rank).Should(Equal(float32(0.5)))
+		Expect(resp.Results[0].Snippet).Should(Equal(found[0].Snippet))
+		Expect(resp.Results[1].Journey.Address).Should(Equal("Анапа"))
+	})
+
+	It("should search only journeys of acting user if request has no users", func() {
+		ctx = actingUser(2)
+		filter := repo.JourneyFilter{UserIDs: []uint64{2}}
+		mockRepo.EXPECT().SearchJourneys(ctx, "море", filter, uint64(10), uint64(0)).Return(nil, nil).Times(1)
+
+		resp, err := api.SearchJourneysV1(ctx, &desc.SearchJourneysRequestV1{Query: "море", Limit: 10})
+
+		Expect(err).Should(BeNil())
+		Expect(resp.Results).Should(BeEmpty())
+	})
+
+	It("should return permission denied without calling repo for journeys of another user", func() {
+		ctx = actingUser(2)
+		mockRepo.EXPECT().SearchJourneys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := api.SearchJourneysV1(ctx, &desc.SearchJourneysRequestV1{Query: "море", Limit: 10, UserIds: []uint64{1}})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+	})
+
+	It("should return permission denied without calling repo if users of request contain another user", func() {
+		ctx = actingUser(2)
+		mockRepo.EXPECT().SearchJourneys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := api.SearchJourneysV1(ctx, &desc.SearchJourneysRequestV1{Query: "море", Limit: 10, UserIds: []uint64{2, 1}})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+	})
+
+	It("should return error without calling repo for empty query", func() {
+		mockRepo.EXPECT().SearchJourneys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := api.SearchJourneysV1(ctx, &desc.SearchJourneysRequestV1{Limit: 10})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should return error without calling repo for incorrect time range", func() {
+		mockRepo.EXPECT().SearchJourneys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := api.SearchJourneysV1(ctx, &desc.SearchJourneysRequestV1{
+			Query:    "море",
+			Limit:    10,
+			FromTime: timestamppb.New(timeEnd),
+			ToTime:   timestamppb.New(timeStart),
+		})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should return error of repo", func() {
+		mockRepo.EXPECT().SearchJourneys(ctx, "море", repo.JourneyFilter{}, uint64(10), uint64(0)).
+			Return(nil, errors.New("repo error")).Times(1)
+
+		resp, err := api.SearchJourneysV1(ctx, &desc.SearchJourneysRequestV1{Query: "море", Limit: 10})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.Internal))
+	})
+})
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// defaultTopAddressesLimit - number of most frequent addresses in statistics if request does not set it
+const defaultTopAddressesLimit = 10
+
+// GetJourneyStatsV1 - returns statistics of journeys of the user or of all users if user is not set.
+// Only admins can get statistics of all users or of another user
+func (api *JourneyAPI) GetJourneyStatsV1(ctx context.Context, req *desc.GetJourneyStatsRequestV1) (*desc.GetJourneyStatsResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("GetJourneyStatsV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var err error
+	if req.UserId == 0 || checkActingUser(ctx, req.UserId) != nil {
+		err = api.checkAdmin(ctx)
+	}
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", req.UserId).Msg("GetJourneyStatsV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	filter := repo.JourneyFilter{From: timeFromProto(req.FromTime), To: timeFromProto(req.ToTime)}
+	if req.UserId != 0 {
+		filter.UserIDs = []uint64{req.UserId}
+	}
+	if err := checkTimeRange(filter); err != nil {
+		log.Error().Err(err).Msg("GetJourneyStatsV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	topAddressesLimit := req.TopAddressesLimit
+	if topAddressesLimit == 0 {
+		topAddressesLimit = defaultTopAddressesLimit
+	}
+
+	stats, err := api.repo.GetJourneyStats(ctx, filter, topAddressesLimit)
+	if err != nil {
+		log.Error().Err(err).Interface("filter", filter).Msg("GetJourneyStatsV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	resp := &desc.GetJourneyStatsResponseV1{
+		Count:           stats.Count,
+		TotalDuration:   durationpb.New(stats.TotalDuration),
+		AverageDuration: durationpb.New(stats.AverageDuration),
+		TopAddresses:    make([]*desc.AddressCountV1, len(stats.TopAddresses)),
+		Months:          make([]*desc.MonthCountV1, len(stats.Months)),
+		Weekdays:        stats.Weekdays[:],
+	}
+	for i, address := range stats.TopAddresses {
+		resp.TopAddresses[i] = &desc.AddressCountV1{Address: address.Address, Count: address.Count}
+	}
+	for i, month := range stats.Months {
+		resp.Months[i] = &desc.MonthCountV1{Year: int32(month.Year), Month: int32(month.Month), Count: month.Count}
+	}
+
+	log.Debug().Uint64("userId", req.UserId).Uint64("count", stats.Count).Msg("GetJourneyStatsV1: success.")
+	return resp, nil
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("JourneyStatsApi", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRepo    *mocks.MockRepo
+		mockMetrics *mocks.MockMetrics
+		api         desc.JourneyApiV1Server
+		ctx         context.Context
+
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd   = time.Date(2021, 04, 01, 0, 0, 0, 0, time.UTC)
+		stats     = &repo.JourneyStats{
+			Count:           3,
+			TotalDuration:   72 * time.Hour,
+			AverageDuration: 24 * time.Hour,
+			TopAddresses:    []repo.AddressCount{{Address: "Сочи", Count: 2}, {Address: "Анапа", Count: 1}},
+			Months:          []repo.MonthCount{{Year: 2021, Month: time.January, Count: 1}, {Year: 2021, Month: time.March, Count: 2}},
+			Weekdays:        [7]uint64{0, 1, 0, 0, 0, 2, 0},
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		ctx = context.Background()
+		api = NewJourneyAPI(Options{
+			Repo:         mockRepo,
+			Metrics:      mockMetrics,
+			Hub:          watch.NewHub(10, 10),
+			ChunkSize:    2,
+			AdminUserIDs: []uint64{9},
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should return statistics of journeys of the user", func() {
+		filter := repo.JourneyFilter{UserIDs: []uint64{1}, From: timeStart, To: timeEnd}
+		mockRepo.EXPECT().GetJourneyStats(ctx, filter, uint64(5)).Return(stats, nil).Times(1)
+
+		resp, err := api.GetJourneyStatsV1(ctx, &desc.GetJourneyStatsRequestV1{
+			UserId:            1,
+			FromTime:          timestamppb.New(timeStart),
+			ToTime:            timestamppb.New(timeEnd),
+			TopAddressesLimit: 5,
+		})
+
+		Expect(err).Should(BeNil())
+		Expect(resp.Count).Should(Equal(uint64(3)))
+		Expect(resp.TotalDuration.AsDuration()).Should(Equal(72 * time.Hour))
+		Expect(resp.AverageDuration.AsDuration()).Should(Equal(24 * time.Hour))
+		Expect(resp.TopAddresses).Should(HaveLen(2))
+		Expect(resp.TopAddresses[0].Address).Should(Equal("Сочи"))
+		Expect(resp.TopAddresses[0].Count).Should(Equal(uint64(2)))
+		Expect(resp.Months).Should(HaveLen(2))
+		Expect(resp.Months[1].Year).Should(Equal(int32(2021)))
+		Expect(resp.Months[1].Month).Should(Equal(int32(3)))
+		Expect(resp.Months[1].Count).Should(Equal(uint64(2)))
+		Expect(resp.Weekdays).Should(Equal([]uint64{0, 1, 0, 0, 0, 2, 0}))
+	})
+
+	It("should return statistics of all users with default number of top addresses", func() {
+		mockRepo.EXPECT().GetJourneyStats(ctx, repo.JourneyFilter{}, uint64(defaultTopAddressesLimit)).
+			Return(&repo.JourneyStats{}, nil).Times(1)
+
+		resp, err := api.GetJourneyStatsV1(ctx, &desc.GetJourneyStatsRequestV1{})
+
+		Expect(err).Should(BeNil())
+		Expect(resp.Count).Should(BeZero())
+		Expect(resp.TopAddresses).Should(BeEmpty())
+		Expect(resp.Weekdays).Should(HaveLen(7))
+	})
+
+	It("should return statistics of all users to admin", func() {
+		ctx = actingUser(9)
+		mockRepo.EXPECT().GetJourneyStats(ctx, repo.JourneyFilter{}, uint64(defaultTopAddressesLimit)).
+			Return(&repo.JourneyStats{Count: 10}, nil).Times(1)
+
+		resp, err := api.GetJourneyStatsV1(ctx, &desc.GetJourneyStatsRequestV1{})
+
+		Expect(err).Should(BeNil())
+		Expect(resp.Count).Should(Equal(uint64(10)))
+	})
+
+	It("should return statistics of acting user", func() {
+		ctx = actingUser(1)
+		filter := repo.JourneyFilter{UserIDs: []uint64{1}}
+		mockRepo.EXPECT().GetJourneyStats(ctx, filter, uint64(defaultTopAddressesLimit)).Return(stats, nil).Times(1)
+
+		resp, err := api.GetJourneyStatsV1(ctx, &desc.GetJourneyStatsRequestV1{UserId: 1})
+
+		Expect(err).Should(BeNil())
+		Expect(resp.Count).Should(Equal(uint64(3)))
+	})
+
+	It("should return statistics of another user to admin", func() {
+		ctx = actingUser(9)
+		filter := repo.JourneyFilter{UserIDs: []uint64{1}}
+		mockRepo.EXPECT().GetJourneyStats(ctx, filter, uint64(defaultTopAddressesLimit)).Return(stats, nil).Times(1)
+
+		resp, err := api.GetJourneyStatsV1(ctx, &desc.GetJourneyStatsRequestV1{UserId: 1})
+
+		Expect(err).Should(BeNil())
+		Expect(resp.Count).Should(Equal(uint64(3)))
+	})
+
+	It("should return permission denied without calling repo for statistics of all users to not admin", func() {
+		ctx = actingUser(1)
+		mockRepo.EXPECT().GetJourneyStats(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := api.GetJourneyStatsV1(ctx, &desc.GetJourneyStatsRequestV1{})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+	})
+
+	It("should return permission denied without calling repo for statistics of another user to not admin", func() {
+		ctx = actingUser(1)
+		mockRepo.EXPECT().GetJourneyStats(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := api.GetJourneyStatsV1(ctx, &desc.GetJourneyStatsRequestV1{UserId: 2})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+	})
+
+	It("should return error without calling repo for incorrect time range", func() {
+		mockRepo.EXPECT().GetJourneyStats(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := api.GetJourneyStatsV1(ctx, &desc.GetJourneyStatsRequestV1{
+			FromTime: timestamppb.New(timeEnd),
+			ToTime:   timestamppb.New(timeStart),
+		})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should return error without calling repo for too many top addresses", func() {
+		mockRepo.EXPECT().GetJourneyStats(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		resp, err := api.GetJourneyStatsV1(ctx, &desc.GetJourneyStatsRequestV1{TopAddressesLimit: 1000})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should return error of repo", func() {
+		mockRepo.EXPECT().GetJourneyStats(ctx, gomock.Any(), gomock.Any()).Return(nil, errors.New("repo error")).Times(1)
+
+		resp, err := api.GetJourneyStatsV1(ctx, &desc.GetJourneyStatsRequestV1{UserId: 1})
+
+		Expect(resp).Should(BeNil())
+		Expect(status.Code(err)).Should(Equal(codes.Internal))
+	})
+})
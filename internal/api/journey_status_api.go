@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// StartJourneyV1 - change status of planned journey to in progress, returns new revision of journey
+func (api *JourneyAPI) StartJourneyV1(ctx context.Context, req *desc.ChangeJourneyStatusRequestV1) (*desc.ChangeJourneyStatusResponseV1, error) {
+	return api.changeJourneyStatus(ctx, "StartJourneyV1", req, models.StatusInProgress)
+}
+
+// CompleteJourneyV1 - change status of journey in progress to completed, returns new revision of journey
+func (api *JourneyAPI) CompleteJourneyV1(ctx context.Context, req *desc.ChangeJourneyStatusRequestV1) (*desc.ChangeJourneyStatusResponseV1, error) {
+	return api.changeJourneyStatus(ctx, "CompleteJourneyV1", req, models.StatusCompleted)
+}
+
+// CancelJourneyV1 - change status of planned journey or journey in progress to cancelled, returns new revision of journey
+func (api *JourneyAPI) CancelJourneyV1(ctx context.Context, req *desc.ChangeJourneyStatusRequestV1) (*desc.ChangeJourneyStatusResponseV1, error) {
+	return api.changeJourneyStatus(ctx, "CancelJourneyV1", req, models.StatusCancelled)
+}
+
+// changeJourneyStatus - common implementation of status methods, method is used in log messages.
+// If expected revision is set (in request or If-Match metadata) status is changed only if journey
+// was not changed since this revision, editor role is required
+func (api *JourneyAPI) changeJourneyStatus(ctx context.Context, method string, req *desc.ChangeJourneyStatusRequestV1,
+	journeyStatus models.JourneyStatus) (*desc.ChangeJourneyStatusResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg(method + ": invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	revision, err := expectedRevision(ctx, req.ExpectedRevision)
+	if err != nil {
+		log.Error().Err(err).Msg(method + ": invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := api.checkJourneyAccess(ctx, req.JourneyId, models.RoleEditor); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg(method + ": failed.")
+		return nil, toStatusError(err)
+	}
+
+	newRevision, err := api.repo.ChangeJourneyStatus(ctx, req.JourneyId, journeyStatus, revision)
+	if err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Uint64("expectedRevision", revision).Msg(method + ": failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("journeyId", req.JourneyId).Uint64("revision", newRevision).Msg(method + ": success.")
+	api.publishJourneyChanged(ctx, req.JourneyId)
+	return &desc.ChangeJourneyStatusResponseV1{Revision: newRevision, Status: journeyStatusToProto(journeyStatus)}, nil
+}
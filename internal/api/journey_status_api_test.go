@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/auth"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("JourneyStatusApi", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRepo    *mocks.MockRepo
+		mockMetrics *mocks.MockMetrics
+		hub         watch.Hub
+		api         desc.JourneyApiV1Server
+		ctx         context.Context
+
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd   = time.Date(2021, 01, 03, 0, 0, 0, 0, time.UTC)
+		journey   = models.Journey{JourneyID: 5, UserID: 1, Address: "Курск", StartTime: timeStart, EndTime: timeEnd, Revision: 5,
+			Status: models.StatusInProgress}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		// participants of changed journeys are loaded for watchers
+		mockRepo.EXPECT().GetJourneyParticipantIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		hub = watch.NewHub(10, 10)
+		ctx = context.Background()
+		api = NewJourneyAPI(Options{
+			Repo:      mockRepo,
+			Metrics:   mockMetrics,
+			Hub:       hub,
+			ChunkSize: 2,
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("StartJourneyV1", func() {
+		It("should change status and publish changed journey", func() {
+			subscription, err := hub.Subscribe(watch.Filter{}, 0)
+			Expect(err).Should(BeNil())
+			defer subscription.Close()
+
+			mockRepo.EXPECT().ChangeJourneyStatus(ctx, journey.JourneyID, models.StatusInProgress, uint64(4)).Return(uint64(5), nil).Times(1)
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+
+			result, err := api.StartJourneyV1(ctx, &desc.ChangeJourneyStatusRequestV1{JourneyId: journey.JourneyID, ExpectedRevision: 4})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Revision).Should(Equal(uint64(5)))
+			Expect(result.Status).Should(Equal(desc.JourneyStatus_JOURNEY_STATUS_IN_PROGRESS))
+			event := <-subscription.Events()
+			Expect(event.Journey.Status).Should(Equal(models.StatusInProgress))
+		})
+
+		It("should use revision from If-Match metadata", func() {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(IfMatchMetadataKey, FormatETag(4)))
+			mockRepo.EXPECT().ChangeJourneyStatus(ctx, journey.JourneyID, models.StatusInProgress, uint64(4)).Return(uint64(5), nil).Times(1)
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+
+			result, err := api.StartJourneyV1(ctx, &desc.ChangeJourneyStatusRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Revision).Should(Equal(uint64(5)))
+		})
+
+		It("should return error without calling repo for invalid request", func() {
+			mockRepo.EXPECT().ChangeJourneyStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.StartJourneyV1(ctx, &desc.ChangeJourneyStatusRequestV1{})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("CompleteJourneyV1", func() {
+		It("should return failed precondition for illegal transition", func() {
+			mockRepo.EXPECT().ChangeJourneyStatus(ctx, journey.JourneyID, models.StatusCompleted, uint64(0)).
+				Return(uint64(0), models.StatusPlanned.CheckTransition(models.StatusCompleted)).Times(1)
+			mockRepo.EXPECT().DescribeJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.CompleteJourneyV1(ctx, &desc.ChangeJourneyStatusRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should return aborted for revision conflict", func() {
+			mockRepo.EXPECT().ChangeJourneyStatus(ctx, journey.JourneyID, models.StatusCompleted, uint64(3)).
+				Return(uint64(0), repo.ErrRevisionConflict).Times(1)
+
+			result, err := api.CompleteJourneyV1(ctx, &desc.ChangeJourneyStatusRequestV1{JourneyId: journey.JourneyID, ExpectedRevision: 3})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.Aborted))
+		})
+	})
+
+	Context("CancelJourneyV1", func() {
+		It("should return permission denied if acting user is viewer", func() {
+			ctx = auth.WithUserID(ctx, 2)
+			mockRepo.EXPECT().GetJourneyAccess(ctx, journey.JourneyID, uint64(2)).
+				Return(repo.JourneyAccess{OwnerID: 1, Role: models.RoleViewer}, nil).Times(1)
+			mockRepo.EXPECT().ChangeJourneyStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.CancelJourneyV1(ctx, &desc.ChangeJourneyStatusRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should return not found for missing journey", func() {
+			mockRepo.EXPECT().ChangeJourneyStatus(ctx, uint64(6), models.StatusCancelled, uint64(0)).
+				Return(uint64(0), apperrors.New(apperrors.NotFound, "journey 6 not found")).Times(1)
+
+			result, err := api.CancelJourneyV1(ctx, &desc.ChangeJourneyStatusRequestV1{JourneyId: 6})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+
+	Context("ListJourneysV1", func() {
+		It("should pass status filter to repo", func() {
+			filter := repo.JourneyFilter{Statuses: []models.JourneyStatus{models.StatusPlanned, models.StatusInProgress}}
+			mockRepo.EXPECT().ListJourneys(ctx, filter, uint64(10), uint64(0)).Return([]models.Journey{journey}, nil).Times(1)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+				Limit:    10,
+				Statuses: []desc.JourneyStatus{desc.JourneyStatus_JOURNEY_STATUS_PLANNED, desc.JourneyStatus_JOURNEY_STATUS_IN_PROGRESS},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Journeys[0].Status).Should(Equal(desc.JourneyStatus_JOURNEY_STATUS_IN_PROGRESS))
+		})
+
+		It("should return error for unspecified status", func() {
+			mockRepo.EXPECT().ListJourneys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+				Limit:    10,
+				Statuses: []desc.JourneyStatus{desc.JourneyStatus_JOURNEY_STATUS_UNSPECIFIED},
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+})
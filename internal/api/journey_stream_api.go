@@ -0,0 +1,98 @@
+package api
+
+import (
+	"errors"
+	"io"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/utils"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// ExportJourneysV1 - stream journeys matching filter ordered by id.
+// Journeys are sent while they are read from the repo, so the whole result is never kept in memory.
+func (api *JourneyAPI) ExportJourneysV1(req *desc.ExportJourneysRequestV1, stream desc.JourneyApiV1_ExportJourneysV1Server) error {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("ExportJourneysV1: invalid request.")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter, err := journeyFilterFromRequest(req)
+	if err != nil {
+		log.Error().Err(err).Msg("ExportJourneysV1: invalid request.")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if filter.UserIDs, err = scopeUserIDs(stream.Context(), filter.UserIDs); err != nil {
+		log.Error().Err(err).Uints64("userIds", req.UserIds).Msg("ExportJourneysV1: failed.")
+		return toStatusError(err)
+	}
+
+	var sent uint64
+	var sendErr error
+	err = api.repo.ExportJourneys(stream.Context(), filter, func(journey models.Journey) error {
+		if sendErr = stream.Send(&desc.ExportJourneysResponseV1{Journey: journeyToProto(journey)}); sendErr != nil {
+			return sendErr
+		}
+		sent++
+		return nil
+	})
+	if sendErr != nil {
+		log.Error().Err(sendErr).Uint64("sent", sent).Msg("ExportJourneysV1: failed to send journey.")
+		return sendErr
+	}
+	if err != nil {
+		log.Error().Err(err).Uint64("sent", sent).Interface("filter", filter).Msg("ExportJourneysV1: failed.")
+		return toStatusError(err)
+	}
+
+	log.Debug().Uint64("sent", sent).Msg("ExportJourneysV1: success.")
+	return nil
+}
+
+// ImportJourneysV1 - create journeys received from the stream in chunks of chunkSize.
+// Invalid journeys and journeys of failed chunks are skipped, response contains result for every received journey.
+// Journeys imported before the stream is broken are not rolled back.
+func (api *JourneyAPI) ImportJourneysV1(stream desc.JourneyApiV1_ImportJourneysV1Server) error {
+	if api.chunkSize < 1 {
+		log.Error().Err(utils.ErrIncorrectChunkSize).Msg("ImportJourneysV1: failed.")
+		return toStatusError(utils.ErrIncorrectChunkSize)
+	}
+
+	ctx := stream.Context()
+	batch := newJourneyBatch(api.repo, api.hub, api.chunkSize, "ImportJourneysV1", nil)
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Error().Err(err).Uint64("imported", batch.added).Msg("ImportJourneysV1: failed to receive journey.")
+			return err
+		}
+
+		if err := req.Validate(); err != nil {
+			batch.skip(apperrors.Wrap(apperrors.InvalidArgument, err, "invalid journey"))
+			continue
+		}
+		if err := checkActingUser(ctx, req.Journey.UserId); err != nil {
+			batch.skip(err)
+			continue
+		}
+		batch.add(ctx, journeyFromCreateRequest(req.Journey), api.requestOverlapPolicy(req.Journey.OverlapPolicy))
+	}
+	batch.flush(ctx)
+
+	log.Debug().Uint64("imported", batch.added).Uint64("failed", batch.failed).Msg("ImportJourneysV1: success.")
+	api.metric.MultiCreateJourneyCounterInc()
+
+	return stream.SendAndClose(&desc.ImportJourneysResponseV1{
+		Imported: batch.added,
+		Failed:   batch.failed,
+		Results:  batch.results,
+	})
+}
@@ -0,0 +1,244 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+type fakeExportStream struct {
+	fakeServerStream
+	sent    []*desc.ExportJourneysResponseV1
+	sendErr error
+}
+
+func (s *fakeExportStream) Send(resp *desc.ExportJourneysResponseV1) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+type fakeImportStream struct {
+	fakeServerStream
+	requests []*desc.ImportJourneysRequestV1
+	recvErr  error
+	resp     *desc.ImportJourneysResponseV1
+}
+
+func (s *fakeImportStream) Recv() (*desc.ImportJourneysRequestV1, error) {
+	if len(s.requests) == 0 {
+		if s.recvErr != nil {
+			return nil, s.recvErr
+		}
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *fakeImportStream) SendAndClose(resp *desc.ImportJourneysResponseV1) error {
+	s.resp = resp
+	return nil
+}
+
+var _ = Describe("JourneyStreamApi", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRepo    *mocks.MockRepo
+		mockMetrics *mocks.MockMetrics
+		hub         watch.Hub
+		api         *JourneyAPI
+		ctx         context.Context
+
+		timeStart     = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd       = time.Date(2021, 01, 02, 0, 0, 0, 0, time.UTC)
+		journeysTable = []models.Journey{
+			{UserID: 1, Address: "Воронеж", StartTime: timeStart, EndTime: timeEnd},
+			{UserID: 1, Address: "Уфа", StartTime: timeStart, EndTime: timeEnd},
+			{UserID: 2, Address: "Москва", StartTime: timeStart, EndTime: timeEnd},
+		}
+		errRepo = errors.New("repo error")
+	)
+
+	importRequest := func(journey models.Journey) *desc.ImportJourneysRequestV1 {
+		return &desc.ImportJourneysRequestV1{Journey: &desc.CreateJourneyRequestV1{
+			UserId:    journey.UserID,
+			Address:   journey.Address,
+			StartTime: timestamppb.New(journey.StartTime),
+			EndTime:   timestamppb.New(journey.EndTime),
+		}}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		hub = watch.NewHub(10, 10)
+		ctx = context.Background()
+		api = NewJourneyAPI(Options{
+			Repo:      mockRepo,
+			Metrics:   mockMetrics,
+			Hub:       hub,
+			ChunkSize: 2,
+		}).(*JourneyAPI)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("ExportJourneysV1", func() {
+		It("should send every journey matching filter", func() {
+			filter := repo.JourneyFilter{UserIDs: []uint64{1}}
+			mockRepo.EXPECT().ExportJourneys(ctx, filter, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ repo.JourneyFilter, handle func(models.Journey) error) error {
+					for _, journey := range journeysTable[:2] {
+						if err := handle(journey); err != nil {
+							return err
+						}
+					}
+					return nil
+				}).Times(1)
+			stream := &fakeExportStream{fakeServerStream: fakeServerStream{ctx: ctx}}
+
+			err := api.ExportJourneysV1(&desc.ExportJourneysRequestV1{UserIds: []uint64{1}}, stream)
+
+			Expect(err).Should(BeNil())
+			Expect(stream.sent).Should(HaveLen(2))
+			Expect(stream.sent[1].Journey.Address).Should(Equal(journeysTable[1].Address))
+		})
+
+		It("should stop reading journeys if stream is broken", func() {
+			errSend := status.Error(codes.Canceled, "stream is closed")
+			mockRepo.EXPECT().ExportJourneys(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ repo.JourneyFilter, handle func(models.Journey) error) error {
+					return handle(journeysTable[0])
+				}).Times(1)
+			stream := &fakeExportStream{fakeServerStream: fakeServerStream{ctx: ctx}, sendErr: errSend}
+
+			err := api.ExportJourneysV1(&desc.ExportJourneysRequestV1{}, stream)
+
+			Expect(err).Should(Equal(errSend))
+		})
+
+		It("should return error without calling repo for incorrect time range", func() {
+			mockRepo.EXPECT().ExportJourneys(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			stream := &fakeExportStream{fakeServerStream: fakeServerStream{ctx: ctx}}
+
+			err := api.ExportJourneysV1(&desc.ExportJourneysRequestV1{
+				FromTime: timestamppb.New(timeEnd),
+				ToTime:   timestamppb.New(timeStart),
+			}, stream)
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should return error of repo", func() {
+			mockRepo.EXPECT().ExportJourneys(ctx, gomock.Any(), gomock.Any()).
+				Return(apperrors.Wrap(apperrors.Unavailable, errRepo, "database unavailable")).Times(1)
+			stream := &fakeExportStream{fakeServerStream: fakeServerStream{ctx: ctx}}
+
+			err := api.ExportJourneysV1(&desc.ExportJourneysRequestV1{}, stream)
+
+			Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+		})
+	})
+
+	Context("ImportJourneysV1", func() {
+		It("should add journeys by chunks and return result for every journey", func() {
+			invalid := journeysTable[0]
+			invalid.EndTime = invalid.StartTime.Add(-time.Hour)
+			gomock.InOrder(
+				mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTable[:2]).Return([]uint64{10, 11}, nil),
+				mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTable[2:]).Return([]uint64{12}, nil),
+			)
+			mockMetrics.EXPECT().MultiCreateJourneyCounterInc().Times(1)
+			stream := &fakeImportStream{
+				fakeServerStream: fakeServerStream{ctx: ctx},
+				requests: []*desc.ImportJourneysRequestV1{
+					importRequest(journeysTable[0]),
+					importRequest(invalid),
+					importRequest(journeysTable[1]),
+					importRequest(journeysTable[2]),
+				},
+			}
+
+			err := api.ImportJourneysV1(stream)
+
+			Expect(err).Should(BeNil())
+			Expect(stream.resp.Imported).Should(Equal(uint64(3)))
+			Expect(stream.resp.Failed).Should(Equal(uint64(1)))
+			Expect(stream.resp.Results).Should(HaveLen(4))
+			Expect(stream.resp.Results[0].JourneyId).Should(Equal(uint64(10)))
+			Expect(stream.resp.Results[1].Index).Should(Equal(uint64(1)))
+			Expect(stream.resp.Results[1].Code).Should(Equal(uint32(codes.InvalidArgument)))
+			Expect(stream.resp.Results[2].JourneyId).Should(Equal(uint64(11)))
+			Expect(stream.resp.Results[3].JourneyId).Should(Equal(uint64(12)))
+		})
+
+		It("should mark journeys of failed chunk and continue import", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTable[:2]).Return(nil, errRepo),
+				mockRepo.EXPECT().MultiAddJourneys(ctx, journeysTable[2:]).Return([]uint64{12}, nil),
+			)
+			mockMetrics.EXPECT().MultiCreateJourneyCounterInc().Times(1)
+			stream := &fakeImportStream{
+				fakeServerStream: fakeServerStream{ctx: ctx},
+				requests: []*desc.ImportJourneysRequestV1{
+					importRequest(journeysTable[0]),
+					importRequest(journeysTable[1]),
+					importRequest(journeysTable[2]),
+				},
+			}
+
+			err := api.ImportJourneysV1(stream)
+
+			Expect(err).Should(BeNil())
+			Expect(stream.resp.Imported).Should(Equal(uint64(1)))
+			Expect(stream.resp.Failed).Should(Equal(uint64(2)))
+			Expect(stream.resp.Results[0].Code).Should(Equal(uint32(codes.Internal)))
+			Expect(stream.resp.Results[2].JourneyId).Should(Equal(uint64(12)))
+		})
+
+		It("should return error if stream is broken", func() {
+			errRecv := status.Error(codes.Canceled, "stream is closed")
+			mockRepo.EXPECT().MultiAddJourneys(gomock.Any(), gomock.Any()).Times(0)
+			stream := &fakeImportStream{
+				fakeServerStream: fakeServerStream{ctx: ctx},
+				requests:         []*desc.ImportJourneysRequestV1{importRequest(journeysTable[0])},
+				recvErr:          errRecv,
+			}
+
+			err := api.ImportJourneysV1(stream)
+
+			Expect(err).Should(Equal(errRecv))
+			Expect(stream.resp).Should(BeNil())
+		})
+	})
+})
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// ListTagsV1 - returns tags of journeys of the user with number of journeys having each tag, most used tags first
+func (api *JourneyAPI) ListTagsV1(ctx context.Context, req *desc.ListTagsRequestV1) (*desc.ListTagsResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("ListTagsV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := checkActingUser(ctx, req.UserId); err != nil {
+		log.Error().Err(err).Uint64("userId", req.UserId).Msg("ListTagsV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	tags, err := api.repo.ListTags(ctx, req.UserId)
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", req.UserId).Msg("ListTagsV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	resp := &desc.ListTagsResponseV1{Tags: make([]*desc.TagCountV1, len(tags))}
+	for i, tag := range tags {
+		resp.Tags[i] = &desc.TagCountV1{Name: tag.Name, Count: tag.Count}
+	}
+
+	log.Debug().Uint64("userId", req.UserId).Int("count", len(tags)).Msg("ListTagsV1: success.")
+	return resp, nil
+}
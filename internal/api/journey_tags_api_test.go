@@ -0,0 +1,188 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("JourneyTagsApi", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRepo    *mocks.MockRepo
+		mockMetrics *mocks.MockMetrics
+		api         desc.JourneyApiV1Server
+		ctx         context.Context
+
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd   = time.Date(2021, 01, 03, 0, 0, 0, 0, time.UTC)
+		journey   = models.Journey{JourneyID: 5, UserID: 1, Address: "Курск", StartTime: timeStart, EndTime: timeEnd, Revision: 4,
+			Tags: []string{"business"}}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		// participants of changed journeys are loaded for watchers
+		mockRepo.EXPECT().GetJourneyParticipantIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		ctx = context.Background()
+		api = NewJourneyAPI(Options{
+			Repo:      mockRepo,
+			Metrics:   mockMetrics,
+			Hub:       watch.NewHub(10, 10),
+			ChunkSize: 2,
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("CreateJourneyV1", func() {
+		It("should add journey with normalized tags", func() {
+			newJourney := models.Journey{UserID: 1, Address: "Курск", StartTime: timeStart, EndTime: timeEnd,
+				Tags: []string{"business", "командировка"}}
+			mockRepo.EXPECT().AddJourney(ctx, newJourney).Return(uint64(5), nil).Times(1)
+			mockMetrics.EXPECT().CreateJourneyCounterInc().Times(1)
+
+			result, err := api.CreateJourneyV1(ctx, &desc.CreateJourneyRequestV1{
+				UserId:    1,
+				Address:   "Курск",
+				StartTime: timestamppb.New(timeStart),
+				EndTime:   timestamppb.New(timeEnd),
+				Tags:      []string{"Командировка", " business", "Business"},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.JourneyId).Should(Equal(uint64(5)))
+		})
+
+		It("should return error without calling repo for invalid tag", func() {
+			mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.CreateJourneyV1(ctx, &desc.CreateJourneyRequestV1{
+				UserId:    1,
+				Address:   "Курск",
+				StartTime: timestamppb.New(timeStart),
+				EndTime:   timestamppb.New(timeEnd),
+				Tags:      []string{"road trip"},
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("DescribeJourneyV1", func() {
+		It("should return tags of journey", func() {
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+
+			result, err := api.DescribeJourneyV1(ctx, &desc.DescribeJourneyRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Journey.Tags).Should(Equal([]string{"business"}))
+		})
+	})
+
+	Context("PatchJourneyV1", func() {
+		It("should replace tags by tags path", func() {
+			current := journey
+			patched := journey
+			patched.Tags = []string{"vacation"}
+			// journey is loaded before patching and for watchers after it
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&current, nil).Times(2)
+			mockRepo.EXPECT().PatchJourney(ctx, patched, []repo.JourneyField{repo.JourneyFieldTags}).Return(uint64(5), nil).Times(1)
+			mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+			result, err := api.PatchJourneyV1(ctx, &desc.PatchJourneyRequestV1{
+				Journey:    &desc.Journey{JourneyId: journey.JourneyID, Tags: []string{"Vacation"}},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Revision).Should(Equal(uint64(5)))
+		})
+	})
+
+	Context("ListJourneysV1", func() {
+		It("should pass normalized tag filters to repo", func() {
+			filter := repo.JourneyFilter{AnyTags: []string{"business", "commute"}, AllTags: []string{"2021"}}
+			mockRepo.EXPECT().ListJourneys(ctx, filter, uint64(10), uint64(0)).Return([]models.Journey{journey}, nil).Times(1)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+				Limit:   10,
+				AnyTags: []string{"Commute", "business"},
+				AllTags: []string{"2021"},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Journeys).Should(HaveLen(1))
+		})
+	})
+
+	Context("ListTagsV1", func() {
+		It("should return tags with counts in order of repo", func() {
+			mockRepo.EXPECT().ListTags(ctx, uint64(1)).Return([]repo.TagCount{{Name: "vacation", Count: 3}, {Name: "business", Count: 1}}, nil).Times(1)
+
+			result, err := api.ListTagsV1(ctx, &desc.ListTagsRequestV1{UserId: 1})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Tags).Should(HaveLen(2))
+			Expect(result.Tags[0].Name).Should(Equal("vacation"))
+			Expect(result.Tags[0].Count).Should(Equal(uint64(3)))
+		})
+
+		It("should return tags of acting user", func() {
+			ctx = actingUser(1)
+			mockRepo.EXPECT().ListTags(ctx, uint64(1)).Return([]repo.TagCount{{Name: "vacation", Count: 3}}, nil).Times(1)
+
+			result, err := api.ListTagsV1(ctx, &desc.ListTagsRequestV1{UserId: 1})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Tags).Should(HaveLen(1))
+		})
+
+		It("should return permission denied without calling repo for tags of another user", func() {
+			ctx = actingUser(2)
+			mockRepo.EXPECT().ListTags(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ListTagsV1(ctx, &desc.ListTagsRequestV1{UserId: 1})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should return error without calling repo for missing user", func() {
+			mockRepo.EXPECT().ListTags(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ListTagsV1(ctx, &desc.ListTagsRequestV1{})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should return error of repo", func() {
+			mockRepo.EXPECT().ListTags(ctx, uint64(1)).Return(nil, apperrors.New(apperrors.Unavailable, "database unavailable")).Times(1)
+
+			result, err := api.ListTagsV1(ctx, &desc.ListTagsRequestV1{UserId: 1})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+		})
+	})
+})
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// WatchJourneysV1 - stream changes of journeys published to the hub by API handlers and Kafka consumer
+// of this instance of service. Every response contains resume token for continuing watching after reconnecting.
+// Subscriber which does not read events fast enough is dropped with ResourceExhausted status, so it never blocks writers.
+func (api *JourneyAPI) WatchJourneysV1(req *desc.WatchJourneysRequestV1, stream desc.JourneyApiV1_WatchJourneysV1Server) error {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("WatchJourneysV1: invalid request.")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	afterRevision := api.hub.Revision()
+	if req.ResumeToken != "" {
+		token, err := decodeResumeToken(req.ResumeToken)
+		if err != nil {
+			log.Error().Err(err).Msg("WatchJourneysV1: invalid request.")
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		// token is issued by another instance or before restart of service
+		if token.HubID != api.hub.ID() {
+			log.Error().Err(watch.ErrRevisionExpired).Msg("WatchJourneysV1: failed.")
+			return status.Error(codes.OutOfRange, watch.ErrRevisionExpired.Error())
+		}
+		afterRevision = token.Revision
+	}
+
+	userIDs, err := scopeUserIDs(stream.Context(), req.UserIds)
+	if err != nil {
+		log.Error().Err(err).Uints64("userIds", req.UserIds).Msg("WatchJourneysV1: failed.")
+		return toStatusError(err)
+	}
+
+	subscription, err := api.hub.Subscribe(watch.Filter{UserIDs: userIDs}, afterRevision)
+	if err != nil {
+		log.Error().Err(err).Uint64("revision", afterRevision).Msg("WatchJourneysV1: failed.")
+		if errors.Is(err, watch.ErrRevisionExpired) {
+			return status.Error(codes.OutOfRange, err.Error())
+		}
+		return toStatusError(err)
+	}
+	defer subscription.Close()
+
+	lastRevision := afterRevision
+	if err := stream.Send(&desc.WatchJourneysResponseV1{ResumeToken: api.resumeToken(lastRevision)}); err != nil {
+		log.Error().Err(err).Msg("WatchJourneysV1: failed to send event.")
+		return err
+	}
+
+	var sent uint64
+	for {
+		select {
+		case <-stream.Context().Done():
+			log.Debug().Uint64("sent", sent).Msg("WatchJourneysV1: success.")
+			return nil
+		case event, ok := <-subscription.Events():
+			if !ok {
+				token := api.resumeToken(lastRevision)
+				log.Warn().Uint64("sent", sent).Uint64("revision", lastRevision).Msg("WatchJourneysV1: subscriber is dropped.")
+				return status.Errorf(codes.ResourceExhausted, "subscriber is too slow, resume watching with token %s", token)
+			}
+
+			lastRevision = event.Revision
+			err := stream.Send(&desc.WatchJourneysResponseV1{
+				Event:       eventToProto(event),
+				ResumeToken: api.resumeToken(lastRevision),
+			})
+			if err != nil {
+				log.Error().Err(err).Uint64("sent", sent).Msg("WatchJourneysV1: failed to send event.")
+				return err
+			}
+			sent++
+		}
+	}
+}
+
+// resumeToken - returns encoded resume token for revision of the hub
+func (api *JourneyAPI) resumeToken(revision uint64) string {
+	return encodeResumeToken(resumeToken{HubID: api.hub.ID(), Revision: revision})
+}
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+type fakeWatchStream struct {
+	fakeServerStream
+	sent chan *desc.WatchJourneysResponseV1
+}
+
+func (s *fakeWatchStream) Send(resp *desc.WatchJourneysResponseV1) error {
+	select {
+	case s.sent <- resp:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+var _ = Describe("JourneyWatchApi", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRepo    *mocks.MockRepo
+		mockMetrics *mocks.MockMetrics
+		hub         watch.Hub
+		api         *JourneyAPI
+		ctx         context.Context
+		cancel      context.CancelFunc
+		stream      *fakeWatchStream
+		watchErr    chan error
+
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		journeys  = []models.Journey{
+			{JourneyID: 1, UserID: 1, Address: "Воронеж", StartTime: timeStart, EndTime: timeStart.Add(time.Hour)},
+			{JourneyID: 2, UserID: 2, Address: "Уфа", StartTime: timeStart, EndTime: timeStart.Add(time.Hour)},
+		}
+	)
+
+	startWatch := func(req *desc.WatchJourneysRequestV1) {
+		api, stream, watchErr := api, stream, watchErr
+		go func() {
+			watchErr <- api.WatchJourneysV1(req, stream)
+		}()
+	}
+
+	receive := func() *desc.WatchJourneysResponseV1 {
+		var resp *desc.WatchJourneysResponseV1
+		Eventually(stream.sent).Should(Receive(&resp))
+		return resp
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		hub = watch.NewHub(1, 10)
+		api = NewJourneyAPI(Options{
+			Repo:      mockRepo,
+			Metrics:   mockMetrics,
+			Hub:       hub,
+			ChunkSize: 2,
+		}).(*JourneyAPI)
+		ctx, cancel = context.WithCancel(context.Background())
+		stream = &fakeWatchStream{fakeServerStream: fakeServerStream{ctx: ctx}, sent: make(chan *desc.WatchJourneysResponseV1)}
+		watchErr = make(chan error, 1)
+	})
+
+	AfterEach(func() {
+		cancel()
+		ctrl.Finish()
+	})
+
+	It("should send changes of journeys of requested users made by API handlers", func() {
+		mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Return(uint64(1), nil).Times(1)
+		mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{2}).Return(map[uint64]uint64{2: 1}, nil).Times(1)
+		mockRepo.EXPECT().RemoveJourney(gomock.Any(), uint64(2), uint64(0)).Return(nil).Times(1)
+		mockRepo.EXPECT().GetJourneyParticipantIDs(gomock.Any(), []uint64{2}).Return(nil, nil).Times(1)
+		mockMetrics.EXPECT().CreateJourneyCounterInc().Times(1)
+		mockMetrics.EXPECT().DeleteJourneyCounterInc().Times(1)
+
+		startWatch(&desc.WatchJourneysRequestV1{UserIds: []uint64{1}})
+		Expect(receive().Event).Should(BeNil())
+
+		_, err := api.CreateJourneyV1(context.Background(), &desc.CreateJourneyRequestV1{
+			UserId:    journeys[0].UserID,
+			Address:   journeys[0].Address,
+			StartTime: timestamppb.New(journeys[0].StartTime),
+			EndTime:   timestamppb.New(journeys[0].EndTime),
+		})
+		Expect(err).Should(BeNil())
+		created := receive()
+		Expect(created.Event.Type).Should(Equal(desc.JourneyEventType_JOURNEY_EVENT_TYPE_CREATED))
+		Expect(created.Event.Journey.JourneyId).Should(Equal(uint64(1)))
+		Expect(created.Event.Journey.Revision).Should(Equal(models.InitialRevision))
+
+		hub.Publish(watch.Updated(journeys[1]))
+		_, err = api.RemoveJourneyV1(context.Background(), &desc.RemoveJourneyRequestV1{JourneyId: 2})
+		Expect(err).Should(BeNil())
+		deleted := receive()
+		Expect(deleted.Event.Type).Should(Equal(desc.JourneyEventType_JOURNEY_EVENT_TYPE_DELETED))
+		Expect(deleted.Event.JourneyId).Should(Equal(uint64(2)))
+
+		cancel()
+		Eventually(watchErr).Should(Receive(BeNil()))
+	})
+
+	It("should send stored state of changed shared journeys to participants", func() {
+		stored := journeys[1]
+		stored.Tags = []string{"business"}
+		stored.Revision = 3
+		mockRepo.EXPECT().UpdateJourney(gomock.Any(), gomock.Any()).Return(uint64(3), nil).Times(1)
+		mockRepo.EXPECT().DescribeJourney(gomock.Any(), uint64(2)).Return(&stored, nil).Times(1)
+		mockRepo.EXPECT().GetJourneyParticipantIDs(gomock.Any(), []uint64{2}).Return(map[uint64][]uint64{2: {3}}, nil).Times(1)
+		mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+		startWatch(&desc.WatchJourneysRequestV1{UserIds: []uint64{3}})
+		Expect(receive().Event).Should(BeNil())
+
+		_, err := api.UpdateJourneyV1(context.Background(), &desc.UpdateJourneyRequestV1{Journey: &desc.Journey{
+			JourneyId: journeys[1].JourneyID,
+			UserId:    journeys[1].UserID,
+			Address:   journeys[1].Address,
+			StartTime: timestamppb.New(journeys[1].StartTime),
+			EndTime:   timestamppb.New(journeys[1].EndTime),
+		}})
+		Expect(err).Should(BeNil())
+		updated := receive()
+		Expect(updated.Event.Type).Should(Equal(desc.JourneyEventType_JOURNEY_EVENT_TYPE_UPDATED))
+		Expect(updated.Event.Journey.Tags).Should(Equal([]string{"business"}))
+		Expect(updated.Event.Journey.Revision).Should(Equal(uint64(3)))
+	})
+
+	It("should send missed changes after resume token", func() {
+		hub.Publish(watch.Deleted(1, 1))
+		token := api.resumeToken(hub.Revision())
+		hub.Publish(watch.Deleted(2, 1))
+
+		startWatch(&desc.WatchJourneysRequestV1{ResumeToken: token})
+
+		Expect(receive().ResumeToken).Should(Equal(token))
+		Expect(receive().Event.JourneyId).Should(Equal(uint64(2)))
+	})
+
+	It("should drop slow subscriber with resume token of the last sent change", func() {
+		startWatch(&desc.WatchJourneysRequestV1{})
+		receive()
+
+		// subscriber is blocked on sending of the first event, so one of the next events overflows its buffer
+		hub.Publish(watch.Deleted(1, 1), watch.Deleted(2, 1), watch.Deleted(3, 1))
+
+		last := receive()
+		Expect(last.Event.JourneyId).Should(Equal(uint64(1)))
+		var err error
+		for err == nil {
+			select {
+			case resp := <-stream.sent:
+				last = resp
+			case err = <-watchErr:
+			case <-time.After(time.Second):
+				Fail("subscriber is not dropped")
+			}
+		}
+		Expect(last.Event.JourneyId).Should(BeNumerically("<", 3))
+		Expect(status.Code(err)).Should(Equal(codes.ResourceExhausted))
+		Expect(err.Error()).Should(ContainSubstring(last.ResumeToken))
+	})
+
+	It("should return OutOfRange error for token of another hub", func() {
+		token := encodeResumeToken(resumeToken{HubID: "another", Revision: 1})
+
+		err := api.WatchJourneysV1(&desc.WatchJourneysRequestV1{ResumeToken: token}, stream)
+
+		Expect(status.Code(err)).Should(Equal(codes.OutOfRange))
+	})
+
+	It("should return InvalidArgument error for invalid token", func() {
+		err := api.WatchJourneysV1(&desc.WatchJourneysRequestV1{ResumeToken: "invalid"}, stream)
+
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+})
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// errWaypointsInUpdate - update requests do not change waypoints, so waypoints in them are rejected instead of being ignored
+var errWaypointsInUpdate = apperrors.New(apperrors.InvalidArgument, "waypoints cannot be updated with journey, use waypoint methods")
+
+// checkNoWaypoints - returns errWaypointsInUpdate if journey of update request has waypoints
+func checkNoWaypoints(journey *desc.Journey) error {
+	if len(journey.GetWaypoints()) > 0 {
+		return errWaypointsInUpdate
+	}
+	return nil
+}
+
+// AddWaypointV1 - add waypoint to journey at position, returns id of waypoint and new revision of journey
+func (api *JourneyAPI) AddWaypointV1(ctx context.Context, req *desc.AddWaypointRequestV1) (*desc.AddWaypointResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("AddWaypointV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	waypoint := waypointFromProto(req.Waypoint)
+	if err := waypoint.Validate(); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("AddWaypointV1: invalid request.")
+		return nil, toStatusError(err)
+	}
+
+	if _, err := api.checkJourneyAccess(ctx, req.JourneyId, models.RoleEditor); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("AddWaypointV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	waypointID, revision, err := api.repo.AddWaypoint(ctx, req.JourneyId, waypoint, req.Position)
+	if err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Uint64("position", req.Position).Msg("AddWaypointV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("journeyId", req.JourneyId).Uint64("waypointId", waypointID).Uint64("revision", revision).Msg("AddWaypointV1: success.")
+	api.publishJourneyChanged(ctx, req.JourneyId)
+	return &desc.AddWaypointResponseV1{WaypointId: waypointID, Revision: revision}, nil
+}
+
+// ReorderWaypointsV1 - set order of waypoints of journey, request must contain ids of all its waypoints
+func (api *JourneyAPI) ReorderWaypointsV1(ctx context.Context, req *desc.ReorderWaypointsRequestV1) (*desc.ReorderWaypointsResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("ReorderWaypointsV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := api.checkJourneyAccess(ctx, req.JourneyId, models.RoleEditor); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("ReorderWaypointsV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	revision, err := api.repo.ReorderWaypoints(ctx, req.JourneyId, req.WaypointIds)
+	if err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Uints64("waypointIds", req.WaypointIds).Msg("ReorderWaypointsV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("journeyId", req.JourneyId).Uint64("revision", revision).Msg("ReorderWaypointsV1: success.")
+	api.publishJourneyChanged(ctx, req.JourneyId)
+	return &desc.ReorderWaypointsResponseV1{Revision: revision}, nil
+}
+
+// RemoveWaypointV1 - remove waypoint of journey, returns new revision of journey
+func (api *JourneyAPI) RemoveWaypointV1(ctx context.Context, req *desc.RemoveWaypointRequestV1) (*desc.RemoveWaypointResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("RemoveWaypointV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := api.checkJourneyAccess(ctx, req.JourneyId, models.RoleEditor); err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Msg("RemoveWaypointV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	revision, err := api.repo.RemoveWaypoint(ctx, req.JourneyId, req.WaypointId)
+	if err != nil {
+		log.Error().Err(err).Uint64("journeyId", req.JourneyId).Uint64("waypointId", req.WaypointId).Msg("RemoveWaypointV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("journeyId", req.JourneyId).Uint64("waypointId", req.WaypointId).Uint64("revision", revision).Msg("RemoveWaypointV1: success.")
+	api.publishJourneyChanged(ctx, req.JourneyId)
+	return &desc.RemoveWaypointResponseV1{Revision: revision}, nil
+}
+
+// publishJourneyChanged - publishes stored state of changed journey to watchers,
+// change is already saved, so failure to load journey is only logged by watch.Load
+func (api *JourneyAPI) publishJourneyChanged(ctx context.Context, journeyID uint64) {
+	api.hub.Publish(watch.Load(ctx, api.repo, watch.Updated(models.Journey{JourneyID: journeyID}))...)
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("JourneyWaypointApi", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRepo    *mocks.MockRepo
+		mockMetrics *mocks.MockMetrics
+		hub         watch.Hub
+		api         desc.JourneyApiV1Server
+		ctx         context.Context
+
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd   = time.Date(2021, 01, 03, 0, 0, 0, 0, time.UTC)
+		waypoints = []models.Waypoint{
+			{Address: "Тула", Coordinates: &models.Coordinates{Latitude: 54.193, Longitude: 37.617}, ArrivalTime: timeStart.Add(3 * time.Hour)},
+			{Address: "Орёл", DepartureTime: timeStart.Add(30 * time.Hour)},
+		}
+		journey = models.Journey{JourneyID: 5, UserID: 1, Address: "Курск", StartTime: timeStart, EndTime: timeEnd, Revision: 4}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		// participants of changed journeys are loaded for watchers
+		mockRepo.EXPECT().GetJourneyParticipantIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		hub = watch.NewHub(10, 10)
+		ctx = context.Background()
+		api = NewJourneyAPI(Options{
+			Repo:      mockRepo,
+			Metrics:   mockMetrics,
+			Hub:       hub,
+			ChunkSize: 2,
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("CreateJourneyV1", func() {
+		It("should add journey with waypoints in order of request", func() {
+			newJourney := models.Journey{UserID: 1, Address: "Курск", StartTime: timeStart, EndTime: timeEnd, Waypoints: waypoints}
+			mockRepo.EXPECT().AddJourney(ctx, newJourney).Return(uint64(5), nil).Times(1)
+			mockMetrics.EXPECT().CreateJourneyCounterInc().Times(1)
+
+			result, err := api.CreateJourneyV1(ctx, &desc.CreateJourneyRequestV1{
+				UserId:    1,
+				Address:   "Курск",
+				StartTime: timestamppb.New(timeStart),
+				EndTime:   timestamppb.New(timeEnd),
+				Waypoints: []*desc.Waypoint{
+					{Address: "Тула", Coordinates: &desc.Coordinates{Latitude: 54.193, Longitude: 37.617}, ArrivalTime: timestamppb.New(timeStart.Add(3 * time.Hour))},
+					{Address: "Орёл", DepartureTime: timestamppb.New(timeStart.Add(30 * time.Hour))},
+				},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.JourneyId).Should(Equal(uint64(5)))
+		})
+
+		It("should return error without calling repo for invalid waypoint", func() {
+			mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.CreateJourneyV1(ctx, &desc.CreateJourneyRequestV1{
+				UserId:    1,
+				Address:   "Курск",
+				StartTime: timestamppb.New(timeStart),
+				EndTime:   timestamppb.New(timeEnd),
+				Waypoints: []*desc.Waypoint{{Address: " "}},
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("DescribeJourneyV1", func() {
+		It("should return waypoints of journey with unknown times not set", func() {
+			withWaypoints := journey
+			withWaypoints.Waypoints = []models.Waypoint{{WaypointID: 7, Address: "Тула", ArrivalTime: timeStart.Add(3 * time.Hour)}}
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&withWaypoints, nil).Times(1)
+
+			result, err := api.DescribeJourneyV1(ctx, &desc.DescribeJourneyRequestV1{JourneyId: journey.JourneyID})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Journey.Waypoints).Should(HaveLen(1))
+			Expect(result.Journey.Waypoints[0].WaypointId).Should(Equal(uint64(7)))
+			Expect(result.Journey.Waypoints[0].ArrivalTime.AsTime()).Should(Equal(timeStart.Add(3 * time.Hour)))
+			Expect(result.Journey.Waypoints[0].DepartureTime).Should(BeNil())
+		})
+	})
+
+	Context("UpdateJourneyV1", func() {
+		It("should return error without calling repo for journey with waypoints", func() {
+			mockRepo.EXPECT().UpdateJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			request := journeyToProto(journey)
+			request.Waypoints = []*desc.Waypoint{{Address: "Тула"}}
+			result, err := api.UpdateJourneyV1(ctx, &desc.UpdateJourneyRequestV1{Journey: request})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("MultiUpdateJourneyV1", func() {
+		It("should return error result for journey with waypoints", func() {
+			mockRepo.EXPECT().MultiUpdateJourneys(gomock.Any(), gomock.Any()).Times(0)
+			mockMetrics.EXPECT().UpdateJourneyCounterInc().Times(1)
+
+			request := journeyToProto(journey)
+			request.Waypoints = []*desc.Waypoint{{Address: "Тула"}}
+			result, err := api.MultiUpdateJourneyV1(ctx, &desc.MultiUpdateJourneyRequestV1{
+				Journeys: []*desc.UpdateJourneyRequestV1{{Journey: request}},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Results[0].Code).Should(Equal(uint32(codes.InvalidArgument)))
+		})
+	})
+
+	Context("AddWaypointV1", func() {
+		It("should add waypoint at position and publish changed journey", func() {
+			subscription, err := hub.Subscribe(watch.Filter{}, hub.Revision())
+			Expect(err).Should(BeNil())
+			mockRepo.EXPECT().AddWaypoint(ctx, journey.JourneyID, waypoints[0], uint64(2)).Return(uint64(9), uint64(5), nil).Times(1)
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+
+			result, err := api.AddWaypointV1(ctx, &desc.AddWaypointRequestV1{
+				JourneyId: journey.JourneyID,
+				Waypoint:  waypointToProto(waypoints[0]),
+				Position:  2,
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result).Should(Equal(&desc.AddWaypointResponseV1{WaypointId: 9, Revision: 5}))
+			event := <-subscription.Events()
+			Expect(event.Type).Should(Equal(watch.JourneyUpdated))
+			Expect(event.JourneyID).Should(Equal(journey.JourneyID))
+		})
+
+		It("should return error without calling repo for invalid waypoint", func() {
+			mockRepo.EXPECT().AddWaypoint(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.AddWaypointV1(ctx, &desc.AddWaypointRequestV1{
+				JourneyId: journey.JourneyID,
+				Waypoint:  &desc.Waypoint{Address: "Тула", ArrivalTime: timestamppb.New(timeEnd), DepartureTime: timestamppb.New(timeStart)},
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should return failed precondition if journey has too many waypoints", func() {
+			mockRepo.EXPECT().AddWaypoint(ctx, journey.JourneyID, waypoints[1], uint64(0)).
+				Return(uint64(0), uint64(0), repo.ErrTooManyWaypoints).Times(1)
+
+			result, err := api.AddWaypointV1(ctx, &desc.AddWaypointRequestV1{
+				JourneyId: journey.JourneyID,
+				Waypoint:  waypointToProto(waypoints[1]),
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Context("ReorderWaypointsV1", func() {
+		It("should return new revision", func() {
+			mockRepo.EXPECT().ReorderWaypoints(ctx, journey.JourneyID, []uint64{3, 1, 2}).Return(uint64(6), nil).Times(1)
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).Return(&journey, nil).Times(1)
+
+			result, err := api.ReorderWaypointsV1(ctx, &desc.ReorderWaypointsRequestV1{JourneyId: journey.JourneyID, WaypointIds: []uint64{3, 1, 2}})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Revision).Should(Equal(uint64(6)))
+		})
+
+		It("should return error without calling repo for duplicate ids", func() {
+			mockRepo.EXPECT().ReorderWaypoints(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ReorderWaypointsV1(ctx, &desc.ReorderWaypointsRequestV1{JourneyId: journey.JourneyID, WaypointIds: []uint64{1, 1}})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should return error of repo if ids do not match waypoints", func() {
+			mockRepo.EXPECT().ReorderWaypoints(ctx, journey.JourneyID, []uint64{1, 2}).Return(uint64(0), repo.ErrWaypointsMismatch).Times(1)
+
+			result, err := api.ReorderWaypointsV1(ctx, &desc.ReorderWaypointsRequestV1{JourneyId: journey.JourneyID, WaypointIds: []uint64{1, 2}})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("RemoveWaypointV1", func() {
+		It("should return new revision even if journey cannot be loaded for watchers", func() {
+			mockRepo.EXPECT().RemoveWaypoint(ctx, journey.JourneyID, uint64(3)).Return(uint64(7), nil).Times(1)
+			mockRepo.EXPECT().DescribeJourney(ctx, journey.JourneyID).
+				Return(nil, apperrors.New(apperrors.Unavailable, "database unavailable")).Times(1)
+
+			result, err := api.RemoveWaypointV1(ctx, &desc.RemoveWaypointRequestV1{JourneyId: journey.JourneyID, WaypointId: 3})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Revision).Should(Equal(uint64(7)))
+		})
+
+		It("should return not found error of repo", func() {
+			mockRepo.EXPECT().RemoveWaypoint(ctx, journey.JourneyID, uint64(3)).
+				Return(uint64(0), apperrors.New(apperrors.NotFound, "waypoint not found")).Times(1)
+
+			result, err := api.RemoveWaypointV1(ctx, &desc.RemoveWaypointRequestV1{JourneyId: journey.JourneyID, WaypointId: 3})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"github.com/ozonva/ova-journey-api/internal/models"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var operationTypes = map[models.OperationType]desc.JourneyTaskType{
+	models.CreateOperation:      desc.JourneyTaskType_JOURNEY_TASK_TYPE_CREATE,
+	models.MultiCreateOperation: desc.JourneyTaskType_JOURNEY_TASK_TYPE_MULTI_CREATE,
+	models.UpdateOperation:      desc.JourneyTaskType_JOURNEY_TASK_TYPE_UPDATE,
+	models.RemoveOperation:      desc.JourneyTaskType_JOURNEY_TASK_TYPE_REMOVE,
+	models.MultiUpdateOperation: desc.JourneyTaskType_JOURNEY_TASK_TYPE_MULTI_UPDATE,
+	models.MultiRemoveOperation: desc.JourneyTaskType_JOURNEY_TASK_TYPE_MULTI_REMOVE,
+}
+
+var operationStatuses = map[models.OperationStatus]desc.JourneyTaskStatus{
+	models.OperationPending:   desc.JourneyTaskStatus_JOURNEY_TASK_STATUS_PENDING,
+	models.OperationSucceeded: desc.JourneyTaskStatus_JOURNEY_TASK_STATUS_SUCCEEDED,
+	models.OperationFailed:    desc.JourneyTaskStatus_JOURNEY_TASK_STATUS_FAILED,
+}
+
+// operationToJourneyTask - convert models.Operation to JourneyTask proto message
+func operationToJourneyTask(operation *models.Operation) *desc.JourneyTask {
+	return &desc.JourneyTask{
+		OperationId: operation.OperationID,
+		Type:        operationTypes[operation.Type],
+		Status:      operationStatuses[operation.Status],
+		JourneyIds:  operation.JourneyIDs,
+		Error:       operation.Error,
+		CreatedAt:   timestamppb.New(operation.CreatedAt),
+		UpdatedAt:   timestamppb.New(operation.UpdatedAt),
+	}
+}
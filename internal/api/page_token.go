@@ -0,0 +1,50 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"github.com/ozonva/ova-journey-api/internal/repo"
+)
+
+var (
+	// errInvalidPageToken - occurs when page token from request cannot be decoded
+	errInvalidPageToken = errors.New("invalid page token")
+	// errPageTokenFilterMismatch - occurs when page token is used with filters other than filters of its first page
+	errPageTokenFilterMismatch = errors.New("page token is issued for other filters")
+)
+
+// pageToken - represents position of the last returned journey for keyset pagination,
+// it is passed to clients as opaque base64 string
+type pageToken struct {
+	LastJourneyID uint64 `json:"last_journey_id"`
+	// FilterHash - hash of filter of pages, position is meaningless for other filters
+	FilterHash string `json:"filter_hash"`
+}
+
+func encodePageToken(token pageToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(value string) (pageToken, error) {
+	var token pageToken
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return token, errInvalidPageToken
+	}
+	if err = json.Unmarshal(data, &token); err != nil || token.LastJourneyID == 0 {
+		return token, errInvalidPageToken
+	}
+	return token, nil
+}
+
+// journeyFilterHash - returns hash of filter for pageToken.FilterHash
+func journeyFilterHash(filter repo.JourneyFilter) string {
+	data, _ := json.Marshal(filter)
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:8])
+}
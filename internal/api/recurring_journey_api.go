@@ -0,0 +1,266 @@
+package api
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// CreateRecurringJourneyV1 - create journey repeated by recurrence rule, returns id of recurring journey
+func (api *JourneyAPI) CreateRecurringJourneyV1(ctx context.Context, req *desc.CreateRecurringJourneyRequestV1) (*desc.CreateRecurringJourneyResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("CreateRecurringJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	recurringJourney := recurringJourneyFromProto(req.RecurringJourney)
+	if err := recurringJourney.Validate(); err != nil {
+		log.Error().Err(err).Str("rule", recurringJourney.Rule).Msg("CreateRecurringJourneyV1: invalid request.")
+		return nil, toStatusError(err)
+	}
+	if err := checkActingUser(ctx, recurringJourney.UserID); err != nil {
+		log.Error().Err(err).Uint64("userId", recurringJourney.UserID).Msg("CreateRecurringJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	recurringJourneyID, err := api.repo.AddRecurringJourney(ctx, recurringJourney)
+	if err != nil {
+		log.Error().Err(err).Str("recurringJourney", req.RecurringJourney.String()).Msg("CreateRecurringJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("recurringJourneyId", recurringJourneyID).Msg("CreateRecurringJourneyV1: success.")
+	return &desc.CreateRecurringJourneyResponseV1{RecurringJourneyId: recurringJourneyID}, nil
+}
+
+// DescribeRecurringJourneyV1 - get recurring journey with its exceptions by id
+func (api *JourneyAPI) DescribeRecurringJourneyV1(ctx context.Context, req *desc.DescribeRecurringJourneyRequestV1) (*desc.DescribeRecurringJourneyResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("DescribeRecurringJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	recurringJourney, err := api.repo.DescribeRecurringJourney(ctx, req.RecurringJourneyId)
+	if err == nil {
+		err = checkRecurringJourneyOwner(ctx, recurringJourney)
+	}
+	if err != nil {
+		log.Error().Err(err).Uint64("recurringJourneyId", req.RecurringJourneyId).Msg("DescribeRecurringJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("recurringJourneyId", req.RecurringJourneyId).Msg("DescribeRecurringJourneyV1: success.")
+	return &desc.DescribeRecurringJourneyResponseV1{RecurringJourney: recurringJourneyToProto(*recurringJourney)}, nil
+}
+
+// UpdateRecurringJourneyV1 - replace recurring journey, if expected revision is set (in request or If-Match metadata)
+// recurring journey is updated only if it was not changed since this revision. Returns new revision of recurring journey
+func (api *JourneyAPI) UpdateRecurringJourneyV1(ctx context.Context, req *desc.UpdateRecurringJourneyRequestV1) (*desc.UpdateRecurringJourneyResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("UpdateRecurringJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.RecurringJourney.RecurringJourneyId == 0 {
+		log.Error().Msg("UpdateRecurringJourneyV1: invalid request, recurring journey id is required.")
+		return nil, status.Error(codes.InvalidArgument, "recurring_journey_id is required")
+	}
+
+	revision, err := expectedRevision(ctx, req.ExpectedRevision)
+	if err != nil {
+		log.Error().Err(err).Msg("UpdateRecurringJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	recurringJourney := recurringJourneyFromProto(req.RecurringJourney)
+	recurringJourney.Revision = revision
+	if err := recurringJourney.Validate(); err != nil {
+		log.Error().Err(err).Str("rule", recurringJourney.Rule).Msg("UpdateRecurringJourneyV1: invalid request.")
+		return nil, toStatusError(err)
+	}
+
+	if err := api.checkRecurringJourneyAccess(ctx, recurringJourney.RecurringJourneyID); err != nil {
+		log.Error().Err(err).Uint64("recurringJourneyId", recurringJourney.RecurringJourneyID).Msg("UpdateRecurringJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	newRevision, err := api.repo.UpdateRecurringJourney(ctx, recurringJourney)
+	if err != nil {
+		log.Error().Err(err).Str("recurringJourney", req.RecurringJourney.String()).Uint64("expectedRevision", revision).
+			Msg("UpdateRecurringJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("recurringJourneyId", recurringJourney.RecurringJourneyID).Uint64("revision", newRevision).
+		Msg("UpdateRecurringJourneyV1: success.")
+	return &desc.UpdateRecurringJourneyResponseV1{Revision: newRevision}, nil
+}
+
+// RemoveRecurringJourneyV1 - permanently remove recurring journey, if expected revision is set (in request or If-Match metadata)
+// recurring journey is removed only if it was not changed since this revision
+func (api *JourneyAPI) RemoveRecurringJourneyV1(ctx context.Context, req *desc.RemoveRecurringJourneyRequestV1) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("RemoveRecurringJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	revision, err := expectedRevision(ctx, req.ExpectedRevision)
+	if err != nil {
+		log.Error().Err(err).Msg("RemoveRecurringJourneyV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := api.checkRecurringJourneyAccess(ctx, req.RecurringJourneyId); err != nil {
+		log.Error().Err(err).Uint64("recurringJourneyId", req.RecurringJourneyId).Msg("RemoveRecurringJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	if err := api.repo.RemoveRecurringJourney(ctx, req.RecurringJourneyId, revision); err != nil {
+		log.Error().Err(err).Uint64("recurringJourneyId", req.RecurringJourneyId).Uint64("expectedRevision", revision).
+			Msg("RemoveRecurringJourneyV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("recurringJourneyId", req.RecurringJourneyId).Msg("RemoveRecurringJourneyV1: success.")
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateOccurrenceV1 - change or cancel single occurrence of recurring journey, change is stored as exception.
+// Returns new revision of recurring journey
+func (api *JourneyAPI) UpdateOccurrenceV1(ctx context.Context, req *desc.UpdateOccurrenceRequestV1) (*desc.UpdateOccurrenceResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("UpdateOccurrenceV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	revision, err := expectedRevision(ctx, req.ExpectedRevision)
+	if err != nil {
+		log.Error().Err(err).Msg("UpdateOccurrenceV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	recurringJourney, err := api.repo.DescribeRecurringJourney(ctx, req.RecurringJourneyId)
+	if err == nil {
+		err = checkRecurringJourneyOwner(ctx, recurringJourney)
+	}
+	if err != nil {
+		log.Error().Err(err).Uint64("recurringJourneyId", req.RecurringJourneyId).Msg("UpdateOccurrenceV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	exception := occurrenceExceptionFromProto(req.Exception)
+	if err := recurringJourney.ValidateException(exception); err != nil {
+		log.Error().Err(err).Uint64("recurringJourneyId", req.RecurringJourneyId).Time("occurrenceTime", exception.OccurrenceTime).
+			Msg("UpdateOccurrenceV1: invalid request.")
+		return nil, toStatusError(err)
+	}
+	// exception is checked against loaded rule, so it is saved only if recurring journey is not changed since loading
+	if revision == 0 {
+		revision = recurringJourney.Revision
+	}
+
+	newRevision, err := api.repo.SetOccurrenceException(ctx, req.RecurringJourneyId, exception, revision)
+	if err != nil {
+		log.Error().Err(err).Uint64("recurringJourneyId", req.RecurringJourneyId).Uint64("expectedRevision", revision).
+			Msg("UpdateOccurrenceV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	log.Debug().Uint64("recurringJourneyId", req.RecurringJourneyId).Uint64("revision", newRevision).Msg("UpdateOccurrenceV1: success.")
+	return &desc.UpdateOccurrenceResponseV1{Revision: newRevision}, nil
+}
+
+// ListOccurrencesV1 - returns occurrences of recurring journeys of users overlapping time range, at most
+// models.MaxOccurrences of them
+func (api *JourneyAPI) ListOccurrencesV1(ctx context.Context, req *desc.ListOccurrencesRequestV1) (*desc.ListOccurrencesResponseV1, error) {
+	if err := req.Validate(); err != nil {
+		log.Error().Err(err).Msg("ListOccurrencesV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter, err := journeyFilterFromRequest(req)
+	if err != nil {
+		log.Error().Err(err).Msg("ListOccurrencesV1: invalid request.")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if filter.UserIDs, err = scopeUserIDs(ctx, filter.UserIDs); err != nil {
+		log.Error().Err(err).Uints64("userIds", req.UserIds).Msg("ListOccurrencesV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	recurringJourneys, err := api.repo.ListRecurringJourneys(ctx, filter)
+	if err != nil {
+		log.Error().Err(err).Interface("filter", filter).Msg("ListOccurrencesV1: failed.")
+		return nil, toStatusError(err)
+	}
+
+	occurrences := models.ExpandOccurrences(recurringJourneys, filter.From, filter.To)
+	resp := &desc.ListOccurrencesResponseV1{Occurrences: make([]*desc.Journey, len(occurrences))}
+	for i, occurrence := range occurrences {
+		resp.Occurrences[i] = journeyToProto(occurrence)
+	}
+
+	log.Debug().Uints64("userIds", filter.UserIDs).Int("count", len(occurrences)).Msg("ListOccurrencesV1: success.")
+	return resp, nil
+}
+
+// listOccurrences - returns occurrences of recurring journeys for the first page of ListJourneysV1 with time range,
+// occurrences have planned status and no tags, so they are filtered by statuses and tags like journeys.
+// Nil is returned for other pages, requests without time range and filters which occurrences do not match
+func (api *JourneyAPI) listOccurrences(ctx context.Context, req *desc.ListJourneysRequestV1, filter repo.JourneyFilter) ([]models.Journey, error) {
+	if req.PageToken != "" || req.Offset > 0 || (filter.From.IsZero() && filter.To.IsZero()) ||
+		len(filter.AnyTags) > 0 || len(filter.AllTags) > 0 || !hasPlannedStatus(filter.Statuses) {
+		return nil, nil
+	}
+
+	recurringJourneys, err := api.repo.ListRecurringJourneys(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return models.ExpandOccurrences(recurringJourneys, filter.From, filter.To), nil
+}
+
+// hasPlannedStatus - checks that journeys with planned status match statuses filter, empty filter matches all statuses
+func hasPlannedStatus(statuses []models.JourneyStatus) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, journeyStatus := range statuses {
+		if journeyStatus == models.StatusPlanned {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRecurringJourneyAccess - returns PermissionDenied error if acting user is not owner of recurring journey,
+// recurring journey is not loaded for requests without acting user
+func (api *JourneyAPI) checkRecurringJourneyAccess(ctx context.Context, recurringJourneyID uint64) error {
+	if actingUserID(ctx) == 0 {
+		return nil
+	}
+
+	recurringJourney, err := api.repo.DescribeRecurringJourney(ctx, recurringJourneyID)
+	if err != nil {
+		return err
+	}
+	return checkRecurringJourneyOwner(ctx, recurringJourney)
+}
+
+// checkRecurringJourneyOwner - returns PermissionDenied error if acting user is not owner of recurring journey,
+// recurring journeys have no participants. Requests without acting user are allowed
+func checkRecurringJourneyOwner(ctx context.Context, recurringJourney *models.RecurringJourney) error {
+	userID := actingUserID(ctx)
+	if userID != 0 && userID != recurringJourney.UserID {
+		return apperrors.New(apperrors.PermissionDenied,
+			"user %d is not owner of recurring journey %d", userID, recurringJourney.RecurringJourneyID)
+	}
+	return nil
+}
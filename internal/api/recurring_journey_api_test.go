@@ -0,0 +1,350 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/auth"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+var _ = Describe("RecurringJourneyApi", func() {
+	var (
+		ctrl        *gomock.Controller
+		mockRepo    *mocks.MockRepo
+		mockMetrics *mocks.MockMetrics
+		api         desc.JourneyApiV1Server
+		ctx         context.Context
+
+		// Monday
+		timeStart = time.Date(2021, 11, 1, 8, 0, 0, 0, time.UTC)
+		timeEnd   = timeStart.Add(time.Hour)
+		commute   = models.RecurringJourney{RecurringJourneyID: 3, UserID: 1, Address: "Офис", StartTime: timeStart, EndTime: timeEnd,
+			Rule: "FREQ=WEEKLY;BYDAY=MO,WE", Revision: 2}
+
+		commuteProto = func() *desc.RecurringJourney {
+			return &desc.RecurringJourney{
+				RecurringJourneyId: commute.RecurringJourneyID,
+				UserId:             commute.UserID,
+				Address:            commute.Address,
+				StartTime:          timestamppb.New(timeStart),
+				EndTime:            timestamppb.New(timeEnd),
+				Rule:               commute.Rule,
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockMetrics = mocks.NewMockMetrics(ctrl)
+		ctx = context.Background()
+		api = NewJourneyAPI(Options{
+			Repo:      mockRepo,
+			Metrics:   mockMetrics,
+			Hub:       watch.NewHub(10, 10),
+			ChunkSize: 2,
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("CreateRecurringJourneyV1", func() {
+		It("should add recurring journey", func() {
+			newCommute := commute
+			newCommute.RecurringJourneyID = 0
+			newCommute.Revision = 0
+			mockRepo.EXPECT().AddRecurringJourney(ctx, newCommute).Return(uint64(3), nil).Times(1)
+
+			request := commuteProto()
+			request.RecurringJourneyId = 0
+			result, err := api.CreateRecurringJourneyV1(ctx, &desc.CreateRecurringJourneyRequestV1{RecurringJourney: request})
+
+			Expect(err).Should(BeNil())
+			Expect(result.RecurringJourneyId).Should(Equal(uint64(3)))
+		})
+
+		It("should return error without calling repo for unsupported rule", func() {
+			mockRepo.EXPECT().AddRecurringJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			request := commuteProto()
+			request.Rule = "FREQ=HOURLY"
+			result, err := api.CreateRecurringJourneyV1(ctx, &desc.CreateRecurringJourneyRequestV1{RecurringJourney: request})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("DescribeRecurringJourneyV1", func() {
+		It("should return recurring journey with exceptions", func() {
+			described := commute
+			described.Exceptions = []models.OccurrenceException{{OccurrenceTime: timeStart, Cancelled: true}}
+			mockRepo.EXPECT().DescribeRecurringJourney(ctx, uint64(3)).Return(&described, nil).Times(1)
+
+			result, err := api.DescribeRecurringJourneyV1(ctx, &desc.DescribeRecurringJourneyRequestV1{RecurringJourneyId: 3})
+
+			Expect(err).Should(BeNil())
+			Expect(result.RecurringJourney.Rule).Should(Equal(commute.Rule))
+			Expect(result.RecurringJourney.Exceptions).Should(HaveLen(1))
+			Expect(result.RecurringJourney.Exceptions[0].Cancelled).Should(BeTrue())
+			Expect(result.RecurringJourney.Exceptions[0].StartTime).Should(BeNil())
+		})
+
+		It("should return permission denied for other acting user", func() {
+			ctx = auth.WithUserID(ctx, 2)
+			mockRepo.EXPECT().DescribeRecurringJourney(ctx, uint64(3)).Return(&commute, nil).Times(1)
+
+			result, err := api.DescribeRecurringJourneyV1(ctx, &desc.DescribeRecurringJourneyRequestV1{RecurringJourneyId: 3})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+	})
+
+	Context("UpdateRecurringJourneyV1", func() {
+		It("should update recurring journey with expected revision", func() {
+			updated := commute
+			updated.Revision = 2
+			mockRepo.EXPECT().UpdateRecurringJourney(ctx, updated).Return(uint64(3), nil).Times(1)
+
+			result, err := api.UpdateRecurringJourneyV1(ctx, &desc.UpdateRecurringJourneyRequestV1{
+				RecurringJourney: commuteProto(), ExpectedRevision: 2,
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Revision).Should(Equal(uint64(3)))
+		})
+
+		It("should check owner if acting user is set", func() {
+			ctx = auth.WithUserID(ctx, 2)
+			mockRepo.EXPECT().DescribeRecurringJourney(ctx, uint64(3)).Return(&commute, nil).Times(1)
+			mockRepo.EXPECT().UpdateRecurringJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.UpdateRecurringJourneyV1(ctx, &desc.UpdateRecurringJourneyRequestV1{RecurringJourney: commuteProto()})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+
+		It("should return error without calling repo for missing id", func() {
+			mockRepo.EXPECT().UpdateRecurringJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			request := commuteProto()
+			request.RecurringJourneyId = 0
+			result, err := api.UpdateRecurringJourneyV1(ctx, &desc.UpdateRecurringJourneyRequestV1{RecurringJourney: request})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("RemoveRecurringJourneyV1", func() {
+		It("should remove recurring journey", func() {
+			mockRepo.EXPECT().RemoveRecurringJourney(ctx, uint64(3), uint64(0)).Return(nil).Times(1)
+
+			_, err := api.RemoveRecurringJourneyV1(ctx, &desc.RemoveRecurringJourneyRequestV1{RecurringJourneyId: 3})
+
+			Expect(err).Should(BeNil())
+		})
+
+		It("should return aborted for revision conflict", func() {
+			mockRepo.EXPECT().RemoveRecurringJourney(ctx, uint64(3), uint64(1)).Return(repo.ErrRevisionConflict).Times(1)
+
+			_, err := api.RemoveRecurringJourneyV1(ctx, &desc.RemoveRecurringJourneyRequestV1{RecurringJourneyId: 3, ExpectedRevision: 1})
+
+			Expect(status.Code(err)).Should(Equal(codes.Aborted))
+		})
+	})
+
+	Context("UpdateOccurrenceV1", func() {
+		wednesday := timeStart.AddDate(0, 0, 2)
+
+		It("should store exception with revision of loaded recurring journey", func() {
+			exception := models.OccurrenceException{
+				OccurrenceTime: wednesday, Address: "Склад", StartTime: wednesday.Add(time.Hour), EndTime: wednesday.Add(2 * time.Hour),
+			}
+			mockRepo.EXPECT().DescribeRecurringJourney(ctx, uint64(3)).Return(&commute, nil).Times(1)
+			mockRepo.EXPECT().SetOccurrenceException(ctx, uint64(3), exception, uint64(2)).Return(uint64(3), nil).Times(1)
+
+			result, err := api.UpdateOccurrenceV1(ctx, &desc.UpdateOccurrenceRequestV1{
+				RecurringJourneyId: 3,
+				Exception: &desc.OccurrenceException{
+					OccurrenceTime: timestamppb.New(wednesday),
+					Address:        "Склад",
+					StartTime:      timestamppb.New(wednesday.Add(time.Hour)),
+					EndTime:        timestamppb.New(wednesday.Add(2 * time.Hour)),
+				},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Revision).Should(Equal(uint64(3)))
+		})
+
+		It("should cancel occurrence ignoring its fields", func() {
+			exception := models.OccurrenceException{OccurrenceTime: wednesday, Cancelled: true}
+			mockRepo.EXPECT().DescribeRecurringJourney(ctx, uint64(3)).Return(&commute, nil).Times(1)
+			mockRepo.EXPECT().SetOccurrenceException(ctx, uint64(3), exception, uint64(2)).Return(uint64(3), nil).Times(1)
+
+			_, err := api.UpdateOccurrenceV1(ctx, &desc.UpdateOccurrenceRequestV1{
+				RecurringJourneyId: 3,
+				Exception:          &desc.OccurrenceException{OccurrenceTime: timestamppb.New(wednesday), Cancelled: true, Address: "Склад"},
+			})
+
+			Expect(err).Should(BeNil())
+		})
+
+		It("should return error for time which is not occurrence", func() {
+			mockRepo.EXPECT().DescribeRecurringJourney(ctx, uint64(3)).Return(&commute, nil).Times(1)
+			mockRepo.EXPECT().SetOccurrenceException(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.UpdateOccurrenceV1(ctx, &desc.UpdateOccurrenceRequestV1{
+				RecurringJourneyId: 3,
+				Exception:          &desc.OccurrenceException{OccurrenceTime: timestamppb.New(wednesday.AddDate(0, 0, 1)), Cancelled: true},
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("ListJourneysV1", func() {
+		It("should return occurrences of recurring journeys in time range", func() {
+			filter := repo.JourneyFilter{UserIDs: []uint64{1}, Participating: true, From: timeStart, To: timeStart.AddDate(0, 0, 7)}
+			mockRepo.EXPECT().ListJourneys(ctx, filter, uint64(10), uint64(0)).Return(nil, nil).Times(1)
+			mockRepo.EXPECT().ListRecurringJourneys(ctx, filter).Return([]models.RecurringJourney{commute}, nil).Times(1)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+				Limit:    10,
+				UserIds:  []uint64{1},
+				FromTime: timestamppb.New(timeStart),
+				ToTime:   timestamppb.New(timeStart.AddDate(0, 0, 7)),
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Journeys).Should(BeEmpty())
+			Expect(result.Occurrences).Should(HaveLen(2))
+			Expect(result.Occurrences[1].RecurringJourneyId).Should(Equal(uint64(3)))
+			Expect(result.Occurrences[1].JourneyId).Should(Equal(uint64(0)))
+			Expect(result.Occurrences[1].OccurrenceTime.AsTime()).Should(Equal(timeStart.AddDate(0, 0, 2)))
+		})
+
+		It("should return occurrences of recurring journeys in time range open at start", func() {
+			filter := repo.JourneyFilter{To: timeStart.AddDate(0, 0, 7)}
+			mockRepo.EXPECT().ListJourneys(ctx, filter, uint64(10), uint64(0)).Return(nil, nil).Times(1)
+			mockRepo.EXPECT().ListRecurringJourneys(ctx, filter).Return([]models.RecurringJourney{commute}, nil).Times(1)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: 10, ToTime: timestamppb.New(timeStart.AddDate(0, 0, 7))})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Occurrences).Should(HaveLen(2))
+		})
+
+		It("should return occurrences of recurring journeys filtered by planned status", func() {
+			filter := repo.JourneyFilter{From: timeStart, To: timeStart.AddDate(0, 0, 7),
+				Statuses: []models.JourneyStatus{models.StatusPlanned, models.StatusCompleted}}
+			mockRepo.EXPECT().ListJourneys(ctx, filter, uint64(10), uint64(0)).Return(nil, nil).Times(1)
+			mockRepo.EXPECT().ListRecurringJourneys(ctx, filter).Return([]models.RecurringJourney{commute}, nil).Times(1)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+				Limit:    10,
+				FromTime: timestamppb.New(timeStart),
+				ToTime:   timestamppb.New(timeStart.AddDate(0, 0, 7)),
+				Statuses: []desc.JourneyStatus{desc.JourneyStatus_JOURNEY_STATUS_PLANNED, desc.JourneyStatus_JOURNEY_STATUS_COMPLETED},
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Occurrences).Should(HaveLen(2))
+		})
+
+		It("should not expand occurrences without time range", func() {
+			mockRepo.EXPECT().ListJourneys(ctx, repo.JourneyFilter{}, uint64(10), uint64(0)).Return(nil, nil).Times(1)
+			mockRepo.EXPECT().ListRecurringJourneys(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: 10})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Occurrences).Should(BeEmpty())
+		})
+
+		It("should not expand occurrences for filters which they do not match", func() {
+			mockRepo.EXPECT().ListJourneys(ctx, gomock.Any(), uint64(10), uint64(0)).Return(nil, nil).Times(2)
+			mockRepo.EXPECT().ListRecurringJourneys(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+				Limit: 10, FromTime: timestamppb.New(timeStart), AnyTags: []string{"work"},
+			})
+			Expect(err).Should(BeNil())
+			Expect(result.Occurrences).Should(BeEmpty())
+
+			result, err = api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{
+				Limit: 10, FromTime: timestamppb.New(timeStart), Statuses: []desc.JourneyStatus{desc.JourneyStatus_JOURNEY_STATUS_COMPLETED},
+			})
+			Expect(err).Should(BeNil())
+			Expect(result.Occurrences).Should(BeEmpty())
+		})
+
+		It("should not expand occurrences for pages after the first one", func() {
+			mockRepo.EXPECT().ListJourneys(ctx, gomock.Any(), uint64(10), uint64(10)).Return(nil, nil).Times(1)
+			mockRepo.EXPECT().ListRecurringJourneys(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ListJourneysV1(ctx, &desc.ListJourneysRequestV1{Limit: 10, Offset: 10, FromTime: timestamppb.New(timeStart)})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Occurrences).Should(BeEmpty())
+		})
+	})
+
+	Context("ListOccurrencesV1", func() {
+		It("should return occurrences of acting user in time range", func() {
+			ctx = auth.WithUserID(ctx, 1)
+			filter := repo.JourneyFilter{UserIDs: []uint64{1}, From: timeStart, To: timeStart.AddDate(0, 0, 7)}
+			mockRepo.EXPECT().ListRecurringJourneys(ctx, filter).Return([]models.RecurringJourney{commute}, nil).Times(1)
+
+			result, err := api.ListOccurrencesV1(ctx, &desc.ListOccurrencesRequestV1{
+				FromTime: timestamppb.New(timeStart),
+				ToTime:   timestamppb.New(timeStart.AddDate(0, 0, 7)),
+			})
+
+			Expect(err).Should(BeNil())
+			Expect(result.Occurrences).Should(HaveLen(2))
+			Expect(result.Occurrences[0].OccurrenceTime.AsTime()).Should(Equal(timeStart))
+			Expect(result.Occurrences[1].OccurrenceTime.AsTime()).Should(Equal(timeStart.AddDate(0, 0, 2)))
+		})
+
+		It("should return error without calling repo for missing time range", func() {
+			mockRepo.EXPECT().ListRecurringJourneys(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ListOccurrencesV1(ctx, &desc.ListOccurrencesRequestV1{FromTime: timestamppb.New(timeStart)})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should return permission denied for occurrences of other user", func() {
+			mockRepo.EXPECT().ListRecurringJourneys(gomock.Any(), gomock.Any()).Times(0)
+
+			result, err := api.ListOccurrencesV1(auth.WithUserID(ctx, 2), &desc.ListOccurrencesRequestV1{
+				UserIds:  []uint64{1},
+				FromTime: timestamppb.New(timeStart),
+				ToTime:   timestamppb.New(timeStart.AddDate(0, 0, 7)),
+			})
+
+			Expect(result).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+	})
+})
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// errInvalidResumeToken - occurs when resume token from request cannot be decoded
+var errInvalidResumeToken = errors.New("invalid resume token")
+
+// resumeToken - represents position of the last event sent to subscriber of watch.Hub,
+// it is passed to clients as opaque base64 string
+type resumeToken struct {
+	HubID    string `json:"hub_id"`
+	Revision uint64 `json:"revision"`
+}
+
+func encodeResumeToken(token resumeToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeResumeToken(value string) (resumeToken, error) {
+	var token resumeToken
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return token, errInvalidResumeToken
+	}
+	if err = json.Unmarshal(data, &token); err != nil || token.HubID == "" {
+		return token, errInvalidResumeToken
+	}
+	return token, nil
+}
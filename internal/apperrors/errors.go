@@ -0,0 +1,102 @@
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind - category of domain error, defines how error is reported to clients
+type Kind int
+
+const (
+	// Unknown - error without known category, usually internal failure
+	Unknown Kind = iota
+	// NotFound - requested entity does not exist
+	NotFound
+	// AlreadyExists - entity that should be created already exists
+	AlreadyExists
+	// Conflict - entity was changed concurrently, e.g. expected revision does not match
+	Conflict
+	// InvalidArgument - entity or request parameters are invalid
+	InvalidArgument
+	// Unavailable - storage or another dependency is temporarily unavailable, operation can be retried
+	Unavailable
+	// FailedPrecondition - entity is not in the state required for operation, e.g. it conflicts with other entities
+	FailedPrecondition
+	// PermissionDenied - acting user is not allowed to perform operation with entity
+	PermissionDenied
+	// Unauthenticated - request has no identity of acting user
+	Unauthenticated
+)
+
+func (k Kind) String() string {
+	switch k {
+	case NotFound:
+		return "not found"
+	case AlreadyExists:
+		return "already exists"
+	case Conflict:
+		return "conflict"
+	case InvalidArgument:
+		return "invalid argument"
+	case Unavailable:
+		return "unavailable"
+	case FailedPrecondition:
+		return "failed precondition"
+	case PermissionDenied:
+		return "permission denied"
+	case Unauthenticated:
+		return "unauthenticated"
+	}
+	return "unknown"
+}
+
+// Error - domain error of some Kind with optional cause
+type Error struct {
+	Kind    Kind
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	switch {
+	case e.Message == "" && e.Err == nil:
+		return e.Kind.String()
+	case e.Err == nil:
+		return e.Message
+	case e.Message == "":
+		return e.Err.Error()
+	}
+	return e.Message + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// New - creates Error of kind with formatted message
+func New(kind Kind, format string, args ...interface{}) error {
+	return &Error{Kind: kind, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap - creates Error of kind with cause err and formatted message, returns nil if err is nil
+func Wrap(kind Kind, err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Kind: kind, Message: fmt.Sprintf(format, args...), Err: err}
+}
+
+// KindOf - returns Kind of the first Error in err chain or Unknown
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return Unknown
+}
+
+// Is - checks that err chain contains Error of kind
+func Is(err error, kind Kind) bool {
+	return err != nil && KindOf(err) == kind
+}
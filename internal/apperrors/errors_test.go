@@ -0,0 +1,36 @@
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_Error(t *testing.T) {
+	cause := errors.New("cause")
+
+	assert.Equal(t, "not found", (&Error{Kind: NotFound}).Error())
+	assert.Equal(t, "journey 1", New(NotFound, "journey %d", 1).Error())
+	assert.Equal(t, "journey 1: cause", Wrap(NotFound, cause, "journey %d", 1).Error())
+	assert.Equal(t, "cause", (&Error{Kind: Unavailable, Err: cause}).Error())
+}
+
+func TestWrap(t *testing.T) {
+	cause := errors.New("cause")
+
+	assert.Nil(t, Wrap(Unavailable, nil, "message"))
+	assert.ErrorIs(t, Wrap(Unavailable, cause, "message"), cause)
+}
+
+func TestKindOf(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", New(Conflict, "revision"))
+
+	assert.Equal(t, Conflict, KindOf(err))
+	assert.Equal(t, Unknown, KindOf(errors.New("plain")))
+	assert.Equal(t, Unknown, KindOf(nil))
+	assert.True(t, Is(err, Conflict))
+	assert.False(t, Is(err, NotFound))
+	assert.False(t, Is(nil, Unknown))
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AuthorizationMetadataKey - key of gRPC metadata with "Bearer <token>" of acting user,
+// gateway passes Authorization header there
+const AuthorizationMetadataKey = "authorization"
+
+type userIDKey struct{}
+
+// WithUserID - returns context of request of authenticated user
+func WithUserID(ctx context.Context, userID uint64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserID - returns id of authenticated user of request, 0 is returned if authentication is disabled
+func UserID(ctx context.Context) uint64 {
+	userID, _ := ctx.Value(userIDKey{}).(uint64)
+	return userID
+}
+
+// UnaryServerInterceptor - authenticates requests by token of ScopeAPI in AuthorizationMetadataKey metadata,
+// requests without valid token are rejected with Unauthenticated status
+func (a *Authenticator) UnaryServerInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor - authenticates streams like UnaryServerInterceptor
+func (a *Authenticator) StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticate - returns context with id of user of token from metadata
+func (a *Authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	var values []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		values = md.Get(AuthorizationMetadataKey)
+	}
+	if len(values) != 1 || !strings.HasPrefix(values[0], "Bearer ") {
+		return nil, status.Errorf(codes.Unauthenticated, "%s metadata with bearer token is required", AuthorizationMetadataKey)
+	}
+	userID, err := a.Verify(strings.TrimPrefix(values[0], "Bearer "), ScopeAPI, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return WithUserID(ctx, userID), nil
+}
+
+// authenticatedStream - grpc.ServerStream with context of authenticated user
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
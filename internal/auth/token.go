@@ -0,0 +1,91 @@
+// Package auth - authentication of users by tokens signed with secret shared with the service issuing them
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+// Scope - purpose of token, token issued for one scope is not accepted for another
+type Scope string
+
+const (
+	// ScopeAPI - token of gRPC and gateway requests of user
+	ScopeAPI Scope = "api"
+	// ScopeCalendarFeed - token in URL of iCalendar feed of user, it gives access only to the feed
+	ScopeCalendarFeed Scope = "calendar"
+)
+
+// ErrInvalidToken - occurs when token is malformed, has wrong signature or scope or is expired
+var ErrInvalidToken = apperrors.New(apperrors.Unauthenticated, "invalid or expired token")
+
+// Authenticator - issues and verifies tokens of users.
+//
+// Token is "<payload>.<signature>" where payload is base64url encoded "<scope>:<user id>:<expiry unix time>"
+// and signature is base64url encoded HMAC-SHA256 of payload with secret, expiry 0 means token never expires.
+// Service issuing tokens of ScopeAPI must share the secret
+type Authenticator struct {
+	secret []byte
+}
+
+// NewAuthenticator - creates Authenticator with secret key of signatures
+func NewAuthenticator(secret string) *Authenticator {
+	return &Authenticator{secret: []byte(secret)}
+}
+
+// Token - returns token of user for scope, token never expires if expiresAt is zero
+func (a *Authenticator) Token(userID uint64, scope Scope, expiresAt time.Time) string {
+	var expiry int64
+	if !expiresAt.IsZero() {
+		expiry = expiresAt.Unix()
+	}
+	payload := string(scope) + ":" + strconv.FormatUint(userID, 10) + ":" + strconv.FormatInt(expiry, 10)
+	return encode([]byte(payload)) + "." + encode(a.sign(payload))
+}
+
+// Verify - returns id of user of token issued for scope, ErrInvalidToken is returned if token is not valid at now
+func (a *Authenticator) Verify(token string, scope Scope, now time.Time) (uint64, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return 0, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(signature, a.sign(string(payload))) {
+		return 0, ErrInvalidToken
+	}
+
+	fields := strings.Split(string(payload), ":")
+	if len(fields) != 3 || Scope(fields[0]) != scope {
+		return 0, ErrInvalidToken
+	}
+	userID, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil || userID == 0 {
+		return 0, ErrInvalidToken
+	}
+	expiry, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil || (expiry != 0 && !now.Before(time.Unix(expiry, 0))) {
+		return 0, ErrInvalidToken
+	}
+	return userID, nil
+}
+
+// sign - returns HMAC-SHA256 of payload
+func (a *Authenticator) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
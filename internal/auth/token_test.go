@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthenticator_Verify(t *testing.T) {
+	now := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+	authenticator := NewAuthenticator("secret")
+
+	userID, err := authenticator.Verify(authenticator.Token(42, ScopeAPI, now.Add(time.Minute)), ScopeAPI, now)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(42), userID)
+	userID, err = authenticator.Verify(authenticator.Token(42, ScopeCalendarFeed, time.Time{}), ScopeCalendarFeed, now)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(42), userID)
+
+	for name, token := range map[string]string{
+		"expired":        authenticator.Token(42, ScopeAPI, now),
+		"another scope":  authenticator.Token(42, ScopeCalendarFeed, time.Time{}),
+		"another secret": NewAuthenticator("another").Token(42, ScopeAPI, time.Time{}),
+		"no user":        authenticator.Token(0, ScopeAPI, time.Time{}),
+		"malformed":      "42",
+		"empty":          "",
+	} {
+		_, err := authenticator.Verify(token, ScopeAPI, now)
+		assert.ErrorIs(t, err, ErrInvalidToken, name)
+	}
+}
+
+func TestAuthenticator_UnaryServerInterceptor(t *testing.T) {
+	authenticator := NewAuthenticator("secret")
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return UserID(ctx), nil
+	}
+	call := func(ctx context.Context) (interface{}, error) {
+		return authenticator.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	}
+	withAuthorization := func(value string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationMetadataKey, value))
+	}
+
+	userID, err := call(withAuthorization("Bearer " + authenticator.Token(7, ScopeAPI, time.Now().Add(time.Minute))))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), userID)
+
+	_, err = call(context.Background())
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = call(withAuthorization(authenticator.Token(7, ScopeAPI, time.Time{})))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = call(withAuthorization("Bearer " + authenticator.Token(7, ScopeCalendarFeed, time.Time{})))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
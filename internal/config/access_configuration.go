@@ -0,0 +1,15 @@
+package config
+
+// AccessConfiguration type represents configuration of permissions of users
+type AccessConfiguration struct {
+	// AdminUserIDs - ids of users allowed to read data of all users, e.g. statistics and removed journeys
+	AdminUserIDs []uint64 `yaml:"adminUserIds"`
+}
+
+// GetAdminUserIDs - returns ids of admin users, there are no admins if access is not configured
+func (c *AccessConfiguration) GetAdminUserIDs() []uint64 {
+	if c == nil {
+		return nil
+	}
+	return c.AdminUserIDs
+}
@@ -0,0 +1,14 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessConfiguration_GetAdminUserIDs(t *testing.T) {
+	var notConfigured *AccessConfiguration
+
+	assert.Empty(t, notConfigured.GetAdminUserIDs(), "should return no admins for nil configuration")
+	assert.Equal(t, []uint64{1, 2}, (&AccessConfiguration{AdminUserIDs: []uint64{1, 2}}).GetAdminUserIDs(), "should return configured admins")
+}
@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+// AdvanceConfiguration type represents configuration for automatic starting and completing of journeys
+// by their start and end times
+type AdvanceConfiguration struct {
+	// Interval - time duration between advancing attempts, 0 disables advancing
+	Interval time.Duration `yaml:"interval"`
+	// BatchSize - maximum count of journeys changed by one query
+	BatchSize uint64 `yaml:"batchSize"`
+}
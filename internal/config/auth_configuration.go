@@ -0,0 +1,15 @@
+package config
+
+// AuthConfiguration type represents configuration of authentication of users
+type AuthConfiguration struct {
+	// Secret - key of signatures of tokens shared with authentication service issuing them
+	Secret string `yaml:"secret"`
+}
+
+// GetSecret - returns key of signatures of tokens, authentication is disabled if it is empty
+func (c *AuthConfiguration) GetSecret() string {
+	if c == nil {
+		return ""
+	}
+	return c.Secret
+}
@@ -0,0 +1,14 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthConfiguration_GetSecret(t *testing.T) {
+	var notConfigured *AuthConfiguration
+
+	assert.Empty(t, notConfigured.GetSecret(), "should return empty secret for nil configuration")
+	assert.Equal(t, "secret", (&AuthConfiguration{Secret: "secret"}).GetSecret(), "should return configured secret")
+}
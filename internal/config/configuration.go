@@ -17,6 +17,13 @@ type Configuration struct {
 	Kafka       *KafkaConfiguration       `yaml:"kafka"`
 	Prometheus  *PrometheusConfiguration  `yaml:"prometheus"`
 	HealthCheck *HealthCheckConfiguration `yaml:"health_check"`
+	Purge       *PurgeConfiguration       `yaml:"purge"`
+	Advance     *AdvanceConfiguration     `yaml:"advance"`
+	Idempotency *IdempotencyConfiguration `yaml:"idempotency"`
+	Watch       *WatchConfiguration       `yaml:"watch"`
+	Overlap     *OverlapConfiguration     `yaml:"overlap"`
+	Access      *AccessConfiguration      `yaml:"access"`
+	Auth        *AuthConfiguration        `yaml:"auth"`
 }
 
 // LoadConfigurationFromFile - method for load Configuration from JSON file.
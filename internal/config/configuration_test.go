@@ -4,6 +4,7 @@ import (
 	"gopkg.in/yaml.v3"
 	"io/fs"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -52,14 +53,34 @@ func TestConfiguration_LoadConfigurationFromFile(t *testing.T) {
 						Port: 6831,
 					},
 					Kafka: &KafkaConfiguration{
-						Topic:   "ova-journey-api",
-						Brokers: []string{"kafka:9092"},
+						Topic:         "ova-journey-api",
+						Brokers:       []string{"kafka:9092"},
+						ConsumerGroup: "ova-journey-api-consumer",
 					},
 					Prometheus: &PrometheusConfiguration{
 						Host: "0.0.0.0",
 						Port: 9100,
 						Path: "/metrics",
 					},
+					Purge: &PurgeConfiguration{
+						Retention: 720 * time.Hour,
+						Interval:  time.Hour,
+						BatchSize: 1000,
+					},
+					Advance: &AdvanceConfiguration{
+						Interval:  time.Minute,
+						BatchSize: 1000,
+					},
+					Idempotency: &IdempotencyConfiguration{
+						TTL: 24 * time.Hour,
+					},
+					Watch: &WatchConfiguration{
+						BufferSize:  100,
+						HistorySize: 1000,
+					},
+					Overlap: &OverlapConfiguration{
+						Policy: "allow",
+					},
 				},
 				err: nil,
 			},
@@ -0,0 +1,20 @@
+package config
+
+import "time"
+
+// DefaultIdempotencyTTL - time of storing idempotency keys if it is not configured
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// IdempotencyConfiguration type represents configuration for idempotency keys of create requests
+type IdempotencyConfiguration struct {
+	// TTL - time duration while request result is stored by idempotency key, expired keys are deleted by purger
+	TTL time.Duration `yaml:"ttl"`
+}
+
+// GetTTL - returns configured TTL of idempotency keys or DefaultIdempotencyTTL if it is not configured
+func (c *IdempotencyConfiguration) GetTTL() time.Duration {
+	if c == nil || c.TTL <= 0 {
+		return DefaultIdempotencyTTL
+	}
+	return c.TTL
+}
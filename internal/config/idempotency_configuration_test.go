@@ -0,0 +1,16 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyConfiguration_GetTTL(t *testing.T) {
+	var notConfigured *IdempotencyConfiguration
+
+	assert.Equal(t, DefaultIdempotencyTTL, notConfigured.GetTTL(), "should return default TTL for nil configuration")
+	assert.Equal(t, DefaultIdempotencyTTL, (&IdempotencyConfiguration{}).GetTTL(), "should return default TTL for zero TTL")
+	assert.Equal(t, time.Hour, (&IdempotencyConfiguration{TTL: time.Hour}).GetTTL(), "should return configured TTL")
+}
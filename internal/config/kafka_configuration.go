@@ -2,6 +2,7 @@ package config
 
 // KafkaConfiguration type represents configuration for Kafka
 type KafkaConfiguration struct {
-	Topic   string   `yaml:"topic"`
-	Brokers []string `yaml:"brokers"`
+	Topic         string   `yaml:"topic"`
+	Brokers       []string `yaml:"brokers"`
+	ConsumerGroup string   `yaml:"consumerGroup"`
 }
@@ -0,0 +1,18 @@
+package config
+
+// DefaultOverlapPolicy - policy for overlapping journeys if it is not configured
+const DefaultOverlapPolicy = "allow"
+
+// OverlapConfiguration type represents configuration for journeys of the same user overlapping in time
+type OverlapConfiguration struct {
+	// Policy - allow, warn or reject, used for create and update requests without their own policy
+	Policy string `yaml:"policy"`
+}
+
+// GetPolicy - returns configured overlap policy or DefaultOverlapPolicy if it is not configured
+func (c *OverlapConfiguration) GetPolicy() string {
+	if c == nil || c.Policy == "" {
+		return DefaultOverlapPolicy
+	}
+	return c.Policy
+}
@@ -0,0 +1,15 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlapConfiguration_GetPolicy(t *testing.T) {
+	var notConfigured *OverlapConfiguration
+
+	assert.Equal(t, DefaultOverlapPolicy, notConfigured.GetPolicy(), "should return default policy for nil configuration")
+	assert.Equal(t, DefaultOverlapPolicy, (&OverlapConfiguration{}).GetPolicy(), "should return default policy for empty policy")
+	assert.Equal(t, "reject", (&OverlapConfiguration{Policy: "reject"}).GetPolicy(), "should return configured policy")
+}
@@ -0,0 +1,13 @@
+package config
+
+import "time"
+
+// PurgeConfiguration type represents configuration for permanent deleting of removed journeys
+type PurgeConfiguration struct {
+	// Retention - removed journeys are kept for this period and can be restored, 0 disables purging
+	Retention time.Duration `yaml:"retention"`
+	// Interval - time duration between purging attempts
+	Interval time.Duration `yaml:"interval"`
+	// BatchSize - maximum count of journeys deleted by one query
+	BatchSize uint64 `yaml:"batchSize"`
+}
@@ -0,0 +1,32 @@
+package config
+
+const (
+	// DefaultWatchBufferSize - count of events buffered for every subscriber if it is not configured
+	DefaultWatchBufferSize = 100
+	// DefaultWatchHistorySize - count of last events kept for resuming if it is not configured
+	DefaultWatchHistorySize = 1000
+)
+
+// WatchConfiguration type represents configuration for streaming of journey changes to subscribers
+type WatchConfiguration struct {
+	// BufferSize - count of events buffered for every subscriber, subscriber is dropped when its buffer is full
+	BufferSize int `yaml:"bufferSize"`
+	// HistorySize - count of last events kept for resuming watching by dropped subscribers
+	HistorySize int `yaml:"historySize"`
+}
+
+// GetBufferSize - returns configured buffer size or DefaultWatchBufferSize if it is not configured
+func (c *WatchConfiguration) GetBufferSize() int {
+	if c == nil || c.BufferSize <= 0 {
+		return DefaultWatchBufferSize
+	}
+	return c.BufferSize
+}
+
+// GetHistorySize - returns configured history size or DefaultWatchHistorySize if it is not configured
+func (c *WatchConfiguration) GetHistorySize() int {
+	if c == nil || c.HistorySize <= 0 {
+		return DefaultWatchHistorySize
+	}
+	return c.HistorySize
+}
@@ -0,0 +1,18 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchConfiguration_Defaults(t *testing.T) {
+	var notConfigured *WatchConfiguration
+
+	assert.Equal(t, DefaultWatchBufferSize, notConfigured.GetBufferSize(), "should return default buffer size for nil configuration")
+	assert.Equal(t, DefaultWatchHistorySize, notConfigured.GetHistorySize(), "should return default history size for nil configuration")
+
+	configured := &WatchConfiguration{BufferSize: 10, HistorySize: 20}
+	assert.Equal(t, 10, configured.GetBufferSize(), "should return configured buffer size")
+	assert.Equal(t, 20, configured.GetHistorySize(), "should return configured history size")
+}
@@ -0,0 +1,432 @@
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// maxContentLineLength - limit of unfolded content line length in octets
+const maxContentLineLength = 1 << 20
+
+// contentLine - unfolded content line with upper-case name, parameters and raw value,
+// number is the number of its first physical line
+type contentLine struct {
+	number int
+	name   string
+	params map[string]string
+	value  string
+}
+
+// event - properties of VEVENT starting at line
+type event struct {
+	line       int
+	properties []contentLine
+}
+
+// Decode - reads VEVENTs of VCALENDAR objects as requests for creating journeys without user id.
+// LOCATION (or SUMMARY) is used as address, DTEND or DURATION as end time, GEO as coordinates
+// and CATEGORIES as tags. Times with TZID are read in that time zone which becomes time zone of journey,
+// floating times and dates are read in time zone of X-WR-TIMEZONE property or in UTC.
+// Cancelled events are skipped, recurring events are not supported. Errors have InvalidArgument kind
+func Decode(r io.Reader) ([]*desc.CreateJourneyRequestV1, error) {
+	lines, err := readContentLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		stack        []string
+		events       []event
+		calendarZone string
+		found        bool
+	)
+	for _, line := range lines {
+		switch line.name {
+		case "BEGIN":
+			component := strings.ToUpper(line.value)
+			if len(stack) == 0 && component != "VCALENDAR" {
+				return nil, invalidCalendar(line.number, "BEGIN:VCALENDAR expected")
+			}
+			found = true
+			stack = append(stack, component)
+			if len(stack) == 2 && component == "VEVENT" {
+				events = append(events, event{line: line.number})
+			}
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1] != strings.ToUpper(line.value) {
+				return nil, invalidCalendar(line.number, "unexpected END:%s", line.value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			switch {
+			case len(stack) == 0:
+				return nil, invalidCalendar(line.number, "property %s outside of VCALENDAR", line.name)
+			case len(stack) == 1 && line.name == "X-WR-TIMEZONE":
+				calendarZone = line.value
+			case len(stack) == 2 && stack[1] == "VEVENT":
+				// properties of nested components like VALARM are skipped
+				events[len(events)-1].properties = append(events[len(events)-1].properties, line)
+			}
+		}
+	}
+	if !found {
+		return nil, apperrors.New(apperrors.InvalidArgument, "invalid calendar: VCALENDAR not found")
+	}
+	if len(stack) > 0 {
+		return nil, apperrors.New(apperrors.InvalidArgument, "invalid calendar: END:%s expected", stack[len(stack)-1])
+	}
+
+	defaultLocation := time.UTC
+	if calendarZone != "" {
+		if defaultLocation, err = loadLocation(calendarZone); err != nil {
+			return nil, apperrors.Wrap(apperrors.InvalidArgument, err, "invalid calendar: X-WR-TIMEZONE")
+		}
+	}
+
+	requests := make([]*desc.CreateJourneyRequestV1, 0, len(events))
+	for _, e := range events {
+		request, err := decodeEvent(e, defaultLocation)
+		if err != nil {
+			return nil, err
+		}
+		if request != nil {
+			requests = append(requests, request)
+		}
+	}
+	return requests, nil
+}
+
+// decodeEvent - returns request for creating journey from event or nil if event is cancelled
+func decodeEvent(e event, defaultLocation *time.Location) (*desc.CreateJourneyRequestV1, error) {
+	var (
+		start, end           time.Time
+		startDate            bool
+		hasStart, hasEnd     bool
+		duration             *eventDuration
+		summary, description string
+		location             string
+		request              = &desc.CreateJourneyRequestV1{}
+	)
+	for _, property := range e.properties {
+		var err error
+		switch property.name {
+		case "DTSTART":
+			start, startDate, err = parseTime(property, defaultLocation)
+			hasStart = true
+		case "DTEND":
+			end, _, err = parseTime(property, defaultLocation)
+			hasEnd = true
+		case "DURATION":
+			duration, err = parseDuration(property.value)
+		case "SUMMARY":
+			summary = unescapeText(property.value)
+		case "LOCATION":
+			location = unescapeText(property.value)
+		case "DESCRIPTION":
+			description = unescapeText(property.value)
+		case "GEO":
+			request.Coordinates, err = parseGeo(property.value)
+		case "CATEGORIES":
+			request.Tags = append(request.Tags, splitText(property.value)...)
+		case "STATUS":
+			if strings.EqualFold(property.value, "CANCELLED") {
+				return nil, nil
+			}
+		case "RRULE", "RDATE", "RECURRENCE-ID":
+			return nil, invalidCalendar(property.number, "recurring events are not supported")
+		}
+		if err != nil {
+			return nil, apperrors.Wrap(apperrors.InvalidArgument, err, "invalid calendar: line %d: %s", property.number, property.name)
+		}
+	}
+	if !hasStart {
+		return nil, invalidCalendar(e.line, "DTSTART of VEVENT is required")
+	}
+
+	switch {
+	case hasEnd:
+	case duration != nil:
+		end = duration.addTo(start)
+	case startDate:
+		// all-day event lasts one day
+		end = start.AddDate(0, 0, 1)
+	default:
+		end = start
+	}
+
+	request.Address = location
+	if request.Address == "" {
+		request.Address = summary
+	} else if summary != "" && summary != location {
+		// summary is kept if location is used as address
+		description = strings.TrimSpace(summary + "\n" + description)
+	}
+	request.Description = description
+	request.StartTime = timestamppb.New(start)
+	request.EndTime = timestamppb.New(end)
+	if name := start.Location().String(); name != "UTC" {
+		request.TimeZone = name
+	}
+	return request, nil
+}
+
+// readContentLines - reads and unfolds content lines, empty lines are skipped
+func readContentLines(r io.Reader) ([]contentLine, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxContentLineLength)
+
+	var (
+		lines   []contentLine
+		current strings.Builder
+		start   int
+		number  int
+	)
+	flush := func() error {
+		if current.Len() == 0 {
+			return nil
+		}
+		line, err := parseContentLine(start, current.String())
+		if err != nil {
+			return err
+		}
+		lines = append(lines, line)
+		current.Reset()
+		return nil
+	}
+
+	for scanner.Scan() {
+		number++
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+		if strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t") {
+			if current.Len() == 0 {
+				return nil, invalidCalendar(number, "continuation of missing content line")
+			}
+			current.WriteString(text[1:])
+			continue
+		}
+		if err := flush(); err != nil {
+			return nil, err
+		}
+		start = number
+		current.WriteString(text)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, apperrors.Wrap(apperrors.InvalidArgument, err, "invalid calendar: line %d", number+1)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// parseContentLine - parses content line "name *(";" param) ":" value", only the first value
+// of multi-valued parameter is kept
+func parseContentLine(number int, text string) (contentLine, error) {
+	i := strings.IndexAny(text, ";:")
+	if i <= 0 {
+		return contentLine{}, invalidCalendar(number, "invalid content line")
+	}
+	line := contentLine{number: number, name: strings.ToUpper(text[:i]), params: make(map[string]string)}
+
+	rest := text[i:]
+	for rest[0] == ';' {
+		rest = rest[1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return contentLine{}, invalidCalendar(number, "invalid parameter of %s", line.name)
+		}
+		name := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return contentLine{}, invalidCalendar(number, "unterminated quoted parameter %s of %s", name, line.name)
+			}
+			value, rest = rest[1:end+1], rest[end+2:]
+		}
+		// unquoted value and the rest of multi-valued parameter
+		end := strings.IndexAny(rest, ";:")
+		if end < 0 {
+			return contentLine{}, invalidCalendar(number, "value of %s expected", line.name)
+		}
+		if value == "" {
+			value = strings.SplitN(rest[:end], ",", 2)[0]
+		}
+		line.params[name] = value
+		rest = rest[end:]
+	}
+	if rest[0] != ':' {
+		return contentLine{}, invalidCalendar(number, "value of %s expected", line.name)
+	}
+	line.value = rest[1:]
+	return line, nil
+}
+
+// parseTime - parses DATE or DATE-TIME value, returns true for DATE value
+func parseTime(line contentLine, defaultLocation *time.Location) (time.Time, bool, error) {
+	location := defaultLocation
+	if tzid, ok := line.params["TZID"]; ok {
+		var err error
+		if location, err = loadLocation(tzid); err != nil {
+			return time.Time{}, false, err
+		}
+	}
+
+	value := strings.TrimSpace(line.value)
+	if strings.EqualFold(line.params["VALUE"], "DATE") || len(value) == len(dateLayout) {
+		date, err := time.ParseInLocation(dateLayout, value, location)
+		return date, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		utc, err := time.Parse(dateTimeUTCLayout, value)
+		return utc, false, err
+	}
+	local, err := time.ParseInLocation(dateTimeLayout, value, location)
+	return local, false, err
+}
+
+// loadLocation - returns location of IANA time zone, globally unique TZIDs
+// like "/mozilla.org/20050126_1/Europe/Moscow" are resolved by IANA name at their end
+func loadLocation(tzid string) (*time.Location, error) {
+	parts := strings.Split(strings.TrimSpace(tzid), "/")
+	for i := range parts {
+		name := strings.Join(parts[i:], "/")
+		if name == "" || name == "Local" {
+			continue
+		}
+		if location, err := time.LoadLocation(name); err == nil {
+			return location, nil
+		}
+	}
+	return nil, apperrors.New(apperrors.InvalidArgument, "unknown time zone %q", tzid)
+}
+
+// eventDuration - DURATION value, days are nominal and added to local date
+type eventDuration struct {
+	days  int
+	clock time.Duration
+}
+
+func (d *eventDuration) addTo(start time.Time) time.Time {
+	return start.AddDate(0, 0, d.days).Add(d.clock)
+}
+
+// parseDuration - parses non-negative DURATION value like "P1W", "P1DT2H" or "PT30M"
+func parseDuration(value string) (*eventDuration, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "+")
+	if !strings.HasPrefix(value, "P") || len(value) < 3 {
+		return nil, apperrors.New(apperrors.InvalidArgument, "invalid duration %q", value)
+	}
+
+	duration := &eventDuration{}
+	inTime := false
+	number := ""
+	for _, r := range value[1:] {
+		switch {
+		case r >= '0' && r <= '9':
+			number += string(r)
+			continue
+		case r == 'T' && !inTime && number == "":
+			inTime = true
+			continue
+		}
+
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return nil, apperrors.New(apperrors.InvalidArgument, "invalid duration %q", value)
+		}
+		number = ""
+		switch {
+		case r == 'W' && !inTime:
+			duration.days += 7 * n
+		case r == 'D' && !inTime:
+			duration.days += n
+		case r == 'H' && inTime:
+			duration.clock += time.Duration(n) * time.Hour
+		case r == 'M' && inTime:
+			duration.clock += time.Duration(n) * time.Minute
+		case r == 'S' && inTime:
+			duration.clock += time.Duration(n) * time.Second
+		default:
+			return nil, apperrors.New(apperrors.InvalidArgument, "invalid duration %q", value)
+		}
+	}
+	if number != "" || strings.HasSuffix(value, "T") {
+		return nil, apperrors.New(apperrors.InvalidArgument, "invalid duration %q", value)
+	}
+	return duration, nil
+}
+
+// parseGeo - parses GEO value "latitude;longitude"
+func parseGeo(value string) (*desc.Coordinates, error) {
+	parts := strings.Split(value, ";")
+	if len(parts) != 2 {
+		return nil, apperrors.New(apperrors.InvalidArgument, "invalid geographic position %q", value)
+	}
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.InvalidArgument, err, "invalid latitude")
+	}
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return nil, apperrors.Wrap(apperrors.InvalidArgument, err, "invalid longitude")
+	}
+	return &desc.Coordinates{Latitude: latitude, Longitude: longitude}, nil
+}
+
+// unescapeText - unescapes value of TEXT type
+func unescapeText(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+
+	var b strings.Builder
+	escaped := false
+	for _, r := range value {
+		switch {
+		case escaped && (r == 'n' || r == 'N'):
+			b.WriteRune('\n')
+		case escaped:
+			b.WriteRune(r)
+		case r == '\\':
+			escaped = true
+			continue
+		default:
+			b.WriteRune(r)
+		}
+		escaped = false
+	}
+	return b.String()
+}
+
+// splitText - splits list of TEXT values by unescaped commas, empty values are skipped
+func splitText(value string) []string {
+	var result []string
+	start := 0
+	escaped := false
+	for i := 0; i <= len(value); i++ {
+		if i < len(value) && (escaped || value[i] != ',') {
+			escaped = !escaped && value[i] == '\\'
+			continue
+		}
+		if text := strings.TrimSpace(unescapeText(value[start:i])); text != "" {
+			result = append(result, text)
+		}
+		start = i + 1
+	}
+	return result
+}
+
+func invalidCalendar(number int, format string, args ...interface{}) error {
+	return apperrors.New(apperrors.InvalidArgument, "invalid calendar: line %d: "+format, append([]interface{}{number}, args...)...)
+}
@@ -0,0 +1,182 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+func TestDecode(t *testing.T) {
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Google Inc//Google Calendar 70.9054//EN",
+		"X-WR-TIMEZONE:Asia/Tokyo",
+		"BEGIN:VTIMEZONE",
+		"TZID:Europe/Moscow",
+		"BEGIN:STANDARD",
+		"DTSTART:19700101T000000",
+		"TZOFFSETFROM:+0300",
+		"TZOFFSETTO:+0300",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:1@example.com",
+		"DTSTART;TZID=\"/mozilla.org/20050126_1/Europe/Moscow\":20211101T080000",
+		"DTEND;TZID=Europe/Moscow:20211101T093000",
+		"SUMMARY:Поездка в офис",
+		"LOCATION:Москва\\, Тверская 1",
+		"DESCRIPTION:Взять ноутбук\\nи зарядку. Очень длинное описание, которое не помещается в одну",
+		"  строку",
+		"GEO:55.7577;37.6136",
+		"CATEGORIES:business,office\\,main",
+		"CATEGORIES:work",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"DESCRIPTION:Напоминание",
+		"TRIGGER:-PT15M",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:2@example.com",
+		"DTSTART:20211105T100000",
+		"DURATION:P1DT2H",
+		"SUMMARY:Onsen",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:3@example.com",
+		"DTSTART;VALUE=DATE:20211110",
+		"SUMMARY:Day off",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:4@example.com",
+		"DTSTART:20211111T100000Z",
+		"DTEND:20211111T110000Z",
+		"SUMMARY:Cancelled trip",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	requests, err := Decode(strings.NewReader(calendar))
+	assert.NoError(t, err)
+	assert.Len(t, requests, 3)
+
+	moscow, _ := time.LoadLocation("Europe/Moscow")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	assert.True(t, proto.Equal(&desc.CreateJourneyRequestV1{
+		Address:     "Москва, Тверская 1",
+		Description: "Поездка в офис\nВзять ноутбук\nи зарядку. Очень длинное описание, которое не помещается в одну строку",
+		StartTime:   timestamppb.New(time.Date(2021, 11, 1, 8, 0, 0, 0, moscow)),
+		EndTime:     timestamppb.New(time.Date(2021, 11, 1, 9, 30, 0, 0, moscow)),
+		TimeZone:    "Europe/Moscow",
+		Coordinates: &desc.Coordinates{Latitude: 55.7577, Longitude: 37.6136},
+		Tags:        []string{"business", "office,main", "work"},
+	}, requests[0]), "%v", requests[0])
+
+	assert.Equal(t, "Onsen", requests[1].Address)
+	assert.Equal(t, "Asia/Tokyo", requests[1].TimeZone)
+	assert.Equal(t, time.Date(2021, 11, 5, 10, 0, 0, 0, tokyo).Unix(), requests[1].StartTime.AsTime().Unix())
+	assert.Equal(t, time.Date(2021, 11, 6, 12, 0, 0, 0, tokyo).Unix(), requests[1].EndTime.AsTime().Unix())
+
+	assert.Equal(t, time.Date(2021, 11, 10, 0, 0, 0, 0, tokyo).Unix(), requests[2].StartTime.AsTime().Unix())
+	assert.Equal(t, 24*time.Hour, requests[2].EndTime.AsTime().Sub(requests[2].StartTime.AsTime()))
+}
+
+func TestDecode_EncodedJourneys(t *testing.T) {
+	start := time.Date(2021, 10, 30, 9, 0, 0, 0, time.UTC)
+	journeys := []*desc.Journey{
+		{
+			JourneyId:   1,
+			Address:     "Berlin; Alexanderplatz",
+			Description: strings.Repeat("Long description, ", 10),
+			StartTime:   timestamppb.New(start),
+			EndTime:     timestamppb.New(start.AddDate(0, 0, 2)),
+			TimeZone:    "Europe/Berlin",
+			Tags:        []string{"vacation"},
+		},
+		{
+			JourneyId: 2,
+			Address:   "Тула",
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(start.Add(time.Hour)),
+		},
+	}
+	var buffer bytes.Buffer
+	assert.NoError(t, Encode(&buffer, journeys, start))
+
+	requests, err := Decode(&buffer)
+	assert.NoError(t, err)
+	assert.Len(t, requests, len(journeys))
+	for i, journey := range journeys {
+		assert.True(t, proto.Equal(&desc.CreateJourneyRequestV1{
+			Address:     journey.Address,
+			Description: journey.Description,
+			StartTime:   journey.StartTime,
+			EndTime:     journey.EndTime,
+			TimeZone:    journey.TimeZone,
+			Tags:        journey.Tags,
+		}, requests[i]), "%v", requests[i])
+	}
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	event := func(lines ...string) string {
+		return strings.Join(append(append([]string{"BEGIN:VCALENDAR", "BEGIN:VEVENT"}, lines...), "END:VEVENT", "END:VCALENDAR"), "\r\n")
+	}
+
+	testTable := []struct {
+		name     string
+		calendar string
+	}{
+		{name: "empty", calendar: ""},
+		{name: "not calendar", calendar: "BEGIN:VCARD\r\nEND:VCARD"},
+		{name: "not closed", calendar: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VEVENT"},
+		{name: "invalid content line", calendar: event("DTSTART")},
+		{name: "missing start", calendar: event("SUMMARY:Тула")},
+		{name: "invalid time", calendar: event("DTSTART:2021-11-01")},
+		{name: "unknown time zone", calendar: event("DTSTART;TZID=Russian Standard Time:20211101T080000")},
+		{name: "invalid duration", calendar: event("DTSTART:20211101T080000Z", "DURATION:PT1D")},
+		{name: "invalid geo", calendar: event("DTSTART:20211101T080000Z", "GEO:55.75")},
+		{name: "recurring", calendar: event("DTSTART:20211101T080000Z", "RRULE:FREQ=DAILY")},
+	}
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Decode(strings.NewReader(testCase.calendar))
+			assert.True(t, apperrors.Is(err, apperrors.InvalidArgument), "error: %v", err)
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	testTable := []struct {
+		value string
+		days  int
+		clock time.Duration
+	}{
+		{value: "P2W", days: 14},
+		{value: "P1DT2H", days: 1, clock: 2 * time.Hour},
+		{value: "PT1H30M15S", clock: time.Hour + 30*time.Minute + 15*time.Second},
+		{value: "+PT45M", clock: 45 * time.Minute},
+	}
+	for _, testCase := range testTable {
+		t.Run(testCase.value, func(t *testing.T) {
+			duration, err := parseDuration(testCase.value)
+			assert.NoError(t, err)
+			assert.Equal(t, &eventDuration{days: testCase.days, clock: testCase.clock}, duration)
+		})
+	}
+
+	for _, value := range []string{"", "P", "-PT1H", "PT", "P1H", "PT1D", "P1DT", "P1X"} {
+		_, err := parseDuration(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,226 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+const (
+	// ProdID - identifier of the product which created calendar
+	ProdID = "-//ozonva//ova-journey-api//EN"
+	// ContentType - media type of iCalendar data
+	ContentType = "text/calendar; charset=utf-8"
+
+	dateTimeLayout    = "20060102T150405"
+	dateTimeUTCLayout = "20060102T150405Z"
+	dateLayout        = "20060102"
+	uidSuffix         = "@ova-journey-api"
+	// maxLineLength - content lines longer than 75 octets are folded
+	maxLineLength = 75
+)
+
+// UID - returns stable unique identifier of calendar event for journey
+func UID(journeyID uint64) string {
+	return "journey-" + strconv.FormatUint(journeyID, 10) + uidSuffix
+}
+
+// OccurrenceUID - returns stable unique identifier of calendar event for occurrence of recurring journey,
+// occurrence is identified by its start time by recurrence rule
+func OccurrenceUID(recurringJourneyID uint64, occurrenceTime time.Time) string {
+	return "recurring-journey-" + strconv.FormatUint(recurringJourneyID, 10) + "-" +
+		occurrenceTime.UTC().Format(dateTimeUTCLayout) + uidSuffix
+}
+
+// Encode - writes journeys as RFC 5545 VCALENDAR with VEVENT for every journey or occurrence of recurring journey,
+// times of journeys with time zone are written in local time with VTIMEZONE for every used time zone,
+// stamp is written as DTSTAMP of events
+func Encode(w io.Writer, journeys []*desc.Journey, stamp time.Time) error {
+	cw := &contentWriter{w: bufio.NewWriter(w)}
+	cw.line("BEGIN", "VCALENDAR")
+	cw.line("VERSION", "2.0")
+	cw.line("PRODID", ProdID)
+	cw.line("CALSCALE", "GREGORIAN")
+
+	zones := usedTimeZones(journeys)
+	names := make([]string, 0, len(zones))
+	for name := range zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeTimeZone(cw, zones[name])
+	}
+
+	for _, journey := range journeys {
+		writeEvent(cw, journey, stamp)
+	}
+	cw.line("END", "VCALENDAR")
+
+	if cw.err != nil {
+		return cw.err
+	}
+	return cw.w.Flush()
+}
+
+// writeEvent - writes VEVENT of journey
+func writeEvent(cw *contentWriter, journey *desc.Journey, stamp time.Time) {
+	cw.line("BEGIN", "VEVENT")
+	if journey.RecurringJourneyId > 0 {
+		cw.line("UID", OccurrenceUID(journey.RecurringJourneyId, journey.OccurrenceTime.AsTime()))
+	} else {
+		cw.line("UID", UID(journey.JourneyId))
+	}
+	cw.line("DTSTAMP", stamp.UTC().Format(dateTimeUTCLayout))
+
+	location := journeyLocation(journey.TimeZone)
+	writeTime(cw, "DTSTART", journey.StartTime.AsTime(), location)
+	writeTime(cw, "DTEND", journey.EndTime.AsTime(), location)
+
+	cw.line("SUMMARY", escapeText(journey.Address))
+	cw.line("LOCATION", escapeText(journey.Address))
+	if journey.Description != "" {
+		cw.line("DESCRIPTION", escapeText(journey.Description))
+	}
+	if coordinates := journey.Coordinates; coordinates != nil {
+		cw.line("GEO", strconv.FormatFloat(coordinates.Latitude, 'f', -1, 64)+";"+
+			strconv.FormatFloat(coordinates.Longitude, 'f', -1, 64))
+	}
+	if len(journey.Tags) > 0 {
+		tags := make([]string, len(journey.Tags))
+		for i, tag := range journey.Tags {
+			tags[i] = escapeText(tag)
+		}
+		cw.line("CATEGORIES", strings.Join(tags, ","))
+	}
+	if journey.Status == desc.JourneyStatus_JOURNEY_STATUS_CANCELLED {
+		cw.line("STATUS", "CANCELLED")
+	} else {
+		cw.line("STATUS", "CONFIRMED")
+	}
+	cw.line("SEQUENCE", strconv.FormatUint(journey.Revision, 10))
+	cw.line("END", "VEVENT")
+}
+
+// writeTime - writes time property in UTC or in local time of location with TZID parameter,
+// times are truncated to seconds
+func writeTime(cw *contentWriter, name string, value time.Time, location *time.Location) {
+	value = value.Truncate(time.Second)
+	if location == nil {
+		cw.line(name, value.UTC().Format(dateTimeUTCLayout))
+		return
+	}
+	cw.line(name+";TZID="+location.String(), value.In(location).Format(dateTimeLayout))
+}
+
+// journeyLocation - returns location of journey time zone, nil means UTC
+// (time zones of journeys are validated by service, unknown ones are also written in UTC)
+func journeyLocation(timeZone string) *time.Location {
+	if timeZone == "" || timeZone == "UTC" {
+		return nil
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil
+	}
+	return location
+}
+
+// escapeText - escapes value of TEXT type
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// contentWriter - writes folded content lines separated by CRLF and keeps the first error
+type contentWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (cw *contentWriter) line(name, value string) {
+	if cw.err != nil {
+		return
+	}
+	_, cw.err = cw.w.WriteString(fold(name+":"+value) + "\r\n")
+}
+
+// fold - splits line to parts of at most 75 octets without breaking UTF-8 sequences,
+// every next part starts with space
+func fold(line string) string {
+	if len(line) <= maxLineLength {
+		return line
+	}
+
+	var b strings.Builder
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		// do not cut in the middle of multi-byte character
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// leading space is counted in length of continuation line
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line)
+	return b.String()
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// usedTimeZones - returns time zones used by journeys with time range of their journeys
+func usedTimeZones(journeys []*desc.Journey) map[string]*timeZoneRange {
+	zones := make(map[string]*timeZoneRange)
+	for _, journey := range journeys {
+		location := journeyLocation(journey.TimeZone)
+		if location == nil {
+			continue
+		}
+		start, end := journey.StartTime.AsTime(), journey.EndTime.AsTime()
+		zone, ok := zones[location.String()]
+		if !ok {
+			zones[location.String()] = &timeZoneRange{location: location, from: start, to: end}
+			continue
+		}
+		if start.Before(zone.from) {
+			zone.from = start
+		}
+		if end.After(zone.to) {
+			zone.to = end
+		}
+	}
+	return zones
+}
+
+// formatOffset - formats UTC offset in seconds as UTC-OFFSET value (+hhmm or +hhmmss)
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	value := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+	if seconds := offset % 60; seconds != 0 {
+		value += fmt.Sprintf("%02d", seconds)
+	}
+	return value
+}
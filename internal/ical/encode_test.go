@@ -0,0 +1,116 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+func TestUID(t *testing.T) {
+	assert.Equal(t, "journey-42@ova-journey-api", UID(42))
+	assert.Equal(t, "recurring-journey-7-20211101T080000Z@ova-journey-api",
+		OccurrenceUID(7, time.Date(2021, 11, 1, 11, 0, 0, 0, time.FixedZone("MSK", 3*60*60))))
+}
+
+func TestEncode(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	start := time.Date(2021, 10, 30, 9, 0, 0, 0, berlin)
+	stamp := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	journeys := []*desc.Journey{
+		{
+			JourneyId:   1,
+			UserId:      1,
+			Address:     "Москва, Красная площадь",
+			Description: "Экскурсия; билеты\nв кассе",
+			StartTime:   timestamppb.New(time.Date(2021, 8, 14, 9, 45, 30, 123456000, time.UTC)),
+			EndTime:     timestamppb.New(time.Date(2021, 8, 14, 11, 0, 0, 0, time.UTC)),
+			Coordinates: &desc.Coordinates{Latitude: 55.7539, Longitude: 37.6208},
+			Tags:        []string{"sightseeing", "weekend"},
+			Revision:    3,
+		},
+		{
+			JourneyId: 2,
+			UserId:    1,
+			Address:   "Berlin",
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(start.AddDate(0, 0, 2)),
+			TimeZone:  "Europe/Berlin",
+			Status:    desc.JourneyStatus_JOURNEY_STATUS_CANCELLED,
+			Revision:  1,
+		},
+		{
+			UserId:             1,
+			Address:            "Офис",
+			StartTime:          timestamppb.New(time.Date(2021, 11, 1, 8, 0, 0, 0, time.UTC)),
+			EndTime:            timestamppb.New(time.Date(2021, 11, 1, 9, 0, 0, 0, time.UTC)),
+			Revision:           5,
+			RecurringJourneyId: 7,
+			OccurrenceTime:     timestamppb.New(time.Date(2021, 11, 1, 8, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	var buffer bytes.Buffer
+	assert.NoError(t, Encode(&buffer, journeys, stamp))
+	calendar := buffer.String()
+
+	assert.True(t, strings.HasPrefix(calendar, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:"+ProdID+"\r\n"))
+	assert.True(t, strings.HasSuffix(calendar, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	for _, line := range strings.Split(calendar, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, line)
+	}
+
+	for _, expected := range []string{
+		"UID:journey-1@ova-journey-api\r\nDTSTAMP:20211101T120000Z\r\n",
+		"DTSTART:20210814T094530Z\r\nDTEND:20210814T110000Z\r\n",
+		"SUMMARY:Москва\\, Красная площадь\r\n",
+		"DESCRIPTION:Экскурсия\\; билеты\\nв кассе\r\n",
+		"GEO:55.7539;37.6208\r\n",
+		"CATEGORIES:sightseeing,weekend\r\n",
+		"STATUS:CONFIRMED\r\nSEQUENCE:3\r\n",
+		"UID:journey-2@ova-journey-api\r\n",
+		"DTSTART;TZID=Europe/Berlin:20211030T090000\r\nDTEND;TZID=Europe/Berlin:20211101T090000\r\n",
+		"STATUS:CANCELLED\r\n",
+		"UID:recurring-journey-7-20211101T080000Z@ova-journey-api\r\n",
+		"STATUS:CONFIRMED\r\nSEQUENCE:5\r\n",
+		// observance at the beginning of the first day and end of daylight saving time
+		"BEGIN:VTIMEZONE\r\nTZID:Europe/Berlin\r\n" +
+			"BEGIN:DAYLIGHT\r\nDTSTART:20211030T000000\r\nTZOFFSETFROM:+0200\r\nTZOFFSETTO:+0200\r\nTZNAME:CEST\r\nEND:DAYLIGHT\r\n" +
+			"BEGIN:STANDARD\r\nDTSTART:20211031T030000\r\nTZOFFSETFROM:+0200\r\nTZOFFSETTO:+0100\r\nTZNAME:CET\r\nEND:STANDARD\r\n" +
+			"END:VTIMEZONE\r\n",
+	} {
+		assert.Contains(t, calendar, expected)
+	}
+	assert.Equal(t, 1, strings.Count(calendar, "BEGIN:VTIMEZONE"))
+}
+
+func TestFold(t *testing.T) {
+	assert.Equal(t, "SUMMARY:short", fold("SUMMARY:short"))
+
+	line := "DESCRIPTION:" + strings.Repeat("путешествие ", 20)
+	folded := fold(line)
+	parts := strings.Split(folded, "\r\n ")
+	assert.Greater(t, len(parts), 1)
+	assert.Equal(t, line, strings.Join(parts, ""))
+	for i, part := range parts {
+		length := len(part)
+		if i > 0 {
+			length++
+		}
+		assert.LessOrEqual(t, length, 75)
+		assert.True(t, isRuneStart(part[0]))
+	}
+}
+
+func TestFormatOffset(t *testing.T) {
+	assert.Equal(t, "+0300", formatOffset(3*3600))
+	assert.Equal(t, "-0930", formatOffset(-(9*3600 + 30*60)))
+	assert.Equal(t, "+023017", formatOffset(2*3600+30*60+17))
+}
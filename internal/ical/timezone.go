@@ -0,0 +1,84 @@
+package ical
+
+import "time"
+
+// timeZoneRange - time zone used by journeys and time range which its VTIMEZONE should cover
+type timeZoneRange struct {
+	location *time.Location
+	from     time.Time
+	to       time.Time
+}
+
+// observance - period of time zone with the same UTC offset and abbreviation starting at onset
+type observance struct {
+	onset      time.Time
+	offsetFrom int
+	offsetTo   int
+	name       string
+	daylight   bool
+}
+
+// writeTimeZone - writes VTIMEZONE with observances of the time zone in its time range,
+// they are computed from time zone database without recurrence rules
+func writeTimeZone(cw *contentWriter, zone *timeZoneRange) {
+	cw.line("BEGIN", "VTIMEZONE")
+	cw.line("TZID", zone.location.String())
+	for _, o := range zone.observances() {
+		component := "STANDARD"
+		if o.daylight {
+			component = "DAYLIGHT"
+		}
+		cw.line("BEGIN", component)
+		// onset is written in local time of the previous observance
+		cw.line("DTSTART", o.onset.In(time.FixedZone("", o.offsetFrom)).Format(dateTimeLayout))
+		cw.line("TZOFFSETFROM", formatOffset(o.offsetFrom))
+		cw.line("TZOFFSETTO", formatOffset(o.offsetTo))
+		cw.line("TZNAME", escapeText(o.name))
+		cw.line("END", component)
+	}
+	cw.line("END", "VTIMEZONE")
+}
+
+// observances - returns observance at the beginning of the first day of time range
+// and every change of offset or abbreviation until the end of time range
+func (z *timeZoneRange) observances() []observance {
+	from := z.from.In(z.location)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, z.location)
+	name, offset := day.Zone()
+	result := []observance{{onset: day, offsetFrom: offset, offsetTo: offset, name: name, daylight: day.IsDST()}}
+
+	// changes are at least a day apart, so it is enough to look for one change in every day
+	for !day.After(z.to) {
+		next := day.Add(24 * time.Hour)
+		nextName, nextOffset := next.Zone()
+		if nextName != name || nextOffset != offset {
+			onset := z.findChange(day, next)
+			result = append(result, observance{
+				onset:      onset,
+				offsetFrom: offset,
+				offsetTo:   nextOffset,
+				name:       nextName,
+				daylight:   next.IsDST(),
+			})
+			name, offset = nextName, nextOffset
+		}
+		day = next
+	}
+	return result
+}
+
+// findChange - returns the first second after before with zone of after, zone of before and after should differ
+func (z *timeZoneRange) findChange(before, after time.Time) time.Time {
+	name, offset := before.Zone()
+	low, high := before.Unix(), after.Unix()
+	for high-low > 1 {
+		middle := low + (high-low)/2
+		middleName, middleOffset := time.Unix(middle, 0).In(z.location).Zone()
+		if middleName == name && middleOffset == offset {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+	return time.Unix(high, 0).In(z.location)
+}
@@ -0,0 +1,500 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/config"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+)
+
+// ConsumerRetryDelay - time duration between attempts to process message that failed to apply to the repo.Repo
+const ConsumerRetryDelay = 5 * time.Second
+
+// Consumer - interface for reading messages sent by Producer from Kafka and applying them to the storage
+type Consumer interface {
+	Start()
+	Close() error
+}
+
+type consumer struct {
+	group   sarama.ConsumerGroup
+	topic   string
+	handler sarama.ConsumerGroupHandler
+	cancel  context.CancelFunc
+	wg      *sync.WaitGroup
+}
+
+// NewConsumer - creates new Consumer joined to the Kafka consumer group for applying messages to repo.Repo,
+// tracking their results in repo.OperationRepo and publishing changes of journeys to watch.Publisher
+func NewConsumer(
+	configuration *config.KafkaConfiguration,
+	repo repo.Repo,
+	operationRepo repo.OperationRepo,
+	publisher watch.Publisher,
+) (Consumer, error) {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Version = sarama.V2_0_0_0
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	saramaConfig.Consumer.Offsets.AutoCommit.Enable = false
+
+	group, err := sarama.NewConsumerGroup(configuration.Brokers, configuration.ConsumerGroup, saramaConfig)
+	if err != nil {
+		log.Error().Err(err).Msg("Kafka consumer: failed to create")
+		return nil, err
+	}
+
+	return &consumer{
+		group:   group,
+		topic:   configuration.Topic,
+		handler: NewConsumerHandler(repo, operationRepo, publisher, ConsumerRetryDelay),
+	}, nil
+}
+
+// Start - start consuming messages in background until Consumer.Close() is called
+func (c *consumer) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.wg = &sync.WaitGroup{}
+	c.wg.Add(1)
+
+	go func() {
+		defer c.wg.Done()
+		log.Debug().Msg("Kafka consumer: starting")
+		for {
+			// Consume returns on every rebalance or after handler error, so it should be called again
+			err := c.group.Consume(ctx, []string{c.topic}, c.handler)
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return
+			}
+			if err != nil {
+				log.Err(err).Msg("Kafka consumer: failed to consume")
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+}
+
+// Close - stop consuming messages and leave consumer group
+func (c *consumer) Close() error {
+	if c.cancel != nil {
+		c.cancel()
+		c.wg.Wait()
+	}
+	return c.group.Close()
+}
+
+type consumerHandler struct {
+	repo          repo.Repo
+	operationRepo repo.OperationRepo
+	publisher     watch.Publisher
+	retryDelay    time.Duration
+}
+
+// NewConsumerHandler - creates sarama.ConsumerGroupHandler that applies messages to repo.Repo.
+//
+// Offset of message is committed only after it was successfully applied.
+// Messages that cannot be decoded or cannot be applied because of permanent error (e.g. journey is not found,
+// acting user of message has no permission to change it or journey is rejected by its overlap policy) are skipped with committing their offsets, messages failed because storage is unavailable are always retried.
+// If message has OperationID, the result of applying is saved to repo.OperationRepo,
+// and message that cannot be applied is committed after its operation is marked as failed.
+// Saving of the result of applied message is retried after retryDelay until it succeeds, because message
+// must not be applied again, and its operation is marked as failed if session is finished before that.
+// Otherwise, handler waits for retryDelay and stops claim processing,
+// so the message will be consumed again from the last committed offset.
+// Changes of journeys made by applied messages are published to publisher.
+func NewConsumerHandler(
+	repo repo.Repo,
+	operationRepo repo.OperationRepo,
+	publisher watch.Publisher,
+	retryDelay time.Duration,
+) sarama.ConsumerGroupHandler {
+	return &consumerHandler{
+		repo:          repo,
+		operationRepo: operationRepo,
+		publisher:     publisher,
+		retryDelay:    retryDelay,
+	}
+}
+
+func (h *consumerHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *consumerHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *consumerHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			message, err := DecodeMessage(msg.Value)
+			if err != nil {
+				log.Error().Err(err).Int64("offset", msg.Offset).Msg("Kafka consumer: skip message that cannot be decoded")
+				session.MarkMessage(msg, "")
+				session.Commit()
+				continue
+			}
+
+			if err := h.process(ctx, message); err != nil {
+				log.Error().Err(err).Int64("offset", msg.Offset).Msg("Kafka consumer: failed to apply message")
+				select {
+				case <-ctx.Done():
+				case <-time.After(h.retryDelay):
+				}
+				return err
+			}
+
+			session.MarkMessage(msg, "")
+			session.Commit()
+		}
+	}
+}
+
+// process - applies message and saves the result to its operation.
+// Returns error only if message should be consumed again.
+func (h *consumerHandler) process(ctx context.Context, message Message) error {
+	journeyIDs, err := h.apply(ctx, message)
+	if apperrors.Is(err, apperrors.Unavailable) {
+		return err
+	}
+	if message.OperationID == 0 || message.MessageType == Ping {
+		if isPermanentError(err) {
+			log.Error().Err(err).Msg("Kafka consumer: skip message that cannot be applied")
+			return nil
+		}
+		return err
+	}
+
+	if err != nil {
+		if failErr := h.operationRepo.FailOperation(ctx, message.OperationID, err.Error()); failErr != nil {
+			log.Error().Err(failErr).Uint64("operationId", message.OperationID).Msg("Kafka consumer: failed to save operation error")
+			return err
+		}
+		log.Debug().Err(err).Uint64("operationId", message.OperationID).Msg("Kafka consumer: operation failed")
+		return nil
+	}
+
+	h.completeOperationChunk(ctx, message.OperationID, journeyIDs)
+	return nil
+}
+
+// completeOperationChunk - saves ids of journeys changed by applied message to its operation.
+// Journeys are already changed, so message must not be applied again: saving is retried after retryDelay
+// until it succeeds or session is finished, and operation is marked as failed if its result cannot be saved
+func (h *consumerHandler) completeOperationChunk(ctx context.Context, operationID uint64, journeyIDs []uint64) {
+	err := h.operationRepo.CompleteOperationChunk(ctx, operationID, journeyIDs)
+	for err != nil && !isPermanentError(err) && ctx.Err() == nil {
+		log.Error().Err(err).Uint64("operationId", operationID).Msg("Kafka consumer: failed to save operation result, retrying")
+		select {
+		case <-ctx.Done():
+		case <-time.After(h.retryDelay):
+			err = h.operationRepo.CompleteOperationChunk(ctx, operationID, journeyIDs)
+		}
+	}
+	if err == nil {
+		return
+	}
+
+	log.Error().Err(err).Uint64("operationId", operationID).Msg("Kafka consumer: failed to save operation result")
+	reason := fmt.Sprintf("journeys %v are changed, but result is not saved: %v", journeyIDs, err)
+	if failErr := h.operationRepo.FailOperation(ctx, operationID, reason); failErr != nil {
+		log.Error().Err(failErr).Uint64("operationId", operationID).Msg("Kafka consumer: failed to save operation error")
+	}
+}
+
+// isPermanentError - checks that error is caused by message itself, so applying it again will fail again
+func isPermanentError(err error) bool {
+	switch apperrors.KindOf(err) {
+	case apperrors.NotFound, apperrors.AlreadyExists, apperrors.Conflict, apperrors.InvalidArgument, apperrors.PermissionDenied,
+		apperrors.FailedPrecondition:
+		return true
+	}
+	return false
+}
+
+// apply - applies message to the repo.Repo and returns ids of affected journeys
+func (h *consumerHandler) apply(ctx context.Context, message Message) ([]uint64, error) {
+	if err := h.authorize(ctx, message); err != nil {
+		return nil, err
+	}
+
+	switch message.MessageType {
+	case CreateJourney:
+		journey := message.Value.(models.Journey)
+		if err := journey.Validate(); err != nil {
+			return nil, err
+		}
+		var journeyID uint64
+		err := h.saveWithOverlapPolicies(ctx, message, []models.Journey{journey}, func(r repo.Repo) error {
+			var err error
+			journeyID, err = r.AddJourney(ctx, journey)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		log.Debug().Uint64("journeyId", journeyID).Msg("Kafka consumer: journey created")
+		journey.JourneyID = journeyID
+		h.publisher.Publish(watch.Created(journey))
+		return []uint64{journeyID}, nil
+	case MultiCreateJourney:
+		journeys := message.Value.([]models.Journey)
+		if len(journeys) == 0 {
+			return nil, nil
+		}
+		for i := range journeys {
+			if err := journeys[i].Validate(); err != nil {
+				return nil, apperrors.Wrap(apperrors.InvalidArgument, err, "journeys[%d]", i)
+			}
+		}
+		var journeyIDs []uint64
+		err := h.saveWithOverlapPolicies(ctx, message, journeys, func(r repo.Repo) error {
+			var err error
+			journeyIDs, err = r.MultiAddJourneys(ctx, journeys)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		log.Debug().Int("count", len(journeys)).Msg("Kafka consumer: journeys created")
+		events := make([]watch.Event, 0, len(journeyIDs))
+		for i, journeyID := range journeyIDs {
+			journeys[i].JourneyID = journeyID
+			events = append(events, watch.Created(journeys[i]))
+		}
+		h.publisher.Publish(events...)
+		return journeyIDs, nil
+	case UpdateJourney:
+		journey := message.Value.(models.Journey)
+		if err := journey.Validate(); err != nil {
+			return nil, err
+		}
+		err := h.saveWithOverlapPolicies(ctx, message, []models.Journey{journey}, func(r repo.Repo) error {
+			_, err := r.UpdateJourney(ctx, journey)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		log.Debug().Uint64("journeyId", journey.JourneyID).Msg("Kafka consumer: journey updated")
+		h.publisher.Publish(watch.Load(ctx, h.repo, watch.Updated(journey))...)
+		return []uint64{journey.JourneyID}, nil
+	case DeleteJourney:
+		journeyID := message.Value.(uint64)
+		// owner is loaded before removal to filter subscriptions of event
+		owners, err := h.repo.GetJourneyOwners(ctx, []uint64{journeyID})
+		if err != nil {
+			return nil, err
+		}
+		if err := h.repo.RemoveJourney(ctx, journeyID, 0); err != nil {
+			return nil, err
+		}
+		log.Debug().Uint64("journeyId", journeyID).Msg("Kafka consumer: journey removed")
+		h.publisher.Publish(watch.Load(ctx, h.repo, watch.Deleted(journeyID, owners[journeyID]))...)
+		return []uint64{journeyID}, nil
+	case MultiUpdateJourney:
+		journeys := message.Value.([]models.Journey)
+		for i := range journeys {
+			if err := journeys[i].Validate(); err != nil {
+				return nil, apperrors.Wrap(apperrors.InvalidArgument, err, "journeys[%d]", i)
+			}
+		}
+		if _, err := h.multiUpdate(ctx, journeys, overlapPolicies(message, len(journeys))); err != nil {
+			return nil, err
+		}
+		log.Debug().Int("count", len(journeys)).Msg("Kafka consumer: journeys updated")
+		journeyIDs := make([]uint64, len(journeys))
+		events := make([]watch.Event, len(journeys))
+		for i, journey := range journeys {
+			journeyIDs[i] = journey.JourneyID
+			events[i] = watch.Updated(journey)
+		}
+		h.publisher.Publish(watch.Load(ctx, h.repo, events...)...)
+		return journeyIDs, nil
+	case MultiDeleteJourney:
+		journeyIDs := message.Value.([]uint64)
+		owners, err := h.multiRemove(ctx, journeyIDs)
+		if err != nil {
+			return nil, err
+		}
+		log.Debug().Int("count", len(journeyIDs)).Msg("Kafka consumer: journeys removed")
+		events := make([]watch.Event, len(journeyIDs))
+		for i, journeyID := range journeyIDs {
+			events[i] = watch.Deleted(journeyID, owners[journeyID])
+		}
+		h.publisher.Publish(watch.Load(ctx, h.repo, events...)...)
+		return journeyIDs, nil
+	}
+	return nil, nil
+}
+
+// authorize - returns PermissionDenied error if acting user of message cannot apply it:
+// journeys are created only for acting user, editor role is required for update (and owner role for changing user
+// of journey), owner role is required for removal. Messages without acting user (authentication is disabled) are not checked
+func (h *consumerHandler) authorize(ctx context.Context, message Message) error {
+	if message.UserID == 0 {
+		return nil
+	}
+
+	switch message.MessageType {
+	case CreateJourney:
+		return checkJourneyUser(message.UserID, message.Value.(models.Journey))
+	case MultiCreateJourney:
+		for _, journey := range message.Value.([]models.Journey) {
+			if err := checkJourneyUser(message.UserID, journey); err != nil {
+				return err
+			}
+		}
+	case UpdateJourney:
+		return h.checkJourneyUpdate(ctx, message.UserID, message.Value.(models.Journey))
+	case MultiUpdateJourney:
+		for _, journey := range message.Value.([]models.Journey) {
+			if err := h.checkJourneyUpdate(ctx, message.UserID, journey); err != nil {
+				return err
+			}
+		}
+	case DeleteJourney:
+		_, err := repo.CheckJourneyAccess(ctx, h.repo, message.Value.(uint64), message.UserID, models.RoleOwner)
+		return err
+	case MultiDeleteJourney:
+		for _, journeyID := range message.Value.([]uint64) {
+			if _, err := repo.CheckJourneyAccess(ctx, h.repo, journeyID, message.UserID, models.RoleOwner); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkJourneyUser - returns PermissionDenied error if journey is created for another user than acting one
+func checkJourneyUser(userID uint64, journey models.Journey) error {
+	if journey.UserID != userID {
+		return apperrors.New(apperrors.PermissionDenied, "user %d cannot create journeys of user %d", userID, journey.UserID)
+	}
+	return nil
+}
+
+// checkJourneyUpdate - returns PermissionDenied error if user is not editor of journey or changes its user without being owner
+func (h *consumerHandler) checkJourneyUpdate(ctx context.Context, userID uint64, journey models.Journey) error {
+	access, err := repo.CheckJourneyAccess(ctx, h.repo, journey.JourneyID, userID, models.RoleEditor)
+	if err != nil {
+		return err
+	}
+	return repo.CheckOwnerChange(access, journey.UserID)
+}
+
+// saveWithOverlapPolicies - calls save after checking journeys by overlap policies of message in one transaction,
+// nothing is saved if any journey is rejected. Journeys are saved without transaction if policies require no checks
+func (h *consumerHandler) saveWithOverlapPolicies(
+	ctx context.Context,
+	message Message,
+	journeys []models.Journey,
+	save func(r repo.Repo) error,
+) error {
+	policies := overlapPolicies(message, len(journeys))
+	if !repo.NeedOverlapCheck(policies) {
+		return save(h.repo)
+	}
+	return h.repo.WithTx(ctx, func(tx repo.Repo) error {
+		if err := checkOverlaps(ctx, tx, journeys, policies); err != nil {
+			return err
+		}
+		return save(tx)
+	})
+}
+
+// checkOverlaps - returns error of the first journey rejected by its overlap policy,
+// overlaps allowed by OverlapWarn are only logged because consumer has no client to report them
+func checkOverlaps(ctx context.Context, tx repo.Repo, journeys []models.Journey, policies []models.OverlapPolicy) error {
+	checks, err := repo.CheckOverlaps(ctx, tx, journeys, policies)
+	if err != nil {
+		return err
+	}
+	for i, check := range checks {
+		if check.Err != nil {
+			return apperrors.Wrap(apperrors.KindOf(check.Err), check.Err, "journeys[%d]", i)
+		}
+		if len(check.JourneyIDs) > 0 || len(check.Indexes) > 0 {
+			log.Warn().Uint64("journeyId", journeys[i].JourneyID).Uint64("userId", journeys[i].UserID).
+				Uints64("overlapping", check.JourneyIDs).Msg("Kafka consumer: journey overlaps other journeys of the user")
+		}
+	}
+	return nil
+}
+
+// overlapPolicies - returns policies of n journeys of message, OverlapAllow is used for journeys without policy
+func overlapPolicies(message Message, n int) []models.OverlapPolicy {
+	policies := make([]models.OverlapPolicy, n)
+	copy(policies, message.OverlapPolicies)
+	return policies
+}
+
+// multiUpdate - updates all journeys in one transaction after checking them by overlap policies and returns
+// their new revisions by ids, nothing is updated if any journey is not found, has another revision or is rejected
+func (h *consumerHandler) multiUpdate(ctx context.Context, journeys []models.Journey, policies []models.OverlapPolicy) (map[uint64]uint64, error) {
+	var revisions map[uint64]uint64
+	err := h.repo.WithTx(ctx, func(tx repo.Repo) error {
+		if err := checkOverlaps(ctx, tx, journeys, policies); err != nil {
+			return err
+		}
+		var err error
+		revisions, err = tx.MultiUpdateJourneys(ctx, journeys)
+		if err != nil {
+			return err
+		}
+		for _, journey := range journeys {
+			if _, ok := revisions[journey.JourneyID]; !ok {
+				return apperrors.New(apperrors.NotFound, "journey %d not found or has another revision", journey.JourneyID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// multiRemove - removes all journeys in one transaction and returns their owners by ids,
+// nothing is removed if any journey is not found
+func (h *consumerHandler) multiRemove(ctx context.Context, journeyIDs []uint64) (map[uint64]uint64, error) {
+	var owners map[uint64]uint64
+	err := h.repo.WithTx(ctx, func(tx repo.Repo) error {
+		var err error
+		if owners, err = tx.GetJourneyOwners(ctx, journeyIDs); err != nil {
+			return err
+		}
+		removedIDs, err := tx.MultiRemoveJourneys(ctx, journeyIDs)
+		if err != nil {
+			return err
+		}
+		if len(removedIDs) != len(journeyIDs) {
+			return apperrors.New(apperrors.NotFound, "%d of %d journeys not found", len(journeyIDs)-len(removedIDs), len(journeyIDs))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return owners, nil
+}
@@ -0,0 +1,490 @@
+package kafka_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/kafka"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+	"github.com/ozonva/ova-journey-api/internal/models"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+	"github.com/ozonva/ova-journey-api/internal/watch"
+)
+
+type fakeSession struct {
+	ctx     context.Context
+	marked  []int64
+	commits int
+}
+
+func (s *fakeSession) Claims() map[string][]int32 { return nil }
+func (s *fakeSession) MemberID() string           { return "fake" }
+func (s *fakeSession) GenerationID() int32        { return 1 }
+func (s *fakeSession) MarkOffset(string, int32, int64, string) {
+}
+func (s *fakeSession) ResetOffset(string, int32, int64, string) {
+}
+func (s *fakeSession) Commit() { s.commits++ }
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+func (s *fakeSession) Context() context.Context { return s.ctx }
+
+type fakeClaim struct {
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Topic() string                            { return "ova-journey-api" }
+func (c *fakeClaim) Partition() int32                         { return 0 }
+func (c *fakeClaim) InitialOffset() int64                     { return 0 }
+func (c *fakeClaim) HighWaterMarkOffset() int64               { return int64(len(c.messages)) }
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+func newFakeClaim(messages ...kafka.Message) *fakeClaim {
+	claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage, len(messages))}
+	for i, message := range messages {
+		value, err := json.Marshal(message)
+		Expect(err).Should(BeNil())
+		claim.messages <- &sarama.ConsumerMessage{Topic: claim.Topic(), Offset: int64(i), Value: value}
+	}
+	close(claim.messages)
+	return claim
+}
+
+var _ = Describe("Consumer", func() {
+	var (
+		ctrl       *gomock.Controller
+		mockRepo   *mocks.MockRepo
+		mockOpRepo *mocks.MockOperationRepo
+		hub        watch.Hub
+		handler    sarama.ConsumerGroupHandler
+		session    *fakeSession
+
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd   = time.Date(2021, 01, 02, 0, 0, 0, 0, time.UTC)
+		journeys  = []models.Journey{
+			{JourneyID: 1, UserID: 1, Address: "Воронеж", StartTime: timeStart, EndTime: timeEnd},
+			{JourneyID: 2, UserID: 2, Address: "Москва", StartTime: timeStart, EndTime: timeEnd},
+		}
+		errRepo = errors.New("repo error")
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockOpRepo = mocks.NewMockOperationRepo(ctrl)
+		// changed journeys and their participants are loaded for watchers
+		mockRepo.EXPECT().DescribeJourney(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, journeyID uint64) (*models.Journey, error) {
+				return &models.Journey{JourneyID: journeyID, UserID: 1}, nil
+			}).AnyTimes()
+		mockRepo.EXPECT().GetJourneyParticipantIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		hub = watch.NewHub(10, 10)
+		handler = kafka.NewConsumerHandler(mockRepo, mockOpRepo, hub, time.Millisecond)
+		session = &fakeSession{ctx: context.Background()}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("all messages are applied", func() {
+		It("should call repo for every message type and commit every offset", func() {
+			subscription, err := hub.Subscribe(watch.Filter{}, hub.Revision())
+			Expect(err).Should(BeNil())
+			defer subscription.Close()
+
+			gomock.InOrder(
+				mockRepo.EXPECT().AddJourney(gomock.Any(), journeys[0]).Return(uint64(1), nil),
+				mockRepo.EXPECT().MultiAddJourneys(gomock.Any(), journeys).Return([]uint64{1, 2}, nil),
+				mockRepo.EXPECT().UpdateJourney(gomock.Any(), journeys[1]).Return(uint64(2), nil),
+				mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{2}).Return(map[uint64]uint64{2: 1}, nil),
+				mockRepo.EXPECT().RemoveJourney(gomock.Any(), uint64(2), uint64(0)).Return(nil),
+			)
+
+			claim := newFakeClaim(
+				kafka.Message{MessageType: kafka.CreateJourney, Value: journeys[0]},
+				kafka.Message{MessageType: kafka.MultiCreateJourney, Value: journeys},
+				kafka.Message{MessageType: kafka.UpdateJourney, Value: journeys[1]},
+				kafka.Message{MessageType: kafka.DeleteJourney, Value: uint64(2)},
+				kafka.Message{MessageType: kafka.Ping, Value: "1"},
+			)
+
+			err = handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0, 1, 2, 3, 4}))
+			Expect(session.commits).Should(Equal(5))
+
+			expected := []struct {
+				eventType watch.EventType
+				journeyID uint64
+			}{
+				{watch.JourneyCreated, 1},
+				{watch.JourneyCreated, 1},
+				{watch.JourneyCreated, 2},
+				{watch.JourneyUpdated, 2},
+				{watch.JourneyDeleted, 2},
+			}
+			for _, e := range expected {
+				event := <-subscription.Events()
+				Expect(event.Type).Should(Equal(e.eventType))
+				Expect(event.JourneyID).Should(Equal(e.journeyID))
+			}
+		})
+	})
+
+	Context("message cannot be decoded", func() {
+		It("should skip message and commit its offset", func() {
+			mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			claim := newFakeClaim(kafka.Message{MessageType: kafka.MessageType(100), Value: "unknown"})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0}))
+		})
+	})
+
+	Context("error in repo", func() {
+		It("should stop processing without committing failed offset", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().AddJourney(gomock.Any(), journeys[0]).Return(uint64(1), nil),
+				mockRepo.EXPECT().AddJourney(gomock.Any(), journeys[1]).Return(uint64(0), errRepo),
+			)
+
+			claim := newFakeClaim(
+				kafka.Message{MessageType: kafka.CreateJourney, Value: journeys[0]},
+				kafka.Message{MessageType: kafka.CreateJourney, Value: journeys[1]},
+				kafka.Message{MessageType: kafka.CreateJourney, Value: journeys[0]},
+			)
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(Equal(errRepo))
+			Expect(session.marked).Should(Equal([]int64{0}))
+			Expect(session.commits).Should(Equal(1))
+		})
+
+		It("should skip message failed with permanent error", func() {
+			errNotFound := apperrors.New(apperrors.NotFound, "journey 2 not found")
+			gomock.InOrder(
+				mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{2}).Return(map[uint64]uint64{2: 1}, nil),
+				mockRepo.EXPECT().RemoveJourney(gomock.Any(), uint64(2), uint64(0)).Return(errNotFound),
+				mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{3}).Return(map[uint64]uint64{3: 1}, nil),
+				mockRepo.EXPECT().RemoveJourney(gomock.Any(), uint64(3), uint64(0)).Return(nil),
+			)
+
+			claim := newFakeClaim(
+				kafka.Message{MessageType: kafka.DeleteJourney, Value: uint64(2)},
+				kafka.Message{MessageType: kafka.DeleteJourney, Value: uint64(3)},
+			)
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0, 1}))
+		})
+
+		It("should skip invalid journey without calling repo", func() {
+			invalid := journeys[0]
+			invalid.Address = ""
+			mockRepo.EXPECT().AddJourney(gomock.Any(), journeys[1]).Return(uint64(2), nil).Times(1)
+
+			claim := newFakeClaim(
+				kafka.Message{MessageType: kafka.CreateJourney, Value: invalid},
+				kafka.Message{MessageType: kafka.CreateJourney, Value: journeys[1]},
+			)
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0, 1}))
+		})
+	})
+
+	Context("multi update and multi delete messages", func() {
+		BeforeEach(func() {
+			mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(repo.Repo) error) error {
+					return fn(mockRepo)
+				}).AnyTimes()
+		})
+
+		It("should apply journeys in transaction and save their ids to operation", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().MultiUpdateJourneys(gomock.Any(), journeys).Return(map[uint64]uint64{1: 2, 2: 3}, nil),
+				mockOpRepo.EXPECT().CompleteOperationChunk(gomock.Any(), uint64(7), []uint64{1, 2}).Return(nil),
+				mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{1, 2}).Return(map[uint64]uint64{1: 1, 2: 1}, nil),
+				mockRepo.EXPECT().MultiRemoveJourneys(gomock.Any(), []uint64{1, 2}).Return([]uint64{1, 2}, nil),
+				mockOpRepo.EXPECT().CompleteOperationChunk(gomock.Any(), uint64(8), []uint64{1, 2}).Return(nil),
+			)
+
+			claim := newFakeClaim(
+				kafka.Message{MessageType: kafka.MultiUpdateJourney, OperationID: 7, Value: journeys},
+				kafka.Message{MessageType: kafka.MultiDeleteJourney, OperationID: 8, Value: []uint64{1, 2}},
+			)
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0, 1}))
+		})
+
+		It("should fail operation if any journey is not updated", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().MultiUpdateJourneys(gomock.Any(), journeys).Return(map[uint64]uint64{1: 2}, nil),
+				mockOpRepo.EXPECT().FailOperation(gomock.Any(), uint64(7), gomock.Any()).Return(nil),
+				mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{1, 2}).Return(map[uint64]uint64{1: 1, 2: 1}, nil),
+				mockRepo.EXPECT().MultiRemoveJourneys(gomock.Any(), []uint64{1, 2}).Return([]uint64{2}, nil),
+				mockOpRepo.EXPECT().FailOperation(gomock.Any(), uint64(8), gomock.Any()).Return(nil),
+			)
+
+			claim := newFakeClaim(
+				kafka.Message{MessageType: kafka.MultiUpdateJourney, OperationID: 7, Value: journeys},
+				kafka.Message{MessageType: kafka.MultiDeleteJourney, OperationID: 8, Value: []uint64{1, 2}},
+			)
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0, 1}))
+		})
+	})
+
+	Context("session is finished", func() {
+		It("should return without processing messages", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			session.ctx = ctx
+			claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage)}
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(BeEmpty())
+		})
+	})
+
+	Context("message with operation", func() {
+		It("should save journey ids to the operation after applying", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().MultiAddJourneys(gomock.Any(), journeys).Return([]uint64{3, 4}, nil),
+				mockOpRepo.EXPECT().CompleteOperationChunk(gomock.Any(), uint64(7), []uint64{3, 4}).Return(nil),
+			)
+
+			claim := newFakeClaim(kafka.Message{MessageType: kafka.MultiCreateJourney, OperationID: 7, Value: journeys})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0}))
+		})
+
+		It("should retry saving of journey ids to the operation without applying message again", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().MultiAddJourneys(gomock.Any(), journeys).Return([]uint64{3, 4}, nil).Times(1),
+				mockOpRepo.EXPECT().CompleteOperationChunk(gomock.Any(), uint64(7), []uint64{3, 4}).Return(errRepo).Times(2),
+				mockOpRepo.EXPECT().CompleteOperationChunk(gomock.Any(), uint64(7), []uint64{3, 4}).Return(nil),
+			)
+
+			claim := newFakeClaim(kafka.Message{MessageType: kafka.MultiCreateJourney, OperationID: 7, Value: journeys})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0}))
+		})
+
+		It("should mark operation as failed if journey ids cannot be saved to it", func() {
+			errConflict := apperrors.New(apperrors.Conflict, "operation 7 is changed")
+			gomock.InOrder(
+				mockRepo.EXPECT().MultiAddJourneys(gomock.Any(), journeys).Return([]uint64{3, 4}, nil).Times(1),
+				mockOpRepo.EXPECT().CompleteOperationChunk(gomock.Any(), uint64(7), []uint64{3, 4}).Return(errConflict).Times(1),
+				mockOpRepo.EXPECT().FailOperation(gomock.Any(), uint64(7), gomock.Any()).Return(nil).Times(1),
+			)
+
+			claim := newFakeClaim(kafka.Message{MessageType: kafka.MultiCreateJourney, OperationID: 7, Value: journeys})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0}))
+		})
+
+		It("should mark operation as failed and commit message on repo error", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{2}).Return(map[uint64]uint64{2: 1}, nil),
+				mockRepo.EXPECT().RemoveJourney(gomock.Any(), uint64(2), uint64(0)).Return(errRepo),
+				mockOpRepo.EXPECT().FailOperation(gomock.Any(), uint64(7), errRepo.Error()).Return(nil),
+				mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{3}).Return(map[uint64]uint64{3: 1}, nil),
+				mockRepo.EXPECT().RemoveJourney(gomock.Any(), uint64(3), uint64(0)).Return(nil),
+			)
+			mockOpRepo.EXPECT().CompleteOperationChunk(gomock.Any(), uint64(8), []uint64{3}).Return(nil)
+
+			claim := newFakeClaim(
+				kafka.Message{MessageType: kafka.DeleteJourney, OperationID: 7, Value: uint64(2)},
+				kafka.Message{MessageType: kafka.DeleteJourney, OperationID: 8, Value: uint64(3)},
+			)
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0, 1}))
+		})
+
+		It("should stop processing if operation cannot be marked as failed", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{2}).Return(map[uint64]uint64{2: 1}, nil),
+				mockRepo.EXPECT().RemoveJourney(gomock.Any(), uint64(2), uint64(0)).Return(errRepo),
+				mockOpRepo.EXPECT().FailOperation(gomock.Any(), uint64(7), errRepo.Error()).Return(errRepo),
+			)
+
+			claim := newFakeClaim(kafka.Message{MessageType: kafka.DeleteJourney, OperationID: 7, Value: uint64(2)})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(Equal(errRepo))
+			Expect(session.marked).Should(BeEmpty())
+		})
+
+		It("should retry message without failing operation if repo is unavailable", func() {
+			errUnavailable := apperrors.Wrap(apperrors.Unavailable, errRepo, "database unavailable")
+			mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{2}).Return(map[uint64]uint64{2: 1}, nil)
+			mockRepo.EXPECT().RemoveJourney(gomock.Any(), uint64(2), uint64(0)).Return(errUnavailable)
+			mockOpRepo.EXPECT().FailOperation(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			claim := newFakeClaim(kafka.Message{MessageType: kafka.DeleteJourney, OperationID: 7, Value: uint64(2)})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(Equal(errUnavailable))
+			Expect(session.marked).Should(BeEmpty())
+		})
+	})
+
+	Context("messages with acting user", func() {
+		It("should apply message if acting user has required role", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().GetJourneyAccess(gomock.Any(), uint64(2), uint64(2)).
+					Return(repo.JourneyAccess{OwnerID: 2, Role: models.RoleOwner}, nil),
+				mockRepo.EXPECT().GetJourneyOwners(gomock.Any(), []uint64{2}).Return(map[uint64]uint64{2: 1}, nil),
+				mockRepo.EXPECT().RemoveJourney(gomock.Any(), uint64(2), uint64(0)).Return(nil),
+				mockOpRepo.EXPECT().CompleteOperationChunk(gomock.Any(), uint64(7), []uint64{2}).Return(nil),
+			)
+
+			claim := newFakeClaim(kafka.Message{MessageType: kafka.DeleteJourney, OperationID: 7, UserID: 2, Value: uint64(2)})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0}))
+		})
+
+		It("should fail operation and commit message if acting user has no permission", func() {
+			mockRepo.EXPECT().GetJourneyAccess(gomock.Any(), uint64(2), uint64(3)).
+				Return(repo.JourneyAccess{OwnerID: 2, Role: models.RoleEditor}, nil)
+			mockRepo.EXPECT().RemoveJourney(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			mockOpRepo.EXPECT().FailOperation(gomock.Any(), uint64(7), gomock.Any()).Return(nil)
+
+			claim := newFakeClaim(kafka.Message{MessageType: kafka.DeleteJourney, OperationID: 7, UserID: 3, Value: uint64(2)})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0}))
+		})
+
+		It("should not create journeys of another user", func() {
+			mockRepo.EXPECT().MultiAddJourneys(gomock.Any(), gomock.Any()).Times(0)
+			mockOpRepo.EXPECT().FailOperation(gomock.Any(), uint64(7), gomock.Any()).Return(nil)
+
+			claim := newFakeClaim(kafka.Message{MessageType: kafka.MultiCreateJourney, OperationID: 7, UserID: 1, Value: journeys})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0}))
+		})
+
+		It("should not let editor give journey to another user", func() {
+			updated := journeys[1]
+			updated.UserID = 3
+			mockRepo.EXPECT().GetJourneyAccess(gomock.Any(), uint64(2), uint64(3)).
+				Return(repo.JourneyAccess{OwnerID: 2, Role: models.RoleEditor}, nil)
+			mockRepo.EXPECT().UpdateJourney(gomock.Any(), gomock.Any()).Times(0)
+			mockOpRepo.EXPECT().FailOperation(gomock.Any(), uint64(7), gomock.Any()).Return(nil)
+
+			claim := newFakeClaim(kafka.Message{MessageType: kafka.UpdateJourney, OperationID: 7, UserID: 3, Value: updated})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0}))
+		})
+	})
+
+	Context("messages with overlap policies", func() {
+		BeforeEach(func() {
+			mockRepo.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(repo.Repo) error) error {
+					return fn(mockRepo)
+				}).AnyTimes()
+		})
+
+		It("should fail operation without creating journey overlapping other journeys with reject policy", func() {
+			journey := journeys[0]
+			journey.JourneyID = 0
+			gomock.InOrder(
+				mockRepo.EXPECT().LockUserJourneys(gomock.Any(), uint64(1)).Return(nil),
+				mockRepo.EXPECT().ListOverlappingJourneyIDs(gomock.Any(), journey).Return([]uint64{5}, nil),
+				mockOpRepo.EXPECT().FailOperation(gomock.Any(), uint64(7), gomock.Any()).Return(nil),
+			)
+			mockRepo.EXPECT().AddJourney(gomock.Any(), gomock.Any()).Times(0)
+
+			claim := newFakeClaim(kafka.Message{
+				MessageType:     kafka.CreateJourney,
+				OperationID:     7,
+				OverlapPolicies: []models.OverlapPolicy{models.OverlapReject},
+				Value:           journey,
+			})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0}))
+		})
+
+		It("should lock users once and update journeys with warn policy in one transaction", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().LockUserJourneys(gomock.Any(), uint64(1)).Return(nil),
+				mockRepo.EXPECT().LockUserJourneys(gomock.Any(), uint64(2)).Return(nil),
+				mockRepo.EXPECT().ListOverlappingJourneyIDs(gomock.Any(), journeys[0]).Return([]uint64{5}, nil),
+				mockRepo.EXPECT().ListOverlappingJourneyIDs(gomock.Any(), journeys[1]).Return(nil, nil),
+				mockRepo.EXPECT().MultiUpdateJourneys(gomock.Any(), journeys).Return(map[uint64]uint64{1: 2, 2: 3}, nil),
+				mockOpRepo.EXPECT().CompleteOperationChunk(gomock.Any(), uint64(7), []uint64{1, 2}).Return(nil),
+			)
+
+			claim := newFakeClaim(kafka.Message{
+				MessageType:     kafka.MultiUpdateJourney,
+				OperationID:     7,
+				OverlapPolicies: []models.OverlapPolicy{models.OverlapWarn, models.OverlapWarn},
+				Value:           journeys,
+			})
+
+			err := handler.ConsumeClaim(session, claim)
+
+			Expect(err).Should(BeNil())
+			Expect(session.marked).Should(Equal([]int64{0}))
+		})
+	})
+})
@@ -1,5 +1,15 @@
 package kafka
 
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// ErrUnknownMessageType - occurs when decoded message has MessageType that consumer cannot process
+var ErrUnknownMessageType = errors.New("unknown kafka message type")
+
 // MessageType - represents type for iota with Create, MultiCreate, Update, Delete operations
 type MessageType int
 
@@ -14,10 +24,70 @@ const (
 	UpdateJourney
 	// DeleteJourney - Delete Journey via Producer
 	DeleteJourney
+	// MultiUpdateJourney - Update several Journeys via Producer
+	MultiUpdateJourney
+	// MultiDeleteJourney - Delete several Journeys via Producer
+	MultiDeleteJourney
 )
 
-// Message - message for Kafka
+// Message - message for Kafka, OperationID refers to models.Operation tracking the message processing,
+// UserID is id of user acting in request that sent the message, consumer checks their permissions.
+// UserID is 0 for messages of trusted internal clients. OverlapPolicies are policies of created or updated journeys
+// of Value in the same order, journeys are saved without overlap checks if they are not set
 type Message struct {
-	MessageType MessageType
-	Value       interface{}
+	MessageType     MessageType
+	OperationID     uint64
+	UserID          uint64
+	OverlapPolicies []models.OverlapPolicy
+	Value           interface{}
+}
+
+// DecodeMessage - decodes JSON message sent by Producer and restores typed Value for its MessageType:
+// models.Journey for CreateJourney and UpdateJourney, []models.Journey for MultiCreateJourney and MultiUpdateJourney,
+// uint64 journey id for DeleteJourney, []uint64 journey ids for MultiDeleteJourney and raw JSON for Ping.
+func DecodeMessage(data []byte) (Message, error) {
+	var raw struct {
+		MessageType     MessageType
+		OperationID     uint64
+		UserID          uint64
+		OverlapPolicies []models.OverlapPolicy
+		Value           json.RawMessage
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return Message{}, err
+	}
+
+	message := Message{
+		MessageType:     raw.MessageType,
+		OperationID:     raw.OperationID,
+		UserID:          raw.UserID,
+		OverlapPolicies: raw.OverlapPolicies,
+	}
+	var err error
+	switch raw.MessageType {
+	case Ping:
+		message.Value = raw.Value
+	case CreateJourney, UpdateJourney:
+		var journey models.Journey
+		err = json.Unmarshal(raw.Value, &journey)
+		message.Value = journey
+	case MultiCreateJourney, MultiUpdateJourney:
+		var journeys []models.Journey
+		err = json.Unmarshal(raw.Value, &journeys)
+		message.Value = journeys
+	case DeleteJourney:
+		var journeyID uint64
+		err = json.Unmarshal(raw.Value, &journeyID)
+		message.Value = journeyID
+	case MultiDeleteJourney:
+		var journeyIDs []uint64
+		err = json.Unmarshal(raw.Value, &journeyIDs)
+		message.Value = journeyIDs
+	default:
+		err = ErrUnknownMessageType
+	}
+	if err != nil {
+		return Message{}, err
+	}
+	return message, nil
 }
@@ -0,0 +1,57 @@
+package kafka_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/ozonva/ova-journey-api/internal/kafka"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+var _ = Describe("Message", func() {
+	var (
+		timeStart = time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+		timeEnd   = time.Date(2021, 01, 02, 0, 0, 0, 0, time.UTC)
+		journey   = models.Journey{JourneyID: 1, UserID: 1, Address: "Воронеж", StartTime: timeStart, EndTime: timeEnd}
+	)
+
+	DescribeTable("DecodeMessage should restore typed value",
+		func(message kafka.Message) {
+			data, err := json.Marshal(message)
+			Expect(err).Should(BeNil())
+
+			result, err := kafka.DecodeMessage(data)
+
+			Expect(err).Should(BeNil())
+			Expect(result).Should(Equal(message))
+		},
+		Entry("create", kafka.Message{MessageType: kafka.CreateJourney, Value: journey}),
+		Entry("multi create", kafka.Message{MessageType: kafka.MultiCreateJourney, Value: []models.Journey{journey, journey}}),
+		Entry("update", kafka.Message{MessageType: kafka.UpdateJourney, Value: journey}),
+		Entry("delete", kafka.Message{MessageType: kafka.DeleteJourney, Value: uint64(1)}),
+		Entry("delete with acting user", kafka.Message{MessageType: kafka.DeleteJourney, OperationID: 3, UserID: 2, Value: uint64(1)}),
+		Entry("multi update", kafka.Message{MessageType: kafka.MultiUpdateJourney, Value: []models.Journey{journey, journey}}),
+		Entry("multi create with overlap policies", kafka.Message{
+			MessageType:     kafka.MultiCreateJourney,
+			OverlapPolicies: []models.OverlapPolicy{models.OverlapReject, models.OverlapAllow},
+			Value:           []models.Journey{journey, journey},
+		}),
+		Entry("multi delete", kafka.Message{MessageType: kafka.MultiDeleteJourney, Value: []uint64{1, 2}}),
+	)
+
+	It("DecodeMessage should return error for unknown message type", func() {
+		_, err := kafka.DecodeMessage([]byte(`{"MessageType":100,"Value":null}`))
+
+		Expect(err).Should(Equal(kafka.ErrUnknownMessageType))
+	})
+
+	It("DecodeMessage should return error for incorrect value", func() {
+		_, err := kafka.DecodeMessage([]byte(`{"MessageType":4,"Value":"text"}`))
+
+		Expect(err).Should(HaveOccurred())
+	})
+})
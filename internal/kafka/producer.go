@@ -2,6 +2,8 @@ package kafka
 
 import (
 	"encoding/json"
+	"strconv"
+
 	"github.com/Shopify/sarama"
 	"github.com/ozonva/ova-journey-api/internal/config"
 	"github.com/rs/zerolog/log"
@@ -18,10 +20,12 @@ type producer struct {
 	topic        string
 }
 
-// NewProducer - creates new Producer for work with Kafka
+// NewProducer - creates new Producer for work with Kafka.
+// Messages of one operation are sent to one partition, so its chunks are consumed in order of sending
+// and ids of their journeys are saved to the operation in the same order
 func NewProducer(configuration *config.KafkaConfiguration) (Producer, error) {
 	saramaConfig := sarama.NewConfig()
-	saramaConfig.Producer.Partitioner = sarama.NewRandomPartitioner
+	saramaConfig.Producer.Partitioner = sarama.NewHashPartitioner
 	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
 	saramaConfig.Producer.Return.Successes = true
 
@@ -48,12 +52,21 @@ func (p *producer) Send(message Message) error {
 		&sarama.ProducerMessage{
 			Topic:     p.topic,
 			Partition: -1,
-			Key:       sarama.StringEncoder(p.topic),
+			Key:       messageKey(message),
 			Value:     sarama.StringEncoder(jsonMes),
 		})
 	return err
 }
 
+// messageKey - returns key of message choosing its partition: operation id for messages of operation,
+// messages without operation have no key and are sent to random partition
+func messageKey(message Message) sarama.Encoder {
+	if message.OperationID == 0 {
+		return nil
+	}
+	return sarama.StringEncoder(strconv.FormatUint(message.OperationID, 10))
+}
+
 func (p *producer) Close() error {
 	return p.syncProducer.Close()
 }
@@ -2,5 +2,7 @@ package internal
 
 //go:generate mockgen -destination=./mocks/flusher_mock.go -package=mocks github.com/ozonva/ova-journey-api/internal/flusher Flusher
 //go:generate mockgen -destination=./mocks/repo_mock.go -package=mocks github.com/ozonva/ova-journey-api/internal/repo Repo
+//go:generate mockgen -destination=./mocks/operation_repo_mock.go -package=mocks github.com/ozonva/ova-journey-api/internal/repo OperationRepo
+//go:generate mockgen -destination=./mocks/idempotency_repo_mock.go -package=mocks github.com/ozonva/ova-journey-api/internal/repo IdempotencyRepo
 //go:generate mockgen -destination=./mocks/producer_mock.go -package=mocks github.com/ozonva/ova-journey-api/internal/kafka Producer
 //go:generate mockgen -destination=./mocks/metrics_mock.go -package=mocks github.com/ozonva/ova-journey-api/internal/metrics Metrics
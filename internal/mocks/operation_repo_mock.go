@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ozonva/ova-journey-api/internal/repo (interfaces: OperationRepo)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// MockOperationRepo is a mock of OperationRepo interface.
+type MockOperationRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockOperationRepoMockRecorder
+}
+
+// MockOperationRepoMockRecorder is the mock recorder for MockOperationRepo.
+type MockOperationRepoMockRecorder struct {
+	mock *MockOperationRepo
+}
+
+// NewMockOperationRepo creates a new mock instance.
+func NewMockOperationRepo(ctrl *gomock.Controller) *MockOperationRepo {
+	mock := &MockOperationRepo{ctrl: ctrl}
+	mock.recorder = &MockOperationRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOperationRepo) EXPECT() *MockOperationRepoMockRecorder {
+	return m.recorder
+}
+
+// AddOperation mocks base method.
+func (m *MockOperationRepo) AddOperation(arg0 context.Context, arg1 models.Operation) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOperation", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOperation indicates an expected call of AddOperation.
+func (mr *MockOperationRepoMockRecorder) AddOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOperation", reflect.TypeOf((*MockOperationRepo)(nil).AddOperation), arg0, arg1)
+}
+
+// CompleteOperationChunk mocks base method.
+func (m *MockOperationRepo) CompleteOperationChunk(arg0 context.Context, arg1 uint64, arg2 []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOperationChunk", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteOperationChunk indicates an expected call of CompleteOperationChunk.
+func (mr *MockOperationRepoMockRecorder) CompleteOperationChunk(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOperationChunk", reflect.TypeOf((*MockOperationRepo)(nil).CompleteOperationChunk), arg0, arg1, arg2)
+}
+
+// DescribeOperation mocks base method.
+func (m *MockOperationRepo) DescribeOperation(arg0 context.Context, arg1 uint64) (*models.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeOperation", arg0, arg1)
+	ret0, _ := ret[0].(*models.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeOperation indicates an expected call of DescribeOperation.
+func (mr *MockOperationRepoMockRecorder) DescribeOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeOperation", reflect.TypeOf((*MockOperationRepo)(nil).DescribeOperation), arg0, arg1)
+}
+
+// FailOperation mocks base method.
+func (m *MockOperationRepo) FailOperation(arg0 context.Context, arg1 uint64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailOperation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailOperation indicates an expected call of FailOperation.
+func (mr *MockOperationRepoMockRecorder) FailOperation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailOperation", reflect.TypeOf((*MockOperationRepo)(nil).FailOperation), arg0, arg1, arg2)
+}
+
+// ListOperations mocks base method.
+func (m *MockOperationRepo) ListOperations(arg0 context.Context, arg1, arg2, arg3 uint64) ([]models.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOperations", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOperations indicates an expected call of ListOperations.
+func (mr *MockOperationRepoMockRecorder) ListOperations(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOperations", reflect.TypeOf((*MockOperationRepo)(nil).ListOperations), arg0, arg1, arg2, arg3)
+}
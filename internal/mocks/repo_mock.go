@@ -7,9 +7,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	models "github.com/ozonva/ova-journey-api/internal/models"
+	repo "github.com/ozonva/ova-journey-api/internal/repo"
 )
 
 // MockRepo is a mock of Repo interface.
@@ -50,6 +52,96 @@ func (mr *MockRepoMockRecorder) AddJourney(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddJourney", reflect.TypeOf((*MockRepo)(nil).AddJourney), arg0, arg1)
 }
 
+// AddParticipant mocks base method.
+func (m *MockRepo) AddParticipant(arg0 context.Context, arg1 uint64, arg2 models.Participant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddParticipant", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddParticipant indicates an expected call of AddParticipant.
+func (mr *MockRepoMockRecorder) AddParticipant(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddParticipant", reflect.TypeOf((*MockRepo)(nil).AddParticipant), arg0, arg1, arg2)
+}
+
+// AddRecurringJourney mocks base method.
+func (m *MockRepo) AddRecurringJourney(arg0 context.Context, arg1 models.RecurringJourney) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRecurringJourney", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRecurringJourney indicates an expected call of AddRecurringJourney.
+func (mr *MockRepoMockRecorder) AddRecurringJourney(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecurringJourney", reflect.TypeOf((*MockRepo)(nil).AddRecurringJourney), arg0, arg1)
+}
+
+// AddWaypoint mocks base method.
+func (m *MockRepo) AddWaypoint(arg0 context.Context, arg1 uint64, arg2 models.Waypoint, arg3 uint64) (uint64, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWaypoint", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddWaypoint indicates an expected call of AddWaypoint.
+func (mr *MockRepoMockRecorder) AddWaypoint(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWaypoint", reflect.TypeOf((*MockRepo)(nil).AddWaypoint), arg0, arg1, arg2, arg3)
+}
+
+// AdvanceJourneyStatuses mocks base method.
+func (m *MockRepo) AdvanceJourneyStatuses(arg0 context.Context, arg1 time.Time, arg2 uint64) ([]models.Journey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceJourneyStatuses", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Journey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceJourneyStatuses indicates an expected call of AdvanceJourneyStatuses.
+func (mr *MockRepoMockRecorder) AdvanceJourneyStatuses(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceJourneyStatuses", reflect.TypeOf((*MockRepo)(nil).AdvanceJourneyStatuses), arg0, arg1, arg2)
+}
+
+// BatchGetJourneys mocks base method.
+func (m *MockRepo) BatchGetJourneys(arg0 context.Context, arg1 []uint64) ([]models.Journey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetJourneys", arg0, arg1)
+	ret0, _ := ret[0].([]models.Journey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetJourneys indicates an expected call of BatchGetJourneys.
+func (mr *MockRepoMockRecorder) BatchGetJourneys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetJourneys", reflect.TypeOf((*MockRepo)(nil).BatchGetJourneys), arg0, arg1)
+}
+
+// ChangeJourneyStatus mocks base method.
+func (m *MockRepo) ChangeJourneyStatus(arg0 context.Context, arg1 uint64, arg2 models.JourneyStatus, arg3 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeJourneyStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeJourneyStatus indicates an expected call of ChangeJourneyStatus.
+func (mr *MockRepoMockRecorder) ChangeJourneyStatus(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeJourneyStatus", reflect.TypeOf((*MockRepo)(nil).ChangeJourneyStatus), arg0, arg1, arg2, arg3)
+}
+
 // DescribeJourney mocks base method.
 func (m *MockRepo) DescribeJourney(arg0 context.Context, arg1 uint64) (*models.Journey, error) {
 	m.ctrl.T.Helper()
@@ -65,19 +157,257 @@ func (mr *MockRepoMockRecorder) DescribeJourney(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeJourney", reflect.TypeOf((*MockRepo)(nil).DescribeJourney), arg0, arg1)
 }
 
+// DescribeRecurringJourney mocks base method.
+func (m *MockRepo) DescribeRecurringJourney(arg0 context.Context, arg1 uint64) (*models.RecurringJourney, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeRecurringJourney", arg0, arg1)
+	ret0, _ := ret[0].(*models.RecurringJourney)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeRecurringJourney indicates an expected call of DescribeRecurringJourney.
+func (mr *MockRepoMockRecorder) DescribeRecurringJourney(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRecurringJourney", reflect.TypeOf((*MockRepo)(nil).DescribeRecurringJourney), arg0, arg1)
+}
+
+// ExportJourneys mocks base method.
+func (m *MockRepo) ExportJourneys(arg0 context.Context, arg1 repo.JourneyFilter, arg2 func(models.Journey) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportJourneys", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportJourneys indicates an expected call of ExportJourneys.
+func (mr *MockRepoMockRecorder) ExportJourneys(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportJourneys", reflect.TypeOf((*MockRepo)(nil).ExportJourneys), arg0, arg1, arg2)
+}
+
+// FindOverlappingJourneys mocks base method.
+func (m *MockRepo) FindOverlappingJourneys(arg0 context.Context, arg1 uint64, arg2, arg3 time.Time, arg4 uint64) ([]repo.JourneyOverlap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOverlappingJourneys", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]repo.JourneyOverlap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOverlappingJourneys indicates an expected call of FindOverlappingJourneys.
+func (mr *MockRepoMockRecorder) FindOverlappingJourneys(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOverlappingJourneys", reflect.TypeOf((*MockRepo)(nil).FindOverlappingJourneys), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetJourneyAccess mocks base method.
+func (m *MockRepo) GetJourneyAccess(arg0 context.Context, arg1, arg2 uint64) (repo.JourneyAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJourneyAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(repo.JourneyAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJourneyAccess indicates an expected call of GetJourneyAccess.
+func (mr *MockRepoMockRecorder) GetJourneyAccess(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJourneyAccess", reflect.TypeOf((*MockRepo)(nil).GetJourneyAccess), arg0, arg1, arg2)
+}
+
+// GetJourneyOwners mocks base method.
+func (m *MockRepo) GetJourneyOwners(arg0 context.Context, arg1 []uint64) (map[uint64]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJourneyOwners", arg0, arg1)
+	ret0, _ := ret[0].(map[uint64]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJourneyOwners indicates an expected call of GetJourneyOwners.
+func (mr *MockRepoMockRecorder) GetJourneyOwners(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJourneyOwners", reflect.TypeOf((*MockRepo)(nil).GetJourneyOwners), arg0, arg1)
+}
+
+// GetJourneyParticipantIDs mocks base method.
+func (m *MockRepo) GetJourneyParticipantIDs(arg0 context.Context, arg1 []uint64) (map[uint64][]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJourneyParticipantIDs", arg0, arg1)
+	ret0, _ := ret[0].(map[uint64][]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJourneyParticipantIDs indicates an expected call of GetJourneyParticipantIDs.
+func (mr *MockRepoMockRecorder) GetJourneyParticipantIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJourneyParticipantIDs", reflect.TypeOf((*MockRepo)(nil).GetJourneyParticipantIDs), arg0, arg1)
+}
+
+// GetJourneyStats mocks base method.
+func (m *MockRepo) GetJourneyStats(arg0 context.Context, arg1 repo.JourneyFilter, arg2 uint64) (*repo.JourneyStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJourneyStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*repo.JourneyStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJourneyStats indicates an expected call of GetJourneyStats.
+func (mr *MockRepoMockRecorder) GetJourneyStats(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJourneyStats", reflect.TypeOf((*MockRepo)(nil).GetJourneyStats), arg0, arg1, arg2)
+}
+
+// GetRemovedJourneyOwner mocks base method.
+func (m *MockRepo) GetRemovedJourneyOwner(arg0 context.Context, arg1 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemovedJourneyOwner", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemovedJourneyOwner indicates an expected call of GetRemovedJourneyOwner.
+func (mr *MockRepoMockRecorder) GetRemovedJourneyOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemovedJourneyOwner", reflect.TypeOf((*MockRepo)(nil).GetRemovedJourneyOwner), arg0, arg1)
+}
+
+// ListDeletedJourneys mocks base method.
+func (m *MockRepo) ListDeletedJourneys(arg0 context.Context, arg1, arg2 uint64) ([]models.Journey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedJourneys", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Journey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedJourneys indicates an expected call of ListDeletedJourneys.
+func (mr *MockRepoMockRecorder) ListDeletedJourneys(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedJourneys", reflect.TypeOf((*MockRepo)(nil).ListDeletedJourneys), arg0, arg1, arg2)
+}
+
 // ListJourneys mocks base method.
-func (m *MockRepo) ListJourneys(arg0 context.Context, arg1, arg2 uint64) ([]models.Journey, error) {
+func (m *MockRepo) ListJourneys(arg0 context.Context, arg1 repo.JourneyFilter, arg2, arg3 uint64) ([]models.Journey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListJourneys", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ListJourneys", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]models.Journey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListJourneys indicates an expected call of ListJourneys.
-func (mr *MockRepoMockRecorder) ListJourneys(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockRepoMockRecorder) ListJourneys(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJourneys", reflect.TypeOf((*MockRepo)(nil).ListJourneys), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJourneys", reflect.TypeOf((*MockRepo)(nil).ListJourneys), arg0, arg1, arg2, arg3)
+}
+
+// ListJourneysAfter mocks base method.
+func (m *MockRepo) ListJourneysAfter(arg0 context.Context, arg1 repo.JourneyFilter, arg2, arg3 uint64) ([]models.Journey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJourneysAfter", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Journey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJourneysAfter indicates an expected call of ListJourneysAfter.
+func (mr *MockRepoMockRecorder) ListJourneysAfter(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJourneysAfter", reflect.TypeOf((*MockRepo)(nil).ListJourneysAfter), arg0, arg1, arg2, arg3)
+}
+
+// ListJourneysNear mocks base method.
+func (m *MockRepo) ListJourneysNear(arg0 context.Context, arg1 models.Coordinates, arg2 float64, arg3 repo.JourneyFilter, arg4 uint64) ([]models.Journey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJourneysNear", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Journey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJourneysNear indicates an expected call of ListJourneysNear.
+func (mr *MockRepoMockRecorder) ListJourneysNear(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJourneysNear", reflect.TypeOf((*MockRepo)(nil).ListJourneysNear), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ListOverlappingJourneyIDs mocks base method.
+func (m *MockRepo) ListOverlappingJourneyIDs(arg0 context.Context, arg1 models.Journey) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverlappingJourneyIDs", arg0, arg1)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverlappingJourneyIDs indicates an expected call of ListOverlappingJourneyIDs.
+func (mr *MockRepoMockRecorder) ListOverlappingJourneyIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverlappingJourneyIDs", reflect.TypeOf((*MockRepo)(nil).ListOverlappingJourneyIDs), arg0, arg1)
+}
+
+// ListParticipants mocks base method.
+func (m *MockRepo) ListParticipants(arg0 context.Context, arg1 uint64) ([]models.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListParticipants", arg0, arg1)
+	ret0, _ := ret[0].([]models.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListParticipants indicates an expected call of ListParticipants.
+func (mr *MockRepoMockRecorder) ListParticipants(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListParticipants", reflect.TypeOf((*MockRepo)(nil).ListParticipants), arg0, arg1)
+}
+
+// ListRecurringJourneys mocks base method.
+func (m *MockRepo) ListRecurringJourneys(arg0 context.Context, arg1 repo.JourneyFilter) ([]models.RecurringJourney, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecurringJourneys", arg0, arg1)
+	ret0, _ := ret[0].([]models.RecurringJourney)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecurringJourneys indicates an expected call of ListRecurringJourneys.
+func (mr *MockRepoMockRecorder) ListRecurringJourneys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecurringJourneys", reflect.TypeOf((*MockRepo)(nil).ListRecurringJourneys), arg0, arg1)
+}
+
+// ListTags mocks base method.
+func (m *MockRepo) ListTags(arg0 context.Context, arg1 uint64) ([]repo.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", arg0, arg1)
+	ret0, _ := ret[0].([]repo.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockRepoMockRecorder) ListTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockRepo)(nil).ListTags), arg0, arg1)
+}
+
+// LockUserJourneys mocks base method.
+func (m *MockRepo) LockUserJourneys(arg0 context.Context, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUserJourneys", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockUserJourneys indicates an expected call of LockUserJourneys.
+func (mr *MockRepoMockRecorder) LockUserJourneys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUserJourneys", reflect.TypeOf((*MockRepo)(nil).LockUserJourneys), arg0, arg1)
 }
 
 // MultiAddJourneys mocks base method.
@@ -95,30 +425,223 @@ func (mr *MockRepoMockRecorder) MultiAddJourneys(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiAddJourneys", reflect.TypeOf((*MockRepo)(nil).MultiAddJourneys), arg0, arg1)
 }
 
+// MultiRemoveJourneys mocks base method.
+func (m *MockRepo) MultiRemoveJourneys(arg0 context.Context, arg1 []uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultiRemoveJourneys", arg0, arg1)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultiRemoveJourneys indicates an expected call of MultiRemoveJourneys.
+func (mr *MockRepoMockRecorder) MultiRemoveJourneys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiRemoveJourneys", reflect.TypeOf((*MockRepo)(nil).MultiRemoveJourneys), arg0, arg1)
+}
+
+// MultiUpdateJourneys mocks base method.
+func (m *MockRepo) MultiUpdateJourneys(arg0 context.Context, arg1 []models.Journey) (map[uint64]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultiUpdateJourneys", arg0, arg1)
+	ret0, _ := ret[0].(map[uint64]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultiUpdateJourneys indicates an expected call of MultiUpdateJourneys.
+func (mr *MockRepoMockRecorder) MultiUpdateJourneys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiUpdateJourneys", reflect.TypeOf((*MockRepo)(nil).MultiUpdateJourneys), arg0, arg1)
+}
+
+// PatchJourney mocks base method.
+func (m *MockRepo) PatchJourney(arg0 context.Context, arg1 models.Journey, arg2 []repo.JourneyField) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchJourney", arg0, arg1, arg2)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchJourney indicates an expected call of PatchJourney.
+func (mr *MockRepoMockRecorder) PatchJourney(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchJourney", reflect.TypeOf((*MockRepo)(nil).PatchJourney), arg0, arg1, arg2)
+}
+
+// PurgeJourneys mocks base method.
+func (m *MockRepo) PurgeJourneys(arg0 context.Context, arg1 time.Time, arg2 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeJourneys", arg0, arg1, arg2)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeJourneys indicates an expected call of PurgeJourneys.
+func (mr *MockRepoMockRecorder) PurgeJourneys(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeJourneys", reflect.TypeOf((*MockRepo)(nil).PurgeJourneys), arg0, arg1, arg2)
+}
+
 // RemoveJourney mocks base method.
-func (m *MockRepo) RemoveJourney(arg0 context.Context, arg1 uint64) error {
+func (m *MockRepo) RemoveJourney(arg0 context.Context, arg1, arg2 uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveJourney", arg0, arg1)
+	ret := m.ctrl.Call(m, "RemoveJourney", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveJourney indicates an expected call of RemoveJourney.
-func (mr *MockRepoMockRecorder) RemoveJourney(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockRepoMockRecorder) RemoveJourney(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveJourney", reflect.TypeOf((*MockRepo)(nil).RemoveJourney), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveJourney", reflect.TypeOf((*MockRepo)(nil).RemoveJourney), arg0, arg1, arg2)
 }
 
-// UpdateJourney mocks base method.
-func (m *MockRepo) UpdateJourney(arg0 context.Context, arg1 models.Journey) error {
+// RemoveParticipant mocks base method.
+func (m *MockRepo) RemoveParticipant(arg0 context.Context, arg1, arg2 uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateJourney", arg0, arg1)
+	ret := m.ctrl.Call(m, "RemoveParticipant", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveParticipant indicates an expected call of RemoveParticipant.
+func (mr *MockRepoMockRecorder) RemoveParticipant(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveParticipant", reflect.TypeOf((*MockRepo)(nil).RemoveParticipant), arg0, arg1, arg2)
+}
+
+// RemoveRecurringJourney mocks base method.
+func (m *MockRepo) RemoveRecurringJourney(arg0 context.Context, arg1, arg2 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRecurringJourney", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RemoveRecurringJourney indicates an expected call of RemoveRecurringJourney.
+func (mr *MockRepoMockRecorder) RemoveRecurringJourney(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRecurringJourney", reflect.TypeOf((*MockRepo)(nil).RemoveRecurringJourney), arg0, arg1, arg2)
+}
+
+// RemoveWaypoint mocks base method.
+func (m *MockRepo) RemoveWaypoint(arg0 context.Context, arg1, arg2 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWaypoint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveWaypoint indicates an expected call of RemoveWaypoint.
+func (mr *MockRepoMockRecorder) RemoveWaypoint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWaypoint", reflect.TypeOf((*MockRepo)(nil).RemoveWaypoint), arg0, arg1, arg2)
+}
+
+// ReorderWaypoints mocks base method.
+func (m *MockRepo) ReorderWaypoints(arg0 context.Context, arg1 uint64, arg2 []uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderWaypoints", arg0, arg1, arg2)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderWaypoints indicates an expected call of ReorderWaypoints.
+func (mr *MockRepoMockRecorder) ReorderWaypoints(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderWaypoints", reflect.TypeOf((*MockRepo)(nil).ReorderWaypoints), arg0, arg1, arg2)
+}
+
+// RestoreJourney mocks base method.
+func (m *MockRepo) RestoreJourney(arg0 context.Context, arg1 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreJourney", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreJourney indicates an expected call of RestoreJourney.
+func (mr *MockRepoMockRecorder) RestoreJourney(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreJourney", reflect.TypeOf((*MockRepo)(nil).RestoreJourney), arg0, arg1)
+}
+
+// SearchJourneys mocks base method.
+func (m *MockRepo) SearchJourneys(arg0 context.Context, arg1 string, arg2 repo.JourneyFilter, arg3, arg4 uint64) ([]repo.JourneySearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchJourneys", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]repo.JourneySearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchJourneys indicates an expected call of SearchJourneys.
+func (mr *MockRepoMockRecorder) SearchJourneys(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJourneys", reflect.TypeOf((*MockRepo)(nil).SearchJourneys), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SetOccurrenceException mocks base method.
+func (m *MockRepo) SetOccurrenceException(arg0 context.Context, arg1 uint64, arg2 models.OccurrenceException, arg3 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOccurrenceException", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOccurrenceException indicates an expected call of SetOccurrenceException.
+func (mr *MockRepoMockRecorder) SetOccurrenceException(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOccurrenceException", reflect.TypeOf((*MockRepo)(nil).SetOccurrenceException), arg0, arg1, arg2, arg3)
+}
+
+// UpdateJourney mocks base method.
+func (m *MockRepo) UpdateJourney(arg0 context.Context, arg1 models.Journey) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJourney", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateJourney indicates an expected call of UpdateJourney.
 func (mr *MockRepoMockRecorder) UpdateJourney(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJourney", reflect.TypeOf((*MockRepo)(nil).UpdateJourney), arg0, arg1)
 }
+
+// UpdateRecurringJourney mocks base method.
+func (m *MockRepo) UpdateRecurringJourney(arg0 context.Context, arg1 models.RecurringJourney) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurringJourney", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecurringJourney indicates an expected call of UpdateRecurringJourney.
+func (mr *MockRepoMockRecorder) UpdateRecurringJourney(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurringJourney", reflect.TypeOf((*MockRepo)(nil).UpdateRecurringJourney), arg0, arg1)
+}
+
+// WithTx mocks base method.
+func (m *MockRepo) WithTx(arg0 context.Context, arg1 func(repo.Repo) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepoMockRecorder) WithTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepo)(nil).WithTx), arg0, arg1)
+}
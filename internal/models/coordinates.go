@@ -0,0 +1,83 @@
+package models
+
+import "math"
+
+// EarthRadius - mean radius of the Earth in meters
+const EarthRadius = 6371008.8
+
+// Coordinates - geographic point in degrees (WGS 84)
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Valid - checks that latitude is in [-90, 90] and longitude is in [-180, 180]
+func (c Coordinates) Valid() bool {
+	return c.Latitude >= -90 && c.Latitude <= 90 && c.Longitude >= -180 && c.Longitude <= 180
+}
+
+// DistanceTo - returns great-circle distance to other point in meters calculated by haversine formula
+func (c Coordinates) DistanceTo(other Coordinates) float64 {
+	lat1, lat2 := toRadians(c.Latitude), toRadians(other.Latitude)
+	dLat := lat2 - lat1
+	dLon := toRadians(other.Longitude - c.Longitude)
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BoundingBox - represents range of coordinates, MinLongitude is greater than MaxLongitude
+// if box crosses the 180th meridian
+type BoundingBox struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// CrossesAntimeridian - checks that box crosses the 180th meridian, so it contains longitudes
+// from MinLongitude to 180 and from -180 to MaxLongitude
+func (b BoundingBox) CrossesAntimeridian() bool {
+	return b.MinLongitude > b.MaxLongitude
+}
+
+// BoundingBox - returns box containing all points within radius meters around the point,
+// box is wider than the circle so points in it must be checked with DistanceTo
+func (c Coordinates) BoundingBox(radius float64) BoundingBox {
+	angular := radius / EarthRadius
+	lat := toRadians(c.Latitude)
+	minLat, maxLat := lat-angular, lat+angular
+
+	// box containing a pole includes all longitudes
+	if minLat <= -math.Pi/2 || maxLat >= math.Pi/2 || angular >= math.Pi {
+		return BoundingBox{
+			MinLatitude:  toDegrees(math.Max(minLat, -math.Pi/2)),
+			MaxLatitude:  toDegrees(math.Min(maxLat, math.Pi/2)),
+			MinLongitude: -180,
+			MaxLongitude: 180,
+		}
+	}
+
+	dLon := toDegrees(math.Asin(math.Sin(angular) / math.Cos(lat)))
+	minLon, maxLon := c.Longitude-dLon, c.Longitude+dLon
+	if minLon < -180 {
+		minLon += 360
+	}
+	if maxLon > 180 {
+		maxLon -= 360
+	}
+	return BoundingBox{
+		MinLatitude:  toDegrees(minLat),
+		MaxLatitude:  toDegrees(maxLat),
+		MinLongitude: minLon,
+		MaxLongitude: maxLon,
+	}
+}
+
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoordinates_DistanceTo(t *testing.T) {
+	moscow := Coordinates{Latitude: 55.7558, Longitude: 37.6173}
+	petersburg := Coordinates{Latitude: 59.9343, Longitude: 30.3351}
+
+	assert.InDelta(t, 634000, moscow.DistanceTo(petersburg), 2000)
+	assert.InDelta(t, moscow.DistanceTo(petersburg), petersburg.DistanceTo(moscow), 1e-6)
+	assert.Equal(t, 0.0, moscow.DistanceTo(moscow))
+	assert.InDelta(t, 111195, Coordinates{Longitude: 179.5}.DistanceTo(Coordinates{Longitude: -179.5}), 1)
+}
+
+func TestCoordinates_BoundingBox(t *testing.T) {
+	testTable := []struct {
+		name   string
+		center Coordinates
+		radius float64
+	}{
+		{name: "middle latitude", center: Coordinates{Latitude: 55.7558, Longitude: 37.6173}, radius: 50000},
+		{name: "antimeridian", center: Coordinates{Latitude: 64.7337, Longitude: 177.5089}, radius: 300000},
+		{name: "pole", center: Coordinates{Latitude: 89.5, Longitude: 0}, radius: 100000},
+	}
+
+	for _, testCase := range testTable {
+		box := testCase.center.BoundingBox(testCase.radius)
+
+		// points on the circle in every direction must be in the box
+		for bearing := 0; bearing < 360; bearing += 15 {
+			point := destination(testCase.center, float64(bearing), testCase.radius*0.999)
+			assert.InDelta(t, testCase.radius*0.999, testCase.center.DistanceTo(point), 1, testCase.name)
+			assert.True(t, point.Latitude >= box.MinLatitude && point.Latitude <= box.MaxLatitude, testCase.name)
+			if box.CrossesAntimeridian() {
+				assert.True(t, point.Longitude >= box.MinLongitude || point.Longitude <= box.MaxLongitude, testCase.name)
+			} else {
+				assert.True(t, point.Longitude >= box.MinLongitude && point.Longitude <= box.MaxLongitude, testCase.name)
+			}
+		}
+	}
+
+	assert.True(t, Coordinates{Longitude: 179.9}.BoundingBox(100000).CrossesAntimeridian())
+	polar := Coordinates{Latitude: 85}.BoundingBox(toRadians(5) * EarthRadius)
+	assert.InDelta(t, 80, polar.MinLatitude, 1e-9)
+	assert.Equal(t, 90.0, polar.MaxLatitude)
+	assert.Equal(t, -180.0, polar.MinLongitude)
+	assert.Equal(t, 180.0, polar.MaxLongitude)
+}
+
+// destination - returns point at distance meters from start by initial bearing in degrees
+func destination(start Coordinates, bearing, distance float64) Coordinates {
+	angular := distance / EarthRadius
+	lat1, lon1, theta := toRadians(start.Latitude), toRadians(start.Longitude), toRadians(bearing)
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(angular) + math.Cos(lat1)*math.Sin(angular)*math.Cos(theta))
+	lon2 := lon1 + math.Atan2(math.Sin(theta)*math.Sin(angular)*math.Cos(lat1), math.Cos(angular)-math.Sin(lat1)*math.Sin(lat2))
+	lon := math.Mod(toDegrees(lon2)+540, 360) - 180
+	return Coordinates{Latitude: toDegrees(lat2), Longitude: lon}
+}
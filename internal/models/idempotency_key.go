@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// IdempotencyKey - represents result of request stored by key sent by client for safe retries of the request
+type IdempotencyKey struct {
+	// UserID - acting user of request, keys of different users do not intersect, 0 if authentication is disabled
+	UserID uint64
+	// Method - name of API method, keys of different methods do not intersect
+	Method string
+	Key    string
+	// RequestHash - hash of request payload, the same key with another payload is rejected
+	RequestHash string
+	// Response - serialized response of request, nil while request is in progress
+	Response  []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// IsPending - returns true if request with the key is not completed yet
+func (k *IdempotencyKey) IsPending() bool {
+	return k.Response == nil
+}
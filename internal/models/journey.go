@@ -5,6 +5,9 @@ import (
 	"time"
 )
 
+// InitialRevision - revision of created journey
+const InitialRevision uint64 = 1
+
 //Journey - represents the journey description object
 type Journey struct {
 	JourneyID   uint64
@@ -13,6 +16,24 @@ type Journey struct {
 	Description string
 	StartTime   time.Time
 	EndTime     time.Time
+	// TimeZone - IANA time zone name of the journey (e.g. "Europe/Moscow"), empty means UTC
+	TimeZone string
+	// Coordinates - optional geographic point of the address, nil if unknown
+	Coordinates *Coordinates
+	// Waypoints - stops within journey in order of route
+	Waypoints []Waypoint
+	// Tags - labels of journey like "business" or "vacation", normalized by NormalizeTags
+	Tags []string
+	// Status - stage of journey lifecycle, it is changed only by transitions allowed by JourneyStatus.CheckTransition
+	Status JourneyStatus
+	// Revision - incremented on every change of journey, used for optimistic concurrency control
+	Revision uint64
+	// DeletedAt - time of removing journey, zero if journey is not removed
+	DeletedAt time.Time
+	// RecurringJourneyID, OccurrenceTime - set only for occurrences of recurring journeys which are not stored
+	// and have no JourneyID, OccurrenceTime is start time of occurrence by rule before applying exception
+	RecurringJourneyID uint64
+	OccurrenceTime     time.Time
 }
 
 func (j *Journey) String() string {
@@ -27,6 +48,26 @@ func (j *Journey) String() string {
 	)
 }
 
+// Location - returns location of journey time zone, UTC is returned for empty or unknown time zone
+func (j *Journey) Location() *time.Location {
+	location, err := time.LoadLocation(j.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// Localize - converts start and end times of journey and times of its waypoints to its time zone,
+// instants of times are not changed
+func (j *Journey) Localize() {
+	location := j.Location()
+	j.StartTime = j.StartTime.In(location)
+	j.EndTime = j.EndTime.In(location)
+	for i := range j.Waypoints {
+		j.Waypoints[i].localize(location)
+	}
+}
+
 // NewJourney - creates new Journey object using arguments
 func NewJourney(journeyID uint64, userID uint64, address string, description string, startTime time.Time, endTime time.Time) *Journey {
 	return &Journey{
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+// JourneyStatus - stage of journey lifecycle, zero value is status of new journey
+type JourneyStatus int
+
+const (
+	// StatusPlanned - journey has not started yet
+	StatusPlanned JourneyStatus = iota
+	// StatusInProgress - journey has started
+	StatusInProgress
+	// StatusCompleted - journey has finished, status is final
+	StatusCompleted
+	// StatusCancelled - journey will not happen or was interrupted, status is final
+	StatusCancelled
+)
+
+var journeyStatusNames = map[JourneyStatus]string{
+	StatusPlanned:    "planned",
+	StatusInProgress: "in_progress",
+	StatusCompleted:  "completed",
+	StatusCancelled:  "cancelled",
+}
+
+// journeyStatusTransitions - statuses that can be set after status, final statuses are missing
+var journeyStatusTransitions = map[JourneyStatus][]JourneyStatus{
+	StatusPlanned:    {StatusInProgress, StatusCancelled},
+	StatusInProgress: {StatusCompleted, StatusCancelled},
+}
+
+func (s JourneyStatus) String() string {
+	if name, ok := journeyStatusNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("JourneyStatus(%d)", int(s))
+}
+
+// ParseJourneyStatus - returns JourneyStatus by its name: planned, in_progress, completed or cancelled
+func ParseJourneyStatus(name string) (JourneyStatus, error) {
+	for status, statusName := range journeyStatusNames {
+		if statusName == name {
+			return status, nil
+		}
+	}
+	return StatusPlanned, fmt.Errorf("unknown journey status %q", name)
+}
+
+// IsFinal - checks that status cannot be changed
+func (s JourneyStatus) IsFinal() bool {
+	return len(journeyStatusTransitions[s]) == 0
+}
+
+// CheckTransition - returns apperrors.FailedPrecondition error if journey with status s cannot get status to:
+// planned journey can be started or cancelled, journey in progress can be completed or cancelled
+func (s JourneyStatus) CheckTransition(to JourneyStatus) error {
+	for _, allowed := range journeyStatusTransitions[s] {
+		if allowed == to {
+			return nil
+		}
+	}
+	return apperrors.New(apperrors.FailedPrecondition, "journey status cannot be changed from %s to %s", s, to)
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+func TestParseJourneyStatus(t *testing.T) {
+	for _, status := range []JourneyStatus{StatusPlanned, StatusInProgress, StatusCompleted, StatusCancelled} {
+		parsed, err := ParseJourneyStatus(status.String())
+		assert.NoError(t, err, status.String())
+		assert.Equal(t, status, parsed)
+	}
+
+	_, err := ParseJourneyStatus("started")
+	assert.Error(t, err)
+}
+
+func TestJourneyStatus_CheckTransition(t *testing.T) {
+	testTable := []struct {
+		from, to JourneyStatus
+		allowed  bool
+	}{
+		{from: StatusPlanned, to: StatusInProgress, allowed: true},
+		{from: StatusPlanned, to: StatusCancelled, allowed: true},
+		{from: StatusPlanned, to: StatusCompleted},
+		{from: StatusPlanned, to: StatusPlanned},
+		{from: StatusInProgress, to: StatusCompleted, allowed: true},
+		{from: StatusInProgress, to: StatusCancelled, allowed: true},
+		{from: StatusInProgress, to: StatusPlanned},
+		{from: StatusCompleted, to: StatusCancelled},
+		{from: StatusCompleted, to: StatusInProgress},
+		{from: StatusCancelled, to: StatusInProgress},
+		{from: StatusCancelled, to: StatusCancelled},
+	}
+
+	for _, testCase := range testTable {
+		err := testCase.from.CheckTransition(testCase.to)
+
+		name := testCase.from.String() + " -> " + testCase.to.String()
+		if testCase.allowed {
+			assert.NoError(t, err, name)
+			continue
+		}
+		assert.True(t, apperrors.Is(err, apperrors.FailedPrecondition), name)
+	}
+}
+
+func TestJourneyStatus_IsFinal(t *testing.T) {
+	assert.False(t, StatusPlanned.IsFinal())
+	assert.False(t, StatusInProgress.IsFinal())
+	assert.True(t, StatusCompleted.IsFinal())
+	assert.True(t, StatusCancelled.IsFinal())
+}
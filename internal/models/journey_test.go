@@ -55,3 +55,25 @@ func TestNewJourney(t *testing.T) {
 
 	assert.Equal(t, journey, result, "should return equal journey")
 }
+
+func TestJourney_Localize(t *testing.T) {
+	start := time.Date(2021, 8, 14, 6, 30, 15, 1000, time.UTC)
+	journey := Journey{StartTime: start, EndTime: start.Add(time.Hour), TimeZone: "Europe/Moscow",
+		Waypoints: []Waypoint{{Address: "Химки", ArrivalTime: start.Add(time.Minute)}}}
+
+	journey.Localize()
+
+	assert.Equal(t, "Europe/Moscow", journey.StartTime.Location().String())
+	assert.Equal(t, 9, journey.StartTime.Hour())
+	assert.True(t, journey.StartTime.Equal(start))
+	assert.True(t, journey.EndTime.Equal(start.Add(time.Hour)))
+	assert.Equal(t, "Europe/Moscow", journey.Waypoints[0].ArrivalTime.Location().String())
+	assert.True(t, journey.Waypoints[0].ArrivalTime.Equal(start.Add(time.Minute)))
+	assert.True(t, journey.Waypoints[0].DepartureTime.IsZero(), "unknown time should stay zero")
+}
+
+func TestJourney_Location(t *testing.T) {
+	assert.Equal(t, time.UTC, (&Journey{}).Location())
+	assert.Equal(t, time.UTC, (&Journey{TimeZone: "Mars/Olympus"}).Location())
+	assert.Equal(t, "Asia/Novosibirsk", (&Journey{TimeZone: "Asia/Novosibirsk"}).Location().String())
+}
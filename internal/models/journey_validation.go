@@ -0,0 +1,96 @@
+package models
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+const (
+	// MaxJourneyDuration - maximum duration between start and end of journey
+	MaxJourneyDuration = 366 * 24 * time.Hour
+	// MaxAddressLength - maximum length of journey address in characters
+	MaxAddressLength = 512
+	// MaxDescriptionLength - maximum length of journey description in characters
+	MaxDescriptionLength = 4096
+)
+
+var (
+	// ErrUserIDRequired - occurs when journey has no user
+	ErrUserIDRequired = apperrors.New(apperrors.InvalidArgument, "journey user_id is required")
+	// ErrStartTimeRequired - occurs when journey has no start time
+	ErrStartTimeRequired = apperrors.New(apperrors.InvalidArgument, "journey start_time is required")
+	// ErrEndTimeRequired - occurs when journey has no end time
+	ErrEndTimeRequired = apperrors.New(apperrors.InvalidArgument, "journey end_time is required")
+	// ErrEndBeforeStart - occurs when journey ends before it starts
+	ErrEndBeforeStart = apperrors.New(apperrors.InvalidArgument, "journey end_time must not be before start_time")
+	// ErrDurationTooLong - occurs when journey is longer than MaxJourneyDuration
+	ErrDurationTooLong = apperrors.New(apperrors.InvalidArgument, "journey duration must not exceed %s", MaxJourneyDuration)
+	// ErrAddressRequired - occurs when journey has empty address
+	ErrAddressRequired = apperrors.New(apperrors.InvalidArgument, "journey address is required")
+	// ErrAddressTooLong - occurs when journey address is longer than MaxAddressLength
+	ErrAddressTooLong = apperrors.New(apperrors.InvalidArgument, "journey address must not exceed %d characters", MaxAddressLength)
+	// ErrDescriptionTooLong - occurs when journey description is longer than MaxDescriptionLength
+	ErrDescriptionTooLong = apperrors.New(apperrors.InvalidArgument, "journey description must not exceed %d characters", MaxDescriptionLength)
+	// ErrInvalidTimeZone - occurs when journey time zone is not a known IANA time zone name
+	ErrInvalidTimeZone = apperrors.New(apperrors.InvalidArgument, "journey time_zone must be an IANA time zone name")
+	// ErrInvalidCoordinates - occurs when journey latitude or longitude is out of range
+	ErrInvalidCoordinates = apperrors.New(apperrors.InvalidArgument, "journey latitude must be in [-90, 90] and longitude in [-180, 180]")
+)
+
+// Validate - checks domain rules of journey: user, start and end times are required, journey cannot end before start
+// and be longer than MaxJourneyDuration, address is required and address and description lengths are limited,
+// time zone must be empty or a known IANA time zone name, coordinates must be in range if set,
+// number of waypoints and tags is limited, every waypoint must be valid and every tag must be normalized.
+// Returns first found violation as apperrors.InvalidArgument error.
+func (j *Journey) Validate() error {
+	switch {
+	case j.UserID == 0:
+		return ErrUserIDRequired
+	case j.StartTime.IsZero():
+		return ErrStartTimeRequired
+	case j.EndTime.IsZero():
+		return ErrEndTimeRequired
+	case j.EndTime.Before(j.StartTime):
+		return ErrEndBeforeStart
+	case j.EndTime.Sub(j.StartTime) > MaxJourneyDuration:
+		return ErrDurationTooLong
+	case strings.TrimSpace(j.Address) == "":
+		return ErrAddressRequired
+	case utf8.RuneCountInString(j.Address) > MaxAddressLength:
+		return ErrAddressTooLong
+	case utf8.RuneCountInString(j.Description) > MaxDescriptionLength:
+		return ErrDescriptionTooLong
+	case !isValidTimeZone(j.TimeZone):
+		return ErrInvalidTimeZone
+	case j.Coordinates != nil && !j.Coordinates.Valid():
+		return ErrInvalidCoordinates
+	case len(j.Waypoints) > MaxWaypoints:
+		return ErrTooManyWaypoints
+	case len(j.Tags) > MaxTags:
+		return ErrTooManyTags
+	}
+	for _, tag := range j.Tags {
+		if !isValidTag(tag) {
+			return ErrInvalidTag
+		}
+	}
+	for i := range j.Waypoints {
+		if err := j.Waypoints[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isValidTimeZone - checks that time zone is empty or can be loaded from the IANA time zone database,
+// "Local" is rejected because it depends on the server settings
+func isValidTimeZone(timeZone string) bool {
+	if timeZone == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(timeZone)
+	return err == nil
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+func TestJourney_Validate(t *testing.T) {
+	start := time.Date(2021, 8, 14, 9, 0, 0, 0, time.UTC)
+	valid := Journey{
+		UserID:      1,
+		Address:     "Воронеж",
+		Description: "Поездка на выходные",
+		StartTime:   start,
+		EndTime:     start.Add(36 * time.Hour),
+	}
+
+	testTable := []struct {
+		name   string
+		modify func(j *Journey)
+		err    error
+	}{
+		{name: "valid journey", modify: func(j *Journey) {}},
+		{name: "same start and end", modify: func(j *Journey) { j.EndTime = j.StartTime }},
+		{name: "maximum duration", modify: func(j *Journey) { j.EndTime = j.StartTime.Add(MaxJourneyDuration) }},
+		{name: "maximum lengths", modify: func(j *Journey) {
+			j.Address = strings.Repeat("ы", MaxAddressLength)
+			j.Description = strings.Repeat("ы", MaxDescriptionLength)
+		}},
+		{name: "time zone", modify: func(j *Journey) { j.TimeZone = "Europe/Moscow" }},
+		{name: "coordinates", modify: func(j *Journey) { j.Coordinates = &Coordinates{Latitude: -90, Longitude: 180} }},
+		{name: "no user", modify: func(j *Journey) { j.UserID = 0 }, err: ErrUserIDRequired},
+		{name: "no start time", modify: func(j *Journey) { j.StartTime = time.Time{} }, err: ErrStartTimeRequired},
+		{name: "no end time", modify: func(j *Journey) { j.EndTime = time.Time{} }, err: ErrEndTimeRequired},
+		{name: "end before start", modify: func(j *Journey) { j.EndTime = j.StartTime.Add(-time.Second) }, err: ErrEndBeforeStart},
+		{name: "too long duration", modify: func(j *Journey) { j.EndTime = j.StartTime.Add(MaxJourneyDuration + time.Second) }, err: ErrDurationTooLong},
+		{name: "empty address", modify: func(j *Journey) { j.Address = " " }, err: ErrAddressRequired},
+		{name: "too long address", modify: func(j *Journey) { j.Address = strings.Repeat("ы", MaxAddressLength+1) }, err: ErrAddressTooLong},
+		{name: "too long description", modify: func(j *Journey) { j.Description = strings.Repeat("ы", MaxDescriptionLength+1) }, err: ErrDescriptionTooLong},
+		{name: "unknown time zone", modify: func(j *Journey) { j.TimeZone = "Mars/Olympus" }, err: ErrInvalidTimeZone},
+		{name: "local time zone", modify: func(j *Journey) { j.TimeZone = "Local" }, err: ErrInvalidTimeZone},
+		{name: "invalid latitude", modify: func(j *Journey) { j.Coordinates = &Coordinates{Latitude: 91} }, err: ErrInvalidCoordinates},
+		{name: "invalid longitude", modify: func(j *Journey) { j.Coordinates = &Coordinates{Longitude: math.NaN()} }, err: ErrInvalidCoordinates},
+		{name: "waypoints", modify: func(j *Journey) {
+			j.Waypoints = []Waypoint{
+				{Address: "Рамонь", Coordinates: &Coordinates{Latitude: 51.9, Longitude: 39.3}, ArrivalTime: start, DepartureTime: start},
+				{Address: "Задонск", ArrivalTime: start.Add(time.Hour)},
+				{Address: "Елец", DepartureTime: start},
+			}
+		}},
+		{name: "too many waypoints", modify: func(j *Journey) { j.Waypoints = make([]Waypoint, MaxWaypoints+1) }, err: ErrTooManyWaypoints},
+		{name: "waypoint without address", modify: func(j *Journey) { j.Waypoints = []Waypoint{{Address: "Елец"}, {}} }, err: ErrWaypointAddressRequired},
+		{name: "too long waypoint address", modify: func(j *Journey) {
+			j.Waypoints = []Waypoint{{Address: strings.Repeat("ы", MaxAddressLength+1)}}
+		}, err: ErrWaypointAddressTooLong},
+		{name: "invalid waypoint coordinates", modify: func(j *Journey) {
+			j.Waypoints = []Waypoint{{Address: "Елец", Coordinates: &Coordinates{Latitude: -91}}}
+		}, err: ErrInvalidWaypointCoordinates},
+		{name: "departure before arrival", modify: func(j *Journey) {
+			j.Waypoints = []Waypoint{{Address: "Елец", ArrivalTime: start, DepartureTime: start.Add(-time.Minute)}}
+		}, err: ErrDepartureBeforeArrival},
+		{name: "tags", modify: func(j *Journey) { j.Tags = []string{"business", "командировка"} }},
+		{name: "too many tags", modify: func(j *Journey) { j.Tags = make([]string, MaxTags+1) }, err: ErrTooManyTags},
+		{name: "not normalized tag", modify: func(j *Journey) { j.Tags = []string{"Business"} }, err: ErrInvalidTag},
+	}
+
+	for _, testCase := range testTable {
+		journey := valid
+		testCase.modify(&journey)
+
+		err := journey.Validate()
+
+		if testCase.err == nil {
+			assert.NoError(t, err, testCase.name)
+			continue
+		}
+		assert.ErrorIs(t, err, testCase.err, testCase.name)
+		assert.True(t, apperrors.Is(err, apperrors.InvalidArgument), testCase.name)
+	}
+}
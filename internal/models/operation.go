@@ -0,0 +1,63 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// OperationType - represents kind of asynchronous operation with journeys
+type OperationType string
+
+const (
+	// CreateOperation - create journey via Kafka
+	CreateOperation OperationType = "create"
+	// MultiCreateOperation - create several journeys via Kafka
+	MultiCreateOperation OperationType = "multi_create"
+	// UpdateOperation - update journey via Kafka
+	UpdateOperation OperationType = "update"
+	// RemoveOperation - remove journey via Kafka
+	RemoveOperation OperationType = "remove"
+	// MultiUpdateOperation - update several journeys via Kafka
+	MultiUpdateOperation OperationType = "multi_update"
+	// MultiRemoveOperation - remove several journeys via Kafka
+	MultiRemoveOperation OperationType = "multi_remove"
+)
+
+// OperationStatus - represents state of asynchronous operation with journeys
+type OperationStatus string
+
+const (
+	// OperationPending - operation is sent to Kafka and not processed yet
+	OperationPending OperationStatus = "pending"
+	// OperationSucceeded - all parts of operation were applied to the storage
+	OperationSucceeded OperationStatus = "succeeded"
+	// OperationFailed - operation or any of its parts failed
+	OperationFailed OperationStatus = "failed"
+)
+
+// Operation - represents state of asynchronous operation with journeys sent via Kafka,
+// UserID is id of user who created the operation, it is 0 for operations of trusted internal clients
+type Operation struct {
+	OperationID   uint64
+	UserID        uint64
+	Type          OperationType
+	Status        OperationStatus
+	PendingChunks uint
+	JourneyIDs    []uint64
+	Error         string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (o *Operation) String() string {
+	return fmt.Sprintf(
+		"Operation: Id = %d, UserId = %d, Type = %s, Status = %s, PendingChunks = %d, JourneyIDs = %v, Error = %s",
+		o.OperationID,
+		o.UserID,
+		o.Type,
+		o.Status,
+		o.PendingChunks,
+		o.JourneyIDs,
+		o.Error,
+	)
+}
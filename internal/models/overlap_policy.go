@@ -0,0 +1,35 @@
+package models
+
+import "fmt"
+
+// OverlapPolicy - defines how journeys of the same user overlapping in time are handled on saving
+type OverlapPolicy int
+
+const (
+	// OverlapAllow - journey is saved without checking overlaps
+	OverlapAllow OverlapPolicy = iota
+	// OverlapWarn - journey is saved, overlapping journeys are reported to client
+	OverlapWarn
+	// OverlapReject - journey overlapping other journeys of the user is not saved
+	OverlapReject
+)
+
+var overlapPolicyNames = map[string]OverlapPolicy{
+	"allow":  OverlapAllow,
+	"warn":   OverlapWarn,
+	"reject": OverlapReject,
+}
+
+// ParseOverlapPolicy - returns OverlapPolicy by its name: allow, warn or reject
+func ParseOverlapPolicy(name string) (OverlapPolicy, error) {
+	policy, ok := overlapPolicyNames[name]
+	if !ok {
+		return OverlapAllow, fmt.Errorf("unknown overlap policy %q", name)
+	}
+	return policy, nil
+}
+
+// Overlaps - checks that time ranges [StartTime, EndTime) of journeys intersect
+func (j *Journey) Overlaps(other Journey) bool {
+	return j.StartTime.Before(other.EndTime) && other.StartTime.Before(j.EndTime)
+}
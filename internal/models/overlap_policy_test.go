@@ -0,0 +1,41 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOverlapPolicy(t *testing.T) {
+	for name, expected := range map[string]OverlapPolicy{"allow": OverlapAllow, "warn": OverlapWarn, "reject": OverlapReject} {
+		policy, err := ParseOverlapPolicy(name)
+		assert.NoError(t, err, name)
+		assert.Equal(t, expected, policy, name)
+	}
+
+	_, err := ParseOverlapPolicy("deny")
+	assert.Error(t, err)
+}
+
+func TestJourney_Overlaps(t *testing.T) {
+	start := time.Date(2021, 8, 14, 9, 0, 0, 0, time.UTC)
+	journey := Journey{StartTime: start, EndTime: start.Add(2 * time.Hour)}
+
+	testTable := []struct {
+		name     string
+		other    Journey
+		overlaps bool
+	}{
+		{name: "inside", other: Journey{StartTime: start.Add(time.Hour), EndTime: start.Add(90 * time.Minute)}, overlaps: true},
+		{name: "crossing start", other: Journey{StartTime: start.Add(-time.Hour), EndTime: start.Add(time.Minute)}, overlaps: true},
+		{name: "same range", other: journey, overlaps: true},
+		{name: "ends at start", other: Journey{StartTime: start.Add(-time.Hour), EndTime: start}, overlaps: false},
+		{name: "starts at end", other: Journey{StartTime: start.Add(2 * time.Hour), EndTime: start.Add(3 * time.Hour)}, overlaps: false},
+	}
+
+	for _, testCase := range testTable {
+		assert.Equal(t, testCase.overlaps, journey.Overlaps(testCase.other), testCase.name)
+		assert.Equal(t, testCase.overlaps, testCase.other.Overlaps(journey), testCase.name)
+	}
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParticipantRole - defines what user can do with journey, greater role includes permissions of lesser ones
+type ParticipantRole int
+
+const (
+	// RoleNone - user does not participate in journey
+	RoleNone ParticipantRole = iota
+	// RoleViewer - user can see journey
+	RoleViewer
+	// RoleEditor - user can also change journey and its waypoints
+	RoleEditor
+	// RoleOwner - user of journey, owner can also remove journey, change its owner and manage participants
+	RoleOwner
+)
+
+var participantRoleNames = map[ParticipantRole]string{
+	RoleViewer: "viewer",
+	RoleEditor: "editor",
+	RoleOwner:  "owner",
+}
+
+func (r ParticipantRole) String() string {
+	if name, ok := participantRoleNames[r]; ok {
+		return name
+	}
+	return "none"
+}
+
+// ParseParticipantRole - returns ParticipantRole by its name: viewer, editor or owner
+func ParseParticipantRole(name string) (ParticipantRole, error) {
+	for role, roleName := range participantRoleNames {
+		if roleName == name {
+			return role, nil
+		}
+	}
+	return RoleNone, fmt.Errorf("unknown participant role %q", name)
+}
+
+// Participant - represents user sharing journey with its owner
+type Participant struct {
+	UserID uint64
+	Role   ParticipantRole
+	// InvitedAt - time of inviting user, zero for owner of journey
+	InvitedAt time.Time
+}
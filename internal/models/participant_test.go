@@ -0,0 +1,26 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseParticipantRole(t *testing.T) {
+	for _, role := range []ParticipantRole{RoleViewer, RoleEditor, RoleOwner} {
+		parsed, err := ParseParticipantRole(role.String())
+		assert.NoError(t, err, role.String())
+		assert.Equal(t, role, parsed)
+	}
+
+	_, err := ParseParticipantRole("none")
+	assert.Error(t, err)
+	_, err = ParseParticipantRole("admin")
+	assert.Error(t, err)
+}
+
+func TestParticipantRole_Order(t *testing.T) {
+	assert.True(t, RoleNone < RoleViewer)
+	assert.True(t, RoleViewer < RoleEditor)
+	assert.True(t, RoleEditor < RoleOwner)
+}
@@ -0,0 +1,464 @@
+package models
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+const (
+	// MaxRecurrenceCount - maximum COUNT of recurrence rule
+	MaxRecurrenceCount = 1000
+	// maxRecurrenceYears - occurrences are not searched further than this number of years after the first one
+	maxRecurrenceYears = 100
+	// untilLayout - layout of UTC date-time value of UNTIL
+	untilLayout = "20060102T150405Z"
+	// untilDateLayout - layout of date value of UNTIL
+	untilDateLayout = "20060102"
+)
+
+// Frequency - FREQ part of recurrence rule
+type Frequency int
+
+const (
+	// FrequencyDaily - journey is repeated every INTERVAL days
+	FrequencyDaily Frequency = iota + 1
+	// FrequencyWeekly - journey is repeated every INTERVAL weeks
+	FrequencyWeekly
+	// FrequencyMonthly - journey is repeated every INTERVAL months
+	FrequencyMonthly
+	// FrequencyYearly - journey is repeated every INTERVAL years
+	FrequencyYearly
+)
+
+var frequencyNames = map[Frequency]string{
+	FrequencyDaily:   "DAILY",
+	FrequencyWeekly:  "WEEKLY",
+	FrequencyMonthly: "MONTHLY",
+	FrequencyYearly:  "YEARLY",
+}
+
+var weekdayNames = map[time.Weekday]string{
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+	time.Sunday:    "SU",
+}
+
+// WeekdayNum - BYDAY value, N is number of the weekday within month (1 is first, -1 is last), 0 means every such weekday
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return weekdayNames[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdayNames[w.Weekday]
+}
+
+// RecurrenceRule - subset of RFC 5545 RRULE supported for recurring journeys: FREQ (DAILY, WEEKLY, MONTHLY or YEARLY),
+// INTERVAL, COUNT, UNTIL (UTC date-time or date meaning the end of the day in UTC), BYDAY, BYMONTHDAY, BYMONTH and WKST.
+// Numbered BYDAY values like "-1FR" are supported for MONTHLY rules and YEARLY rules with BYMONTH
+type RecurrenceRule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+	WeekStart  time.Weekday
+}
+
+// invalidRule - returns apperrors.InvalidArgument error describing problem of recurrence rule
+func invalidRule(format string, args ...interface{}) error {
+	return apperrors.New(apperrors.InvalidArgument, "invalid recurrence rule: "+format, args...)
+}
+
+// ParseRecurrenceRule - parses RRULE value like "FREQ=WEEKLY;BYDAY=MO,WE", "RRULE:" prefix is allowed.
+// Returns apperrors.InvalidArgument error for malformed rule or parts which are not supported
+func ParseRecurrenceRule(value string) (RecurrenceRule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	rule := RecurrenceRule{Interval: 1, WeekStart: time.Monday}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		pair := strings.SplitN(part, "=", 2)
+		if len(pair) != 2 || pair[1] == "" {
+			return RecurrenceRule{}, invalidRule("part %q must be NAME=VALUE", part)
+		}
+		name, val := strings.ToUpper(pair[0]), strings.ToUpper(pair[1])
+		if seen[name] {
+			return RecurrenceRule{}, invalidRule("duplicate %s", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Freq, err = parseFrequency(val)
+		case "INTERVAL":
+			rule.Interval, err = parseRuleNumber(name, val, 1, 1000)
+		case "COUNT":
+			rule.Count, err = parseRuleNumber(name, val, 1, MaxRecurrenceCount)
+		case "UNTIL":
+			rule.Until, err = parseUntil(val)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(val)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseByMonthDay(val)
+		case "BYMONTH":
+			rule.ByMonth, err = parseByMonth(val)
+		case "WKST":
+			rule.WeekStart, err = parseWeekday(val)
+		default:
+			err = invalidRule("%s is not supported", name)
+		}
+		if err != nil {
+			return RecurrenceRule{}, err
+		}
+	}
+
+	if err := rule.check(); err != nil {
+		return RecurrenceRule{}, err
+	}
+	return rule, nil
+}
+
+// check - checks combination of parsed parts
+func (r RecurrenceRule) check() error {
+	switch {
+	case r.Freq == 0:
+		return invalidRule("FREQ is required")
+	case r.Count > 0 && !r.Until.IsZero():
+		return invalidRule("COUNT and UNTIL cannot be used together")
+	case r.Freq == FrequencyWeekly && len(r.ByMonthDay) > 0:
+		return invalidRule("BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+	numbered := r.Freq == FrequencyMonthly || (r.Freq == FrequencyYearly && len(r.ByMonth) > 0)
+	for _, day := range r.ByDay {
+		if day.N != 0 && !numbered {
+			return invalidRule("numbered BYDAY %s requires FREQ=MONTHLY or FREQ=YEARLY with BYMONTH", day)
+		}
+	}
+	return nil
+}
+
+func parseFrequency(value string) (Frequency, error) {
+	for freq, name := range frequencyNames {
+		if name == value {
+			return freq, nil
+		}
+	}
+	return 0, invalidRule("FREQ=%s is not supported", value)
+}
+
+func parseRuleNumber(name, value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, invalidRule("%s must be integer in [%d, %d]", name, min, max)
+	}
+	return n, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if until, err := time.Parse(untilLayout, value); err == nil {
+		return until, nil
+	}
+	if date, err := time.Parse(untilDateLayout, value); err == nil {
+		return date.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, invalidRule("UNTIL must be UTC date-time like 20211231T235959Z or date like 20211231")
+}
+
+func parseWeekday(value string) (time.Weekday, error) {
+	for weekday, name := range weekdayNames {
+		if name == value {
+			return weekday, nil
+		}
+	}
+	return 0, invalidRule("unknown weekday %q", value)
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, invalidRule("unknown weekday %q", item)
+		}
+		weekday, err := parseWeekday(item[len(item)-2:])
+		if err != nil {
+			return nil, err
+		}
+		day := WeekdayNum{Weekday: weekday}
+		if prefix := item[:len(item)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, invalidRule("number of BYDAY %q must be in [-5, 5] and not 0", item)
+			}
+			day.N = n
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		day, err := strconv.Atoi(item)
+		if err != nil || day == 0 || day < -31 || day > 31 {
+			return nil, invalidRule("BYMONTHDAY must be in [-31, 31] and not 0")
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func parseByMonth(value string) ([]time.Month, error) {
+	var months []time.Month
+	for _, item := range strings.Split(value, ",") {
+		month, err := parseRuleNumber("BYMONTH", item, 1, 12)
+		if err != nil {
+			return nil, err
+		}
+		months = append(months, time.Month(month))
+	}
+	return months, nil
+}
+
+// String - returns rule in canonical form
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + frequencyNames[r.Freq]}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayout))
+	}
+	if len(r.ByMonth) > 0 {
+		months := make([]string, len(r.ByMonth))
+		for i, month := range r.ByMonth {
+			months[i] = strconv.Itoa(int(month))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(months, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, day := range r.ByMonthDay {
+			days[i] = strconv.Itoa(day)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = day.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayNames[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences - calls yield with start times of occurrences in order beginning from start of the first occurrence
+// until yield returns false or occurrences end. As DTSTART of RFC 5545, start is always the first occurrence
+// counted by Count even if it does not match the rule. Occurrences have the wall clock time of start in its location,
+// days which do not exist (like February 30) are skipped
+func (r RecurrenceRule) Occurrences(start time.Time, yield func(time.Time) bool) {
+	if !yield(start) || r.Count == 1 {
+		return
+	}
+
+	location := start.Location()
+	year, month, day := start.Date()
+	hour, minute, second := start.Clock()
+	// dates are iterated in UTC to avoid daylight saving time shifts
+	first := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	horizon := first.AddDate(maxRecurrenceYears, 0, 0)
+
+	count := 1
+	for period := 0; ; period++ {
+		periodStart, dates := r.periodDates(first, period)
+		if periodStart.After(horizon) {
+			return
+		}
+		for _, date := range dates {
+			occurrence := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, second, start.Nanosecond(), location)
+			if !occurrence.After(start) {
+				continue
+			}
+			if !r.Until.IsZero() && occurrence.After(r.Until) {
+				return
+			}
+			count++
+			if !yield(occurrence) || (r.Count > 0 && count >= r.Count) {
+				return
+			}
+		}
+	}
+}
+
+// periodDates - returns start of period with number n (in units of interval) and sorted dates of occurrences in it
+func (r RecurrenceRule) periodDates(first time.Time, n int) (time.Time, []time.Time) {
+	switch r.Freq {
+	case FrequencyDaily:
+		date := first.AddDate(0, 0, n*r.Interval)
+		if r.matchesDay(date) {
+			return date, []time.Time{date}
+		}
+		return date, nil
+	case FrequencyWeekly:
+		offset := (int(first.Weekday()) - int(r.WeekStart) + 7) % 7
+		periodStart := first.AddDate(0, 0, 7*n*r.Interval-offset)
+		var dates []time.Time
+		for i := 0; i < 7; i++ {
+			date := periodStart.AddDate(0, 0, i)
+			if len(r.ByDay) == 0 && date.Weekday() != first.Weekday() {
+				continue
+			}
+			if r.matchesDay(date) {
+				dates = append(dates, date)
+			}
+		}
+		return periodStart, dates
+	case FrequencyMonthly:
+		periodStart := time.Date(first.Year(), first.Month()+time.Month(n*r.Interval), 1, 0, 0, 0, 0, time.UTC)
+		if len(r.ByMonth) > 0 && !containsMonth(r.ByMonth, periodStart.Month()) {
+			return periodStart, nil
+		}
+		return periodStart, r.monthDates(periodStart, first.Day())
+	default:
+		periodStart := time.Date(first.Year()+n*r.Interval, time.January, 1, 0, 0, 0, 0, time.UTC)
+		months := r.ByMonth
+		if len(months) == 0 {
+			if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+				months = []time.Month{first.Month()}
+			} else {
+				months = []time.Month{time.January, time.February, time.March, time.April, time.May, time.June,
+					time.July, time.August, time.September, time.October, time.November, time.December}
+			}
+		}
+		var dates []time.Time
+		for _, month := range months {
+			dates = append(dates, r.monthDates(time.Date(periodStart.Year(), month, 1, 0, 0, 0, 0, time.UTC), first.Day())...)
+		}
+		return periodStart, sortUniqueDates(dates)
+	}
+}
+
+// matchesDay - checks BYMONTH, BYMONTHDAY and not numbered BYDAY limits of DAILY and WEEKLY rules
+func (r RecurrenceRule) matchesDay(date time.Time) bool {
+	if len(r.ByMonth) > 0 && !containsMonth(r.ByMonth, date.Month()) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := daysInMonth(date)
+		matched := false
+		for _, day := range r.ByMonthDay {
+			matched = matched || monthDay(day, days) == date.Day()
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(r.ByDay) > 0 {
+		matched := false
+		for _, day := range r.ByDay {
+			matched = matched || day.Weekday == date.Weekday()
+		}
+		return matched
+	}
+	return true
+}
+
+// monthDates - returns sorted dates of month matching BYMONTHDAY and BYDAY, both of them must match if they are set,
+// day of the first occurrence is used if neither is set
+func (r RecurrenceRule) monthDates(monthStart time.Time, firstDay int) []time.Time {
+	days := daysInMonth(monthStart)
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		if firstDay > days {
+			return nil
+		}
+		return []time.Time{monthStart.AddDate(0, 0, firstDay-1)}
+	}
+
+	var byMonthDay, byDay map[int]bool
+	if len(r.ByMonthDay) > 0 {
+		byMonthDay = make(map[int]bool)
+		for _, day := range r.ByMonthDay {
+			byMonthDay[monthDay(day, days)] = true
+		}
+	}
+	if len(r.ByDay) > 0 {
+		byDay = make(map[int]bool)
+		for _, weekdayNum := range r.ByDay {
+			// first day of month having the weekday
+			firstWeekday := 1 + (int(weekdayNum.Weekday)-int(monthStart.Weekday())+7)%7
+			switch {
+			case weekdayNum.N > 0:
+				byDay[firstWeekday+7*(weekdayNum.N-1)] = true
+			case weekdayNum.N < 0:
+				lastWeekday := firstWeekday + 7*((days-firstWeekday)/7)
+				byDay[lastWeekday+7*(weekdayNum.N+1)] = true
+			default:
+				for day := firstWeekday; day <= days; day += 7 {
+					byDay[day] = true
+				}
+			}
+		}
+	}
+
+	var dates []time.Time
+	for day := 1; day <= days; day++ {
+		if (byMonthDay == nil || byMonthDay[day]) && (byDay == nil || byDay[day]) {
+			dates = append(dates, monthStart.AddDate(0, 0, day-1))
+		}
+	}
+	return dates
+}
+
+// monthDay - converts BYMONTHDAY value to day of month, negative values are counted from the end of month
+func monthDay(day, days int) int {
+	if day < 0 {
+		return days + day + 1
+	}
+	return day
+}
+
+func daysInMonth(date time.Time) int {
+	return time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func containsMonth(months []time.Month, month time.Month) bool {
+	for _, m := range months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+// sortUniqueDates - sorts dates and removes duplicates coming from repeated BYMONTH values
+func sortUniqueDates(dates []time.Time) []time.Time {
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	unique := dates[:0]
+	for i, date := range dates {
+		if i == 0 || !date.Equal(dates[i-1]) {
+			unique = append(unique, date)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,182 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	testTable := []struct {
+		value     string
+		canonical string
+	}{
+		{value: "FREQ=DAILY", canonical: "FREQ=DAILY"},
+		{value: "RRULE:freq=weekly;byday=mo,fr;interval=2", canonical: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR"},
+		{value: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=12", canonical: "FREQ=MONTHLY;COUNT=12;BYDAY=-1FR"},
+		{value: "FREQ=YEARLY;BYMONTH=3;BYDAY=2SU;UNTIL=20301231", canonical: "FREQ=YEARLY;UNTIL=20301231T235959Z;BYMONTH=3;BYDAY=2SU"},
+		{value: "FREQ=MONTHLY;BYMONTHDAY=1,-1;WKST=SU", canonical: "FREQ=MONTHLY;BYMONTHDAY=1,-1;WKST=SU"},
+	}
+	for _, testCase := range testTable {
+		t.Run(testCase.value, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(testCase.value)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.canonical, rule.String())
+		})
+	}
+}
+
+func TestParseRecurrenceRule_Invalid(t *testing.T) {
+	for _, value := range []string{
+		"",
+		"BYDAY=MO",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=1001",
+		"FREQ=DAILY;COUNT=2;UNTIL=20211231",
+		"FREQ=DAILY;UNTIL=2021-12-31",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=YEARLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=6MO",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=YEARLY;BYMONTH=13",
+		"FREQ=DAILY;BYSETPOS=1",
+	} {
+		t.Run(value, func(t *testing.T) {
+			_, err := ParseRecurrenceRule(value)
+			assert.True(t, apperrors.Is(err, apperrors.InvalidArgument), "error: %v", err)
+		})
+	}
+}
+
+func TestRecurrenceRule_Occurrences(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	assert.NoError(t, err)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 8, 30, 0, 0, moscow)
+	}
+
+	testTable := []struct {
+		name     string
+		rule     string
+		start    time.Time
+		limit    int
+		expected []time.Time
+	}{
+		{
+			name:     "daily with count",
+			rule:     "FREQ=DAILY;COUNT=3",
+			start:    date(2021, 12, 30),
+			limit:    10,
+			expected: []time.Time{date(2021, 12, 30), date(2021, 12, 31), date(2022, 1, 1)},
+		},
+		{
+			name:     "weekdays of every other week",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			start:    date(2021, 11, 3), // Wednesday
+			limit:    4,
+			expected: []time.Time{date(2021, 11, 3), date(2021, 11, 5), date(2021, 11, 15), date(2021, 11, 19)},
+		},
+		{
+			name:     "weekly on day of start until date",
+			rule:     "FREQ=WEEKLY;UNTIL=20211117",
+			start:    date(2021, 11, 3),
+			limit:    10,
+			expected: []time.Time{date(2021, 11, 3), date(2021, 11, 10), date(2021, 11, 17)},
+		},
+		{
+			name:     "last friday of month",
+			rule:     "FREQ=MONTHLY;BYDAY=-1FR",
+			start:    date(2021, 10, 1),
+			limit:    3,
+			expected: []time.Time{date(2021, 10, 1), date(2021, 10, 29), date(2021, 11, 26)},
+		},
+		{
+			name:     "monthly skips missing days",
+			rule:     "FREQ=MONTHLY",
+			start:    date(2021, 1, 31),
+			limit:    3,
+			expected: []time.Time{date(2021, 1, 31), date(2021, 3, 31), date(2021, 5, 31)},
+		},
+		{
+			name:     "last day of month",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start:    date(2021, 1, 15),
+			limit:    2,
+			expected: []time.Time{date(2021, 1, 15), date(2021, 1, 31)},
+		},
+		{
+			name:     "yearly in months",
+			rule:     "FREQ=YEARLY;BYMONTH=6,1",
+			start:    date(2021, 3, 10),
+			limit:    3,
+			expected: []time.Time{date(2021, 3, 10), date(2021, 6, 10), date(2022, 1, 10)},
+		},
+		{
+			name:     "start not matching rule is counted",
+			rule:     "FREQ=WEEKLY;BYDAY=MO;COUNT=3",
+			start:    date(2021, 11, 3), // Wednesday
+			limit:    10,
+			expected: []time.Time{date(2021, 11, 3), date(2021, 11, 8), date(2021, 11, 15)},
+		},
+		{
+			name:     "start is first occurrence with count of one",
+			rule:     "FREQ=DAILY;COUNT=1",
+			start:    date(2021, 11, 3),
+			limit:    10,
+			expected: []time.Time{date(2021, 11, 3)},
+		},
+		{
+			name:     "february 29 exists every fourth year",
+			rule:     "FREQ=YEARLY",
+			start:    date(2020, 2, 29),
+			limit:    2,
+			expected: []time.Time{date(2020, 2, 29), date(2024, 2, 29)},
+		},
+		{
+			name:  "wall clock is kept over daylight saving time change",
+			rule:  "FREQ=DAILY;COUNT=2",
+			start: time.Date(2021, 10, 30, 9, 0, 0, 0, berlin),
+			limit: 10,
+			expected: []time.Time{
+				time.Date(2021, 10, 30, 9, 0, 0, 0, berlin),
+				time.Date(2021, 10, 31, 9, 0, 0, 0, berlin),
+			},
+		},
+	}
+	for _, testCase := range testTable {
+		t.Run(testCase.name, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(testCase.rule)
+			assert.NoError(t, err)
+
+			var occurrences []time.Time
+			rule.Occurrences(testCase.start, func(occurrence time.Time) bool {
+				occurrences = append(occurrences, occurrence)
+				return len(occurrences) < testCase.limit
+			})
+			assert.Equal(t, testCase.expected, occurrences)
+		})
+	}
+}
+
+func TestRecurrenceRule_OccurrencesNeverMatching(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30")
+	assert.NoError(t, err)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	var occurrences []time.Time
+	rule.Occurrences(start, func(occurrence time.Time) bool {
+		occurrences = append(occurrences, occurrence)
+		return true
+	})
+	assert.Equal(t, []time.Time{start}, occurrences)
+}
@@ -0,0 +1,190 @@
+package models
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+// MaxOccurrences - maximum number of occurrences of recurring journeys returned for one time range
+const MaxOccurrences = 1000
+
+// ErrOccurrenceNotFound - occurs when exception is set for time which is not start of occurrence by recurrence rule
+var ErrOccurrenceNotFound = apperrors.New(apperrors.InvalidArgument, "occurrence_time must be start time of occurrence by recurrence rule")
+
+// RecurringJourney - represents journey repeated by recurrence rule, start and end times are times of the first occurrence
+// and other occurrences have the same duration and wall clock start time in time zone of journey
+type RecurringJourney struct {
+	RecurringJourneyID uint64
+	UserID             uint64
+	Address            string
+	Description        string
+	StartTime          time.Time
+	EndTime            time.Time
+	// TimeZone - IANA time zone name of the journey, occurrences are repeated by wall clock of this time zone
+	TimeZone string
+	// Coordinates - optional geographic point of the address, nil if unknown
+	Coordinates *Coordinates
+	// Rule - RFC 5545 RRULE value like "FREQ=WEEKLY;BYDAY=MO,FR", see RecurrenceRule for supported parts
+	Rule string
+	// Exceptions - changed and cancelled occurrences
+	Exceptions []OccurrenceException
+	// Revision - incremented on every change of recurring journey and its exceptions
+	Revision uint64
+}
+
+// OccurrenceException - represents change of single occurrence of recurring journey
+type OccurrenceException struct {
+	// OccurrenceTime - start time of occurrence by rule, it identifies occurrence like RECURRENCE-ID of iCalendar
+	OccurrenceTime time.Time
+	// Cancelled - occurrence is removed, other fields are ignored
+	Cancelled bool
+	// Address, Description, StartTime, EndTime - changed fields of occurrence, empty fields keep values of occurrence
+	// and occurrence with changed StartTime only keeps its duration
+	Address     string
+	Description string
+	StartTime   time.Time
+	EndTime     time.Time
+}
+
+// Validate - checks recurring journey by rules of Journey.Validate and checks its recurrence rule
+func (r *RecurringJourney) Validate() error {
+	first := r.occurrence(r.StartTime)
+	if err := first.Validate(); err != nil {
+		return err
+	}
+	_, err := ParseRecurrenceRule(r.Rule)
+	return err
+}
+
+// ValidateException - checks that exception changes existing occurrence and changed occurrence is valid journey
+func (r *RecurringJourney) ValidateException(exception OccurrenceException) error {
+	if !r.IsOccurrence(exception.OccurrenceTime) {
+		return ErrOccurrenceNotFound
+	}
+	if exception.Cancelled {
+		return nil
+	}
+	journey := r.occurrence(exception.OccurrenceTime)
+	exception.apply(&journey)
+	return journey.Validate()
+}
+
+// IsOccurrence - checks that occurrence of recurring journey starts at time t by its rule
+func (r *RecurringJourney) IsOccurrence(t time.Time) bool {
+	rule, err := ParseRecurrenceRule(r.Rule)
+	if err != nil {
+		return false
+	}
+	found := false
+	rule.Occurrences(r.startTime(), func(occurrence time.Time) bool {
+		found = occurrence.Equal(t)
+		return occurrence.Before(t)
+	})
+	return found
+}
+
+// Occurrences - returns occurrences of recurring journey overlapping time range [from, to) with exceptions applied,
+// sorted by start time, zero from or to means time range is not bounded on this side. Occurrences are not stored journeys, so they have RecurringJourneyID and OccurrenceTime
+// instead of JourneyID and revision of recurring journey. At most MaxOccurrences are returned
+func (r *RecurringJourney) Occurrences(from, to time.Time) []Journey {
+	rule, err := ParseRecurrenceRule(r.Rule)
+	if err != nil {
+		// rule is validated on saving
+		return nil
+	}
+
+	exceptions := make(map[int64]OccurrenceException, len(r.Exceptions))
+	var occurrences []Journey
+	for _, exception := range r.Exceptions {
+		exceptions[exception.OccurrenceTime.UnixNano()] = exception
+		if exception.Cancelled {
+			continue
+		}
+		// changed occurrence can be moved into time range from any other time
+		journey := r.occurrence(exception.OccurrenceTime)
+		exception.apply(&journey)
+		if (to.IsZero() || journey.StartTime.Before(to)) && journey.EndTime.After(from) {
+			occurrences = append(occurrences, journey)
+		}
+	}
+
+	duration := r.EndTime.Sub(r.StartTime)
+	rule.Occurrences(r.startTime(), func(start time.Time) bool {
+		if (!to.IsZero() && !start.Before(to)) || len(occurrences) >= MaxOccurrences {
+			return false
+		}
+		if _, ok := exceptions[start.UnixNano()]; !ok && start.Add(duration).After(from) {
+			occurrences = append(occurrences, r.occurrence(start))
+		}
+		return true
+	})
+
+	sortJourneysByStartTime(occurrences)
+	if len(occurrences) > MaxOccurrences {
+		occurrences = occurrences[:MaxOccurrences]
+	}
+	return occurrences
+}
+
+// ExpandOccurrences - returns occurrences of recurring journeys overlapping time range [from, to) sorted by start time,
+// zero from or to means time range is not bounded on this side, at most MaxOccurrences are returned
+func ExpandOccurrences(recurringJourneys []RecurringJourney, from, to time.Time) []Journey {
+	var occurrences []Journey
+	for i := range recurringJourneys {
+		occurrences = append(occurrences, recurringJourneys[i].Occurrences(from, to)...)
+	}
+	sortJourneysByStartTime(occurrences)
+	if len(occurrences) > MaxOccurrences {
+		occurrences = occurrences[:MaxOccurrences]
+	}
+	return occurrences
+}
+
+// startTime - returns start time of the first occurrence in time zone of journey
+func (r *RecurringJourney) startTime() time.Time {
+	journey := Journey{TimeZone: r.TimeZone}
+	return r.StartTime.In(journey.Location())
+}
+
+// occurrence - returns occurrence of recurring journey starting at time by rule, exception is not applied
+func (r *RecurringJourney) occurrence(start time.Time) Journey {
+	journey := Journey{
+		UserID:             r.UserID,
+		Address:            r.Address,
+		Description:        r.Description,
+		StartTime:          start,
+		EndTime:            start.Add(r.EndTime.Sub(r.StartTime)),
+		TimeZone:           r.TimeZone,
+		Coordinates:        r.Coordinates,
+		RecurringJourneyID: r.RecurringJourneyID,
+		OccurrenceTime:     start,
+		Revision:           r.Revision,
+	}
+	journey.Localize()
+	return journey
+}
+
+// apply - changes occurrence by non-empty fields of exception
+func (e OccurrenceException) apply(journey *Journey) {
+	if e.Address != "" {
+		journey.Address = e.Address
+	}
+	if e.Description != "" {
+		journey.Description = e.Description
+	}
+	duration := journey.EndTime.Sub(journey.StartTime)
+	if !e.StartTime.IsZero() {
+		journey.StartTime = e.StartTime
+		journey.EndTime = e.StartTime.Add(duration)
+	}
+	if !e.EndTime.IsZero() {
+		journey.EndTime = e.EndTime
+	}
+	journey.Localize()
+}
+
+func sortJourneysByStartTime(journeys []Journey) {
+	sort.SliceStable(journeys, func(i, j int) bool { return journeys[i].StartTime.Before(journeys[j].StartTime) })
+}
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+func newCommute() RecurringJourney {
+	start := time.Date(2021, 11, 1, 8, 0, 0, 0, time.UTC) // Monday
+	return RecurringJourney{
+		RecurringJourneyID: 3,
+		UserID:             1,
+		Address:            "Офис",
+		StartTime:          start,
+		EndTime:            start.Add(time.Hour),
+		Rule:               "FREQ=WEEKLY;BYDAY=MO,WE",
+	}
+}
+
+func TestRecurringJourney_Validate(t *testing.T) {
+	commute := newCommute()
+	assert.NoError(t, commute.Validate())
+
+	commute.Address = ""
+	assert.Equal(t, ErrAddressRequired, commute.Validate())
+
+	commute = newCommute()
+	commute.Rule = "FREQ=SECONDLY"
+	assert.True(t, apperrors.Is(commute.Validate(), apperrors.InvalidArgument))
+}
+
+func TestRecurringJourney_ValidateException(t *testing.T) {
+	commute := newCommute()
+	wednesday := commute.StartTime.AddDate(0, 0, 2)
+
+	assert.NoError(t, commute.ValidateException(OccurrenceException{OccurrenceTime: wednesday, Cancelled: true}))
+	assert.NoError(t, commute.ValidateException(OccurrenceException{
+		OccurrenceTime: wednesday, Address: "Склад", StartTime: wednesday.Add(time.Hour), EndTime: wednesday.Add(2 * time.Hour),
+	}))
+	assert.Equal(t, ErrOccurrenceNotFound,
+		commute.ValidateException(OccurrenceException{OccurrenceTime: wednesday.Add(time.Minute), Cancelled: true}))
+	assert.Equal(t, ErrEndBeforeStart, commute.ValidateException(OccurrenceException{
+		OccurrenceTime: wednesday, Address: "Склад", StartTime: wednesday, EndTime: wednesday.Add(-time.Hour),
+	}))
+	assert.Equal(t, ErrEndBeforeStart, commute.ValidateException(OccurrenceException{
+		OccurrenceTime: wednesday, EndTime: wednesday.Add(-time.Hour),
+	}))
+}
+
+func TestOccurrenceException_apply(t *testing.T) {
+	commute := newCommute()
+	wednesday := commute.StartTime.AddDate(0, 0, 2)
+
+	journey := commute.occurrence(wednesday)
+	OccurrenceException{OccurrenceTime: wednesday, Description: "Совещание"}.apply(&journey)
+	assert.Equal(t, "Офис", journey.Address)
+	assert.Equal(t, "Совещание", journey.Description)
+	assert.Equal(t, wednesday, journey.StartTime)
+	assert.Equal(t, wednesday.Add(time.Hour), journey.EndTime)
+
+	journey = commute.occurrence(wednesday)
+	OccurrenceException{OccurrenceTime: wednesday, StartTime: wednesday.Add(2 * time.Hour)}.apply(&journey)
+	assert.Equal(t, "Офис", journey.Address)
+	assert.Equal(t, wednesday.Add(2*time.Hour), journey.StartTime)
+	assert.Equal(t, wednesday.Add(3*time.Hour), journey.EndTime)
+
+	journey = commute.occurrence(wednesday)
+	OccurrenceException{OccurrenceTime: wednesday, EndTime: wednesday.Add(30 * time.Minute)}.apply(&journey)
+	assert.Equal(t, wednesday, journey.StartTime)
+	assert.Equal(t, wednesday.Add(30*time.Minute), journey.EndTime)
+}
+
+func TestRecurringJourney_Occurrences(t *testing.T) {
+	commute := newCommute()
+	monday := commute.StartTime
+	commute.Exceptions = []OccurrenceException{
+		// first wednesday is cancelled
+		{OccurrenceTime: monday.AddDate(0, 0, 2), Cancelled: true},
+		// second monday is moved before the time range
+		{OccurrenceTime: monday.AddDate(0, 0, 7), Address: "Дача", StartTime: monday.AddDate(0, 0, -1), EndTime: monday.AddDate(0, 0, -1).Add(time.Hour)},
+		// third monday after the time range is moved into it
+		{OccurrenceTime: monday.AddDate(0, 0, 14), Address: "Склад", StartTime: monday.AddDate(0, 0, 10), EndTime: monday.AddDate(0, 0, 10).Add(time.Hour)},
+	}
+
+	occurrences := commute.Occurrences(monday.AddDate(0, 0, 1), monday.AddDate(0, 0, 11))
+
+	var starts []time.Time
+	for _, occurrence := range occurrences {
+		starts = append(starts, occurrence.StartTime)
+		assert.Equal(t, uint64(3), occurrence.RecurringJourneyID)
+		assert.Equal(t, uint64(0), occurrence.JourneyID)
+		assert.Equal(t, commute.Revision, occurrence.Revision)
+	}
+	assert.Equal(t, []time.Time{monday.AddDate(0, 0, 9), monday.AddDate(0, 0, 10)}, starts)
+	assert.Equal(t, "Офис", occurrences[0].Address)
+	assert.Equal(t, "Склад", occurrences[1].Address)
+	assert.Equal(t, monday.AddDate(0, 0, 14), occurrences[1].OccurrenceTime)
+}
+
+func TestRecurringJourney_OccurrencesInOpenTimeRange(t *testing.T) {
+	commute := newCommute()
+
+	assert.Len(t, commute.Occurrences(time.Time{}, commute.StartTime.AddDate(0, 0, 7)), 2)
+	assert.Len(t, commute.Occurrences(commute.StartTime, time.Time{}), MaxOccurrences)
+}
+
+func TestRecurringJourney_OccurrencesInTimeZone(t *testing.T) {
+	commute := newCommute()
+	commute.TimeZone = "Asia/Tokyo"
+	commute.Rule = "FREQ=DAILY"
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	assert.NoError(t, err)
+
+	// 8:00 UTC is 17:00 in Tokyo, so occurrences repeat at 17:00 of Tokyo
+	occurrences := commute.Occurrences(commute.StartTime, commute.StartTime.Add(48*time.Hour))
+	assert.Len(t, occurrences, 2)
+	assert.Equal(t, time.Date(2021, 11, 2, 17, 0, 0, 0, tokyo), occurrences[1].StartTime)
+	assert.Equal(t, tokyo, occurrences[1].StartTime.Location())
+}
+
+func TestExpandOccurrences(t *testing.T) {
+	commute := newCommute()
+	gym := newCommute()
+	gym.RecurringJourneyID = 4
+	gym.StartTime = gym.StartTime.Add(10 * time.Hour)
+	gym.EndTime = gym.StartTime.Add(time.Hour)
+	gym.Rule = "FREQ=DAILY;COUNT=2"
+
+	occurrences := ExpandOccurrences([]RecurringJourney{commute, gym}, commute.StartTime, commute.StartTime.AddDate(0, 0, 3))
+
+	var ids []uint64
+	for _, occurrence := range occurrences {
+		ids = append(ids, occurrence.RecurringJourneyID)
+	}
+	assert.Equal(t, []uint64{3, 4, 4, 3}, ids)
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+const (
+	// MaxTags - maximum number of tags of journey
+	MaxTags = 20
+	// MaxTagLength - maximum length of tag in characters
+	MaxTagLength = 32
+)
+
+var (
+	// ErrTooManyTags - occurs when journey has more than MaxTags tags
+	ErrTooManyTags = apperrors.New(apperrors.InvalidArgument, "journey must not have more than %d tags", MaxTags)
+	// ErrInvalidTag - occurs when tag is empty, too long or contains characters other than letters, digits, '-' and '_'
+	ErrInvalidTag = apperrors.New(apperrors.InvalidArgument,
+		"tag must contain from 1 to %d lowercase letters, digits, '-' or '_'", MaxTagLength)
+)
+
+// NormalizeTags - returns trimmed lowercase tags without duplicates sorted by name, nil is returned for empty list.
+// Tags are stored normalized, so "Business" and "business " are the same tag
+func NormalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	unique := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !unique[tag] {
+			unique[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// isValidTag - checks that tag is normalized, not empty, not longer than MaxTagLength
+// and contains only letters, digits, '-' and '_'
+func isValidTag(tag string) bool {
+	if tag == "" || utf8.RuneCountInString(tag) > MaxTagLength || tag != strings.ToLower(tag) {
+		return false
+	}
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Nil(t, NormalizeTags(nil))
+	assert.Nil(t, NormalizeTags([]string{}))
+	assert.Equal(t,
+		[]string{"business", "vacation", "командировка"},
+		NormalizeTags([]string{" Vacation", "business", "Командировка", "BUSINESS "}))
+}
+
+func TestIsValidTag(t *testing.T) {
+	for _, tag := range []string{"business", "road-trip", "2022", "с_детьми", strings.Repeat("a", MaxTagLength)} {
+		assert.True(t, isValidTag(tag), tag)
+	}
+	for _, tag := range []string{"", "Business", "road trip", "a,b", strings.Repeat("a", MaxTagLength+1)} {
+		assert.False(t, isValidTag(tag), tag)
+	}
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+// MaxWaypoints - maximum number of waypoints of journey
+const MaxWaypoints = 100
+
+var (
+	// ErrTooManyWaypoints - occurs when journey has more than MaxWaypoints waypoints
+	ErrTooManyWaypoints = apperrors.New(apperrors.InvalidArgument, "journey must not have more than %d waypoints", MaxWaypoints)
+	// ErrWaypointAddressRequired - occurs when waypoint has empty address
+	ErrWaypointAddressRequired = apperrors.New(apperrors.InvalidArgument, "waypoint address is required")
+	// ErrWaypointAddressTooLong - occurs when waypoint address is longer than MaxAddressLength
+	ErrWaypointAddressTooLong = apperrors.New(apperrors.InvalidArgument, "waypoint address must not exceed %d characters", MaxAddressLength)
+	// ErrInvalidWaypointCoordinates - occurs when waypoint latitude or longitude is out of range
+	ErrInvalidWaypointCoordinates = apperrors.New(apperrors.InvalidArgument, "waypoint latitude must be in [-90, 90] and longitude in [-180, 180]")
+	// ErrDepartureBeforeArrival - occurs when departure from waypoint is before arrival
+	ErrDepartureBeforeArrival = apperrors.New(apperrors.InvalidArgument, "waypoint departure_time must not be before arrival_time")
+)
+
+// Waypoint - represents stop within journey, waypoints of journey are ordered along its route
+type Waypoint struct {
+	WaypointID uint64
+	Address    string
+	// Coordinates - optional geographic point of the address, nil if unknown
+	Coordinates *Coordinates
+	// ArrivalTime, DepartureTime - optional times of the stop, zero if unknown
+	ArrivalTime   time.Time
+	DepartureTime time.Time
+}
+
+// Validate - checks domain rules of waypoint: address is required and its length is limited,
+// coordinates must be in range if set, departure cannot be before arrival if both are set.
+// Returns first found violation as apperrors.InvalidArgument error.
+func (w *Waypoint) Validate() error {
+	switch {
+	case strings.TrimSpace(w.Address) == "":
+		return ErrWaypointAddressRequired
+	case utf8.RuneCountInString(w.Address) > MaxAddressLength:
+		return ErrWaypointAddressTooLong
+	case w.Coordinates != nil && !w.Coordinates.Valid():
+		return ErrInvalidWaypointCoordinates
+	case !w.ArrivalTime.IsZero() && !w.DepartureTime.IsZero() && w.DepartureTime.Before(w.ArrivalTime):
+		return ErrDepartureBeforeArrival
+	}
+	return nil
+}
+
+// localize - converts arrival and departure times to location, zero times are kept
+func (w *Waypoint) localize(location *time.Location) {
+	if !w.ArrivalTime.IsZero() {
+		w.ArrivalTime = w.ArrivalTime.In(location)
+	}
+	if !w.DepartureTime.IsZero() {
+		w.DepartureTime = w.DepartureTime.In(location)
+	}
+}
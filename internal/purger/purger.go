@@ -0,0 +1,133 @@
+package purger
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozonva/ova-journey-api/internal/config"
+	"github.com/ozonva/ova-journey-api/internal/repo"
+)
+
+// Purger - background job for permanent deleting of journeys removed more than retention period ago
+// and expired idempotency keys
+type Purger interface {
+	// Start - start purging in background until Purger.Close() is called
+	Start()
+	// Close - stop purging and wait for current attempt to finish
+	Close()
+}
+
+type purger struct {
+	repo            repo.Repo
+	idempotencyRepo repo.IdempotencyRepo
+	retention       time.Duration
+	interval        time.Duration
+	batchSize       uint64
+	now             func() time.Time
+	cancel          context.CancelFunc
+	wg              *sync.WaitGroup
+}
+
+// NewPurger - creates new Purger using repo.Repo, repo.IdempotencyRepo and configuration of retention period.
+// Purger does nothing if configuration is nil or retention period is not positive.
+func NewPurger(repo repo.Repo, idempotencyRepo repo.IdempotencyRepo, configuration *config.PurgeConfiguration) Purger {
+	p := &purger{repo: repo, idempotencyRepo: idempotencyRepo, now: time.Now}
+	if configuration != nil {
+		p.retention = configuration.Retention
+		p.interval = configuration.Interval
+		p.batchSize = configuration.BatchSize
+	}
+	return p
+}
+
+func (p *purger) Start() {
+	if p.retention <= 0 || p.interval <= 0 || p.batchSize == 0 {
+		log.Debug().Msg("Purger: disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.wg = &sync.WaitGroup{}
+	p.wg.Add(1)
+
+	go func() {
+		defer p.wg.Done()
+		log.Debug().Dur("retention", p.retention).Msg("Purger: starting")
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			p.purge(ctx)
+			p.purgeIdempotencyKeys(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (p *purger) Close() {
+	if p.cancel != nil {
+		p.cancel()
+		p.wg.Wait()
+	}
+}
+
+// purge - deletes expired journeys by batches until there are no more of them, returns count of deleted journeys
+func (p *purger) purge(ctx context.Context) uint64 {
+	deletedBefore := p.now().Add(-p.retention)
+
+	total, err := p.purgeByBatches(ctx, func(ctx context.Context) (uint64, error) {
+		return p.repo.PurgeJourneys(ctx, deletedBefore, p.batchSize)
+	})
+	if err != nil {
+		log.Error().Err(err).Time("deletedBefore", deletedBefore).Msg("Purger: failed to purge journeys")
+	}
+
+	if total > 0 {
+		log.Info().Uint64("count", total).Time("deletedBefore", deletedBefore).Msg("Purger: journeys purged")
+	}
+	return total
+}
+
+// purgeIdempotencyKeys - deletes expired idempotency keys by batches, returns count of deleted keys
+func (p *purger) purgeIdempotencyKeys(ctx context.Context) uint64 {
+	if p.idempotencyRepo == nil {
+		return 0
+	}
+	expiredBefore := p.now()
+
+	total, err := p.purgeByBatches(ctx, func(ctx context.Context) (uint64, error) {
+		return p.idempotencyRepo.PurgeIdempotencyKeys(ctx, expiredBefore, p.batchSize)
+	})
+	if err != nil {
+		log.Error().Err(err).Time("expiredBefore", expiredBefore).Msg("Purger: failed to purge idempotency keys")
+	}
+
+	if total > 0 {
+		log.Info().Uint64("count", total).Msg("Purger: idempotency keys purged")
+	}
+	return total
+}
+
+// purgeByBatches - calls purgeBatch until it deletes less than batch size, returns total count of deleted rows
+func (p *purger) purgeByBatches(ctx context.Context, purgeBatch func(ctx context.Context) (uint64, error)) (uint64, error) {
+	var total uint64
+	for ctx.Err() == nil {
+		purged, err := purgeBatch(ctx)
+		total += purged
+		if err != nil {
+			return total, err
+		}
+		if purged < p.batchSize {
+			break
+		}
+	}
+	return total, nil
+}
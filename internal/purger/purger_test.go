@@ -0,0 +1,104 @@
+package purger
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ozonva/ova-journey-api/internal/config"
+	"github.com/ozonva/ova-journey-api/internal/mocks"
+)
+
+var _ = Describe("Purger", func() {
+	var (
+		ctrl                *gomock.Controller
+		mockRepo            *mocks.MockRepo
+		mockIdempotencyRepo *mocks.MockIdempotencyRepo
+		p                   *purger
+		ctx                 context.Context
+
+		now           = time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+		retention     = 24 * time.Hour
+		deletedBefore = now.Add(-retention)
+		errRepo       = errors.New("repo error")
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockIdempotencyRepo = mocks.NewMockIdempotencyRepo(ctrl)
+		ctx = context.Background()
+		p = NewPurger(mockRepo, mockIdempotencyRepo, &config.PurgeConfiguration{
+			Retention: retention,
+			Interval:  time.Hour,
+			BatchSize: 2,
+		}).(*purger)
+		p.now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("purge", func() {
+		It("should delete journeys by batches until batch is not full", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().PurgeJourneys(ctx, deletedBefore, uint64(2)).Return(uint64(2), nil),
+				mockRepo.EXPECT().PurgeJourneys(ctx, deletedBefore, uint64(2)).Return(uint64(1), nil),
+			)
+
+			Expect(p.purge(ctx)).Should(Equal(uint64(3)))
+		})
+
+		It("should stop on repo error", func() {
+			mockRepo.EXPECT().PurgeJourneys(ctx, deletedBefore, uint64(2)).Return(uint64(0), errRepo).Times(1)
+
+			Expect(p.purge(ctx)).Should(Equal(uint64(0)))
+		})
+	})
+
+	Context("purgeIdempotencyKeys", func() {
+		It("should delete keys expired before now by batches", func() {
+			gomock.InOrder(
+				mockIdempotencyRepo.EXPECT().PurgeIdempotencyKeys(ctx, now, uint64(2)).Return(uint64(2), nil),
+				mockIdempotencyRepo.EXPECT().PurgeIdempotencyKeys(ctx, now, uint64(2)).Return(uint64(0), nil),
+			)
+
+			Expect(p.purgeIdempotencyKeys(ctx)).Should(Equal(uint64(2)))
+		})
+
+		It("should stop on repo error", func() {
+			mockIdempotencyRepo.EXPECT().PurgeIdempotencyKeys(ctx, now, uint64(2)).Return(uint64(1), errRepo).Times(1)
+
+			Expect(p.purgeIdempotencyKeys(ctx)).Should(Equal(uint64(1)))
+		})
+	})
+
+	Context("Start and Close", func() {
+		It("should purge on start and stop after closing", func() {
+			done := make(chan struct{})
+			mockRepo.EXPECT().PurgeJourneys(gomock.Any(), deletedBefore, uint64(2)).
+				DoAndReturn(func(context.Context, time.Time, uint64) (uint64, error) {
+					close(done)
+					return 0, nil
+				}).Times(1)
+			mockIdempotencyRepo.EXPECT().PurgeIdempotencyKeys(gomock.Any(), now, uint64(2)).Return(uint64(0), nil).AnyTimes()
+
+			p.Start()
+			Eventually(done).Should(BeClosed())
+			p.Close()
+		})
+
+		It("should do nothing if retention is not set", func() {
+			mockRepo.EXPECT().PurgeJourneys(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			disabled := NewPurger(mockRepo, mockIdempotencyRepo, nil)
+			disabled.Start()
+			disabled.Close()
+		})
+	})
+})
@@ -0,0 +1,46 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgconn"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+)
+
+// wrapDBError - converts database error to apperrors.Error of corresponding kind, unknown errors are returned as is
+func wrapDBError(err error) error {
+	if err == nil || apperrors.KindOf(err) != apperrors.Unknown {
+		return err
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == "23505":
+			return apperrors.Wrap(apperrors.AlreadyExists, err, "unique violation")
+		case pgErr.Code == "23502", pgErr.Code == "23514", strings.HasPrefix(pgErr.Code, "22"):
+			return apperrors.Wrap(apperrors.InvalidArgument, err, "invalid data")
+		case pgErr.Code == "40001", pgErr.Code == "40P01":
+			return apperrors.Wrap(apperrors.Conflict, err, "concurrent transaction")
+		case strings.HasPrefix(pgErr.Code, "08"), strings.HasPrefix(pgErr.Code, "57P"), pgErr.Code == "53300":
+			return apperrors.Wrap(apperrors.Unavailable, err, "database unavailable")
+		}
+		return err
+	}
+
+	var netErr net.Error
+	if errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.As(err, &netErr) ||
+		pgconn.Timeout(err) {
+		return apperrors.Wrap(apperrors.Unavailable, err, "database unavailable")
+	}
+	return err
+}
@@ -0,0 +1,153 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// IdempotencyRepo - represents the object for working with storage of idempotency keys
+type IdempotencyRepo interface {
+	// ReserveIdempotencyKey - saves pending key if there is no stored key with the same user, method and key,
+	// otherwise returns stored key and false. Expired keys and keys pending longer than PendingKeyTimeout are replaced.
+	ReserveIdempotencyKey(ctx context.Context, key models.IdempotencyKey) (models.IdempotencyKey, bool, error)
+	// CompleteIdempotencyKey - saves response of request to the pending key
+	CompleteIdempotencyKey(ctx context.Context, userID uint64, method, key string, response []byte) error
+	// ReleaseIdempotencyKey - deletes pending key of failed request, so the request can be retried with the same key
+	ReleaseIdempotencyKey(ctx context.Context, userID uint64, method, key string) error
+	// PurgeIdempotencyKeys - deletes up to limit keys expired before expiredBefore and returns their count
+	PurgeIdempotencyKeys(ctx context.Context, expiredBefore time.Time, limit uint64) (uint64, error)
+}
+
+// PendingKeyTimeout - pending key older than this timeout is considered abandoned (e.g. after crash of service)
+// and can be reserved by another request
+const PendingKeyTimeout = time.Minute
+
+type idempotencyRepo struct {
+	db *sqlx.DB
+}
+
+// NewIdempotencyRepo - creates new IdempotencyKey repository using database
+func NewIdempotencyRepo(db *sqlx.DB) IdempotencyRepo {
+	return &idempotencyRepo{db: db}
+}
+
+var idempotencyKeyColumns = []string{"user_id", "method", "key", "request_hash", "response", "created_at", "expires_at"}
+
+func (r *idempotencyRepo) ReserveIdempotencyKey(ctx context.Context, key models.IdempotencyKey) (models.IdempotencyKey, bool, error) {
+	reserve := squirrel.
+		Insert("idempotency_keys").
+		Columns("user_id", "method", "key", "request_hash", "expires_at").
+		Values(key.UserID, key.Method, key.Key, key.RequestHash, key.ExpiresAt).
+		Suffix(`ON CONFLICT (user_id, method, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			response = NULL,
+			created_at = now(),
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= now()
+			OR (idempotency_keys.response IS NULL AND idempotency_keys.created_at <= ?)
+		RETURNING "user_id", "method", "key", "request_hash", "response", "created_at", "expires_at"`,
+			time.Now().Add(-PendingKeyTimeout),
+		).
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	reserved, err := scanIdempotencyKey(reserve.QueryRowContext(ctx))
+	if err == nil {
+		return reserved, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return models.IdempotencyKey{}, false, wrapDBError(err)
+	}
+
+	// key is not reserved because it is stored by another request
+	query := squirrel.
+		Select(idempotencyKeyColumns...).
+		From("idempotency_keys").
+		Where(squirrel.Eq{"user_id": key.UserID, "method": key.Method, "key": key.Key}).
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	stored, err := scanIdempotencyKey(query.QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.IdempotencyKey{}, false, apperrors.New(apperrors.Conflict, "idempotency key %q is released concurrently", key.Key)
+	}
+	if err != nil {
+		return models.IdempotencyKey{}, false, wrapDBError(err)
+	}
+	return stored, false, nil
+}
+
+func (r *idempotencyRepo) CompleteIdempotencyKey(ctx context.Context, userID uint64, method, key string, response []byte) error {
+	query := squirrel.
+		Update("idempotency_keys").
+		Set("response", response).
+		Where(squirrel.Eq{"user_id": userID, "method": method, "key": key}).
+		Where(squirrel.Eq{"response": nil}).
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	result, err := query.ExecContext(ctx)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return wrapDBError(err)
+	}
+	if affected == 0 {
+		return apperrors.New(apperrors.NotFound, "pending idempotency key %q not found", key)
+	}
+	return nil
+}
+
+func (r *idempotencyRepo) ReleaseIdempotencyKey(ctx context.Context, userID uint64, method, key string) error {
+	query := squirrel.
+		Delete("idempotency_keys").
+		Where(squirrel.Eq{"user_id": userID, "method": method, "key": key}).
+		Where(squirrel.Eq{"response": nil}).
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if _, err := query.ExecContext(ctx); err != nil {
+		return wrapDBError(err)
+	}
+	return nil
+}
+
+func (r *idempotencyRepo) PurgeIdempotencyKeys(ctx context.Context, expiredBefore time.Time, limit uint64) (uint64, error) {
+	expired := squirrel.
+		Select("user_id", "method", "key").
+		From("idempotency_keys").
+		Where(squirrel.Lt{"expires_at": expiredBefore}).
+		Limit(limit)
+
+	query := squirrel.
+		Delete("idempotency_keys").
+		Where(squirrel.Expr("(user_id, method, key) IN (?)", expired)).
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	result, err := query.ExecContext(ctx)
+	if err != nil {
+		return 0, wrapDBError(err)
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, wrapDBError(err)
+	}
+	return uint64(purged), nil
+}
+
+func scanIdempotencyKey(row squirrel.RowScanner) (models.IdempotencyKey, error) {
+	var key models.IdempotencyKey
+	err := row.Scan(&key.UserID, &key.Method, &key.Key, &key.RequestHash, &key.Response, &key.CreatedAt, &key.ExpiresAt)
+	return key, err
+}
@@ -0,0 +1,87 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+func TestIdempotencyRepo_ReserveAndComplete(t *testing.T) {
+	idempotencyRepository := NewIdempotencyRepo(db)
+	key := models.IdempotencyKey{
+		UserID:      1,
+		Method:      "CreateJourneyV1",
+		Key:         "reserve-and-complete",
+		RequestHash: "hash",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	reserved, ok, err := idempotencyRepository.ReserveIdempotencyKey(context.Background(), key)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, reserved.IsPending())
+
+	stored, ok, err := idempotencyRepository.ReserveIdempotencyKey(context.Background(), key)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.True(t, stored.IsPending())
+
+	err = idempotencyRepository.CompleteIdempotencyKey(context.Background(), key.UserID, key.Method, key.Key, []byte{1, 2})
+	assert.NoError(t, err)
+	err = idempotencyRepository.CompleteIdempotencyKey(context.Background(), key.UserID, key.Method, key.Key, []byte{3})
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+
+	stored, ok, err = idempotencyRepository.ReserveIdempotencyKey(context.Background(), key)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, []byte{1, 2}, stored.Response)
+
+	otherMethod := key
+	otherMethod.Method = "CreateJourneyTaskV1"
+	_, ok, err = idempotencyRepository.ReserveIdempotencyKey(context.Background(), otherMethod)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	otherUser := key
+	otherUser.UserID = 2
+	reserved, ok, err = idempotencyRepository.ReserveIdempotencyKey(context.Background(), otherUser)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), reserved.UserID)
+}
+
+func TestIdempotencyRepo_ReleaseAndExpire(t *testing.T) {
+	idempotencyRepository := NewIdempotencyRepo(db)
+	key := models.IdempotencyKey{
+		UserID:      1,
+		Method:      "CreateJourneyV1",
+		Key:         "release-and-expire",
+		RequestHash: "hash",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	_, _, err := idempotencyRepository.ReserveIdempotencyKey(context.Background(), key)
+	assert.NoError(t, err)
+	assert.NoError(t, idempotencyRepository.ReleaseIdempotencyKey(context.Background(), key.UserID, key.Method, key.Key))
+
+	expired := key
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	_, ok, err := idempotencyRepository.ReserveIdempotencyKey(context.Background(), expired)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// expired key is replaced by new request
+	_, ok, err = idempotencyRepository.ReserveIdempotencyKey(context.Background(), key)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	assert.NoError(t, idempotencyRepository.CompleteIdempotencyKey(context.Background(), key.UserID, key.Method, key.Key, []byte{1}))
+	purged, err := idempotencyRepository.PurgeIdempotencyKeys(context.Background(), time.Now().Add(2*time.Hour), 100)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, purged, uint64(1))
+}
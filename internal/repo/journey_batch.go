@@ -0,0 +1,182 @@
+package repo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// multiUpdateValuesRow - row of VALUES list in MultiUpdateJourneys query, types are set explicitly
+// because Postgres cannot infer types of parameters in VALUES
+const multiUpdateValuesRow = "(?::bigint, ?::bigint, ?::text, ?::text, ?::timestamptz, ?::timestamptz, ?::text, ?::float8, ?::float8, ?::bigint)"
+
+func (r *repo) MultiUpdateJourneys(ctx context.Context, journeys []models.Journey) (map[uint64]uint64, error) {
+	if len(journeys) == 0 {
+		return map[uint64]uint64{}, nil
+	}
+
+	var revisions map[uint64]uint64
+	err := r.inTx(ctx, nil, func(tx *repo) error {
+		var err error
+		revisions, err = tx.updateJourneyRows(ctx, journeys)
+		if err != nil {
+			return err
+		}
+
+		// tags of journeys which are not updated are kept
+		updatedIDs := make([]uint64, 0, len(revisions))
+		updated := make([]models.Journey, 0, len(revisions))
+		for _, journey := range journeys {
+			if _, ok := revisions[journey.JourneyID]; ok {
+				updatedIDs = append(updatedIDs, journey.JourneyID)
+				updated = append(updated, journey)
+			}
+		}
+		return tx.replaceTags(ctx, updatedIDs, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// updateJourneyRows - updates rows of journeys with one query and returns new revisions by ids of updated journeys
+func (r *repo) updateJourneyRows(ctx context.Context, journeys []models.Journey) (map[uint64]uint64, error) {
+	// squirrel does not support UPDATE ... FROM, so query is built manually
+	var sql strings.Builder
+	sql.WriteString(`UPDATE journeys AS j SET
+		user_id = v.user_id,
+		address = v.address,
+		description = v.description,
+		start_time = v.start_time,
+		end_time = v.end_time,
+		time_zone = v.time_zone,
+		latitude = v.latitude,
+		longitude = v.longitude,
+		revision = j.revision + 1
+	FROM (VALUES `)
+	args := make([]interface{}, 0, len(journeys)*10)
+	for i, journey := range journeys {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		sql.WriteString(multiUpdateValuesRow)
+		args = append(args,
+			journey.JourneyID,
+			journey.UserID,
+			journey.Address,
+			journey.Description,
+			journey.StartTime,
+			journey.EndTime,
+			journey.TimeZone,
+			latitude(journey),
+			longitude(journey),
+			journey.Revision,
+		)
+	}
+	sql.WriteString(`) AS v(journey_id, user_id, address, description, start_time, end_time, time_zone, latitude, longitude, expected_revision)
+	WHERE j.journey_id = v.journey_id AND NOT j.is_deleted
+		AND (v.expected_revision = 0 OR j.revision = v.expected_revision)
+	RETURNING j.journey_id, j.revision`)
+
+	query, err := squirrel.Dollar.ReplacePlaceholders(sql.String())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.runner.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	revisions := make(map[uint64]uint64, len(journeys))
+	for rows.Next() {
+		var journeyID, revision uint64
+		if err := rows.Scan(&journeyID, &revision); err != nil {
+			return nil, wrapDBError(err)
+		}
+		revisions[journeyID] = revision
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError(err)
+	}
+	return revisions, nil
+}
+
+func (r *repo) MultiRemoveJourneys(ctx context.Context, journeyIDs []uint64) ([]uint64, error) {
+	query := squirrel.
+		Update("journeys").
+		Set("is_deleted", true).
+		Set("deleted_at", squirrel.Expr("now()")).
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(squirrel.Expr("journey_id = ANY(?)", toInt64Array(journeyIDs))).
+		Where(squirrel.Eq{"is_deleted": false}).
+		Suffix("RETURNING \"journey_id\"").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	removedIDs := make([]uint64, 0, len(journeyIDs))
+	for rows.Next() {
+		var journeyID uint64
+		if err := rows.Scan(&journeyID); err != nil {
+			return nil, wrapDBError(err)
+		}
+		removedIDs = append(removedIDs, journeyID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError(err)
+	}
+	return removedIDs, nil
+}
+
+func (r *repo) GetJourneyOwners(ctx context.Context, journeyIDs []uint64) (map[uint64]uint64, error) {
+	query := squirrel.
+		Select("journey_id", "user_id").
+		From("journeys").
+		Where(squirrel.Expr("journey_id = ANY(?)", toInt64Array(journeyIDs))).
+		Where(squirrel.Eq{"is_deleted": false}).
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	owners := make(map[uint64]uint64, len(journeyIDs))
+	for rows.Next() {
+		var journeyID, userID uint64
+		if err := rows.Scan(&journeyID, &userID); err != nil {
+			return nil, wrapDBError(err)
+		}
+		owners[journeyID] = userID
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError(err)
+	}
+	return owners, nil
+}
+
+func (r *repo) BatchGetJourneys(ctx context.Context, journeyIDs []uint64) ([]models.Journey, error) {
+	query := squirrel.
+		Select(journeyColumns...).
+		From("journeys").
+		Where(squirrel.Expr("journey_id = ANY(?)", toInt64Array(journeyIDs))).
+		Where(squirrel.Eq{"is_deleted": false}).
+		OrderBy("journey_id ASC").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return r.queryJourneysWithDetails(ctx, query)
+}
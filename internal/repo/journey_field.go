@@ -0,0 +1,48 @@
+package repo
+
+import (
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// JourneyField - name of journey field that can be changed by Repo.PatchJourney
+type JourneyField string
+
+const (
+	JourneyFieldUserID      JourneyField = "user_id"
+	JourneyFieldAddress     JourneyField = "address"
+	JourneyFieldDescription JourneyField = "description"
+	JourneyFieldStartTime   JourneyField = "start_time"
+	JourneyFieldEndTime     JourneyField = "end_time"
+	JourneyFieldTimeZone    JourneyField = "time_zone"
+	JourneyFieldLatitude    JourneyField = "latitude"
+	JourneyFieldLongitude   JourneyField = "longitude"
+	// JourneyFieldTags - tags are stored in separate table, so Repo.PatchJourney replaces them instead of setting column
+	JourneyFieldTags JourneyField = "tags"
+)
+
+// ErrNoFieldsToPatch - returned by Repo.PatchJourney when list of fields is empty
+var ErrNoFieldsToPatch = apperrors.New(apperrors.InvalidArgument, "no fields to patch")
+
+// value - returns value of the field from journey
+func (f JourneyField) value(journey models.Journey) (interface{}, error) {
+	switch f {
+	case JourneyFieldUserID:
+		return journey.UserID, nil
+	case JourneyFieldAddress:
+		return journey.Address, nil
+	case JourneyFieldDescription:
+		return journey.Description, nil
+	case JourneyFieldStartTime:
+		return journey.StartTime, nil
+	case JourneyFieldEndTime:
+		return journey.EndTime, nil
+	case JourneyFieldTimeZone:
+		return journey.TimeZone, nil
+	case JourneyFieldLatitude:
+		return latitude(journey), nil
+	case JourneyFieldLongitude:
+		return longitude(journey), nil
+	}
+	return nil, apperrors.New(apperrors.InvalidArgument, "unknown journey field %q", string(f))
+}
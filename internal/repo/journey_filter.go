@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// JourneyFilter - represents optional conditions for selecting journeys, zero value of field means no condition
+type JourneyFilter struct {
+	// UserIDs - journeys of any of these users
+	UserIDs []uint64
+	// Participating - journeys shared with users from UserIDs as participants are also matched
+	Participating bool
+	// From, To - journeys overlapping time range [From, To)
+	From time.Time
+	To   time.Time
+	// Text - case insensitive substring of address or description
+	Text string
+	// AnyTags, AllTags - journeys having any of AnyTags and all of AllTags, tags must be normalized
+	AnyTags []string
+	AllTags []string
+	// Statuses - journeys having any of these statuses
+	Statuses []models.JourneyStatus
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// toSql - builds condition for WHERE clause, returns nil if filter is empty
+func (f JourneyFilter) toSql() squirrel.Sqlizer {
+	conditions := squirrel.And{}
+	if len(f.UserIDs) > 0 {
+		var condition squirrel.Sqlizer = squirrel.Eq{"user_id": f.UserIDs}
+		if f.Participating {
+			condition = squirrel.Or{condition, squirrel.Expr(
+				"journey_id IN (SELECT journey_id FROM journey_participants WHERE user_id = ANY(?))", toInt64Array(f.UserIDs))}
+		}
+		conditions = append(conditions, condition)
+	}
+	if !f.From.IsZero() {
+		conditions = append(conditions, squirrel.Gt{"end_time": f.From})
+	}
+	if !f.To.IsZero() {
+		conditions = append(conditions, squirrel.Lt{"start_time": f.To})
+	}
+	if f.Text != "" {
+		pattern := "%" + likeEscaper.Replace(f.Text) + "%"
+		conditions = append(conditions, squirrel.Or{
+			squirrel.ILike{"address": pattern},
+			squirrel.ILike{"description": pattern},
+		})
+	}
+
+	if len(f.AnyTags) > 0 {
+		conditions = append(conditions, tagsCondition(f.AnyTags, false))
+	}
+	if len(f.AllTags) > 0 {
+		conditions = append(conditions, tagsCondition(f.AllTags, true))
+	}
+	if len(f.Statuses) > 0 {
+		statuses := make([]string, len(f.Statuses))
+		for i, status := range f.Statuses {
+			statuses[i] = status.String()
+		}
+		conditions = append(conditions, squirrel.Eq{"status": statuses})
+	}
+
+	if len(conditions) == 0 {
+		return nil
+	}
+	return conditions
+}
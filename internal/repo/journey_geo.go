@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// distanceExpr - great-circle distance in meters from the point with latitude and longitude of placeholders
+// to coordinates of journey calculated by haversine formula like models.Coordinates.DistanceTo
+const distanceExpr = "2 * ?::float8 * asin(least(1, sqrt(" +
+	"power(sin(radians(latitude - ?::float8) / 2), 2) + " +
+	"cos(radians(?::float8)) * cos(radians(latitude)) * power(sin(radians(longitude - ?::float8) / 2), 2))))"
+
+// ListJourneysNear - returns at most limit journeys within radius meters of the point matching filter ordered
+// by distance and id. Journeys are selected by bounding box of the circle and then by exact distance
+func (r *repo) ListJourneysNear(ctx context.Context, point models.Coordinates, radius float64, filter JourneyFilter, limit uint64) ([]models.Journey, error) {
+	box := point.BoundingBox(radius)
+	longitudeCondition := squirrel.Sqlizer(squirrel.And{
+		squirrel.GtOrEq{"longitude": box.MinLongitude},
+		squirrel.LtOrEq{"longitude": box.MaxLongitude},
+	})
+	if box.CrossesAntimeridian() {
+		longitudeCondition = squirrel.Or{
+			squirrel.GtOrEq{"longitude": box.MinLongitude},
+			squirrel.LtOrEq{"longitude": box.MaxLongitude},
+		}
+	}
+	distanceArgs := []interface{}{models.EarthRadius, point.Latitude, point.Latitude, point.Longitude}
+
+	query := squirrel.
+		Select(journeyColumns...).
+		From("journeys").
+		Where(squirrel.And{
+			squirrel.GtOrEq{"latitude": box.MinLatitude},
+			squirrel.LtOrEq{"latitude": box.MaxLatitude},
+			longitudeCondition,
+		}).
+		Where(squirrel.Eq{"is_deleted": false}).
+		Where(filter.toSql()).
+		Where(distanceExpr+" <= ?", append(distanceArgs, radius)...).
+		OrderByClause(distanceExpr+" ASC, journey_id ASC", distanceArgs...).
+		Limit(limit).
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return queryJourneys(ctx, query)
+}
+
+// latitude - returns latitude of journey or nil if journey has no coordinates
+func latitude(journey models.Journey) interface{} {
+	if journey.Coordinates == nil {
+		return nil
+	}
+	return journey.Coordinates.Latitude
+}
+
+// longitude - returns longitude of journey or nil if journey has no coordinates
+func longitude(journey models.Journey) interface{} {
+	if journey.Coordinates == nil {
+		return nil
+	}
+	return journey.Coordinates.Longitude
+}
@@ -0,0 +1,221 @@
+package repo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// userJourneysLockSpace - first key of advisory locks of users journeys, it separates them from other advisory locks
+const userJourneysLockSpace = 1
+
+// JourneyOverlap - represents two journeys of the same user overlapping in time
+type JourneyOverlap struct {
+	// First, Second - overlapping journeys, First has lower id
+	First  models.Journey
+	Second models.Journey
+	// Start, End - time range when both journeys take place
+	Start time.Time
+	End   time.Time
+}
+
+// OverlapCheck - result of checking one journey by CheckOverlaps
+type OverlapCheck struct {
+	// JourneyIDs - ids of stored journeys of the user overlapping journey
+	JourneyIDs []uint64
+	// Indexes - positions of other checked journeys of the user overlapping journey, filled with OverlapWarn only
+	Indexes []int
+	// Err - FailedPrecondition error if journey must not be saved because of OverlapReject
+	Err error
+}
+
+// Overlapping - returns ids of overlapping stored journeys and of overlapping checked journeys,
+// ids are ids of checked journeys by their positions known after saving
+func (c OverlapCheck) Overlapping(ids []uint64) []uint64 {
+	overlapping := c.JourneyIDs
+	for _, index := range c.Indexes {
+		overlapping = append(overlapping, ids[index])
+	}
+	return overlapping
+}
+
+// CheckOverlaps - checks journeys which are going to be saved by transaction of r started by WithTx,
+// every journey is checked by policy at the same position. Journeys of users are locked once until the end
+// of transaction, so concurrent requests cannot save overlapping journeys. Journey is checked against stored journeys
+// of the user except checked ones (their stored versions are replaced by the same transaction) and against other
+// checked journeys which are not rejected, OverlapReject takes into account only earlier journeys,
+// so the first of overlapping journeys is saved. Journeys with OverlapAllow are neither locked nor checked.
+func CheckOverlaps(ctx context.Context, r Repo, journeys []models.Journey, policies []models.OverlapPolicy) ([]OverlapCheck, error) {
+	checkedIDs := make(map[uint64]bool, len(journeys))
+	lockedUsers := make(map[uint64]bool)
+	var userIDs []uint64
+	for i, journey := range journeys {
+		if journey.JourneyID > 0 {
+			checkedIDs[journey.JourneyID] = true
+		}
+		if policies[i] != models.OverlapAllow && !lockedUsers[journey.UserID] {
+			lockedUsers[journey.UserID] = true
+			userIDs = append(userIDs, journey.UserID)
+		}
+	}
+	// users are locked in the same order by all transactions to avoid deadlocks
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+	for _, userID := range userIDs {
+		if err := r.LockUserJourneys(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	checks := make([]OverlapCheck, len(journeys))
+	for i, journey := range journeys {
+		if policies[i] == models.OverlapAllow {
+			continue
+		}
+		overlapping, err := r.ListOverlappingJourneyIDs(ctx, journey)
+		if err != nil {
+			return nil, err
+		}
+		for _, journeyID := range overlapping {
+			if !checkedIDs[journeyID] {
+				checks[i].JourneyIDs = append(checks[i].JourneyIDs, journeyID)
+			}
+		}
+		if policies[i] != models.OverlapReject {
+			continue
+		}
+		if len(checks[i].JourneyIDs) > 0 {
+			checks[i].Err = apperrors.New(apperrors.FailedPrecondition,
+				"journey overlaps other journeys of the user: %v", checks[i].JourneyIDs)
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if checks[j].Err == nil && overlapsJourney(journey, journeys[j]) {
+				checks[i].Err = apperrors.New(apperrors.FailedPrecondition,
+					"journey overlaps other journey of the user saved with it")
+				break
+			}
+		}
+	}
+
+	for i, journey := range journeys {
+		if policies[i] != models.OverlapWarn {
+			continue
+		}
+		for j, other := range journeys {
+			if j != i && checks[j].Err == nil && overlapsJourney(journey, other) {
+				checks[i].Indexes = append(checks[i].Indexes, j)
+			}
+		}
+	}
+	return checks, nil
+}
+
+// NeedOverlapCheck - checks that any of policies requires CheckOverlaps, so journeys should be saved in transaction
+func NeedOverlapCheck(policies []models.OverlapPolicy) bool {
+	for _, policy := range policies {
+		if policy != models.OverlapAllow {
+			return true
+		}
+	}
+	return false
+}
+
+// overlapsJourney - checks that journeys belong to the same user and overlap in time
+func overlapsJourney(journey, other models.Journey) bool {
+	return journey.UserID == other.UserID && journey.Overlaps(other)
+}
+
+func (r *repo) LockUserJourneys(ctx context.Context, userID uint64) error {
+	// lock key is int4 hash of user id, collision of users only makes them wait for each other
+	_, err := r.runner.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, hashint8($2))", userJourneysLockSpace, userID)
+	return wrapDBError(err)
+}
+
+func (r *repo) ListOverlappingJourneyIDs(ctx context.Context, journey models.Journey) ([]uint64, error) {
+	query := squirrel.
+		Select("journey_id").
+		From("journeys").
+		Where(squirrel.Eq{"user_id": journey.UserID}).
+		Where(squirrel.NotEq{"journey_id": journey.JourneyID}).
+		Where(squirrel.Lt{"start_time": journey.EndTime}).
+		Where(squirrel.Gt{"end_time": journey.StartTime}).
+		Where(squirrel.Eq{"is_deleted": false}).
+		OrderBy("journey_id ASC").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	var journeyIDs []uint64
+	for rows.Next() {
+		var journeyID uint64
+		if err := rows.Scan(&journeyID); err != nil {
+			return nil, wrapDBError(err)
+		}
+		journeyIDs = append(journeyIDs, journeyID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError(err)
+	}
+	return journeyIDs, nil
+}
+
+func (r *repo) FindOverlappingJourneys(ctx context.Context, userID uint64, from, to time.Time, limit uint64) ([]JourneyOverlap, error) {
+	columns := make([]string, 0, 2*len(journeyColumns)+2)
+	for _, column := range journeyColumns {
+		columns = append(columns, "a."+column)
+	}
+	for _, column := range journeyColumns {
+		columns = append(columns, "b."+column)
+	}
+	columns = append(columns, "greatest(a.start_time, b.start_time) AS overlap_start", "least(a.end_time, b.end_time) AS overlap_end")
+
+	query := squirrel.
+		Select(columns...).
+		From("journeys AS a").
+		Join("journeys AS b ON b.user_id = a.user_id AND b.journey_id > a.journey_id"+
+			" AND b.start_time < a.end_time AND b.end_time > a.start_time AND NOT b.is_deleted").
+		Where(squirrel.Eq{"a.user_id": userID}).
+		Where(squirrel.Eq{"a.is_deleted": false}).
+		OrderBy("overlap_start ASC", "a.journey_id ASC", "b.journey_id ASC").
+		Limit(limit).
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+	if !from.IsZero() {
+		query = query.Where("least(a.end_time, b.end_time) > ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("greatest(a.start_time, b.start_time) < ?", to)
+	}
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	var overlaps []JourneyOverlap
+	for rows.Next() {
+		var first, second journeyScanner
+		var overlap JourneyOverlap
+		dest := append(first.dest(), second.dest()...)
+		if err := rows.Scan(append(dest, &overlap.Start, &overlap.End)...); err != nil {
+			return nil, wrapDBError(err)
+		}
+		overlap.First, overlap.Second = first.journey(), second.journey()
+		overlaps = append(overlaps, overlap)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError(err)
+	}
+	return overlaps, nil
+}
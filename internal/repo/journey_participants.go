@@ -0,0 +1,197 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// ErrOwnerParticipant - returned when owner of journey is invited as participant
+var ErrOwnerParticipant = apperrors.New(apperrors.FailedPrecondition, "owner of journey cannot be invited as participant")
+
+// JourneyAccess - represents role of user in journey
+type JourneyAccess struct {
+	OwnerID uint64
+	Role    models.ParticipantRole
+}
+
+// CheckJourneyAccess - returns access of user to journey or PermissionDenied error if its role is less than required
+func CheckJourneyAccess(ctx context.Context, r Repo, journeyID uint64, userID uint64, required models.ParticipantRole) (JourneyAccess, error) {
+	access, err := r.GetJourneyAccess(ctx, journeyID, userID)
+	if err != nil {
+		return JourneyAccess{}, err
+	}
+	if access.Role < required {
+		return JourneyAccess{}, apperrors.New(apperrors.PermissionDenied,
+			"user %d is %s of journey %d, %s role is required", userID, access.Role, journeyID, required)
+	}
+	return access, nil
+}
+
+// CheckOwnerChange - returns PermissionDenied error if journey is given to another user not by its owner
+func CheckOwnerChange(access JourneyAccess, userID uint64) error {
+	if access.Role < models.RoleOwner && userID != access.OwnerID {
+		return apperrors.New(apperrors.PermissionDenied, "only owner can change user of journey")
+	}
+	return nil
+}
+
+func (r *repo) GetJourneyAccess(ctx context.Context, journeyID uint64, userID uint64) (JourneyAccess, error) {
+	query := squirrel.
+		Select("j.user_id", "p.role").
+		From("journeys AS j").
+		LeftJoin("journey_participants AS p ON p.journey_id = j.journey_id AND p.user_id = ?", userID).
+		Where(squirrel.Eq{"j.journey_id": journeyID, "j.is_deleted": false}).
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var access JourneyAccess
+	var role sql.NullString
+	err := query.QueryRowContext(ctx).Scan(&access.OwnerID, &role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return JourneyAccess{}, apperrors.New(apperrors.NotFound, "journey %d not found", journeyID)
+	}
+	if err != nil {
+		return JourneyAccess{}, wrapDBError(err)
+	}
+
+	switch {
+	case access.OwnerID == userID:
+		access.Role = models.RoleOwner
+	case role.Valid:
+		access.Role, err = models.ParseParticipantRole(role.String)
+	}
+	return access, err
+}
+
+func (r *repo) AddParticipant(ctx context.Context, journeyID uint64, participant models.Participant) error {
+	return r.inTx(ctx, nil, func(tx *repo) error {
+		access, err := tx.GetJourneyAccess(ctx, journeyID, participant.UserID)
+		if err != nil {
+			return err
+		}
+		if access.Role == models.RoleOwner {
+			return ErrOwnerParticipant
+		}
+
+		_, err = squirrel.
+			Insert("journey_participants").
+			Columns("journey_id", "user_id", "role").
+			Values(journeyID, participant.UserID, participant.Role.String()).
+			Suffix("ON CONFLICT (journey_id, user_id) DO UPDATE SET role = EXCLUDED.role").
+			RunWith(tx.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			ExecContext(ctx)
+		return wrapDBError(err)
+	})
+}
+
+func (r *repo) RemoveParticipant(ctx context.Context, journeyID uint64, userID uint64) error {
+	result, err := squirrel.
+		Delete("journey_participants").
+		Where(squirrel.Eq{"journey_id": journeyID, "user_id": userID}).
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar).
+		ExecContext(ctx)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return wrapDBError(err)
+	}
+	if removed == 0 {
+		return apperrors.New(apperrors.NotFound, "participant %d of journey %d not found", userID, journeyID)
+	}
+	return nil
+}
+
+func (r *repo) ListParticipants(ctx context.Context, journeyID uint64) ([]models.Participant, error) {
+	var participants []models.Participant
+	err := r.withSnapshot(ctx, func(tx *repo) error {
+		var ownerID uint64
+		err := squirrel.
+			Select("user_id").
+			From("journeys").
+			Where(squirrel.Eq{"journey_id": journeyID, "is_deleted": false}).
+			RunWith(tx.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryRowContext(ctx).
+			Scan(&ownerID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.New(apperrors.NotFound, "journey %d not found", journeyID)
+		}
+		if err != nil {
+			return wrapDBError(err)
+		}
+		participants = append(participants, models.Participant{UserID: ownerID, Role: models.RoleOwner})
+
+		// owner could be invited before receiving journey, participant role of owner is ignored
+		rows, err := squirrel.
+			Select("user_id", "role", "invited_at").
+			From("journey_participants").
+			Where(squirrel.Eq{"journey_id": journeyID}).
+			Where(squirrel.NotEq{"user_id": ownerID}).
+			OrderBy("invited_at ASC", "user_id ASC").
+			RunWith(tx.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryContext(ctx)
+		if err != nil {
+			return wrapDBError(err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var participant models.Participant
+			var role string
+			if err := rows.Scan(&participant.UserID, &role, &participant.InvitedAt); err != nil {
+				return wrapDBError(err)
+			}
+			if participant.Role, err = models.ParseParticipantRole(role); err != nil {
+				return err
+			}
+			participants = append(participants, participant)
+		}
+		return wrapDBError(rows.Err())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return participants, nil
+}
+
+func (r *repo) GetJourneyParticipantIDs(ctx context.Context, journeyIDs []uint64) (map[uint64][]uint64, error) {
+	rows, err := squirrel.
+		Select("p.journey_id", "p.user_id").
+		From("journey_participants p").
+		Join("journeys j ON j.journey_id = p.journey_id").
+		Where(squirrel.Expr("p.journey_id = ANY(?)", toInt64Array(journeyIDs))).
+		// owner could be invited before receiving journey
+		Where("p.user_id <> j.user_id").
+		OrderBy("p.journey_id ASC", "p.invited_at ASC", "p.user_id ASC").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar).
+		QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	participantIDs := make(map[uint64][]uint64)
+	for rows.Next() {
+		var journeyID, userID uint64
+		if err := rows.Scan(&journeyID, &userID); err != nil {
+			return nil, wrapDBError(err)
+		}
+		participantIDs[journeyID] = append(participantIDs[journeyID], userID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError(err)
+	}
+	return participantIDs, nil
+}
@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"context"
+	"html"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// searchConfig - text search configuration of journeys.search_vector column,
+// it stems Cyrillic words with Russian and Latin words with English snowball stemmer
+const searchConfig = "russian"
+
+// searchHeadlineStart, searchHeadlineStop - control characters marking matched words in ts_headline output,
+// they are removed from address and description, so they are the only markup of headline
+const (
+	searchHeadlineStart = "\x02"
+	searchHeadlineStop  = "\x03"
+)
+
+// searchHeadlineOptions - options of ts_headline, matched words are wrapped in searchHeadlineStart and searchHeadlineStop
+const searchHeadlineOptions = "StartSel=" + searchHeadlineStart + ", StopSel=" + searchHeadlineStop +
+	", MaxWords=30, MinWords=10, MaxFragments=2"
+
+// snippetReplacer - replaces markers of matched words in HTML escaped headline by <b></b>
+var snippetReplacer = strings.NewReplacer(searchHeadlineStart, "<b>", searchHeadlineStop, "</b>")
+
+// JourneySearchResult - represents journey found by full-text search
+type JourneySearchResult struct {
+	Journey models.Journey
+	// Rank - relevance of journey to search query, greater is better
+	Rank float32
+	// Snippet - HTML escaped fragments of address and description with matched words wrapped in <b></b>
+	Snippet string
+}
+
+func (r *repo) SearchJourneys(ctx context.Context, text string, filter JourneyFilter, limit, offset uint64) ([]JourneySearchResult, error) {
+	query := squirrel.
+		Select(journeyColumns...).
+		Column("ts_rank_cd(search_vector, search_query) AS rank").
+		Column("ts_headline(?::regconfig, translate(concat_ws(' ', address, description), ?, ''), search_query, ?)",
+			searchConfig, searchHeadlineStart+searchHeadlineStop, searchHeadlineOptions).
+		From("journeys").
+		CrossJoin("websearch_to_tsquery(?::regconfig, ?) AS search_query", searchConfig, text).
+		Where("search_vector @@ search_query").
+		Where(squirrel.Eq{"is_deleted": false}).
+		Where(filter.toSql()).
+		OrderBy("rank DESC", "journey_id ASC").
+		Limit(limit).
+		Offset(offset).
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	var results []JourneySearchResult
+	for rows.Next() {
+		var result JourneySearchResult
+		var headline string
+		result.Journey, err = scanJourney(rows, &result.Rank, &headline)
+		if err != nil {
+			return nil, err
+		}
+		// address and description are user input, so they are escaped before adding markup
+		result.Snippet = snippetReplacer.Replace(html.EscapeString(headline))
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError(err)
+	}
+	return results, nil
+}
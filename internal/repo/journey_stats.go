@@ -0,0 +1,154 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// localStartTime - start time of journey as timestamp without time zone in time zone of journey
+const localStartTime = "start_time AT TIME ZONE coalesce(nullif(time_zone, ''), 'UTC')"
+
+// JourneyStats - represents aggregated statistics of journeys
+type JourneyStats struct {
+	Count uint64
+	// TotalDuration, AverageDuration - durations of whole journeys, they are not cut by time range of filter
+	TotalDuration   time.Duration
+	AverageDuration time.Duration
+	// TopAddresses - most frequent not empty addresses in order of decreasing count
+	TopAddresses []AddressCount
+	// Months - numbers of journeys by month of start in time zone of journey in ascending order,
+	// months without journeys are omitted
+	Months []MonthCount
+	// Weekdays - numbers of journeys by weekday of start in time zone of journey
+	Weekdays [7]uint64
+}
+
+// AddressCount - represents number of journeys with address
+type AddressCount struct {
+	Address string
+	Count   uint64
+}
+
+// MonthCount - represents number of journeys started in month
+type MonthCount struct {
+	Year  int
+	Month time.Month
+	Count uint64
+}
+
+func (r *repo) GetJourneyStats(ctx context.Context, filter JourneyFilter, topAddressesLimit uint64) (*JourneyStats, error) {
+	var stats *JourneyStats
+	// all statistics are computed from the same state of journeys
+	err := r.withSnapshot(ctx, func(tx *repo) error {
+		var err error
+		stats, err = tx.getJourneyStats(ctx, filter, topAddressesLimit)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// getJourneyStats - computes statistics of journeys with several queries, it should be called in snapshot
+func (r *repo) getJourneyStats(ctx context.Context, filter JourneyFilter, topAddressesLimit uint64) (*JourneyStats, error) {
+	selectJourneys := func(columns ...string) squirrel.SelectBuilder {
+		return squirrel.
+			Select(columns...).
+			From("journeys").
+			Where(squirrel.Eq{"is_deleted": false}).
+			Where(filter.toSql()).
+			RunWith(r.runner).
+			PlaceholderFormat(squirrel.Dollar)
+	}
+
+	stats := &JourneyStats{}
+	var totalSeconds, averageSeconds float64
+	err := selectJourneys(
+		"count(*)",
+		"coalesce(sum(extract(epoch FROM end_time - start_time)), 0)::float8",
+		"coalesce(avg(extract(epoch FROM end_time - start_time)), 0)::float8",
+	).QueryRowContext(ctx).Scan(&stats.Count, &totalSeconds, &averageSeconds)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	stats.TotalDuration = time.Duration(totalSeconds * float64(time.Second))
+	stats.AverageDuration = time.Duration(averageSeconds * float64(time.Second))
+	if stats.Count == 0 {
+		return stats, nil
+	}
+
+	if err = scanStatsRows(ctx,
+		selectJourneys("address", "count(*) AS count").
+			Where(squirrel.NotEq{"address": ""}).
+			GroupBy("address").
+			OrderBy("count DESC", "address ASC").
+			Limit(topAddressesLimit),
+		func(rows squirrel.RowScanner) error {
+			var address AddressCount
+			if err := rows.Scan(&address.Address, &address.Count); err != nil {
+				return err
+			}
+			stats.TopAddresses = append(stats.TopAddresses, address)
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	if err = scanStatsRows(ctx,
+		selectJourneys(
+			"extract(year FROM "+localStartTime+")::int AS year",
+			"extract(month FROM "+localStartTime+")::int AS month",
+			"count(*)",
+		).
+			GroupBy("year", "month").
+			OrderBy("year ASC", "month ASC"),
+		func(rows squirrel.RowScanner) error {
+			var month MonthCount
+			if err := rows.Scan(&month.Year, &month.Month, &month.Count); err != nil {
+				return err
+			}
+			stats.Months = append(stats.Months, month)
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	if err = scanStatsRows(ctx,
+		selectJourneys("extract(dow FROM "+localStartTime+")::int AS weekday", "count(*)").
+			GroupBy("weekday"),
+		func(rows squirrel.RowScanner) error {
+			var weekday time.Weekday
+			var count uint64
+			if err := rows.Scan(&weekday, &count); err != nil {
+				return err
+			}
+			stats.Weekdays[weekday] = count
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// scanStatsRows - runs query and calls scan for each row
+func scanStatsRows(ctx context.Context, query squirrel.SelectBuilder, scan func(rows squirrel.RowScanner) error) error {
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return wrapDBError(err)
+		}
+	}
+	return wrapDBError(rows.Err())
+}
@@ -0,0 +1,122 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+func (r *repo) ChangeJourneyStatus(ctx context.Context, journeyID uint64, status models.JourneyStatus, expectedRevision uint64) (uint64, error) {
+	var revision uint64
+	err := r.inTx(ctx, nil, func(tx *repo) error {
+		var currentName string
+		err := squirrel.
+			Select("status").
+			From("journeys").
+			Where(squirrel.Eq{"journey_id": journeyID, "is_deleted": false}).
+			Suffix("FOR UPDATE").
+			RunWith(tx.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryRowContext(ctx).
+			Scan(&currentName)
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.New(apperrors.NotFound, "journey %d not found", journeyID)
+		}
+		if err != nil {
+			return wrapDBError(err)
+		}
+
+		// status is always valid because of check constraint of journeys table
+		current, _ := models.ParseJourneyStatus(currentName)
+		if err := current.CheckTransition(status); err != nil {
+			return err
+		}
+
+		query := squirrel.
+			Update("journeys").
+			Set("status", status.String()).
+			Set("revision", squirrel.Expr("revision + 1")).
+			Where(squirrel.Eq{"journey_id": journeyID, "is_deleted": false})
+		revision, err = tx.updateWithRevision(ctx, query, journeyID, expectedRevision)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+func (r *repo) AdvanceJourneyStatuses(ctx context.Context, now time.Time, limit uint64) ([]models.Journey, error) {
+	plannedStarted := squirrel.And{
+		squirrel.Eq{"status": models.StatusPlanned.String()},
+		squirrel.LtOrEq{"start_time": now},
+	}
+	inProgressEnded := squirrel.And{
+		squirrel.Eq{"status": models.StatusInProgress.String()},
+		squirrel.LtOrEq{"end_time": now},
+	}
+	// journeys are locked by subquery and skipped if they are changed concurrently,
+	// they will be advanced by next call
+	subquery := squirrel.
+		Select("journey_id").
+		From("journeys").
+		Where(squirrel.Eq{"is_deleted": false}).
+		Where(squirrel.Or{plannedStarted, inProgressEnded}).
+		OrderBy("journey_id ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	// planned journey which has already ended is completed at once
+	status := squirrel.Expr("CASE WHEN end_time <= ? THEN ? ELSE ? END",
+		now, models.StatusCompleted.String(), models.StatusInProgress.String())
+	query := squirrel.
+		Update("journeys").
+		Set("status", status).
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(squirrel.Expr("journey_id IN (?)", subquery)).
+		Suffix("RETURNING " + strings.Join(journeyColumns, ", ")).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var journeys []models.Journey
+	err := r.inTx(ctx, nil, func(tx *repo) error {
+		var err error
+		journeys, err = queryAdvancedJourneys(ctx, query.RunWith(tx.runner))
+		if err != nil {
+			return err
+		}
+		if err := tx.loadWaypoints(ctx, journeys); err != nil {
+			return err
+		}
+		return tx.loadTags(ctx, journeys)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return journeys, nil
+}
+
+// queryAdvancedJourneys - executes update query returning journeyColumns and scans changed journeys
+func queryAdvancedJourneys(ctx context.Context, query squirrel.UpdateBuilder) ([]models.Journey, error) {
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	var journeys []models.Journey
+	for rows.Next() {
+		journey, err := scanJourney(rows)
+		if err != nil {
+			return nil, err
+		}
+		journeys = append(journeys, journey)
+	}
+	return journeys, wrapDBError(rows.Err())
+}
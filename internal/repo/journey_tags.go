@@ -0,0 +1,152 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// TagCount - represents tag with number of journeys having it
+type TagCount struct {
+	Name  string
+	Count uint64
+}
+
+func (r *repo) ListTags(ctx context.Context, userID uint64) ([]TagCount, error) {
+	query := squirrel.
+		Select("t.name", "count(*)").
+		From("journey_tags AS jt").
+		Join("tags AS t ON t.tag_id = jt.tag_id").
+		Join("journeys AS j ON j.journey_id = jt.journey_id").
+		Where(squirrel.Eq{"j.user_id": userID, "j.is_deleted": false}).
+		GroupBy("t.name").
+		OrderBy("count(*) DESC", "t.name ASC").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	var tags []TagCount
+	for rows.Next() {
+		var tag TagCount
+		if err := rows.Scan(&tag.Name, &tag.Count); err != nil {
+			return nil, wrapDBError(err)
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError(err)
+	}
+	return tags, nil
+}
+
+// insertTags - adds tags to journeys, journeyIDs are ids of journeys in the same order,
+// tags which are not used by any journey yet are created
+func (r *repo) insertTags(ctx context.Context, journeyIDs []uint64, journeys []models.Journey) error {
+	var tagJourneyIDs []uint64
+	var names pq.StringArray
+	for i, journey := range journeys {
+		for _, tag := range journey.Tags {
+			tagJourneyIDs = append(tagJourneyIDs, journeyIDs[i])
+			names = append(names, tag)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	_, err := r.runner.ExecContext(ctx,
+		"INSERT INTO tags (name) SELECT DISTINCT unnest($1::text[]) ON CONFLICT (name) DO NOTHING", names)
+	if err != nil {
+		return wrapDBError(err)
+	}
+
+	_, err = r.runner.ExecContext(ctx,
+		"INSERT INTO journey_tags (journey_id, tag_id)"+
+			" SELECT v.journey_id, t.tag_id FROM unnest($1::bigint[], $2::text[]) AS v(journey_id, name)"+
+			" JOIN tags AS t ON t.name = v.name"+
+			" ON CONFLICT DO NOTHING",
+		toInt64Array(tagJourneyIDs), names)
+	return wrapDBError(err)
+}
+
+// replaceTags - works like insertTags but removes previous tags of journeys first
+func (r *repo) replaceTags(ctx context.Context, journeyIDs []uint64, journeys []models.Journey) error {
+	if len(journeyIDs) == 0 {
+		return nil
+	}
+
+	_, err := squirrel.
+		Delete("journey_tags").
+		Where(squirrel.Expr("journey_id = ANY(?)", toInt64Array(journeyIDs))).
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar).
+		ExecContext(ctx)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	return r.insertTags(ctx, journeyIDs, journeys)
+}
+
+// loadTags - sets tags of journeys loaded with one query, tags of every journey are sorted by name
+func (r *repo) loadTags(ctx context.Context, journeys []models.Journey) error {
+	if len(journeys) == 0 {
+		return nil
+	}
+	indexes := make(map[uint64]int, len(journeys))
+	journeyIDs := make([]uint64, len(journeys))
+	for i, journey := range journeys {
+		indexes[journey.JourneyID] = i
+		journeyIDs[i] = journey.JourneyID
+	}
+
+	query := squirrel.
+		Select("jt.journey_id", "t.name").
+		From("journey_tags AS jt").
+		Join("tags AS t ON t.tag_id = jt.tag_id").
+		Where(squirrel.Expr("jt.journey_id = ANY(?)", toInt64Array(journeyIDs))).
+		OrderBy("jt.journey_id ASC", "t.name ASC").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var journeyID uint64
+		var name string
+		if err := rows.Scan(&journeyID, &name); err != nil {
+			return wrapDBError(err)
+		}
+		journey := &journeys[indexes[journeyID]]
+		journey.Tags = append(journey.Tags, name)
+	}
+	return wrapDBError(rows.Err())
+}
+
+// tagsCondition - returns condition matching journeys having any of tags or, if all is true, all of them
+func tagsCondition(tags []string, all bool) squirrel.Sqlizer {
+	subquery := squirrel.
+		Select("jt.journey_id").
+		From("journey_tags AS jt").
+		Join("tags AS t ON t.tag_id = jt.tag_id").
+		Where(squirrel.Expr("t.name = ANY(?)", pq.StringArray(tags)))
+	if all {
+		unique := make(map[string]bool, len(tags))
+		for _, tag := range tags {
+			unique[tag] = true
+		}
+		subquery = subquery.GroupBy("jt.journey_id").Having("count(*) = ?", len(unique))
+	}
+	return squirrel.Expr("journey_id IN (?)", subquery)
+}
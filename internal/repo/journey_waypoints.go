@@ -0,0 +1,298 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+var (
+	// ErrTooManyWaypoints - returned when waypoint is added to journey with models.MaxWaypoints waypoints
+	ErrTooManyWaypoints = apperrors.New(apperrors.FailedPrecondition, "journey already has %d waypoints", models.MaxWaypoints)
+	// ErrWaypointsMismatch - returned when reordered waypoint ids are not the ids of all waypoints of journey
+	ErrWaypointsMismatch = apperrors.New(apperrors.InvalidArgument, "waypoint ids must contain every waypoint of journey exactly once")
+)
+
+// waypointColumns - columns of journey_waypoints table scanned by loadWaypoints
+var waypointColumns = []string{
+	"journey_id", "waypoint_id", "address", "latitude", "longitude", "arrival_time", "departure_time",
+}
+
+func (r *repo) AddWaypoint(ctx context.Context, journeyID uint64, waypoint models.Waypoint, position uint64) (uint64, uint64, error) {
+	var waypointID, revision uint64
+	err := r.inTx(ctx, nil, func(tx *repo) error {
+		var err error
+		revision, err = tx.touchJourney(ctx, journeyID)
+		if err != nil {
+			return err
+		}
+
+		var count uint64
+		err = squirrel.
+			Select("count(*)").
+			From("journey_waypoints").
+			Where(squirrel.Eq{"journey_id": journeyID}).
+			RunWith(tx.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryRowContext(ctx).
+			Scan(&count)
+		if err != nil {
+			return wrapDBError(err)
+		}
+		if count >= models.MaxWaypoints {
+			return ErrTooManyWaypoints
+		}
+		if position == 0 || position > count {
+			position = count + 1
+		}
+
+		if err = tx.shiftWaypoints(ctx, journeyID, squirrel.GtOrEq{"position": position}, "position + 1"); err != nil {
+			return err
+		}
+
+		latitude, longitude := coordinatesValues(waypoint.Coordinates)
+		err = squirrel.
+			Insert("journey_waypoints").
+			Columns("journey_id", "position", "address", "latitude", "longitude", "arrival_time", "departure_time").
+			Values(journeyID, position, waypoint.Address, latitude, longitude,
+				timeValue(waypoint.ArrivalTime), timeValue(waypoint.DepartureTime)).
+			Suffix("RETURNING \"waypoint_id\"").
+			RunWith(tx.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryRowContext(ctx).
+			Scan(&waypointID)
+		return wrapDBError(err)
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return waypointID, revision, nil
+}
+
+func (r *repo) ReorderWaypoints(ctx context.Context, journeyID uint64, waypointIDs []uint64) (uint64, error) {
+	var revision uint64
+	err := r.inTx(ctx, nil, func(tx *repo) error {
+		var err error
+		revision, err = tx.touchJourney(ctx, journeyID)
+		if err != nil {
+			return err
+		}
+
+		rows, err := squirrel.
+			Select("waypoint_id").
+			From("journey_waypoints").
+			Where(squirrel.Eq{"journey_id": journeyID}).
+			RunWith(tx.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryContext(ctx)
+		if err != nil {
+			return wrapDBError(err)
+		}
+		current := make(map[uint64]bool)
+		for rows.Next() {
+			var waypointID uint64
+			if err := rows.Scan(&waypointID); err != nil {
+				_ = rows.Close()
+				return wrapDBError(err)
+			}
+			current[waypointID] = true
+		}
+		if err := rows.Close(); err != nil {
+			return wrapDBError(err)
+		}
+		if err := rows.Err(); err != nil {
+			return wrapDBError(err)
+		}
+
+		if len(waypointIDs) != len(current) {
+			return ErrWaypointsMismatch
+		}
+		for _, waypointID := range waypointIDs {
+			if !current[waypointID] {
+				return ErrWaypointsMismatch
+			}
+			// duplicate id is detected as missing
+			delete(current, waypointID)
+		}
+
+		_, err = tx.runner.ExecContext(ctx,
+			"UPDATE journey_waypoints AS w SET position = o.position"+
+				" FROM unnest($1::bigint[]) WITH ORDINALITY AS o(waypoint_id, position)"+
+				" WHERE w.waypoint_id = o.waypoint_id AND w.journey_id = $2",
+			toInt64Array(waypointIDs), journeyID)
+		return wrapDBError(err)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+func (r *repo) RemoveWaypoint(ctx context.Context, journeyID uint64, waypointID uint64) (uint64, error) {
+	var revision uint64
+	err := r.inTx(ctx, nil, func(tx *repo) error {
+		var err error
+		revision, err = tx.touchJourney(ctx, journeyID)
+		if err != nil {
+			return err
+		}
+
+		var position uint64
+		err = squirrel.
+			Delete("journey_waypoints").
+			Where(squirrel.Eq{"journey_id": journeyID, "waypoint_id": waypointID}).
+			Suffix("RETURNING \"position\"").
+			RunWith(tx.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryRowContext(ctx).
+			Scan(&position)
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.New(apperrors.NotFound, "waypoint %d of journey %d not found", waypointID, journeyID)
+		}
+		if err != nil {
+			return wrapDBError(err)
+		}
+
+		return tx.shiftWaypoints(ctx, journeyID, squirrel.Gt{"position": position}, "position - 1")
+	})
+	if err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+// touchJourney - increments revision of not removed journey and returns it,
+// row of journey stays locked until the end of transaction, so changes of its waypoints are serialized
+func (r *repo) touchJourney(ctx context.Context, journeyID uint64) (uint64, error) {
+	var revision uint64
+	err := squirrel.
+		Update("journeys").
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(squirrel.And{squirrel.Eq{"journey_id": journeyID}, squirrel.Eq{"is_deleted": false}}).
+		Suffix("RETURNING \"revision\"").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar).
+		QueryRowContext(ctx).
+		Scan(&revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, apperrors.New(apperrors.NotFound, "journey %d not found", journeyID)
+	}
+	if err != nil {
+		return 0, wrapDBError(err)
+	}
+	return revision, nil
+}
+
+// shiftWaypoints - sets position of waypoints of journey matching condition to expression,
+// uniqueness of positions is checked at commit
+func (r *repo) shiftWaypoints(ctx context.Context, journeyID uint64, condition squirrel.Sqlizer, position string) error {
+	_, err := squirrel.
+		Update("journey_waypoints").
+		Set("position", squirrel.Expr(position)).
+		Where(squirrel.Eq{"journey_id": journeyID}).
+		Where(condition).
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar).
+		ExecContext(ctx)
+	return wrapDBError(err)
+}
+
+// insertWaypoints - inserts waypoints of journeys with one query, journeyIDs are ids of journeys in the same order
+func (r *repo) insertWaypoints(ctx context.Context, journeyIDs []uint64, journeys []models.Journey) error {
+	query := squirrel.
+		Insert("journey_waypoints").
+		Columns("journey_id", "position", "address", "latitude", "longitude", "arrival_time", "departure_time").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	count := 0
+	for i, journey := range journeys {
+		for j, waypoint := range journey.Waypoints {
+			latitude, longitude := coordinatesValues(waypoint.Coordinates)
+			query = query.Values(journeyIDs[i], j+1, waypoint.Address, latitude, longitude,
+				timeValue(waypoint.ArrivalTime), timeValue(waypoint.DepartureTime))
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+
+	_, err := query.ExecContext(ctx)
+	return wrapDBError(err)
+}
+
+// loadWaypoints - sets waypoints of journeys loaded with one query, times of waypoints are in time zone of journey
+func (r *repo) loadWaypoints(ctx context.Context, journeys []models.Journey) error {
+	if len(journeys) == 0 {
+		return nil
+	}
+	indexes := make(map[uint64]int, len(journeys))
+	journeyIDs := make([]uint64, len(journeys))
+	for i, journey := range journeys {
+		indexes[journey.JourneyID] = i
+		journeyIDs[i] = journey.JourneyID
+	}
+
+	query := squirrel.
+		Select(waypointColumns...).
+		From("journey_waypoints").
+		Where(squirrel.Expr("journey_id = ANY(?)", toInt64Array(journeyIDs))).
+		OrderBy("journey_id ASC", "position ASC").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var journeyID uint64
+		var waypoint models.Waypoint
+		var latitude, longitude sql.NullFloat64
+		var arrivalTime, departureTime sql.NullTime
+		err := rows.Scan(&journeyID, &waypoint.WaypointID, &waypoint.Address, &latitude, &longitude, &arrivalTime, &departureTime)
+		if err != nil {
+			return wrapDBError(err)
+		}
+		if latitude.Valid && longitude.Valid {
+			waypoint.Coordinates = &models.Coordinates{Latitude: latitude.Float64, Longitude: longitude.Float64}
+		}
+		waypoint.ArrivalTime, waypoint.DepartureTime = arrivalTime.Time, departureTime.Time
+
+		journey := &journeys[indexes[journeyID]]
+		journey.Waypoints = append(journey.Waypoints, waypoint)
+	}
+	if err := rows.Err(); err != nil {
+		return wrapDBError(err)
+	}
+
+	for i := range journeys {
+		journeys[i].Localize()
+	}
+	return nil
+}
+
+// coordinatesValues - returns latitude and longitude values for query, nil values are returned for nil coordinates
+func coordinatesValues(coordinates *models.Coordinates) (interface{}, interface{}) {
+	if coordinates == nil {
+		return nil, nil
+	}
+	return coordinates.Latitude, coordinates.Longitude
+}
+
+// timeValue - returns value of optional time for query, nil is returned for zero time
+func timeValue(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,193 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+//OperationRepo - represents the object for working with storage of asynchronous Operations
+type OperationRepo interface {
+	AddOperation(ctx context.Context, operation models.Operation) (uint64, error)
+	CompleteOperationChunk(ctx context.Context, operationID uint64, journeyIDs []uint64) error
+	FailOperation(ctx context.Context, operationID uint64, reason string) error
+	DescribeOperation(ctx context.Context, operationID uint64) (*models.Operation, error)
+	ListOperations(ctx context.Context, userID, limit, offset uint64) ([]models.Operation, error)
+}
+
+type operationRepo struct {
+	db *sqlx.DB
+}
+
+// NewOperationRepo - creates new Operation repository using database
+func NewOperationRepo(db *sqlx.DB) OperationRepo {
+	return &operationRepo{db: db}
+}
+
+func (r *operationRepo) AddOperation(ctx context.Context, operation models.Operation) (uint64, error) {
+	query := squirrel.
+		Insert("operations").
+		Columns("user_id", "operation_type", "status", "pending_chunks").
+		Values(operation.UserID, operation.Type, models.OperationPending, operation.PendingChunks).
+		Suffix("RETURNING \"operation_id\"").
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var operationID uint64
+	err := query.QueryRowContext(ctx).Scan(&operationID)
+	if err != nil {
+		return 0, wrapDBError(err)
+	}
+	return operationID, nil
+}
+
+// CompleteOperationChunk - saves journeys ids of applied chunk and marks operation as succeeded
+// when there are no pending chunks anymore. Failed operation stays failed.
+func (r *operationRepo) CompleteOperationChunk(ctx context.Context, operationID uint64, journeyIDs []uint64) error {
+	query := squirrel.
+		Update("operations").
+		Set("journey_ids", squirrel.Expr("journey_ids || ?::bigint[]", toInt64Array(journeyIDs))).
+		Set("pending_chunks", squirrel.Expr("GREATEST(pending_chunks - 1, 0)")).
+		Set("status", squirrel.Expr(
+			"CASE WHEN status = ? AND pending_chunks <= 1 THEN ? ELSE status END",
+			models.OperationPending,
+			models.OperationSucceeded,
+		)).
+		Set("updated_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"operation_id": operationID}).
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return execOperationUpdate(ctx, query, operationID)
+}
+
+func (r *operationRepo) FailOperation(ctx context.Context, operationID uint64, reason string) error {
+	query := squirrel.
+		Update("operations").
+		Set("status", models.OperationFailed).
+		Set("pending_chunks", squirrel.Expr("GREATEST(pending_chunks - 1, 0)")).
+		Set("error", reason).
+		Set("updated_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"operation_id": operationID}).
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return execOperationUpdate(ctx, query, operationID)
+}
+
+func (r *operationRepo) DescribeOperation(ctx context.Context, operationID uint64) (*models.Operation, error) {
+	query := squirrel.
+		Select(operationColumns...).
+		From("operations").
+		Where(squirrel.Eq{"operation_id": operationID}).
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	operation, err := scanOperation(query.QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.New(apperrors.NotFound, "operation %d not found", operationID)
+	}
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	return operation, nil
+}
+
+// ListOperations - returns operations created by user, newest first. Operations of all users are returned if userID is 0
+func (r *operationRepo) ListOperations(ctx context.Context, userID, limit, offset uint64) ([]models.Operation, error) {
+	query := squirrel.
+		Select(operationColumns...).
+		From("operations").
+		Limit(limit).
+		Offset(offset).
+		OrderBy("operation_id DESC").
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar)
+	if userID > 0 {
+		query = query.Where(squirrel.Eq{"user_id": userID})
+	}
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	var operationsList []models.Operation
+	for rows.Next() {
+		operation, err := scanOperation(rows)
+		if err != nil {
+			return operationsList, wrapDBError(err)
+		}
+		operationsList = append(operationsList, *operation)
+	}
+	if err := rows.Err(); err != nil {
+		return operationsList, wrapDBError(err)
+	}
+
+	return operationsList, nil
+}
+
+// execOperationUpdate - executes update of one operation, returns apperrors.NotFound error if operation does not exist
+func execOperationUpdate(ctx context.Context, query squirrel.UpdateBuilder, operationID uint64) error {
+	result, err := query.ExecContext(ctx)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return wrapDBError(err)
+	}
+	if affected == 0 {
+		return apperrors.New(apperrors.NotFound, "operation %d not found", operationID)
+	}
+	return nil
+}
+
+var operationColumns = []string{
+	"operation_id", "user_id", "operation_type", "status", "pending_chunks", "journey_ids", "error", "created_at", "updated_at",
+}
+
+func scanOperation(row squirrel.RowScanner) (*models.Operation, error) {
+	var operation models.Operation
+	var journeyIDs pq.Int64Array
+	err := row.Scan(
+		&operation.OperationID,
+		&operation.UserID,
+		&operation.Type,
+		&operation.Status,
+		&operation.PendingChunks,
+		&journeyIDs,
+		&operation.Error,
+		&operation.CreatedAt,
+		&operation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	operation.JourneyIDs = fromInt64Array(journeyIDs)
+	return &operation, nil
+}
+
+func toInt64Array(ids []uint64) pq.Int64Array {
+	array := make(pq.Int64Array, len(ids))
+	for i, id := range ids {
+		array[i] = int64(id)
+	}
+	return array
+}
+
+func fromInt64Array(array pq.Int64Array) []uint64 {
+	ids := make([]uint64, len(array))
+	for i, id := range array {
+		ids[i] = uint64(id)
+	}
+	return ids
+}
@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+func TestOperationRepo_AddOperation(t *testing.T) {
+	operationRepository := NewOperationRepo(db)
+
+	id, err := operationRepository.AddOperation(context.Background(), models.Operation{Type: models.CreateOperation, PendingChunks: 1})
+
+	assert.NoError(t, err)
+	assert.Greater(t, id, uint64(0), "Id must be greater then 0")
+}
+
+func TestOperationRepo_CompleteOperationChunk(t *testing.T) {
+	operationRepository := NewOperationRepo(db)
+	id, _ := operationRepository.AddOperation(context.Background(), models.Operation{Type: models.MultiCreateOperation, PendingChunks: 2})
+
+	assert.NoError(t, operationRepository.CompleteOperationChunk(context.Background(), id, []uint64{1, 2}))
+	operation, err := operationRepository.DescribeOperation(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, models.OperationPending, operation.Status)
+
+	assert.NoError(t, operationRepository.CompleteOperationChunk(context.Background(), id, []uint64{3}))
+	operation, err = operationRepository.DescribeOperation(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, models.OperationSucceeded, operation.Status)
+	assert.Equal(t, []uint64{1, 2, 3}, operation.JourneyIDs)
+}
+
+func TestOperationRepo_FailOperation(t *testing.T) {
+	operationRepository := NewOperationRepo(db)
+	id, _ := operationRepository.AddOperation(context.Background(), models.Operation{Type: models.RemoveOperation, PendingChunks: 1})
+
+	err := operationRepository.FailOperation(context.Background(), id, "error")
+	assert.NoError(t, err)
+
+	operation, err := operationRepository.DescribeOperation(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, models.OperationFailed, operation.Status)
+	assert.Equal(t, "error", operation.Error)
+}
+
+func TestOperationRepo_ListOperations(t *testing.T) {
+	operations, err := NewOperationRepo(db).ListOperations(context.Background(), 0, 10, 0)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, operations)
+}
+
+func TestOperationRepo_ListOperationsOfUser(t *testing.T) {
+	operationRepository := NewOperationRepo(db)
+	id, err := operationRepository.AddOperation(context.Background(), models.Operation{UserID: 42, Type: models.RemoveOperation, PendingChunks: 1})
+	assert.NoError(t, err)
+	_, err = operationRepository.AddOperation(context.Background(), models.Operation{UserID: 43, Type: models.RemoveOperation, PendingChunks: 1})
+	assert.NoError(t, err)
+
+	operations, err := operationRepository.ListOperations(context.Background(), 42, 10, 0)
+
+	assert.NoError(t, err)
+	if assert.Len(t, operations, 1) {
+		assert.Equal(t, id, operations[0].OperationID)
+		assert.Equal(t, uint64(42), operations[0].UserID)
+	}
+}
+
+func TestOperationRepo_OperationNotFound(t *testing.T) {
+	operationRepository := NewOperationRepo(db)
+
+	_, err := operationRepository.DescribeOperation(context.Background(), 1000000)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+
+	err = operationRepository.FailOperation(context.Background(), 1000000, "error")
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+}
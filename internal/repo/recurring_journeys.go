@@ -0,0 +1,320 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// recurringJourneyColumns - columns of recurring_journeys table scanned by scanRecurringJourney
+var recurringJourneyColumns = []string{
+	"recurring_journey_id", "user_id", "address", "description", "start_time", "end_time", "time_zone", "latitude", "longitude",
+	"rule", "revision",
+}
+
+func (r *repo) AddRecurringJourney(ctx context.Context, recurringJourney models.RecurringJourney) (uint64, error) {
+	latitude, longitude := coordinatesValues(recurringJourney.Coordinates)
+	query := squirrel.
+		Insert("recurring_journeys").
+		Columns("user_id", "address", "description", "start_time", "end_time", "time_zone", "latitude", "longitude", "rule").
+		Values(recurringJourney.UserID, recurringJourney.Address, recurringJourney.Description, recurringJourney.StartTime,
+			recurringJourney.EndTime, recurringJourney.TimeZone, latitude, longitude, recurringJourney.Rule).
+		Suffix("RETURNING \"recurring_journey_id\"").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var recurringJourneyID uint64
+	if err := query.QueryRowContext(ctx).Scan(&recurringJourneyID); err != nil {
+		return 0, wrapDBError(err)
+	}
+	return recurringJourneyID, nil
+}
+
+func (r *repo) DescribeRecurringJourney(ctx context.Context, recurringJourneyID uint64) (*models.RecurringJourney, error) {
+	query := squirrel.
+		Select(recurringJourneyColumns...).
+		From("recurring_journeys").
+		Where(squirrel.Eq{"recurring_journey_id": recurringJourneyID})
+
+	recurringJourneys, err := r.queryRecurringJourneys(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(recurringJourneys) == 0 {
+		return nil, recurringJourneyNotFound(recurringJourneyID)
+	}
+	return &recurringJourneys[0], nil
+}
+
+func (r *repo) ListRecurringJourneys(ctx context.Context, filter JourneyFilter) ([]models.RecurringJourney, error) {
+	// first occurrence starts before the end of time range, other conditions of time range are checked by expansion
+	recurringFilter := JourneyFilter{UserIDs: filter.UserIDs, Text: filter.Text, To: filter.To}
+	query := squirrel.
+		Select(recurringJourneyColumns...).
+		From("recurring_journeys").
+		Where(recurringFilter.toSql()).
+		OrderBy("recurring_journey_id ASC")
+
+	return r.queryRecurringJourneys(ctx, query)
+}
+
+func (r *repo) UpdateRecurringJourney(ctx context.Context, recurringJourney models.RecurringJourney) (uint64, error) {
+	latitude, longitude := coordinatesValues(recurringJourney.Coordinates)
+	query := squirrel.
+		Update("recurring_journeys").
+		Set("user_id", recurringJourney.UserID).
+		Set("address", recurringJourney.Address).
+		Set("description", recurringJourney.Description).
+		Set("start_time", recurringJourney.StartTime).
+		Set("end_time", recurringJourney.EndTime).
+		Set("time_zone", recurringJourney.TimeZone).
+		Set("latitude", latitude).
+		Set("longitude", longitude).
+		Set("rule", recurringJourney.Rule).
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(squirrel.Eq{"recurring_journey_id": recurringJourney.RecurringJourneyID})
+
+	var revision uint64
+	err := r.inTx(ctx, nil, func(tx *repo) error {
+		var err error
+		revision, err = tx.updateRecurringWithRevision(ctx, query, recurringJourney.RecurringJourneyID, recurringJourney.Revision)
+		if err != nil {
+			return err
+		}
+
+		recurringJourneys := []models.RecurringJourney{recurringJourney}
+		if err := tx.loadExceptions(ctx, recurringJourneys); err != nil {
+			return err
+		}
+		// exceptions of occurrences which do not exist by changed rule or start time are dropped
+		for _, exception := range recurringJourneys[0].Exceptions {
+			if recurringJourney.IsOccurrence(exception.OccurrenceTime) {
+				continue
+			}
+			_, err := squirrel.
+				Delete("recurring_journey_exceptions").
+				Where(squirrel.Eq{
+					"recurring_journey_id": recurringJourney.RecurringJourneyID,
+					"occurrence_time":      exception.OccurrenceTime,
+				}).
+				RunWith(tx.runner).
+				PlaceholderFormat(squirrel.Dollar).
+				ExecContext(ctx)
+			if err != nil {
+				return wrapDBError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+func (r *repo) RemoveRecurringJourney(ctx context.Context, recurringJourneyID uint64, expectedRevision uint64) error {
+	query := squirrel.
+		Delete("recurring_journeys").
+		Where(squirrel.Eq{"recurring_journey_id": recurringJourneyID})
+	if expectedRevision > 0 {
+		query = query.Where(squirrel.Eq{"revision": expectedRevision})
+	}
+
+	result, err := query.
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar).
+		ExecContext(ctx)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return wrapDBError(err)
+	}
+	if affected == 0 {
+		return r.recurringRevisionError(ctx, recurringJourneyID, expectedRevision)
+	}
+	return nil
+}
+
+func (r *repo) SetOccurrenceException(ctx context.Context, recurringJourneyID uint64, exception models.OccurrenceException,
+	expectedRevision uint64) (uint64, error) {
+	touch := squirrel.
+		Update("recurring_journeys").
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(squirrel.Eq{"recurring_journey_id": recurringJourneyID})
+
+	var revision uint64
+	err := r.inTx(ctx, nil, func(tx *repo) error {
+		var err error
+		revision, err = tx.updateRecurringWithRevision(ctx, touch, recurringJourneyID, expectedRevision)
+		if err != nil {
+			return err
+		}
+
+		_, err = squirrel.
+			Insert("recurring_journey_exceptions").
+			Columns("recurring_journey_id", "occurrence_time", "is_cancelled", "address", "description", "start_time", "end_time").
+			Values(recurringJourneyID, exception.OccurrenceTime, exception.Cancelled, exception.Address, exception.Description,
+				timeValue(exception.StartTime), timeValue(exception.EndTime)).
+			Suffix("ON CONFLICT (recurring_journey_id, occurrence_time) DO UPDATE SET" +
+				" is_cancelled = EXCLUDED.is_cancelled, address = EXCLUDED.address, description = EXCLUDED.description," +
+				" start_time = EXCLUDED.start_time, end_time = EXCLUDED.end_time").
+			RunWith(tx.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			ExecContext(ctx)
+		return wrapDBError(err)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+// queryRecurringJourneys - returns recurring journeys selected by query with their exceptions
+func (r *repo) queryRecurringJourneys(ctx context.Context, query squirrel.SelectBuilder) ([]models.RecurringJourney, error) {
+	var recurringJourneys []models.RecurringJourney
+	err := r.withSnapshot(ctx, func(tx *repo) error {
+		rows, err := query.
+			RunWith(tx.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryContext(ctx)
+		if err != nil {
+			return wrapDBError(err)
+		}
+		for rows.Next() {
+			recurringJourney, err := scanRecurringJourney(rows)
+			if err != nil {
+				_ = rows.Close()
+				return err
+			}
+			recurringJourneys = append(recurringJourneys, recurringJourney)
+		}
+		if err := rows.Close(); err != nil {
+			return wrapDBError(err)
+		}
+		if err := rows.Err(); err != nil {
+			return wrapDBError(err)
+		}
+		return tx.loadExceptions(ctx, recurringJourneys)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recurringJourneys, nil
+}
+
+// scanRecurringJourney - scans recurring journey from row of select with recurringJourneyColumns
+func scanRecurringJourney(row squirrel.RowScanner) (models.RecurringJourney, error) {
+	var recurringJourney models.RecurringJourney
+	var latitude, longitude sql.NullFloat64
+	err := row.Scan(
+		&recurringJourney.RecurringJourneyID,
+		&recurringJourney.UserID,
+		&recurringJourney.Address,
+		&recurringJourney.Description,
+		&recurringJourney.StartTime,
+		&recurringJourney.EndTime,
+		&recurringJourney.TimeZone,
+		&latitude,
+		&longitude,
+		&recurringJourney.Rule,
+		&recurringJourney.Revision,
+	)
+	if err != nil {
+		return models.RecurringJourney{}, wrapDBError(err)
+	}
+	if latitude.Valid && longitude.Valid {
+		recurringJourney.Coordinates = &models.Coordinates{Latitude: latitude.Float64, Longitude: longitude.Float64}
+	}
+	return recurringJourney, nil
+}
+
+// loadExceptions - sets exceptions of recurring journeys loaded with one query, exceptions are sorted by occurrence time
+func (r *repo) loadExceptions(ctx context.Context, recurringJourneys []models.RecurringJourney) error {
+	if len(recurringJourneys) == 0 {
+		return nil
+	}
+	indexes := make(map[uint64]int, len(recurringJourneys))
+	ids := make([]uint64, len(recurringJourneys))
+	for i, recurringJourney := range recurringJourneys {
+		indexes[recurringJourney.RecurringJourneyID] = i
+		ids[i] = recurringJourney.RecurringJourneyID
+	}
+
+	rows, err := squirrel.
+		Select("recurring_journey_id", "occurrence_time", "is_cancelled", "address", "description", "start_time", "end_time").
+		From("recurring_journey_exceptions").
+		Where(squirrel.Expr("recurring_journey_id = ANY(?)", toInt64Array(ids))).
+		OrderBy("recurring_journey_id ASC", "occurrence_time ASC").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar).
+		QueryContext(ctx)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var recurringJourneyID uint64
+		var exception models.OccurrenceException
+		var startTime, endTime sql.NullTime
+		err := rows.Scan(&recurringJourneyID, &exception.OccurrenceTime, &exception.Cancelled, &exception.Address,
+			&exception.Description, &startTime, &endTime)
+		if err != nil {
+			return wrapDBError(err)
+		}
+		exception.StartTime, exception.EndTime = startTime.Time, endTime.Time
+		recurringJourney := &recurringJourneys[indexes[recurringJourneyID]]
+		recurringJourney.Exceptions = append(recurringJourney.Exceptions, exception)
+	}
+	return wrapDBError(rows.Err())
+}
+
+// updateRecurringWithRevision - executes update of recurring journey and returns its new revision,
+// query is limited by expectedRevision if it is greater than 0
+func (r *repo) updateRecurringWithRevision(ctx context.Context, query squirrel.UpdateBuilder, recurringJourneyID,
+	expectedRevision uint64) (uint64, error) {
+	if expectedRevision > 0 {
+		query = query.Where(squirrel.Eq{"revision": expectedRevision})
+	}
+
+	var revision uint64
+	err := query.
+		Suffix("RETURNING \"revision\"").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar).
+		QueryRowContext(ctx).
+		Scan(&revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, r.recurringRevisionError(ctx, recurringJourneyID, expectedRevision)
+	}
+	return revision, wrapDBError(err)
+}
+
+// recurringRevisionError - returns error for recurring journey not changed by query limited by expectedRevision:
+// ErrRevisionConflict if recurring journey exists and NotFound error otherwise
+func (r *repo) recurringRevisionError(ctx context.Context, recurringJourneyID, expectedRevision uint64) error {
+	if expectedRevision > 0 {
+		var exists bool
+		err := r.runner.QueryRowContext(ctx,
+			"SELECT EXISTS (SELECT 1 FROM recurring_journeys WHERE recurring_journey_id = $1)", recurringJourneyID).
+			Scan(&exists)
+		if err != nil {
+			return wrapDBError(err)
+		}
+		if exists {
+			return ErrRevisionConflict
+		}
+	}
+	return recurringJourneyNotFound(recurringJourneyID)
+}
+
+func recurringJourneyNotFound(recurringJourneyID uint64) error {
+	return apperrors.New(apperrors.NotFound, "recurring journey %d not found", recurringJourneyID)
+}
@@ -2,64 +2,209 @@ package repo
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
 
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
 	"github.com/ozonva/ova-journey-api/internal/models"
 )
 
 //Repo - represents the object for working with storage of Journeys
 type Repo interface {
+	// AddJourney, MultiAddJourneys - add journeys with their waypoints and tags in one transaction and return ids of journeys
 	AddJourney(ctx context.Context, journey models.Journey) (uint64, error)
 	MultiAddJourneys(ctx context.Context, journeys []models.Journey) ([]uint64, error)
-	ListJourneys(ctx context.Context, limit, offset uint64) ([]models.Journey, error)
+	ListJourneys(ctx context.Context, filter JourneyFilter, limit, offset uint64) ([]models.Journey, error)
+	ListJourneysAfter(ctx context.Context, filter JourneyFilter, lastJourneyID, limit uint64) ([]models.Journey, error)
+	// DescribeJourney - returns journey with waypoints and tags, ListJourneys and ListJourneysAfter also load them,
+	// other methods return journeys without waypoints and tags
 	DescribeJourney(ctx context.Context, journeyID uint64) (*models.Journey, error)
-	RemoveJourney(ctx context.Context, journeyID uint64) error
-	UpdateJourney(ctx context.Context, journey models.Journey) error
+	// RemoveJourney - removes journey, expectedRevision is checked if it is greater than 0
+	RemoveJourney(ctx context.Context, journeyID uint64, expectedRevision uint64) error
+	// UpdateJourney - updates journey and replaces its tags, returns new revision of journey,
+	// journey.Revision is checked if it is greater than 0, status of journey is not changed
+	UpdateJourney(ctx context.Context, journey models.Journey) (uint64, error)
+	// PatchJourney - works like UpdateJourney but changes only listed fields
+	PatchJourney(ctx context.Context, journey models.Journey, fields []JourneyField) (uint64, error)
+	// RestoreJourney - restores removed journey and returns its new revision
+	RestoreJourney(ctx context.Context, journeyID uint64) (uint64, error)
+	// GetRemovedJourneyOwner - returns id of user owning removed journey which is not purged yet
+	GetRemovedJourneyOwner(ctx context.Context, journeyID uint64) (uint64, error)
+	// ListDeletedJourneys - returns removed journeys, recently removed first
+	ListDeletedJourneys(ctx context.Context, limit, offset uint64) ([]models.Journey, error)
+	// PurgeJourneys - permanently deletes up to limit journeys removed before deletedBefore and returns their count
+	PurgeJourneys(ctx context.Context, deletedBefore time.Time, limit uint64) (uint64, error)
+	// ExportJourneys - calls handle for every journey matching filter ordered by id,
+	// stops and returns error of handle if it fails
+	ExportJourneys(ctx context.Context, filter JourneyFilter, handle func(journey models.Journey) error) error
+	// MultiUpdateJourneys - updates journeys and their tags in one transaction and returns new revisions by ids of updated journeys,
+	// journeys which are not found or have another revision (if journey.Revision is greater than 0) are not updated
+	MultiUpdateJourneys(ctx context.Context, journeys []models.Journey) (map[uint64]uint64, error)
+	// MultiRemoveJourneys - removes journeys with one query and returns ids of removed journeys
+	MultiRemoveJourneys(ctx context.Context, journeyIDs []uint64) ([]uint64, error)
+	// GetJourneyOwners - returns ids of users owning found journeys by ids of journeys
+	GetJourneyOwners(ctx context.Context, journeyIDs []uint64) (map[uint64]uint64, error)
+	// BatchGetJourneys - returns found journeys ordered by id
+	BatchGetJourneys(ctx context.Context, journeyIDs []uint64) ([]models.Journey, error)
+	// ListJourneysNear - returns at most limit journeys within radius meters of the point matching filter
+	// ordered by distance and id
+	ListJourneysNear(ctx context.Context, point models.Coordinates, radius float64, filter JourneyFilter, limit uint64) ([]models.Journey, error)
+	// LockUserJourneys - locks journeys of user for overlap checks until the end of transaction started by WithTx,
+	// lock does not block reading and it is released immediately if it is called outside of transaction
+	LockUserJourneys(ctx context.Context, userID uint64) error
+	// ListOverlappingJourneyIDs - returns ids of other journeys of the same user overlapping journey in time
+	ListOverlappingJourneyIDs(ctx context.Context, journey models.Journey) ([]uint64, error)
+	// FindOverlappingJourneys - returns up to limit pairs of overlapping journeys of user, pair is returned
+	// if time range of their overlap intersects [from, to), zero from or to means unbounded range
+	FindOverlappingJourneys(ctx context.Context, userID uint64, from, to time.Time, limit uint64) ([]JourneyOverlap, error)
+	// SearchJourneys - returns journeys matching full-text search query and filter, most relevant first,
+	// text uses web search syntax: quoted phrases, "or" and "-" for excluding words
+	SearchJourneys(ctx context.Context, text string, filter JourneyFilter, limit, offset uint64) ([]JourneySearchResult, error)
+	// GetJourneyStats - returns statistics of journeys matching filter with up to topAddressesLimit most frequent addresses
+	GetJourneyStats(ctx context.Context, filter JourneyFilter, topAddressesLimit uint64) (*JourneyStats, error)
+	// AddWaypoint - adds waypoint to journey at 1-based position and returns its id and new revision of journey,
+	// waypoints from this position are moved forward, waypoint is appended if position is 0 or greater than number of waypoints
+	AddWaypoint(ctx context.Context, journeyID uint64, waypoint models.Waypoint, position uint64) (uint64, uint64, error)
+	// ReorderWaypoints - sets order of all waypoints of journey and returns new revision of journey
+	ReorderWaypoints(ctx context.Context, journeyID uint64, waypointIDs []uint64) (uint64, error)
+	// RemoveWaypoint - removes waypoint of journey and returns new revision of journey, next waypoints are moved back
+	RemoveWaypoint(ctx context.Context, journeyID uint64, waypointID uint64) (uint64, error)
+	// GetJourneyAccess - returns owner of journey and role of user in it, RoleNone if user does not participate
+	GetJourneyAccess(ctx context.Context, journeyID uint64, userID uint64) (JourneyAccess, error)
+	// AddParticipant - invites user to journey or changes role of invited user
+	AddParticipant(ctx context.Context, journeyID uint64, participant models.Participant) error
+	// RemoveParticipant - removes invited user from journey
+	RemoveParticipant(ctx context.Context, journeyID uint64, userID uint64) error
+	// ListParticipants - returns owner of journey followed by invited users in order of invitation
+	ListParticipants(ctx context.Context, journeyID uint64) ([]models.Participant, error)
+	// GetJourneyParticipantIDs - returns ids of users invited to journeys by ids of journeys including removed ones,
+	// journeys without invited users are not in the result
+	GetJourneyParticipantIDs(ctx context.Context, journeyIDs []uint64) (map[uint64][]uint64, error)
+	// ListTags - returns tags of journeys of user with number of journeys having each tag, most used tags first
+	ListTags(ctx context.Context, userID uint64) ([]TagCount, error)
+	// ChangeJourneyStatus - sets status of journey if transition from its current status is allowed
+	// and returns new revision of journey, expectedRevision is checked if it is greater than 0
+	ChangeJourneyStatus(ctx context.Context, journeyID uint64, status models.JourneyStatus, expectedRevision uint64) (uint64, error)
+	// AdvanceJourneyStatuses - starts up to limit planned journeys started before now and completes journeys ended before now,
+	// returns changed journeys
+	AdvanceJourneyStatuses(ctx context.Context, now time.Time, limit uint64) ([]models.Journey, error)
+	// AddRecurringJourney - adds recurring journey without exceptions and returns its id
+	AddRecurringJourney(ctx context.Context, recurringJourney models.RecurringJourney) (uint64, error)
+	// DescribeRecurringJourney - returns recurring journey with its exceptions
+	DescribeRecurringJourney(ctx context.Context, recurringJourneyID uint64) (*models.RecurringJourney, error)
+	// ListRecurringJourneys - returns recurring journeys with exceptions which may have occurrences matching filter,
+	// only users, text and end of time range of filter are used
+	ListRecurringJourneys(ctx context.Context, filter JourneyFilter) ([]models.RecurringJourney, error)
+	// UpdateRecurringJourney - updates recurring journey and returns its new revision, recurringJourney.Revision is checked
+	// if it is greater than 0, exceptions of occurrences which do not exist after update are removed
+	UpdateRecurringJourney(ctx context.Context, recurringJourney models.RecurringJourney) (uint64, error)
+	// RemoveRecurringJourney - permanently deletes recurring journey with its exceptions, expectedRevision is checked
+	// if it is greater than 0
+	RemoveRecurringJourney(ctx context.Context, recurringJourneyID uint64, expectedRevision uint64) error
+	// SetOccurrenceException - adds or replaces exception of occurrence of recurring journey and returns new revision
+	// of recurring journey, expectedRevision is checked if it is greater than 0
+	SetOccurrenceException(ctx context.Context, recurringJourneyID uint64, exception models.OccurrenceException, expectedRevision uint64) (uint64, error)
+	// WithTx - calls fn with Repo executing all queries in one transaction, transaction is committed
+	// if fn returns nil and rolled back otherwise. Nested calls use the outer transaction.
+	WithTx(ctx context.Context, fn func(tx Repo) error) error
 }
 
 type repo struct {
 	db *sqlx.DB
+	// runner - executes queries, it is db or transaction started by WithTx
+	runner squirrel.StdSqlCtx
 }
 
+// ErrRevisionConflict - returned when expected revision of journey does not match its current revision
+var ErrRevisionConflict = apperrors.New(apperrors.Conflict, "journey revision conflict")
+
 // NewRepo - creates new Journey repository using database
 func NewRepo(db *sqlx.DB) Repo {
-	return &repo{db: db}
+	return &repo{db: db, runner: db}
 }
 
-func (r *repo) AddJourney(ctx context.Context, journey models.Journey) (uint64, error) {
-	query := squirrel.
-		Insert("journeys").
-		Columns("user_id", "address", "description", "start_time", "end_time").
-		Values(journey.UserID, journey.Address, journey.Description, journey.StartTime, journey.EndTime).
-		Suffix("RETURNING \"journey_id\"").
-		RunWith(r.db).
-		PlaceholderFormat(squirrel.Dollar)
+func (r *repo) WithTx(ctx context.Context, fn func(tx Repo) error) error {
+	return r.inTx(ctx, nil, func(tx *repo) error {
+		return fn(tx)
+	})
+}
+
+// withSnapshot - works like WithTx but starts read only repeatable read transaction,
+// so all queries of fn see the same state of database
+func (r *repo) withSnapshot(ctx context.Context, fn func(tx *repo) error) error {
+	return r.inTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, fn)
+}
+
+// inTx - calls fn with repo executing queries in transaction started with options,
+// fn is called with r if r already executes queries in transaction
+func (r *repo) inTx(ctx context.Context, options *sql.TxOptions, fn func(tx *repo) error) error {
+	if r.db == nil {
+		return fn(r)
+	}
 
-	var journeyID uint64
-	err := query.QueryRowContext(ctx).Scan(&journeyID)
+	tx, err := r.db.BeginTxx(ctx, options)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	if err := fn(&repo{runner: tx}); err != nil {
+		// error of fn is more important, transaction is rolled back by database anyway if rollback fails
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return wrapDBError(err)
+	}
+	return nil
+}
+
+func (r *repo) AddJourney(ctx context.Context, journey models.Journey) (uint64, error) {
+	journeyIDs, err := r.MultiAddJourneys(ctx, []models.Journey{journey})
 	if err != nil {
 		return 0, err
 	}
-	return journeyID, nil
+	return journeyIDs[0], nil
 }
 
 func (r *repo) MultiAddJourneys(ctx context.Context, journeys []models.Journey) ([]uint64, error) {
+	var journeyIDs []uint64
+	err := r.inTx(ctx, nil, func(tx *repo) error {
+		var err error
+		journeyIDs, err = tx.insertJourneys(ctx, journeys)
+		if err != nil {
+			return err
+		}
+		if err := tx.insertWaypoints(ctx, journeyIDs, journeys); err != nil {
+			return err
+		}
+		return tx.insertTags(ctx, journeyIDs, journeys)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return journeyIDs, nil
+}
+
+// insertJourneys - inserts journeys without waypoints and returns their ids in order of journeys
+func (r *repo) insertJourneys(ctx context.Context, journeys []models.Journey) ([]uint64, error) {
 	query := squirrel.
 		Insert("journeys").
-		Columns("user_id", "address", "description", "start_time", "end_time").
+		Columns("user_id", "address", "description", "start_time", "end_time", "time_zone", "latitude", "longitude", "status").
 		Suffix("RETURNING \"journey_id\"").
-		RunWith(r.db).
+		RunWith(r.runner).
 		PlaceholderFormat(squirrel.Dollar)
 
 	for _, journey := range journeys {
-		query = query.Values(journey.UserID, journey.Address, journey.Description, journey.StartTime, journey.EndTime)
+		query = query.Values(journey.UserID, journey.Address, journey.Description, journey.StartTime, journey.EndTime, journey.TimeZone,
+			latitude(journey), longitude(journey), journey.Status.String())
 	}
 
 	rows, err := query.QueryContext(ctx)
 	if err != nil {
-		return nil, err
+		return nil, wrapDBError(err)
 	}
 	defer rows.Close()
 
@@ -68,87 +213,170 @@ func (r *repo) MultiAddJourneys(ctx context.Context, journeys []models.Journey)
 		var journeyID uint64
 		err := rows.Scan(&journeyID)
 		if err != nil {
-			return nil, err
+			return nil, wrapDBError(err)
 		}
 		journeyIDs = append(journeyIDs, journeyID)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, wrapDBError(err)
+	}
 	return journeyIDs, nil
 }
 
-func (r *repo) ListJourneys(ctx context.Context, limit, offset uint64) ([]models.Journey, error) {
+func (r *repo) ListJourneys(ctx context.Context, filter JourneyFilter, limit, offset uint64) ([]models.Journey, error) {
 	query := squirrel.
-		Select("journey_id", "user_id", "address", "description", "start_time", "end_time").
+		Select(journeyColumns...).
 		From("journeys").
 		Where(squirrel.Eq{"is_deleted": false}).
+		Where(filter.toSql()).
 		Limit(limit).
 		Offset(offset).
 		OrderBy("journey_id ASC").
-		RunWith(r.db).
+		RunWith(r.runner).
 		PlaceholderFormat(squirrel.Dollar)
 
-	rows, err := query.QueryContext(ctx)
+	return r.queryJourneysWithDetails(ctx, query)
+}
+
+// ListJourneysAfter - returns journeys with id greater than lastJourneyID (keyset pagination),
+// unlike ListJourneys it does not skip or duplicate rows when journeys are added or removed between calls
+func (r *repo) ListJourneysAfter(ctx context.Context, filter JourneyFilter, lastJourneyID, limit uint64) ([]models.Journey, error) {
+	query := squirrel.
+		Select(journeyColumns...).
+		From("journeys").
+		Where(squirrel.And{squirrel.Gt{"journey_id": lastJourneyID}, squirrel.Eq{"is_deleted": false}}).
+		Where(filter.toSql()).
+		Limit(limit).
+		OrderBy("journey_id ASC").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	return r.queryJourneysWithDetails(ctx, query)
+}
+
+// queryJourneysWithDetails - works like queryJourneys but also loads waypoints and tags of journeys,
+// journeys, waypoints and tags are read from the same snapshot
+func (r *repo) queryJourneysWithDetails(ctx context.Context, query squirrel.SelectBuilder) ([]models.Journey, error) {
+	var journeys []models.Journey
+	err := r.withSnapshot(ctx, func(tx *repo) error {
+		var err error
+		journeys, err = queryJourneys(ctx, query.RunWith(tx.runner))
+		if err != nil {
+			return err
+		}
+		if err := tx.loadWaypoints(ctx, journeys); err != nil {
+			return err
+		}
+		return tx.loadTags(ctx, journeys)
+	})
 	if err != nil {
 		return nil, err
 	}
+	return journeys, nil
+}
+
+func queryJourneys(ctx context.Context, query squirrel.SelectBuilder) ([]models.Journey, error) {
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
 	defer rows.Close()
 
 	var journeysList []models.Journey
 	for rows.Next() {
-		var journey models.Journey
-		err = rows.Scan(
-			&journey.JourneyID,
-			&journey.UserID,
-			&journey.Address,
-			&journey.Description,
-			&journey.StartTime,
-			&journey.EndTime,
-		)
+		journey, err := scanJourney(rows)
 		if err != nil {
 			return journeysList, err
 		}
 		journeysList = append(journeysList, journey)
 	}
+	if err := rows.Err(); err != nil {
+		return journeysList, wrapDBError(err)
+	}
 
 	return journeysList, nil
 }
 
+// journeyColumns - columns of journeys table scanned by scanJourney
+var journeyColumns = []string{
+	"journey_id", "user_id", "address", "description", "start_time", "end_time", "time_zone", "revision", "latitude", "longitude", "status",
+}
+
+// scanJourney - scans journey from row of select with journeyColumns followed by columns scanned to extra
+func scanJourney(row squirrel.RowScanner, extra ...interface{}) (models.Journey, error) {
+	var scanner journeyScanner
+	if err := row.Scan(append(scanner.dest(), extra...)...); err != nil {
+		return models.Journey{}, wrapDBError(err)
+	}
+	return scanner.journey(), nil
+}
+
+// journeyScanner - holds values of journeyColumns scanned from row
+type journeyScanner struct {
+	value               models.Journey
+	latitude, longitude sql.NullFloat64
+	status              string
+}
+
+// dest - returns scan destinations for journeyColumns
+func (s *journeyScanner) dest() []interface{} {
+	return []interface{}{
+		&s.value.JourneyID,
+		&s.value.UserID,
+		&s.value.Address,
+		&s.value.Description,
+		&s.value.StartTime,
+		&s.value.EndTime,
+		&s.value.TimeZone,
+		&s.value.Revision,
+		&s.latitude,
+		&s.longitude,
+		&s.status,
+	}
+}
+
+// journey - returns scanned journey with times in its time zone
+func (s *journeyScanner) journey() models.Journey {
+	journey := s.value
+	if s.latitude.Valid && s.longitude.Valid {
+		journey.Coordinates = &models.Coordinates{Latitude: s.latitude.Float64, Longitude: s.longitude.Float64}
+	}
+	// status is always valid because of check constraint of journeys table
+	journey.Status, _ = models.ParseJourneyStatus(s.status)
+	journey.Localize()
+	return journey
+}
+
 func (r *repo) DescribeJourney(ctx context.Context, journeyID uint64) (*models.Journey, error) {
 	query := squirrel.
-		Select("journey_id", "user_id", "address", "description", "start_time", "end_time").
+		Select(journeyColumns...).
 		From("journeys").
 		Where(squirrel.And{squirrel.Eq{"journey_id": journeyID}, squirrel.Eq{"is_deleted": false}}).
-		RunWith(r.db).
 		PlaceholderFormat(squirrel.Dollar)
 
-	var journey models.Journey
-	err := query.QueryRowContext(ctx).
-		Scan(
-			&journey.JourneyID,
-			&journey.UserID,
-			&journey.Address,
-			&journey.Description,
-			&journey.StartTime,
-			&journey.EndTime,
-		)
+	journeys, err := r.queryJourneysWithDetails(ctx, query)
 	if err != nil {
 		return nil, err
 	}
-	return &journey, nil
+	if len(journeys) == 0 {
+		return nil, apperrors.New(apperrors.NotFound, "journey %d not found", journeyID)
+	}
+	return &journeys[0], nil
 }
 
-func (r *repo) RemoveJourney(ctx context.Context, journeyID uint64) error {
+func (r *repo) RemoveJourney(ctx context.Context, journeyID uint64, expectedRevision uint64) error {
 	query := squirrel.
 		Update("journeys").
 		Set("is_deleted", true).
-		Where(squirrel.Eq{"journey_id": journeyID}).
-		RunWith(r.db).
-		PlaceholderFormat(squirrel.Dollar)
+		Set("deleted_at", squirrel.Expr("now()")).
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(squirrel.And{squirrel.Eq{"journey_id": journeyID}, squirrel.Eq{"is_deleted": false}})
 
-	_, err := query.ExecContext(ctx)
+	_, err := r.updateWithRevision(ctx, query, journeyID, expectedRevision)
 	return err
 }
 
-func (r *repo) UpdateJourney(ctx context.Context, journey models.Journey) error {
+func (r *repo) UpdateJourney(ctx context.Context, journey models.Journey) (uint64, error) {
 	query := squirrel.
 		Update("journeys").
 		Set("user_id", journey.UserID).
@@ -156,10 +384,228 @@ func (r *repo) UpdateJourney(ctx context.Context, journey models.Journey) error
 		Set("description", journey.Description).
 		Set("start_time", journey.StartTime).
 		Set("end_time", journey.EndTime).
-		Where(squirrel.Eq{"journey_id": journey.JourneyID}).
-		RunWith(r.db).
+		Set("time_zone", journey.TimeZone).
+		Set("latitude", latitude(journey)).
+		Set("longitude", longitude(journey)).
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(squirrel.And{squirrel.Eq{"journey_id": journey.JourneyID}, squirrel.Eq{"is_deleted": false}})
+
+	return r.updateWithTags(ctx, query, journey)
+}
+
+func (r *repo) PatchJourney(ctx context.Context, journey models.Journey, fields []JourneyField) (uint64, error) {
+	if len(fields) == 0 {
+		return 0, ErrNoFieldsToPatch
+	}
+
+	query := squirrel.
+		Update("journeys").
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(squirrel.And{squirrel.Eq{"journey_id": journey.JourneyID}, squirrel.Eq{"is_deleted": false}})
+
+	patchTags := false
+	for _, field := range fields {
+		if field == JourneyFieldTags {
+			patchTags = true
+			continue
+		}
+		value, err := field.value(journey)
+		if err != nil {
+			return 0, err
+		}
+		query = query.Set(string(field), value)
+	}
+
+	if patchTags {
+		return r.updateWithTags(ctx, query, journey)
+	}
+	return r.updateWithRevision(ctx, query, journey.JourneyID, journey.Revision)
+}
+
+func (r *repo) RestoreJourney(ctx context.Context, journeyID uint64) (uint64, error) {
+	query := squirrel.
+		Update("journeys").
+		Set("is_deleted", false).
+		Set("deleted_at", nil).
+		Set("revision", squirrel.Expr("revision + 1")).
+		Where(squirrel.And{squirrel.Eq{"journey_id": journeyID}, squirrel.Eq{"is_deleted": true}}).
+		Suffix("RETURNING \"revision\"").
+		RunWith(r.runner).
 		PlaceholderFormat(squirrel.Dollar)
 
-	_, err := query.ExecContext(ctx)
-	return err
+	var revision uint64
+	err := query.QueryRowContext(ctx).Scan(&revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, apperrors.New(apperrors.NotFound, "removed journey %d not found", journeyID)
+	}
+	if err != nil {
+		return 0, wrapDBError(err)
+	}
+	return revision, nil
+}
+
+func (r *repo) GetRemovedJourneyOwner(ctx context.Context, journeyID uint64) (uint64, error) {
+	query := squirrel.
+		Select("user_id").
+		From("journeys").
+		Where(squirrel.Eq{"journey_id": journeyID, "is_deleted": true}).
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var userID uint64
+	err := query.QueryRowContext(ctx).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, apperrors.New(apperrors.NotFound, "removed journey %d not found", journeyID)
+	}
+	if err != nil {
+		return 0, wrapDBError(err)
+	}
+	return userID, nil
+}
+
+func (r *repo) ListDeletedJourneys(ctx context.Context, limit, offset uint64) ([]models.Journey, error) {
+	query := squirrel.
+		Select(journeyColumns...).
+		Column("deleted_at").
+		From("journeys").
+		Where(squirrel.Eq{"is_deleted": true}).
+		Limit(limit).
+		Offset(offset).
+		OrderBy("deleted_at DESC", "journey_id ASC").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, wrapDBError(err)
+	}
+	defer rows.Close()
+
+	var journeysList []models.Journey
+	for rows.Next() {
+		var deletedAt sql.NullTime
+		journey, err := scanJourney(rows, &deletedAt)
+		if err != nil {
+			return journeysList, err
+		}
+		journey.DeletedAt = deletedAt.Time
+		journeysList = append(journeysList, journey)
+	}
+	if err := rows.Err(); err != nil {
+		return journeysList, wrapDBError(err)
+	}
+
+	return journeysList, nil
+}
+
+func (r *repo) PurgeJourneys(ctx context.Context, deletedBefore time.Time, limit uint64) (uint64, error) {
+	expired := squirrel.
+		Select("journey_id").
+		From("journeys").
+		Where(squirrel.And{squirrel.Eq{"is_deleted": true}, squirrel.Lt{"deleted_at": deletedBefore}}).
+		Limit(limit)
+
+	query := squirrel.
+		Delete("journeys").
+		Where(squirrel.Expr("journey_id IN (?)", expired)).
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	result, err := query.ExecContext(ctx)
+	if err != nil {
+		return 0, wrapDBError(err)
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, wrapDBError(err)
+	}
+	return uint64(purged), nil
+}
+
+// ExportJourneys - rows are read from the database cursor one by one while handle is called,
+// so journeys are not loaded to memory at once
+func (r *repo) ExportJourneys(ctx context.Context, filter JourneyFilter, handle func(journey models.Journey) error) error {
+	query := squirrel.
+		Select(journeyColumns...).
+		From("journeys").
+		Where(squirrel.Eq{"is_deleted": false}).
+		Where(filter.toSql()).
+		OrderBy("journey_id ASC").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return wrapDBError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		journey, err := scanJourney(rows)
+		if err != nil {
+			return err
+		}
+		if err := handle(journey); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return wrapDBError(err)
+	}
+	return nil
+}
+
+// updateWithTags - works like updateWithRevision and also replaces tags of journey in the same transaction
+func (r *repo) updateWithTags(ctx context.Context, query squirrel.UpdateBuilder, journey models.Journey) (uint64, error) {
+	var revision uint64
+	err := r.inTx(ctx, nil, func(tx *repo) error {
+		var err error
+		revision, err = tx.updateWithRevision(ctx, query, journey.JourneyID, journey.Revision)
+		if err != nil {
+			return err
+		}
+		return tx.replaceTags(ctx, []uint64{journey.JourneyID}, []models.Journey{journey})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+// updateWithRevision - executes update of journey if its revision matches expectedRevision (or expectedRevision is 0)
+// and returns new revision. Returns ErrRevisionConflict if journey exists but has another revision
+// and apperrors.NotFound error if journey does not exist or is removed.
+func (r *repo) updateWithRevision(ctx context.Context, query squirrel.UpdateBuilder, journeyID, expectedRevision uint64) (uint64, error) {
+	if expectedRevision > 0 {
+		query = query.Where(squirrel.Eq{"revision": expectedRevision})
+	}
+	query = query.
+		Suffix("RETURNING \"revision\"").
+		RunWith(r.runner).
+		PlaceholderFormat(squirrel.Dollar)
+
+	var revision uint64
+	err := query.QueryRowContext(ctx).Scan(&revision)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return revision, wrapDBError(err)
+	}
+
+	if expectedRevision > 0 {
+		var actualRevision uint64
+		checkErr := squirrel.
+			Select("revision").
+			From("journeys").
+			Where(squirrel.And{squirrel.Eq{"journey_id": journeyID}, squirrel.Eq{"is_deleted": false}}).
+			RunWith(r.runner).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryRowContext(ctx).
+			Scan(&actualRevision)
+		if checkErr == nil {
+			return 0, ErrRevisionConflict
+		}
+		if !errors.Is(checkErr, sql.ErrNoRows) {
+			return 0, wrapDBError(checkErr)
+		}
+	}
+	return 0, apperrors.New(apperrors.NotFound, "journey %d not found", journeyID)
 }
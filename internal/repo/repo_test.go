@@ -2,10 +2,14 @@ package repo
 
 import (
 	"context"
+	"errors"
+	"github.com/ozonva/ova-journey-api/internal/apperrors"
 	"github.com/ozonva/ova-journey-api/internal/models"
 	"github.com/stretchr/testify/assert"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	_ "github.com/jackc/pgx/v4"
 	_ "github.com/jackc/pgx/v4/stdlib"
@@ -114,7 +118,7 @@ func TestRepo_DescribeJourney(t *testing.T) {
 }
 
 func TestRepo_ListJourneys(t *testing.T) {
-	journeys, err := repository.ListJourneys(context.Background(), 10, 0)
+	journeys, err := repository.ListJourneys(context.Background(), JourneyFilter{}, 10, 0)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, journeys)
@@ -133,13 +137,735 @@ func TestRepo_UpdateJourney(t *testing.T) {
 	testJourney.JourneyID = id
 	testJourney.Address = "changedAddress"
 
-	err := repository.UpdateJourney(context.Background(), testJourney)
+	revision, err := repository.UpdateJourney(context.Background(), testJourney)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), revision)
+}
+
+func TestRepo_UpdateJourneyWithRevision(t *testing.T) {
+	testJourney := journeysTable[0]
+	id, _ := repository.AddJourney(context.Background(), journeysTable[0])
+	testJourney.JourneyID = id
+	testJourney.Revision = 1
+
+	revision, err := repository.UpdateJourney(context.Background(), testJourney)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), revision)
+
+	_, err = repository.UpdateJourney(context.Background(), testJourney)
+	assert.ErrorIs(t, err, ErrRevisionConflict)
+
+	err = repository.RemoveJourney(context.Background(), id, 1)
+	assert.ErrorIs(t, err, ErrRevisionConflict)
+
+	err = repository.RemoveJourney(context.Background(), id, 2)
 	assert.NoError(t, err)
 }
 
 func TestRepo_RemoveJourney(t *testing.T) {
 	id, _ := repository.AddJourney(context.Background(), journeysTable[0])
 
-	err := repository.RemoveJourney(context.Background(), id)
+	err := repository.RemoveJourney(context.Background(), id, 0)
+	assert.NoError(t, err)
+}
+
+func TestRepo_ListJourneysAfter(t *testing.T) {
+	ids, _ := repository.MultiAddJourneys(context.Background(), journeysTable)
+
+	journeys, err := repository.ListJourneysAfter(context.Background(), JourneyFilter{}, ids[1], 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, journeys, 2)
+	assert.Equal(t, ids[2], journeys[0].JourneyID)
+	assert.Equal(t, ids[3], journeys[1].JourneyID)
+}
+
+func TestRepo_ListJourneysWithFilter(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	journeys := []models.Journey{
+		{UserID: 100, Address: "Казань", Description: "50% скидка", StartTime: start, EndTime: start.AddDate(0, 0, 2)},
+		{UserID: 100, Address: "Сочи", Description: "море", StartTime: start.AddDate(0, 0, 5), EndTime: start.AddDate(0, 0, 7)},
+		{UserID: 101, Address: "Сочи", Description: "", StartTime: start, EndTime: start.AddDate(0, 0, 1)},
+	}
+	ids, _ := repository.MultiAddJourneys(context.Background(), journeys)
+
+	byUser, err := repository.ListJourneys(context.Background(), JourneyFilter{UserIDs: []uint64{100}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, byUser, 2)
+
+	byTime, err := repository.ListJourneys(context.Background(), JourneyFilter{
+		UserIDs: []uint64{100, 101},
+		From:    start.AddDate(0, 0, 1),
+		To:      start.AddDate(0, 0, 5),
+	}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, byTime, 1)
+	assert.Equal(t, ids[0], byTime[0].JourneyID)
+
+	byText, err := repository.ListJourneys(context.Background(), JourneyFilter{UserIDs: []uint64{100, 101}, Text: "сочи"}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, byText, 2)
+
+	escaped, err := repository.ListJourneys(context.Background(), JourneyFilter{UserIDs: []uint64{100, 101}, Text: "%"}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, escaped, 1)
+}
+
+func TestRepo_PatchJourney(t *testing.T) {
+	id, _ := repository.AddJourney(context.Background(), journeysTable[1])
+
+	revision, err := repository.PatchJourney(
+		context.Background(),
+		models.Journey{JourneyID: id, Address: "changedAddress", Description: "ignored"},
+		[]JourneyField{JourneyFieldAddress},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), revision)
+
+	journey, err := repository.DescribeJourney(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, "changedAddress", journey.Address)
+	assert.Equal(t, journeysTable[1].UserID, journey.UserID)
+	assert.Equal(t, journeysTable[1].Description, journey.Description)
+
+	_, err = repository.PatchJourney(context.Background(), models.Journey{JourneyID: id}, nil)
+	assert.ErrorIs(t, err, ErrNoFieldsToPatch)
+}
+
+func TestRepo_JourneyNotFound(t *testing.T) {
+	missingID := uint64(1000000)
+
+	_, err := repository.DescribeJourney(context.Background(), missingID)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+
+	_, err = repository.UpdateJourney(context.Background(), models.Journey{JourneyID: missingID, UserID: 1})
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+
+	_, err = repository.UpdateJourney(context.Background(), models.Journey{JourneyID: missingID, UserID: 1, Revision: 1})
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+
+	err = repository.RemoveJourney(context.Background(), missingID, 0)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+}
+
+func TestRepo_RestoreAndPurgeJourney(t *testing.T) {
+	id, _ := repository.AddJourney(context.Background(), journeysTable[0])
+	_, err := repository.RestoreJourney(context.Background(), id)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+	_, err = repository.GetRemovedJourneyOwner(context.Background(), id)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+
+	assert.NoError(t, repository.RemoveJourney(context.Background(), id, 0))
+	ownerID, err := repository.GetRemovedJourneyOwner(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, journeysTable[0].UserID, ownerID)
+	deleted, err := repository.ListDeletedJourneys(context.Background(), 1, 0)
+	assert.NoError(t, err)
+	assert.Len(t, deleted, 1)
+	assert.Equal(t, id, deleted[0].JourneyID)
+	assert.False(t, deleted[0].DeletedAt.IsZero())
+
+	revision, err := repository.RestoreJourney(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), revision)
+	_, err = repository.DescribeJourney(context.Background(), id)
+	assert.NoError(t, err)
+
+	assert.NoError(t, repository.RemoveJourney(context.Background(), id, 0))
+	purged, err := repository.PurgeJourneys(context.Background(), time.Now().Add(-time.Hour), 100)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), purged)
+
+	purged, err = repository.PurgeJourneys(context.Background(), time.Now().Add(time.Hour), 100)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, purged, uint64(1))
+	_, err = repository.RestoreJourney(context.Background(), id)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+}
+
+func TestRepo_JourneyCheckConstraints(t *testing.T) {
+	start := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := repository.AddJourney(context.Background(), models.Journey{UserID: 1, Address: "Уфа", StartTime: start, EndTime: start.AddDate(0, 0, -1)})
+	assert.True(t, apperrors.Is(err, apperrors.InvalidArgument))
+
+	_, err = repository.AddJourney(context.Background(), models.Journey{UserID: 1, Address: " ", StartTime: start, EndTime: start})
+	assert.True(t, apperrors.Is(err, apperrors.InvalidArgument))
+}
+
+func TestRepo_JourneyTimeZone(t *testing.T) {
+	location, _ := time.LoadLocation("Europe/Moscow")
+	start := time.Date(2021, 8, 14, 9, 45, 30, 123456000, location)
+	journey := models.Journey{UserID: 1, Address: "Москва", StartTime: start, EndTime: start.Add(90 * time.Minute), TimeZone: "Europe/Moscow"}
+
+	id, err := repository.AddJourney(context.Background(), journey)
+	assert.NoError(t, err)
+
+	result, err := repository.DescribeJourney(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Moscow", result.TimeZone)
+	assert.Equal(t, start.String(), result.StartTime.String())
+	assert.Equal(t, journey.EndTime.String(), result.EndTime.String())
+}
+
+func TestRepo_ExportJourneys(t *testing.T) {
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	journeys := []models.Journey{
+		{UserID: 200, Address: "Пермь", StartTime: start, EndTime: start.AddDate(0, 0, 1)},
+		{UserID: 200, Address: "Тула", StartTime: start, EndTime: start.AddDate(0, 0, 1)},
+		{UserID: 201, Address: "Омск", StartTime: start, EndTime: start.AddDate(0, 0, 1)},
+	}
+	ids, err := repository.MultiAddJourneys(context.Background(), journeys)
+	assert.NoError(t, err)
+
+	var exported []uint64
+	err = repository.ExportJourneys(context.Background(), JourneyFilter{UserIDs: []uint64{200}}, func(journey models.Journey) error {
+		exported = append(exported, journey.JourneyID)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ids[:2], exported)
+
+	errStop := errors.New("stop")
+	err = repository.ExportJourneys(context.Background(), JourneyFilter{UserIDs: []uint64{200}}, func(journey models.Journey) error {
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+}
+
+func TestRepo_WithTx(t *testing.T) {
+	start := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	journey := models.Journey{UserID: 300, Address: "Псков", StartTime: start, EndTime: start.AddDate(0, 0, 1)}
+	errStop := errors.New("stop")
+
+	var rolledBackID uint64
+	err := repository.WithTx(context.Background(), func(tx Repo) error {
+		var err error
+		rolledBackID, err = tx.AddJourney(context.Background(), journey)
+		assert.NoError(t, err)
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+	_, err = repository.DescribeJourney(context.Background(), rolledBackID)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+
+	var committedID uint64
+	err = repository.WithTx(context.Background(), func(tx Repo) error {
+		var err error
+		committedID, err = tx.AddJourney(context.Background(), journey)
+		return err
+	})
+	assert.NoError(t, err)
+	_, err = repository.DescribeJourney(context.Background(), committedID)
+	assert.NoError(t, err)
+}
+
+func TestRepo_MultiUpdateRemoveBatchGet(t *testing.T) {
+	start := time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)
+	journeys := []models.Journey{
+		{UserID: 400, Address: "Казань", StartTime: start, EndTime: start.AddDate(0, 0, 1)},
+		{UserID: 400, Address: "Самара", StartTime: start, EndTime: start.AddDate(0, 0, 2)},
+	}
+	ids, err := repository.MultiAddJourneys(context.Background(), journeys)
+	assert.NoError(t, err)
+
+	updated := journeys
+	updated[0].JourneyID, updated[0].Address = ids[0], "Казань, Кремль"
+	updated[1].JourneyID, updated[1].Revision = ids[1], 10
+	revisions, err := repository.MultiUpdateJourneys(context.Background(), updated)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64]uint64{ids[0]: 2}, revisions)
+
+	found, err := repository.BatchGetJourneys(context.Background(), []uint64{ids[1], ids[0], ids[1] + 1000})
+	assert.NoError(t, err)
+	assert.Len(t, found, 2)
+	assert.Equal(t, "Казань, Кремль", found[0].Address)
+	assert.Equal(t, "Самара", found[1].Address)
+
+	removed, err := repository.MultiRemoveJourneys(context.Background(), []uint64{ids[0], ids[0] + 1000})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{ids[0]}, removed)
+	found, err = repository.BatchGetJourneys(context.Background(), ids)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	owners, err := repository.GetJourneyOwners(context.Background(), ids)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64]uint64{ids[1]: 400}, owners, "removed journeys are not returned")
+}
+
+func TestRepo_SearchJourneys(t *testing.T) {
+	start := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	journeys := []models.Journey{
+		{UserID: 500, Address: "Сочи", Description: "поездка на море с детьми", StartTime: start, EndTime: start.AddDate(0, 0, 7)},
+		{UserID: 500, Address: "Seaside hotel", Description: "trip to the seaside", StartTime: start, EndTime: start.AddDate(0, 0, 2)},
+		{UserID: 500, Address: "Москва", Description: "командировка", StartTime: start, EndTime: start.AddDate(0, 0, 1)},
+		{UserID: 501, Address: "Анапа", Description: "море", StartTime: start, EndTime: start.AddDate(0, 0, 1)},
+	}
+	ids, err := repository.MultiAddJourneys(context.Background(), journeys)
+	assert.NoError(t, err)
+
+	russian, err := repository.SearchJourneys(context.Background(), "морем", JourneyFilter{UserIDs: []uint64{500}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, russian, 1)
+	assert.Equal(t, ids[0], russian[0].Journey.JourneyID)
+	assert.Contains(t, russian[0].Snippet, "<b>море</b>")
+
+	markupID, err := repository.AddJourney(context.Background(), models.Journey{UserID: 502, Address: "<img src=x onerror=alert(1)>",
+		Description: "море \x02<i>\x03", StartTime: start, EndTime: start.AddDate(0, 0, 1)})
+	assert.NoError(t, err)
+	escaped, err := repository.SearchJourneys(context.Background(), "море", JourneyFilter{UserIDs: []uint64{502}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, escaped, 1)
+	assert.Equal(t, markupID, escaped[0].Journey.JourneyID)
+	assert.Contains(t, escaped[0].Snippet, "<b>море</b>")
+	// only markup of snippet is highlighting of matched words
+	assert.Equal(t, strings.Count(escaped[0].Snippet, "<b>"), strings.Count(escaped[0].Snippet, "</b>"))
+	assert.Equal(t, strings.Count(escaped[0].Snippet, "<b>")*2, strings.Count(escaped[0].Snippet, "<"))
+	assert.NotContains(t, escaped[0].Snippet, "\x02")
+
+	english, err := repository.SearchJourneys(context.Background(), "seasides trips", JourneyFilter{UserIDs: []uint64{500}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, english, 1)
+	assert.Equal(t, ids[1], english[0].Journey.JourneyID)
+	assert.Greater(t, english[0].Rank, float32(0))
+
+	byAddressFirst, err := repository.SearchJourneys(context.Background(), "сочи or море", JourneyFilter{UserIDs: []uint64{500, 501}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, byAddressFirst, 2)
+	assert.Equal(t, ids[0], byAddressFirst[0].Journey.JourneyID)
+
+	byTime, err := repository.SearchJourneys(context.Background(), "море", JourneyFilter{UserIDs: []uint64{500, 501}, From: start.AddDate(0, 0, 3)}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, byTime, 1)
+	assert.Equal(t, ids[0], byTime[0].Journey.JourneyID)
+}
+
+func TestRepo_ListJourneysNear(t *testing.T) {
+	start := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	journeys := []models.Journey{
+		{UserID: 600, Address: "Москва", StartTime: start, EndTime: start.AddDate(0, 0, 1),
+			Coordinates: &models.Coordinates{Latitude: 55.7558, Longitude: 37.6173}},
+		{UserID: 600, Address: "Анадырь", StartTime: start, EndTime: start.AddDate(0, 0, 1),
+			Coordinates: &models.Coordinates{Latitude: 64.7337, Longitude: 177.5089}},
+		{UserID: 600, Address: "Где-то", StartTime: start, EndTime: start.AddDate(0, 0, 1)},
+		// ~26 km from Moscow, inside bounding box of 20 km circle but outside of the circle
+		{UserID: 600, Address: "Мытищи", StartTime: start, EndTime: start.AddDate(0, 0, 1),
+			Coordinates: &models.Coordinates{Latitude: 55.93, Longitude: 37.90}},
+		// ~7 km from Moscow
+		{UserID: 600, Address: "Медведково", StartTime: start, EndTime: start.AddDate(0, 0, 1),
+			Coordinates: &models.Coordinates{Latitude: 55.80, Longitude: 37.70}},
+	}
+	ids, err := repository.MultiAddJourneys(context.Background(), journeys)
+	assert.NoError(t, err)
+
+	found, err := repository.DescribeJourney(context.Background(), ids[0])
+	assert.NoError(t, err)
+	assert.Equal(t, journeys[0].Coordinates, found.Coordinates)
+	found, err = repository.DescribeJourney(context.Background(), ids[2])
+	assert.NoError(t, err)
+	assert.Nil(t, found.Coordinates)
+
+	filter := JourneyFilter{UserIDs: []uint64{600}}
+	moscow := models.Coordinates{Latitude: 55.76, Longitude: 37.62}
+	nearMoscow, err := repository.ListJourneysNear(context.Background(), moscow, 20000, filter, 10)
+	assert.NoError(t, err)
+	assert.Len(t, nearMoscow, 2)
+	assert.Equal(t, ids[0], nearMoscow[0].JourneyID)
+	assert.Equal(t, ids[4], nearMoscow[1].JourneyID)
+
+	nearest, err := repository.ListJourneysNear(context.Background(), moscow, 20000, filter, 1)
+	assert.NoError(t, err)
+	assert.Len(t, nearest, 1)
+	assert.Equal(t, ids[0], nearest[0].JourneyID)
+
+	acrossAntimeridian := models.Coordinates{Latitude: 65, Longitude: -179.5}
+	inChukotka, err := repository.ListJourneysNear(context.Background(), acrossAntimeridian, 300000, filter, 10)
+	assert.NoError(t, err)
+	assert.Len(t, inChukotka, 1)
+	assert.Equal(t, ids[1], inChukotka[0].JourneyID)
+
+	assert.NoError(t, repository.AddParticipant(context.Background(), ids[0], models.Participant{UserID: 601, Role: models.RoleViewer}))
+	shared, err := repository.ListJourneysNear(context.Background(), moscow, 20000,
+		JourneyFilter{UserIDs: []uint64{601}, Participating: true}, 10)
+	assert.NoError(t, err)
+	assert.Len(t, shared, 1)
+	assert.Equal(t, ids[0], shared[0].JourneyID)
+}
+
+func TestRepo_FindOverlappingJourneys(t *testing.T) {
+	start := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	journeys := []models.Journey{
+		{UserID: 700, Address: "Казань", StartTime: start, EndTime: start.AddDate(0, 0, 3)},
+		{UserID: 700, Address: "Самара", StartTime: start.AddDate(0, 0, 2), EndTime: start.AddDate(0, 0, 4)},
+		{UserID: 700, Address: "Саратов", StartTime: start.AddDate(0, 0, 4), EndTime: start.AddDate(0, 0, 5)},
+		{UserID: 701, Address: "Пенза", StartTime: start, EndTime: start.AddDate(0, 0, 5)},
+	}
+	ids, err := repository.MultiAddJourneys(context.Background(), journeys)
+	assert.NoError(t, err)
+
+	assert.NoError(t, repository.WithTx(context.Background(), func(tx Repo) error {
+		return tx.LockUserJourneys(context.Background(), 700)
+	}))
+
+	overlapping, err := repository.ListOverlappingJourneyIDs(context.Background(), journeys[2])
+	assert.NoError(t, err)
+	assert.Empty(t, overlapping, "touching journeys do not overlap")
+	journey := journeys[0]
+	journey.JourneyID = ids[0]
+	overlapping, err = repository.ListOverlappingJourneyIDs(context.Background(), journey)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{ids[1]}, overlapping)
+
+	overlaps, err := repository.FindOverlappingJourneys(context.Background(), 700, time.Time{}, time.Time{}, 10)
+	assert.NoError(t, err)
+	assert.Len(t, overlaps, 1)
+	assert.Equal(t, ids[0], overlaps[0].First.JourneyID)
+	assert.Equal(t, ids[1], overlaps[0].Second.JourneyID)
+	assert.True(t, start.AddDate(0, 0, 2).Equal(overlaps[0].Start))
+	assert.True(t, start.AddDate(0, 0, 3).Equal(overlaps[0].End))
+
+	overlaps, err = repository.FindOverlappingJourneys(context.Background(), 700, start.AddDate(0, 0, 3), time.Time{}, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, overlaps)
+}
+
+func TestRepo_CheckOverlaps(t *testing.T) {
+	start := time.Date(2021, 11, 10, 0, 0, 0, 0, time.UTC)
+	stored := models.Journey{UserID: 702, Address: "Казань", StartTime: start, EndTime: start.AddDate(0, 0, 2)}
+	storedID, err := repository.AddJourney(context.Background(), stored)
+	assert.NoError(t, err)
+
+	journeys := []models.Journey{
+		{UserID: 702, Address: "Самара", StartTime: start.AddDate(0, 0, 1), EndTime: start.AddDate(0, 0, 3)},
+		{UserID: 702, Address: "Саратов", StartTime: start.AddDate(0, 0, 3), EndTime: start.AddDate(0, 0, 5)},
+		{UserID: 702, Address: "Пенза", StartTime: start.AddDate(0, 0, 4), EndTime: start.AddDate(0, 0, 6)},
+		{UserID: 702, Address: "Тула", StartTime: start.AddDate(0, 0, 4), EndTime: start.AddDate(0, 0, 5)},
+		{UserID: 703, Address: "Орёл", StartTime: start, EndTime: start.AddDate(0, 0, 6)},
+	}
+	policies := []models.OverlapPolicy{models.OverlapReject, models.OverlapReject, models.OverlapReject, models.OverlapWarn, models.OverlapWarn}
+
+	var checks []OverlapCheck
+	assert.NoError(t, repository.WithTx(context.Background(), func(tx Repo) error {
+		checks, err = CheckOverlaps(context.Background(), tx, journeys, policies)
+		return err
+	}))
+	assert.Len(t, checks, 5)
+	assert.True(t, apperrors.Is(checks[0].Err, apperrors.FailedPrecondition), "overlaps stored journey")
+	assert.Equal(t, []uint64{storedID}, checks[0].JourneyIDs)
+	assert.NoError(t, checks[1].Err)
+	assert.True(t, apperrors.Is(checks[2].Err, apperrors.FailedPrecondition), "overlaps earlier checked journey")
+	assert.NoError(t, checks[3].Err)
+	assert.Equal(t, []int{1}, checks[3].Indexes, "rejected journeys are not reported")
+	assert.Equal(t, []uint64{20}, checks[3].Overlapping([]uint64{10, 20, 30, 40, 50}))
+	assert.Empty(t, checks[4].JourneyIDs)
+	assert.Empty(t, checks[4].Indexes, "journeys of other users are not reported")
+
+	updated := stored
+	updated.JourneyID = storedID
+	updated.EndTime = start.AddDate(0, 0, 10)
+	checks, err = CheckOverlaps(context.Background(), repository, []models.Journey{updated}, []models.OverlapPolicy{models.OverlapReject})
+	assert.NoError(t, err)
+	assert.NoError(t, checks[0].Err, "stored version of checked journey is ignored")
+}
+
+func TestRepo_GetJourneyStats(t *testing.T) {
+	// 2021-12-05 is Sunday
+	start := time.Date(2021, 12, 5, 22, 0, 0, 0, time.UTC)
+	journeys := []models.Journey{
+		{UserID: 800, Address: "Тверь", StartTime: start, EndTime: start.Add(48 * time.Hour)},
+		{UserID: 800, Address: "Тверь", StartTime: start.AddDate(0, 1, 0), EndTime: start.AddDate(0, 1, 1)},
+		// starts on Monday in Moscow time zone, second journey starts on Wednesday
+		{UserID: 800, Address: "Псков", StartTime: start, EndTime: start.Add(24 * time.Hour), TimeZone: "Europe/Moscow"},
+		{UserID: 801, Address: "Тверь", StartTime: start, EndTime: start.Add(24 * time.Hour)},
+	}
+	_, err := repository.MultiAddJourneys(context.Background(), journeys)
+	assert.NoError(t, err)
+
+	stats, err := repository.GetJourneyStats(context.Background(), JourneyFilter{UserIDs: []uint64{800}}, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), stats.Count)
+	assert.Equal(t, 96*time.Hour, stats.TotalDuration)
+	assert.Equal(t, 32*time.Hour, stats.AverageDuration)
+	assert.Equal(t, []AddressCount{{Address: "Тверь", Count: 2}, {Address: "Псков", Count: 1}}, stats.TopAddresses)
+	assert.Equal(t, []MonthCount{{Year: 2021, Month: time.December, Count: 2}, {Year: 2022, Month: time.January, Count: 1}}, stats.Months)
+	assert.Equal(t, [7]uint64{1, 1, 0, 1, 0, 0, 0}, stats.Weekdays)
+
+	stats, err = repository.GetJourneyStats(context.Background(), JourneyFilter{UserIDs: []uint64{800}, From: start.AddDate(0, 0, 20)}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), stats.Count)
+	assert.Len(t, stats.TopAddresses, 1)
+
+	stats, err = repository.GetJourneyStats(context.Background(), JourneyFilter{UserIDs: []uint64{802}}, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, &JourneyStats{}, stats)
+}
+
+func TestRepo_Waypoints(t *testing.T) {
+	start := time.Date(2021, 12, 10, 8, 0, 0, 0, time.UTC)
+	journey := models.Journey{UserID: 900, Address: "Владимир", StartTime: start, EndTime: start.AddDate(0, 0, 2), TimeZone: "Europe/Moscow",
+		Waypoints: []models.Waypoint{
+			{Address: "Петушки", Coordinates: &models.Coordinates{Latitude: 55.93, Longitude: 39.46}, ArrivalTime: start.Add(2 * time.Hour)},
+			{Address: "Покров", DepartureTime: start.Add(5 * time.Hour)},
+		}}
+	journeyID, err := repository.AddJourney(context.Background(), journey)
+	assert.NoError(t, err)
+
+	found, err := repository.DescribeJourney(context.Background(), journeyID)
+	assert.NoError(t, err)
+	assert.Len(t, found.Waypoints, 2)
+	assert.Equal(t, "Петушки", found.Waypoints[0].Address)
+	assert.Equal(t, journey.Waypoints[0].Coordinates, found.Waypoints[0].Coordinates)
+	assert.True(t, start.Add(2*time.Hour).Equal(found.Waypoints[0].ArrivalTime))
+	assert.Equal(t, "Europe/Moscow", found.Waypoints[0].ArrivalTime.Location().String())
+	assert.True(t, found.Waypoints[0].DepartureTime.IsZero())
+	assert.Nil(t, found.Waypoints[1].Coordinates)
+	petushki, pokrov := found.Waypoints[0].WaypointID, found.Waypoints[1].WaypointID
+
+	kirzhach, revision, err := repository.AddWaypoint(context.Background(), journeyID, models.Waypoint{Address: "Киржач"}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, found.Revision+1, revision)
+	lakinsk, _, err := repository.AddWaypoint(context.Background(), journeyID, models.Waypoint{Address: "Лакинск"}, 0)
+	assert.NoError(t, err)
+	listed, err := repository.ListJourneys(context.Background(), JourneyFilter{UserIDs: []uint64{900}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, listed, 1)
+	assert.Equal(t, []uint64{kirzhach, petushki, pokrov, lakinsk}, waypointIDs(listed[0].Waypoints))
+
+	_, err = repository.ReorderWaypoints(context.Background(), journeyID, []uint64{lakinsk, pokrov, petushki})
+	assert.ErrorIs(t, err, ErrWaypointsMismatch)
+	_, err = repository.ReorderWaypoints(context.Background(), journeyID, []uint64{lakinsk, pokrov, petushki, kirzhach})
+	assert.NoError(t, err)
+	_, err = repository.RemoveWaypoint(context.Background(), journeyID, pokrov)
+	assert.NoError(t, err)
+	_, err = repository.RemoveWaypoint(context.Background(), journeyID, pokrov)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+
+	found, err = repository.DescribeJourney(context.Background(), journeyID)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{lakinsk, petushki, kirzhach}, waypointIDs(found.Waypoints))
+	batch, err := repository.BatchGetJourneys(context.Background(), []uint64{journeyID})
+	assert.NoError(t, err)
+	if assert.Len(t, batch, 1) {
+		assert.Equal(t, []uint64{lakinsk, petushki, kirzhach}, waypointIDs(batch[0].Waypoints))
+	}
+
+	_, _, err = repository.AddWaypoint(context.Background(), journeyID+1000000, models.Waypoint{Address: "Киржач"}, 0)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+}
+
+func waypointIDs(waypoints []models.Waypoint) []uint64 {
+	ids := make([]uint64, len(waypoints))
+	for i, waypoint := range waypoints {
+		ids[i] = waypoint.WaypointID
+	}
+	return ids
+}
+
+func TestRepo_Participants(t *testing.T) {
+	start := time.Date(2021, 12, 20, 8, 0, 0, 0, time.UTC)
+	journeyID, err := repository.AddJourney(context.Background(),
+		models.Journey{UserID: 910, Address: "Суздаль", StartTime: start, EndTime: start.AddDate(0, 0, 1)})
+	assert.NoError(t, err)
+
+	err = repository.AddParticipant(context.Background(), journeyID, models.Participant{UserID: 910, Role: models.RoleEditor})
+	assert.ErrorIs(t, err, ErrOwnerParticipant)
+	assert.NoError(t, repository.AddParticipant(context.Background(), journeyID, models.Participant{UserID: 911, Role: models.RoleViewer}))
+	assert.NoError(t, repository.AddParticipant(context.Background(), journeyID, models.Participant{UserID: 912, Role: models.RoleViewer}))
+	assert.NoError(t, repository.AddParticipant(context.Background(), journeyID, models.Participant{UserID: 911, Role: models.RoleEditor}))
+
+	access, err := repository.GetJourneyAccess(context.Background(), journeyID, 911)
 	assert.NoError(t, err)
+	assert.Equal(t, JourneyAccess{OwnerID: 910, Role: models.RoleEditor}, access)
+	access, err = repository.GetJourneyAccess(context.Background(), journeyID, 910)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RoleOwner, access.Role)
+	access, err = repository.GetJourneyAccess(context.Background(), journeyID, 913)
+	assert.NoError(t, err)
+	assert.Equal(t, models.RoleNone, access.Role)
+
+	participants, err := repository.ListParticipants(context.Background(), journeyID)
+	assert.NoError(t, err)
+	assert.Len(t, participants, 3)
+	assert.Equal(t, models.Participant{UserID: 910, Role: models.RoleOwner}, participants[0])
+	assert.Equal(t, models.RoleEditor, participants[1].Role)
+	assert.False(t, participants[1].InvitedAt.IsZero())
+
+	participantIDs, err := repository.GetJourneyParticipantIDs(context.Background(), []uint64{journeyID, journeyID + 1000000})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64][]uint64{journeyID: {911, 912}}, participantIDs)
+
+	listed, err := repository.ListJourneys(context.Background(), JourneyFilter{UserIDs: []uint64{912}, Participating: true}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, listed, 1)
+	listed, err = repository.ListJourneys(context.Background(), JourneyFilter{UserIDs: []uint64{912}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, listed, 0)
+
+	assert.NoError(t, repository.RemoveParticipant(context.Background(), journeyID, 912))
+	err = repository.RemoveParticipant(context.Background(), journeyID, 912)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+	_, err = repository.GetJourneyAccess(context.Background(), journeyID+1000000, 911)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+}
+
+func TestRepo_Tags(t *testing.T) {
+	start := time.Date(2021, 12, 25, 8, 0, 0, 0, time.UTC)
+	journeyIDs, err := repository.MultiAddJourneys(context.Background(), []models.Journey{
+		{UserID: 920, Address: "Тверь", StartTime: start, EndTime: start.Add(time.Hour), Tags: []string{"business", "commute"}},
+		{UserID: 920, Address: "Клин", StartTime: start, EndTime: start.Add(time.Hour), Tags: []string{"business"}},
+		{UserID: 920, Address: "Дубна", StartTime: start, EndTime: start.Add(time.Hour)},
+		{UserID: 921, Address: "Тверь", StartTime: start, EndTime: start.Add(time.Hour), Tags: []string{"vacation"}},
+	})
+	assert.NoError(t, err)
+
+	found, err := repository.DescribeJourney(context.Background(), journeyIDs[0])
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"business", "commute"}, found.Tags)
+	batch, err := repository.BatchGetJourneys(context.Background(), journeyIDs[:2])
+	assert.NoError(t, err)
+	if assert.Len(t, batch, 2) {
+		assert.Equal(t, []string{"business", "commute"}, batch[0].Tags)
+		assert.Equal(t, []string{"business"}, batch[1].Tags)
+	}
+
+	listed, err := repository.ListJourneys(context.Background(),
+		JourneyFilter{UserIDs: []uint64{920}, AnyTags: []string{"commute", "vacation"}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, listed, 1)
+	listed, err = repository.ListJourneys(context.Background(),
+		JourneyFilter{UserIDs: []uint64{920}, AllTags: []string{"business", "commute", "business"}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, listed, 1)
+	assert.Equal(t, journeyIDs[0], listed[0].JourneyID)
+	listed, err = repository.ListJourneys(context.Background(), JourneyFilter{UserIDs: []uint64{920}, AnyTags: []string{"business"}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, listed, 2)
+
+	found.Tags = []string{"vacation"}
+	_, err = repository.UpdateJourney(context.Background(), *found)
+	assert.NoError(t, err)
+	_, err = repository.PatchJourney(context.Background(), models.Journey{JourneyID: journeyIDs[2], Tags: []string{"commute"}},
+		[]JourneyField{JourneyFieldTags})
+	assert.NoError(t, err)
+	found, err = repository.DescribeJourney(context.Background(), journeyIDs[2])
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"commute"}, found.Tags)
+	assert.Equal(t, "Дубна", found.Address)
+
+	tags, err := repository.ListTags(context.Background(), 920)
+	assert.NoError(t, err)
+	assert.Equal(t, []TagCount{{Name: "business", Count: 1}, {Name: "commute", Count: 1}, {Name: "vacation", Count: 1}}, tags)
+	assert.NoError(t, repository.RemoveJourney(context.Background(), journeyIDs[1], 0))
+	tags, err = repository.ListTags(context.Background(), 920)
+	assert.NoError(t, err)
+	assert.Equal(t, []TagCount{{Name: "commute", Count: 1}, {Name: "vacation", Count: 1}}, tags)
+}
+
+func TestRepo_JourneyStatus(t *testing.T) {
+	// times are before journeys of other tests, so they are not advanced here
+	now := time.Date(1999, 6, 1, 12, 0, 0, 0, time.UTC)
+	journeyIDs, err := repository.MultiAddJourneys(context.Background(), []models.Journey{
+		{UserID: 930, Address: "Тула", StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)},
+		{UserID: 930, Address: "Орёл", StartTime: now.Add(-2 * time.Hour), EndTime: now.Add(-time.Hour)},
+		{UserID: 930, Address: "Калуга", StartTime: now.Add(time.Hour), EndTime: now.Add(2 * time.Hour)},
+	})
+	assert.NoError(t, err)
+
+	found, err := repository.DescribeJourney(context.Background(), journeyIDs[2])
+	assert.NoError(t, err)
+	assert.Equal(t, models.StatusPlanned, found.Status)
+
+	_, err = repository.ChangeJourneyStatus(context.Background(), journeyIDs[2], models.StatusCompleted, 0)
+	assert.True(t, apperrors.Is(err, apperrors.FailedPrecondition))
+	_, err = repository.ChangeJourneyStatus(context.Background(), journeyIDs[2], models.StatusCancelled, found.Revision+1)
+	assert.ErrorIs(t, err, ErrRevisionConflict)
+	revision, err := repository.ChangeJourneyStatus(context.Background(), journeyIDs[2], models.StatusCancelled, found.Revision)
+	assert.NoError(t, err)
+	assert.Equal(t, found.Revision+1, revision)
+	_, err = repository.ChangeJourneyStatus(context.Background(), 0, models.StatusCancelled, 0)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+
+	advanced, err := repository.AdvanceJourneyStatuses(context.Background(), now, 10)
+	assert.NoError(t, err)
+	assert.Len(t, advanced, 2)
+	statuses := make(map[uint64]models.JourneyStatus)
+	for _, journey := range advanced {
+		statuses[journey.JourneyID] = journey.Status
+	}
+	assert.Equal(t, map[uint64]models.JourneyStatus{
+		journeyIDs[0]: models.StatusInProgress,
+		journeyIDs[1]: models.StatusCompleted,
+	}, statuses)
+
+	advanced, err = repository.AdvanceJourneyStatuses(context.Background(), now.Add(time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Len(t, advanced, 1)
+	assert.Equal(t, models.StatusCompleted, advanced[0].Status)
+
+	listed, err := repository.ListJourneys(context.Background(),
+		JourneyFilter{UserIDs: []uint64{930}, Statuses: []models.JourneyStatus{models.StatusCompleted}}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, listed, 2)
+}
+
+func TestRepo_RecurringJourneys(t *testing.T) {
+	monday := time.Date(1998, 6, 1, 8, 0, 0, 0, time.UTC)
+	commute := models.RecurringJourney{
+		UserID: 940, Address: "Офис", StartTime: monday, EndTime: monday.Add(time.Hour), TimeZone: "Europe/Moscow",
+		Rule: "FREQ=WEEKLY;BYDAY=MO,WE",
+	}
+	recurringJourneyID, err := repository.AddRecurringJourney(context.Background(), commute)
+	assert.NoError(t, err)
+
+	found, err := repository.DescribeRecurringJourney(context.Background(), recurringJourneyID)
+	assert.NoError(t, err)
+	assert.Equal(t, commute.Rule, found.Rule)
+	assert.Equal(t, "Europe/Moscow", found.TimeZone)
+	assert.True(t, found.StartTime.Equal(monday))
+	assert.Empty(t, found.Exceptions)
+
+	wednesday := monday.AddDate(0, 0, 2)
+	revision, err := repository.SetOccurrenceException(context.Background(), recurringJourneyID,
+		models.OccurrenceException{OccurrenceTime: wednesday, Cancelled: true}, found.Revision)
+	assert.NoError(t, err)
+	assert.Equal(t, found.Revision+1, revision)
+	// exception of the same occurrence is replaced
+	revision, err = repository.SetOccurrenceException(context.Background(), recurringJourneyID, models.OccurrenceException{
+		OccurrenceTime: wednesday, Address: "Склад", StartTime: wednesday.Add(time.Hour), EndTime: wednesday.Add(2 * time.Hour),
+	}, revision)
+	assert.NoError(t, err)
+	_, err = repository.SetOccurrenceException(context.Background(), recurringJourneyID,
+		models.OccurrenceException{OccurrenceTime: monday, Cancelled: true}, revision-1)
+	assert.ErrorIs(t, err, ErrRevisionConflict)
+
+	listed, err := repository.ListRecurringJourneys(context.Background(),
+		JourneyFilter{UserIDs: []uint64{940}, To: monday.AddDate(0, 0, 7)})
+	assert.NoError(t, err)
+	assert.Len(t, listed, 1)
+	assert.Len(t, listed[0].Exceptions, 1)
+	assert.Equal(t, "Склад", listed[0].Exceptions[0].Address)
+	assert.True(t, listed[0].Exceptions[0].OccurrenceTime.Equal(wednesday))
+
+	listed, err = repository.ListRecurringJourneys(context.Background(),
+		JourneyFilter{UserIDs: []uint64{940}, To: monday.AddDate(0, 0, -1)})
+	assert.NoError(t, err)
+	assert.Empty(t, listed)
+
+	// exceptions of occurrences which are not generated by new rule are removed
+	commute.RecurringJourneyID = recurringJourneyID
+	commute.Rule = "FREQ=WEEKLY;BYDAY=MO"
+	commute.Revision = revision
+	revision, err = repository.UpdateRecurringJourney(context.Background(), commute)
+	assert.NoError(t, err)
+	found, err = repository.DescribeRecurringJourney(context.Background(), recurringJourneyID)
+	assert.NoError(t, err)
+	assert.Equal(t, revision, found.Revision)
+	assert.Empty(t, found.Exceptions)
+
+	assert.ErrorIs(t, repository.RemoveRecurringJourney(context.Background(), recurringJourneyID, revision-1), ErrRevisionConflict)
+	assert.NoError(t, repository.RemoveRecurringJourney(context.Background(), recurringJourneyID, revision))
+	_, err = repository.DescribeRecurringJourney(context.Background(), recurringJourneyID)
+	assert.True(t, apperrors.Is(err, apperrors.NotFound))
+	assert.True(t, apperrors.Is(repository.RemoveRecurringJourney(context.Background(), recurringJourneyID, 0), apperrors.NotFound))
 }
@@ -44,8 +44,12 @@ type saver struct {
 	state      saverState
 }
 
-// Save - add new journey to internal buffer of Saver
+// Save - add new journey to internal buffer of Saver, journey must pass models.Journey.Validate()
 func (s *saver) Save(journey models.Journey) error {
+	if err := journey.Validate(); err != nil {
+		return err
+	}
+
 	s.Lock()
 	defer s.Unlock()
 
@@ -96,6 +100,7 @@ func (s *saver) uploadToFlusher() error {
 // NewSaver return Saver with periodic flushing Journeys data to the storage using flusher.
 //
 // Use Saver.Save() method to add new journey for flushing.
+// Invalid journey is rejected by the Saver.Save() method with the error returned by models.Journey.Validate().
 // For collecting data between flushing attempts used internal buffer with capacity size.
 // If internal buffer is full the Saver.Save() method returns ErrInternalBufferIsFull without adding journey for flushing.
 // If Saver is already closed the Saver.Save() method returns ErrSaverIsClosed without trying to flush journey.
@@ -25,12 +25,14 @@ var _ = Describe("Saver", func() {
 		ctx           context.Context
 	)
 
+	timeStart := time.Date(2021, 01, 01, 0, 0, 0, 0, time.UTC)
+	timeEnd := time.Date(2021, 01, 02, 0, 0, 0, 0, time.UTC)
 	journeysTable := []models.Journey{
-		{JourneyID: 0, UserID: 1, Address: "Воронеж", Description: ""},
-		{JourneyID: 1, UserID: 1, Address: "Уфа", Description: ""},
-		{JourneyID: 2, UserID: 2, Address: "Москва", Description: ""},
-		{JourneyID: 3, UserID: 2, Address: "Лондон", Description: ""},
-		{JourneyID: 4, UserID: 3, Address: "Новосибирск", Description: ""},
+		{JourneyID: 0, UserID: 1, Address: "Воронеж", Description: "", StartTime: timeStart, EndTime: timeEnd},
+		{JourneyID: 1, UserID: 1, Address: "Уфа", Description: "", StartTime: timeStart, EndTime: timeEnd},
+		{JourneyID: 2, UserID: 2, Address: "Москва", Description: "", StartTime: timeStart, EndTime: timeEnd},
+		{JourneyID: 3, UserID: 2, Address: "Лондон", Description: "", StartTime: timeStart, EndTime: timeEnd},
+		{JourneyID: 4, UserID: 3, Address: "Новосибирск", Description: "", StartTime: timeStart, EndTime: timeEnd},
 	}
 
 	BeforeEach(func() {
@@ -125,6 +127,21 @@ var _ = Describe("Saver", func() {
 				})
 			})
 
+			When("try to save invalid journey", func() {
+				It("should return validation error without adding journey to buffer", func() {
+					mockFlusher.EXPECT().Flush(ctx, gomock.Any()).Times(0)
+
+					journey := journeysTable[0]
+					journey.EndTime = journey.StartTime.Add(-time.Hour)
+
+					saveResult := s.Save(journey)
+					closeResult := s.Close()
+
+					Expect(saveResult).Should(Equal(models.ErrEndBeforeStart))
+					Expect(closeResult).Should(BeNil())
+				})
+			})
+
 			When("try to close saver after add one journey with failed flushing", func() {
 				It("should return ErrPartOfDataIsNotFlushed for close", func() {
 					mockFlusher.EXPECT().Flush(ctx, journeysTable[:1]).Times(1).Return(journeysTable[:1])
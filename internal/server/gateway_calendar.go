@@ -0,0 +1,220 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozonva/ova-journey-api/internal/api"
+	"github.com/ozonva/ova-journey-api/internal/auth"
+	"github.com/ozonva/ova-journey-api/internal/ical"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+const (
+	calendarPathPattern = "/v1/users/{user_id}/journeys.ics"
+	// calendarPageSize - journeys of calendar are loaded by pages of this size
+	calendarPageSize = 500
+	// maxCalendarSize - limit of imported calendar size in bytes
+	maxCalendarSize = 10 << 20
+	// calendarExportPast, calendarExportAhead - only journeys and occurrences of recurring journeys
+	// in this time range around the current day are exported, so feed does not grow with history of user
+	// and endlessly repeated journeys
+	calendarExportPast  = 30 * 24 * time.Hour
+	calendarExportAhead = 365 * 24 * time.Hour
+	// calendarRequestTokenTTL - lifetime of token of feed user passed to gRPC service with feed requests
+	calendarRequestTokenTTL = time.Minute
+)
+
+// calendarHandler - serves iCalendar feed of user journeys and imports journeys from iCalendar,
+// like other gateway endpoints it calls gRPC service, so access checks and validation are done there
+type calendarHandler struct {
+	mux           *runtime.ServeMux
+	client        desc.JourneyApiV1Client
+	authenticator *auth.Authenticator
+}
+
+// registerCalendarHandlers - registers GET (export) and POST (import) of calendarPathPattern in gateway mux,
+// authenticator verifies tokens of feeds, it is nil if authentication is disabled
+func registerCalendarHandlers(mux *runtime.ServeMux, client desc.JourneyApiV1Client, authenticator *auth.Authenticator) error {
+	h := &calendarHandler{mux: mux, client: client, authenticator: authenticator}
+	if err := mux.HandlePath(http.MethodGet, calendarPathPattern, h.exportCalendar); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodPost, calendarPathPattern, h.importCalendar)
+}
+
+// exportCalendar - writes journeys of user including journeys shared with them and occurrences of recurring journeys
+// of user from calendarExportPast before the current day to calendarExportAhead after it as VCALENDAR.
+// Weak ETag is computed from ids and revisions of journeys and occurrences, request with matching If-None-Match
+// gets 304 Not Modified. Calendar apps cannot send Authorization header, so feed URL returned by GetCalendarFeedV1
+// has token of the user, see authenticateFeed
+func (h *calendarHandler) exportCalendar(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	_, outboundMarshaler := runtime.MarshalerForRequest(h.mux, r)
+	userID, err := parseUserID(pathParams)
+	if err == nil {
+		err = h.authenticateFeed(r, userID)
+	}
+	if err != nil {
+		runtime.HTTPError(r.Context(), h.mux, outboundMarshaler, w, r, err)
+		return
+	}
+	ctx, err := runtime.AnnotateContext(r.Context(), h.mux, r, "/ova.journey.api.JourneyApiV1/ListJourneysV1",
+		runtime.WithHTTPPathPattern(calendarPathPattern))
+	if err != nil {
+		runtime.HTTPError(r.Context(), h.mux, outboundMarshaler, w, r, err)
+		return
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	fromTime := timestamppb.New(today.Add(-calendarExportPast))
+	toTime := timestamppb.New(today.Add(calendarExportAhead))
+
+	var journeys []*desc.Journey
+	req := &desc.ListJourneysRequestV1{Limit: calendarPageSize, UserIds: []uint64{userID}, FromTime: fromTime, ToTime: toTime}
+	for {
+		resp, err := h.client.ListJourneysV1(ctx, req)
+		if err != nil {
+			log.Err(err).Uint64("userId", userID).Msg("Gateway server: failed to export journeys calendar")
+			runtime.HTTPError(ctx, h.mux, outboundMarshaler, w, r, err)
+			return
+		}
+		journeys = append(journeys, resp.Journeys...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	resp, err := h.client.ListOccurrencesV1(ctx, &desc.ListOccurrencesRequestV1{
+		UserIds:  []uint64{userID},
+		FromTime: fromTime,
+		ToTime:   toTime,
+	})
+	if err != nil {
+		log.Err(err).Uint64("userId", userID).Msg("Gateway server: failed to export journeys calendar")
+		runtime.HTTPError(ctx, h.mux, outboundMarshaler, w, r, err)
+		return
+	}
+	journeys = append(journeys, resp.Occurrences...)
+
+	etag := calendarETag(journeys)
+	w.Header().Set("ETag", etag)
+	// calendar apps poll the feed, so they should revalidate it every time
+	w.Header().Set("Cache-Control", "no-cache")
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	var calendar bytes.Buffer
+	if err := ical.Encode(&calendar, journeys, time.Now()); err != nil {
+		log.Err(err).Uint64("userId", userID).Msg("Gateway server: failed to export journeys calendar")
+		runtime.HTTPError(ctx, h.mux, outboundMarshaler, w, r, status.Error(codes.Internal, err.Error()))
+		return
+	}
+	w.Header().Set("Content-Type", ical.ContentType)
+	if _, err := w.Write(calendar.Bytes()); err != nil {
+		log.Err(err).Uint64("userId", userID).Msg("Gateway server: failed to write journeys calendar")
+	}
+}
+
+// importCalendar - creates journeys of user from events of VCALENDAR in request body with MultiCreateJourneyV1,
+// its atomic and idempotency_key fields are taken from query parameters
+func (h *calendarHandler) importCalendar(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	_, outboundMarshaler := runtime.MarshalerForRequest(h.mux, r)
+	ctx, err := runtime.AnnotateContext(r.Context(), h.mux, r, "/ova.journey.api.JourneyApiV1/MultiCreateJourneyV1",
+		runtime.WithHTTPPathPattern(calendarPathPattern))
+	if err != nil {
+		runtime.HTTPError(r.Context(), h.mux, outboundMarshaler, w, r, err)
+		return
+	}
+	userID, err := parseUserID(pathParams)
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outboundMarshaler, w, r, err)
+		return
+	}
+
+	req := &desc.MultiCreateJourneyRequestV1{IdempotencyKey: r.URL.Query().Get("idempotency_key")}
+	if atomic := r.URL.Query().Get("atomic"); atomic != "" {
+		if req.Atomic, err = strconv.ParseBool(atomic); err != nil {
+			runtime.HTTPError(ctx, h.mux, outboundMarshaler, w, r, status.Errorf(codes.InvalidArgument, "invalid atomic: %v", err))
+			return
+		}
+	}
+
+	req.Journeys, err = ical.Decode(http.MaxBytesReader(w, r.Body, maxCalendarSize))
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+	if len(req.Journeys) == 0 {
+		runtime.HTTPError(ctx, h.mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, "calendar has no events to import"))
+		return
+	}
+	for _, journey := range req.Journeys {
+		journey.UserId = userID
+	}
+
+	var metadata runtime.ServerMetadata
+	resp, err := h.client.MultiCreateJourneyV1(ctx, req, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	ctx = runtime.NewServerMetadataContext(ctx, metadata)
+	if err != nil {
+		log.Err(err).Uint64("userId", userID).Int("events", len(req.Journeys)).Msg("Gateway server: failed to import journeys calendar")
+		runtime.HTTPError(ctx, h.mux, outboundMarshaler, w, r, err)
+		return
+	}
+	runtime.ForwardResponseMessage(ctx, h.mux, outboundMarshaler, w, r, resp, h.mux.GetForwardResponseOptions()...)
+}
+
+// authenticateFeed - replaces Authorization header of feed request having api.CalendarFeedTokenParam by short-lived
+// token of the feed user if feed token is issued for this user, so it gives access only to the feed.
+// Requests without feed token keep their Authorization header, requests are not changed if authentication is disabled
+func (h *calendarHandler) authenticateFeed(r *http.Request, userID uint64) error {
+	token := r.URL.Query().Get(api.CalendarFeedTokenParam)
+	if h.authenticator == nil || token == "" {
+		return nil
+	}
+	tokenUserID, err := h.authenticator.Verify(token, auth.ScopeCalendarFeed, time.Now())
+	if err != nil || tokenUserID != userID {
+		return status.Error(codes.Unauthenticated, "invalid calendar feed token")
+	}
+	r.Header.Set("Authorization", "Bearer "+h.authenticator.Token(userID, auth.ScopeAPI, time.Now().Add(calendarRequestTokenTTL)))
+	return nil
+}
+
+// parseUserID - returns user_id path parameter
+func parseUserID(pathParams map[string]string) (uint64, error) {
+	userID, err := strconv.ParseUint(pathParams["user_id"], 10, 64)
+	if err != nil || userID == 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid user_id %q", pathParams["user_id"])
+	}
+	return userID, nil
+}
+
+// calendarETag - returns weak ETag of calendar with journeys and occurrences, it is changed when any journey
+// or occurrence is added, removed or changed because every change increments revision of journey
+// or recurring journey of occurrence
+func calendarETag(journeys []*desc.Journey) string {
+	hash := sha256.New()
+	for _, journey := range journeys {
+		if journey.RecurringJourneyId > 0 {
+			_, _ = fmt.Fprintf(hash, "r%d@%d:%d;",
+				journey.RecurringJourneyId, journey.OccurrenceTime.AsTime().UnixNano(), journey.Revision)
+			continue
+		}
+		_, _ = fmt.Fprintf(hash, "%d:%d;", journey.JourneyId, journey.Revision)
+	}
+	return "W/" + strconv.Quote(hex.EncodeToString(hash.Sum(nil)[:16]))
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/textproto"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ozonva/ova-journey-api/internal/api"
+	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
+)
+
+// etagIncomingHeaderMatcher - passes If-Match header to gRPC metadata where api.JourneyAPI expects it,
+// other headers are matched by runtime.DefaultHeaderMatcher
+func etagIncomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "If-Match" {
+		return api.IfMatchMetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// etagForwardResponseOption - sets ETag header with journey revision for responses containing one journey
+func etagForwardResponseOption(_ context.Context, w http.ResponseWriter, message proto.Message) error {
+	var revision uint64
+	switch resp := message.(type) {
+	case *desc.DescribeJourneyResponseV1:
+		revision = resp.GetJourney().GetRevision()
+	case *desc.UpdateJourneyResponseV1:
+		revision = resp.GetRevision()
+	case *desc.PatchJourneyResponseV1:
+		revision = resp.GetRevision()
+	}
+
+	if revision > 0 {
+		w.Header().Set("ETag", api.FormatETag(revision))
+	}
+	return nil
+}
+
+// etagMatches - checks that If-None-Match header contains etag or "*", ETags are compared weakly
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
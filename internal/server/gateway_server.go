@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 
+	"github.com/ozonva/ova-journey-api/internal/auth"
 	"github.com/ozonva/ova-journey-api/internal/config"
 	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
 )
@@ -17,6 +18,7 @@ import (
 type GatewayServer struct {
 	gatewayConfiguration      *config.EndpointConfiguration
 	grpcEndpointConfiguration *config.EndpointConfiguration
+	authenticator             *auth.Authenticator
 	httpServer                *http.Server
 	errChan                   chan<- error
 	wg                        *sync.WaitGroup
@@ -24,11 +26,17 @@ type GatewayServer struct {
 
 // NewGatewayServer - creates new GatewayServer with configuration parameters
 //
-// and output channel to signalize about critical errors
-func NewGatewayServer(gatewayConfiguration, grpcEndpointConfiguration *config.EndpointConfiguration, errChan chan<- error) *GatewayServer {
+// and output channel to signalize about critical errors. Authenticator verifies tokens of calendar feeds,
+// it is nil if authentication is disabled
+func NewGatewayServer(
+	gatewayConfiguration, grpcEndpointConfiguration *config.EndpointConfiguration,
+	authenticator *auth.Authenticator,
+	errChan chan<- error,
+) *GatewayServer {
 	return &GatewayServer{
 		gatewayConfiguration:      gatewayConfiguration,
 		grpcEndpointConfiguration: grpcEndpointConfiguration,
+		authenticator:             authenticator,
 		errChan:                   errChan,
 	}
 }
@@ -39,7 +47,10 @@ func (s *GatewayServer) Start() {
 	grpcAddress := s.grpcEndpointConfiguration.GetEndpointAddress()
 
 	mux := http.NewServeMux()
-	gatewayMux := runtime.NewServeMux()
+	gatewayMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(etagIncomingHeaderMatcher),
+		runtime.WithForwardResponseOption(etagForwardResponseOption),
+	)
 	mux.Handle("/", gatewayMux)
 	mux.Handle("/swagger/", http.StripPrefix("/swagger/", http.FileServer(http.Dir("./swagger"))))
 
@@ -53,7 +64,19 @@ func (s *GatewayServer) Start() {
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		err := desc.RegisterJourneyApiV1HandlerFromEndpoint(ctx, gatewayMux, grpcAddress, opts)
+		// connection is shared by generated handlers and calendar handlers
+		conn, err := grpc.DialContext(ctx, grpcAddress, opts...)
+		if err == nil {
+			defer func() {
+				if err := conn.Close(); err != nil {
+					log.Err(err).Msg("Gateway server: failed to close connection to GRPC")
+				}
+			}()
+			err = desc.RegisterJourneyApiV1Handler(ctx, gatewayMux, conn)
+		}
+		if err == nil {
+			err = registerCalendarHandlers(gatewayMux, desc.NewJourneyApiV1Client(conn), s.authenticator)
+		}
 		if err != nil {
 			log.Err(err).Msg("Gateway server: failed to register handler to GRPC")
 			s.errChan <- err
@@ -2,14 +2,13 @@ package server
 
 import (
 	"github.com/jmoiron/sqlx"
-	"github.com/ozonva/ova-journey-api/internal/kafka"
-	"github.com/ozonva/ova-journey-api/internal/metrics"
 	"net"
 
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 
 	"github.com/ozonva/ova-journey-api/internal/api"
+	"github.com/ozonva/ova-journey-api/internal/auth"
 	"github.com/ozonva/ova-journey-api/internal/config"
 	"github.com/ozonva/ova-journey-api/internal/repo"
 	desc "github.com/ozonva/ova-journey-api/pkg/ova-journey-api"
@@ -18,25 +17,30 @@ import (
 // GrpcServer - represents simple gRPC server wrapper
 type GrpcServer struct {
 	configuration *config.EndpointConfiguration
-	producer      kafka.Producer
-	metric        metrics.Metrics
 	db            *sqlx.DB
+	options       api.Options
+	authenticator *auth.Authenticator
 	server        *grpc.Server
 	errChan       chan<- error
-	chunkSize     int
 }
 
 // NewGrpcServer - creates new GrpcServer with configuration endpoint
 //
-// and output channel to signalize about critical errors
-func NewGrpcServer(configuration *config.EndpointConfiguration, producer kafka.Producer, db *sqlx.DB, metric metrics.Metrics, chunkSize int, errChan chan<- error) *GrpcServer {
+// and output channel to signalize about critical errors. API is created with options and repositories using db.
+// Requests are authenticated by authenticator, without it requests have no acting user and are not restricted
+func NewGrpcServer(
+	configuration *config.EndpointConfiguration,
+	db *sqlx.DB,
+	options api.Options,
+	authenticator *auth.Authenticator,
+	errChan chan<- error,
+) *GrpcServer {
 	return &GrpcServer{
 		configuration: configuration,
-		producer:      producer,
 		db:            db,
+		options:       options,
+		authenticator: authenticator,
 		errChan:       errChan,
-		chunkSize:     chunkSize,
-		metric:        metric,
 	}
 }
 
@@ -47,12 +51,23 @@ func (s *GrpcServer) Start() {
 	if err != nil {
 		log.Err(err).Msg("GRPC server: failed to listen")
 		s.errChan <- err
+		return
 	}
 
-	repository := repo.NewRepo(s.db)
+	options := s.options
+	options.Repo = repo.NewRepo(s.db)
+	options.OperationRepo = repo.NewOperationRepo(s.db)
+	options.IdempotencyRepo = repo.NewIdempotencyRepo(s.db)
 
-	s.server = grpc.NewServer()
-	desc.RegisterJourneyApiV1Server(s.server, api.NewJourneyAPI(repository, s.producer, s.metric, s.chunkSize))
+	var serverOptions []grpc.ServerOption
+	if s.authenticator != nil {
+		serverOptions = append(serverOptions,
+			grpc.UnaryInterceptor(s.authenticator.UnaryServerInterceptor),
+			grpc.StreamInterceptor(s.authenticator.StreamServerInterceptor),
+		)
+	}
+	s.server = grpc.NewServer(serverOptions...)
+	desc.RegisterJourneyApiV1Server(s.server, api.NewJourneyAPI(options))
 
 	go func() {
 		log.Debug().Msg("GRPC server: starting")
@@ -27,3 +27,31 @@ func SplitSliceToChunk(slice []int, chunkSize int) ([][]int, error) {
 	}
 	return chunks, nil
 }
+
+// SplitIDsToChunks - split slice of ids into chunks of equal size (chunkSize), except last chunk that contains last ids
+func SplitIDsToChunks(ids []uint64, chunkSize int) ([][]uint64, error) {
+	if ids == nil {
+		return nil, ErrSliceCannotBeNil
+	}
+
+	if chunkSize < 1 {
+		return nil, ErrIncorrectChunkSize
+	}
+
+	var chunksCount = len(ids) / chunkSize
+	if len(ids)%chunkSize > 0 {
+		chunksCount++
+	}
+
+	var chunks = make([][]uint64, chunksCount)
+
+	for i, end := 0, 0; i < chunksCount; i++ {
+		start := end
+		end += chunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunks[i] = ids[start:end]
+	}
+	return chunks, nil
+}
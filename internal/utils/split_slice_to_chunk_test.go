@@ -56,3 +56,43 @@ func TestSplitSliceToChunk(t *testing.T) {
 		assert.Equal(t, testCase.err, err)
 	}
 }
+
+func TestSplitIDsToChunks(t *testing.T) {
+	var testTable = []struct {
+		ids       []uint64
+		chunkSize int
+		result    [][]uint64
+		err       error
+	}{
+		{
+			ids:       []uint64{1, 2, 3, 4, 5},
+			chunkSize: 2,
+			result:    [][]uint64{{1, 2}, {3, 4}, {5}},
+			err:       nil,
+		},
+		{
+			ids:       []uint64{1, 2},
+			chunkSize: 10,
+			result:    [][]uint64{{1, 2}},
+			err:       nil,
+		},
+		{
+			ids:       nil,
+			chunkSize: 2,
+			result:    nil,
+			err:       ErrSliceCannotBeNil,
+		},
+		{
+			ids:       []uint64{1, 2},
+			chunkSize: 0,
+			result:    nil,
+			err:       ErrIncorrectChunkSize,
+		},
+	}
+
+	for _, testCase := range testTable {
+		result, err := SplitIDsToChunks(testCase.ids, testCase.chunkSize)
+		assert.Equal(t, testCase.result, result)
+		assert.Equal(t, testCase.err, err)
+	}
+}
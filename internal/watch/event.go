@@ -0,0 +1,75 @@
+package watch
+
+import "github.com/ozonva/ova-journey-api/internal/models"
+
+// EventType - represents kind of change of journey
+type EventType int
+
+const (
+	// JourneyCreated - journey is created
+	JourneyCreated EventType = iota + 1
+	// JourneyUpdated - journey is updated or patched or its waypoints or status are changed
+	JourneyUpdated
+	// JourneyDeleted - journey is removed
+	JourneyDeleted
+	// JourneyRestored - removed journey is restored
+	JourneyRestored
+)
+
+// Event - represents change of journey published to the Hub
+type Event struct {
+	// Revision - position of event in the Hub, it is assigned by Hub on publishing
+	Revision  uint64
+	Type      EventType
+	JourneyID uint64
+	// UserID - owner of journey, ParticipantIDs - users invited to journey, subscriptions are filtered by them
+	UserID         uint64
+	ParticipantIDs []uint64
+	// Journey - journey after change, it is nil if journey is unknown to publisher (e.g. for JourneyDeleted)
+	Journey *models.Journey
+}
+
+// Created - returns JourneyCreated event for created journey
+func Created(journey models.Journey) Event {
+	journey.Revision = models.InitialRevision
+	return Event{Type: JourneyCreated, JourneyID: journey.JourneyID, UserID: journey.UserID, Journey: &journey}
+}
+
+// Updated - returns JourneyUpdated event for journey
+func Updated(journey models.Journey) Event {
+	return Event{Type: JourneyUpdated, JourneyID: journey.JourneyID, UserID: journey.UserID, Journey: &journey}
+}
+
+// Deleted - returns JourneyDeleted event for journey of user
+func Deleted(journeyID, userID uint64) Event {
+	return Event{Type: JourneyDeleted, JourneyID: journeyID, UserID: userID}
+}
+
+// Restored - returns JourneyRestored event for journey of user
+func Restored(journeyID, userID uint64) Event {
+	return Event{Type: JourneyRestored, JourneyID: journeyID, UserID: userID}
+}
+
+// Filter - represents subscription filter
+type Filter struct {
+	// UserIDs - owners or participants of journeys, any user if empty
+	UserIDs []uint64
+}
+
+// Match - checks that event should be sent to subscriber with the filter
+func (f Filter) Match(event Event) bool {
+	if len(f.UserIDs) == 0 {
+		return true
+	}
+	for _, userID := range f.UserIDs {
+		if event.UserID == userID {
+			return true
+		}
+		for _, participantID := range event.ParticipantIDs {
+			if participantID == userID {
+				return true
+			}
+		}
+	}
+	return false
+}
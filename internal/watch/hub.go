@@ -0,0 +1,167 @@
+package watch
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+)
+
+// ErrRevisionExpired - events after requested revision are not kept by Hub anymore or revision is unknown,
+// so subscriber cannot continue watching from it
+var ErrRevisionExpired = errors.New("revision is expired")
+
+// Publisher - interface for publishing changes of journeys
+type Publisher interface {
+	// Publish - sends events to subscribers, it never blocks on slow subscribers
+	Publish(events ...Event)
+}
+
+// Hub - in-process fan-out of journey events to subscribers.
+//
+// Every published event gets next revision, the last events are kept in history,
+// so subscriber can continue watching after reconnecting from the revision of the last received event.
+// Subscriber that does not read events fast enough is dropped when its buffer is full.
+type Hub interface {
+	Publisher
+	// Subscribe - creates Subscription for events matching filter published after afterRevision,
+	// returns ErrRevisionExpired if some of these events are not kept in history
+	Subscribe(filter Filter, afterRevision uint64) (*Subscription, error)
+	// Revision - returns revision of the last published event
+	Revision() uint64
+	// ID - returns random id of the Hub, revisions of different hubs (e.g. after restart) are not comparable
+	ID() string
+}
+
+type hub struct {
+	mu          sync.Mutex
+	id          string
+	revision    uint64
+	bufferSize  int
+	history     []Event
+	subscribers map[*Subscription]struct{}
+}
+
+// NewHub - creates Hub with buffer of bufferSize events for every subscriber and history of historySize events
+func NewHub(bufferSize, historySize int) Hub {
+	return &hub{
+		id:          newHubID(),
+		bufferSize:  bufferSize,
+		history:     make([]Event, historySize),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+func (h *hub) Publish(events ...Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, event := range events {
+		h.revision++
+		event.Revision = h.revision
+		if len(h.history) > 0 {
+			h.history[h.historyIndex(event.Revision)] = event
+		}
+
+		for subscription := range h.subscribers {
+			if !subscription.filter.Match(event) {
+				continue
+			}
+			select {
+			case subscription.events <- event:
+			default:
+				subscription.dropped = true
+				h.unsubscribe(subscription)
+			}
+		}
+	}
+}
+
+func (h *hub) Subscribe(filter Filter, afterRevision uint64) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if afterRevision > h.revision {
+		return nil, ErrRevisionExpired
+	}
+	missed := h.revision - afterRevision
+	if missed > uint64(len(h.history)) {
+		return nil, ErrRevisionExpired
+	}
+
+	var replay []Event
+	for revision := afterRevision + 1; revision <= h.revision; revision++ {
+		if event := h.history[h.historyIndex(revision)]; filter.Match(event) {
+			replay = append(replay, event)
+		}
+	}
+
+	subscription := &Subscription{
+		hub:    h,
+		filter: filter,
+		events: make(chan Event, h.bufferSize+len(replay)),
+	}
+	for _, event := range replay {
+		subscription.events <- event
+	}
+	h.subscribers[subscription] = struct{}{}
+	return subscription, nil
+}
+
+func (h *hub) Revision() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.revision
+}
+
+func (h *hub) ID() string {
+	return h.id
+}
+
+// historyIndex - returns index of event with revision in the history ring buffer
+func (h *hub) historyIndex(revision uint64) int {
+	return int((revision - 1) % uint64(len(h.history)))
+}
+
+// unsubscribe - removes subscription and closes its channel, it should be called with locked mutex
+func (h *hub) unsubscribe(subscription *Subscription) {
+	if _, ok := h.subscribers[subscription]; !ok {
+		return
+	}
+	delete(h.subscribers, subscription)
+	close(subscription.events)
+}
+
+// newHubID - returns random hex string
+func newHubID() string {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// Subscription - represents subscriber of Hub
+type Subscription struct {
+	hub     *hub
+	filter  Filter
+	events  chan Event
+	dropped bool
+}
+
+// Events - returns channel of events, it is closed when subscription is closed or dropped by Hub
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Dropped - checks that subscription is dropped by Hub because subscriber is too slow
+func (s *Subscription) Dropped() bool {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	return s.dropped
+}
+
+// Close - stops receiving of events
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.unsubscribe(s)
+}
@@ -0,0 +1,110 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+func receive(subscription *Subscription) []Event {
+	var events []Event
+	for {
+		select {
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestHub_PublishToMatchingSubscribers(t *testing.T) {
+	h := NewHub(10, 10)
+	all, err := h.Subscribe(Filter{}, h.Revision())
+	assert.NoError(t, err)
+	firstUser, err := h.Subscribe(Filter{UserIDs: []uint64{1}}, h.Revision())
+	assert.NoError(t, err)
+
+	h.Publish(
+		Created(models.Journey{JourneyID: 1, UserID: 1}),
+		Updated(models.Journey{JourneyID: 2, UserID: 2}),
+		Deleted(3, 1),
+		Restored(4, 2),
+	)
+
+	allEvents := receive(all)
+	assert.Len(t, allEvents, 4)
+	assert.Equal(t, []uint64{1, 2, 3, 4}, []uint64{allEvents[0].Revision, allEvents[1].Revision, allEvents[2].Revision, allEvents[3].Revision})
+
+	firstUserEvents := receive(firstUser)
+	assert.Len(t, firstUserEvents, 2, "events of journeys of other users should not be sent")
+	assert.Equal(t, JourneyCreated, firstUserEvents[0].Type)
+	assert.Equal(t, JourneyDeleted, firstUserEvents[1].Type)
+	assert.Equal(t, uint64(4), h.Revision())
+}
+
+func TestHub_PublishToParticipants(t *testing.T) {
+	h := NewHub(10, 10)
+	participant, err := h.Subscribe(Filter{UserIDs: []uint64{3}}, h.Revision())
+	assert.NoError(t, err)
+
+	shared := Updated(models.Journey{JourneyID: 1, UserID: 1})
+	shared.ParticipantIDs = []uint64{2, 3}
+	h.Publish(shared, Updated(models.Journey{JourneyID: 2, UserID: 1}))
+
+	events := receive(participant)
+	assert.Len(t, events, 1, "events of journeys not shared with user should not be sent")
+	assert.Equal(t, uint64(1), events[0].JourneyID)
+}
+
+func TestHub_DropSlowSubscriber(t *testing.T) {
+	h := NewHub(1, 10)
+	slow, err := h.Subscribe(Filter{}, 0)
+	assert.NoError(t, err)
+
+	h.Publish(Deleted(1, 1), Deleted(2, 1), Deleted(3, 1))
+
+	events := receive(slow)
+	assert.Len(t, events, 1)
+	assert.True(t, slow.Dropped())
+
+	resumed, err := h.Subscribe(Filter{}, events[0].Revision)
+	assert.NoError(t, err)
+	events = receive(resumed)
+	assert.Len(t, events, 2)
+	assert.Equal(t, uint64(2), events[0].JourneyID)
+	assert.False(t, resumed.Dropped())
+}
+
+func TestHub_SubscribeFromExpiredRevision(t *testing.T) {
+	h := NewHub(1, 2)
+	h.Publish(Deleted(1, 1), Deleted(2, 1), Deleted(3, 1))
+
+	_, err := h.Subscribe(Filter{}, 0)
+	assert.ErrorIs(t, err, ErrRevisionExpired)
+	_, err = h.Subscribe(Filter{}, 4)
+	assert.ErrorIs(t, err, ErrRevisionExpired)
+
+	subscription, err := h.Subscribe(Filter{}, 1)
+	assert.NoError(t, err)
+	assert.Len(t, receive(subscription), 2)
+}
+
+func TestSubscription_Close(t *testing.T) {
+	h := NewHub(1, 0)
+	subscription, err := h.Subscribe(Filter{}, 0)
+	assert.NoError(t, err)
+
+	subscription.Close()
+	subscription.Close()
+	h.Publish(Deleted(1, 1))
+
+	_, ok := <-subscription.Events()
+	assert.False(t, ok)
+	assert.False(t, subscription.Dropped())
+}
@@ -0,0 +1,51 @@
+package watch
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+// Loader - loads stored state of changed journeys, it is implemented by repo.Repo
+type Loader interface {
+	DescribeJourney(ctx context.Context, journeyID uint64) (*models.Journey, error)
+	GetJourneyParticipantIDs(ctx context.Context, journeyIDs []uint64) (map[uint64][]uint64, error)
+}
+
+// Load - replaces journeys of JourneyUpdated events by stored journeys with all their fields and sets participants
+// of events, so invited users receive events of shared journeys, created journeys have no participants yet.
+// Changes are already saved, so failures of loading are only logged: JourneyUpdated events of journeys
+// which are not loaded are dropped and other events are returned without participants
+func Load(ctx context.Context, loader Loader, events ...Event) []Event {
+	loaded := make([]Event, 0, len(events))
+	journeyIDs := make([]uint64, 0, len(events))
+	for _, event := range events {
+		if event.Type == JourneyUpdated {
+			journey, err := loader.DescribeJourney(ctx, event.JourneyID)
+			if err != nil {
+				log.Warn().Err(err).Uint64("journeyId", event.JourneyID).Msg("Failed to load changed journey for watchers")
+				continue
+			}
+			event = Updated(*journey)
+		}
+		if event.Type != JourneyCreated {
+			journeyIDs = append(journeyIDs, event.JourneyID)
+		}
+		loaded = append(loaded, event)
+	}
+	if len(journeyIDs) == 0 {
+		return loaded
+	}
+
+	participantIDs, err := loader.GetJourneyParticipantIDs(ctx, journeyIDs)
+	if err != nil {
+		log.Warn().Err(err).Uints64("journeyIds", journeyIDs).Msg("Failed to load participants of changed journeys for watchers")
+		return loaded
+	}
+	for i := range loaded {
+		loaded[i].ParticipantIDs = participantIDs[loaded[i].JourneyID]
+	}
+	return loaded
+}
@@ -0,0 +1,59 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozonva/ova-journey-api/internal/models"
+)
+
+type fakeLoader struct {
+	journeys       map[uint64]models.Journey
+	participantIDs map[uint64][]uint64
+	err            error
+}
+
+func (l *fakeLoader) DescribeJourney(_ context.Context, journeyID uint64) (*models.Journey, error) {
+	journey, ok := l.journeys[journeyID]
+	if !ok {
+		return nil, errors.New("journey not found")
+	}
+	return &journey, nil
+}
+
+func (l *fakeLoader) GetJourneyParticipantIDs(context.Context, []uint64) (map[uint64][]uint64, error) {
+	return l.participantIDs, l.err
+}
+
+func TestLoad(t *testing.T) {
+	stored := models.Journey{JourneyID: 2, UserID: 1, Tags: []string{"business"}, Revision: 4}
+	loader := &fakeLoader{
+		journeys:       map[uint64]models.Journey{2: stored},
+		participantIDs: map[uint64][]uint64{2: {3}, 4: {5, 6}},
+	}
+
+	events := Load(context.Background(), loader,
+		Created(models.Journey{JourneyID: 1, UserID: 1}),
+		Updated(models.Journey{JourneyID: 2, UserID: 1}),
+		Updated(models.Journey{JourneyID: 3, UserID: 1}),
+		Deleted(4, 1),
+	)
+
+	assert.Len(t, events, 3, "updated event of journey which is not loaded should be dropped")
+	assert.Nil(t, events[0].ParticipantIDs)
+	assert.Equal(t, stored, *events[1].Journey)
+	assert.Equal(t, []uint64{3}, events[1].ParticipantIDs)
+	assert.Equal(t, JourneyDeleted, events[2].Type)
+	assert.Equal(t, []uint64{5, 6}, events[2].ParticipantIDs)
+}
+
+func TestLoad_ParticipantsError(t *testing.T) {
+	loader := &fakeLoader{err: errors.New("db error")}
+
+	events := Load(context.Background(), loader, Deleted(4, 1))
+
+	assert.Equal(t, []Event{Deleted(4, 1)}, events)
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS operations (
+                              operation_id BIGSERIAL PRIMARY KEY,
+                              operation_type text NOT NULL,
+                              status text NOT NULL DEFAULT 'pending',
+                              pending_chunks integer NOT NULL DEFAULT 1,
+                              journey_ids bigint[] NOT NULL DEFAULT '{}',
+                              error text NOT NULL DEFAULT '',
+                              created_at timestamptz NOT NULL DEFAULT now(),
+                              updated_at timestamptz NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE operations;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE journeys ADD COLUMN revision bigint NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE journeys DROP COLUMN revision;
+-- +goose StatementEnd
\ No newline at end of file
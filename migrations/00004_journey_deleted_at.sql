@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE journeys ADD COLUMN deleted_at timestamptz;
+UPDATE journeys SET deleted_at = now() WHERE is_deleted;
+CREATE INDEX IF NOT EXISTS "journeys.deleted_at_index" ON "journeys"("deleted_at") WHERE is_deleted;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX "journeys.deleted_at_index";
+ALTER TABLE journeys DROP COLUMN deleted_at;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- NOT VALID keeps existing rows as is, constraints are checked for new and changed rows
+ALTER TABLE journeys
+    ADD CONSTRAINT journeys_user_id_check CHECK (user_id > 0) NOT VALID,
+    ADD CONSTRAINT journeys_time_range_check CHECK (start_time <= end_time) NOT VALID,
+    ADD CONSTRAINT journeys_duration_check CHECK (end_time <= start_time + interval '366 days') NOT VALID,
+    ADD CONSTRAINT journeys_address_check CHECK (char_length(btrim(address)) > 0 AND char_length(address) <= 512) NOT VALID,
+    ADD CONSTRAINT journeys_description_check CHECK (char_length(description) <= 4096) NOT VALID;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE journeys
+    DROP CONSTRAINT journeys_user_id_check,
+    DROP CONSTRAINT journeys_time_range_check,
+    DROP CONSTRAINT journeys_duration_check,
+    DROP CONSTRAINT journeys_address_check,
+    DROP CONSTRAINT journeys_description_check;
+-- +goose StatementEnd
\ No newline at end of file
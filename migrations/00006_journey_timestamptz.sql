@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- existing dates are treated as midnight in UTC
+ALTER TABLE journeys
+    ALTER COLUMN start_time TYPE timestamptz USING start_time::timestamp AT TIME ZONE 'UTC',
+    ALTER COLUMN end_time TYPE timestamptz USING end_time::timestamp AT TIME ZONE 'UTC',
+    ADD COLUMN time_zone text NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE journeys
+    ALTER COLUMN start_time TYPE date USING (start_time AT TIME ZONE 'UTC')::date,
+    ALTER COLUMN end_time TYPE date USING (end_time AT TIME ZONE 'UTC')::date,
+    DROP COLUMN time_zone;
+-- +goose StatementEnd
\ No newline at end of file
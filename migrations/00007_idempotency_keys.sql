@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+                              method text NOT NULL,
+                              key text NOT NULL,
+                              request_hash text NOT NULL,
+                              response bytea,
+                              created_at timestamptz NOT NULL DEFAULT now(),
+                              expires_at timestamptz NOT NULL,
+                              PRIMARY KEY (method, key)
+);
+CREATE INDEX IF NOT EXISTS "idempotency_keys.expires_at_index" ON "idempotency_keys"("expires_at");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE idempotency_keys;
+-- +goose StatementEnd
\ No newline at end of file
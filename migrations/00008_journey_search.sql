@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- russian configuration stems Cyrillic words with Russian and Latin words with English snowball stemmer,
+-- matches in address are ranked higher than matches in description
+ALTER TABLE journeys
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', address), 'A') || setweight(to_tsvector('russian', description), 'B')
+    ) STORED;
+CREATE INDEX IF NOT EXISTS "journeys.search_vector_index" ON "journeys" USING GIN ("search_vector");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX "journeys.search_vector_index";
+ALTER TABLE journeys DROP COLUMN search_vector;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- coordinates are optional, but latitude and longitude are set together
+ALTER TABLE journeys
+    ADD COLUMN latitude double precision,
+    ADD COLUMN longitude double precision,
+    ADD CONSTRAINT journeys_coordinates_check CHECK (
+        (latitude IS NULL AND longitude IS NULL)
+        OR (latitude IS NOT NULL AND longitude IS NOT NULL
+            AND latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+    );
+CREATE INDEX IF NOT EXISTS "journeys.coordinates_index" ON "journeys"("latitude", "longitude") WHERE latitude IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX "journeys.coordinates_index";
+ALTER TABLE journeys
+    DROP COLUMN latitude,
+    DROP COLUMN longitude;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- used for finding overlapping journeys of user
+CREATE INDEX IF NOT EXISTS "journeys.user_id_time_range_index" ON "journeys"("user_id", "start_time", "end_time") WHERE NOT is_deleted;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX "journeys.user_id_time_range_index";
+-- +goose StatementEnd
\ No newline at end of file
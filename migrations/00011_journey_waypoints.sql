@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- position of waypoint in route starts from 1, uniqueness is checked at commit so that positions can be shifted
+CREATE TABLE IF NOT EXISTS journey_waypoints (
+                              waypoint_id bigserial PRIMARY KEY,
+                              journey_id integer NOT NULL REFERENCES journeys (journey_id) ON DELETE CASCADE,
+                              position integer NOT NULL,
+                              address text NOT NULL,
+                              latitude double precision,
+                              longitude double precision,
+                              arrival_time timestamptz,
+                              departure_time timestamptz,
+                              CONSTRAINT journey_waypoints_position_key UNIQUE (journey_id, position) DEFERRABLE INITIALLY DEFERRED,
+                              CONSTRAINT journey_waypoints_position_check CHECK (position > 0),
+                              CONSTRAINT journey_waypoints_address_check CHECK (char_length(btrim(address)) > 0 AND char_length(address) <= 512),
+                              CONSTRAINT journey_waypoints_coordinates_check CHECK (
+                                  (latitude IS NULL AND longitude IS NULL)
+                                  OR (latitude IS NOT NULL AND longitude IS NOT NULL
+                                      AND latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+                              ),
+                              CONSTRAINT journey_waypoints_time_range_check CHECK (arrival_time <= departure_time)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE journey_waypoints;
+-- +goose StatementEnd
\ No newline at end of file
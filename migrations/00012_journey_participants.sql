@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- owner of journey is its user_id, other users are invited as participants
+CREATE TABLE IF NOT EXISTS journey_participants (
+                              journey_id integer NOT NULL REFERENCES journeys (journey_id) ON DELETE CASCADE,
+                              user_id bigint NOT NULL,
+                              role text NOT NULL,
+                              invited_at timestamptz NOT NULL DEFAULT now(),
+                              PRIMARY KEY (journey_id, user_id),
+                              CONSTRAINT journey_participants_user_id_check CHECK (user_id > 0),
+                              CONSTRAINT journey_participants_role_check CHECK (role IN ('viewer', 'editor'))
+);
+CREATE INDEX IF NOT EXISTS "journey_participants.user_id_index" ON "journey_participants"("user_id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE journey_participants;
+-- +goose StatementEnd
\ No newline at end of file
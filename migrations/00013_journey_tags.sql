@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- tags are shared by all journeys, names are stored normalized (see models.NormalizeTags)
+CREATE TABLE IF NOT EXISTS tags (
+                              tag_id bigserial PRIMARY KEY,
+                              name text NOT NULL,
+                              CONSTRAINT tags_name_key UNIQUE (name),
+                              CONSTRAINT tags_name_check CHECK (char_length(name) BETWEEN 1 AND 32 AND name = lower(name))
+);
+CREATE TABLE IF NOT EXISTS journey_tags (
+                              journey_id integer NOT NULL REFERENCES journeys (journey_id) ON DELETE CASCADE,
+                              tag_id bigint NOT NULL REFERENCES tags (tag_id),
+                              PRIMARY KEY (journey_id, tag_id)
+);
+CREATE INDEX IF NOT EXISTS "journey_tags.tag_id_index" ON "journey_tags"("tag_id");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE journey_tags;
+DROP TABLE tags;
+-- +goose StatementEnd
\ No newline at end of file
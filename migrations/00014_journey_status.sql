@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- existing journeys are planned, they are advanced by the background job if it is enabled
+ALTER TABLE journeys
+    ADD COLUMN status text NOT NULL DEFAULT 'planned',
+    ADD CONSTRAINT journeys_status_check CHECK (status IN ('planned', 'in_progress', 'completed', 'cancelled'));
+-- used for advancing status of journeys by their start and end times
+CREATE INDEX IF NOT EXISTS "journeys.planned_start_time_index" ON "journeys"("start_time") WHERE status = 'planned' AND NOT is_deleted;
+CREATE INDEX IF NOT EXISTS "journeys.in_progress_end_time_index" ON "journeys"("end_time") WHERE status = 'in_progress' AND NOT is_deleted;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX "journeys.in_progress_end_time_index";
+DROP INDEX "journeys.planned_start_time_index";
+ALTER TABLE journeys
+    DROP COLUMN status;
+-- +goose StatementEnd
\ No newline at end of file
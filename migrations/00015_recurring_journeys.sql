@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- start_time and end_time are times of the first occurrence, rule is RFC 5545 RRULE value,
+-- occurrences are expanded by service and only changed or cancelled occurrences are stored as exceptions
+CREATE TABLE IF NOT EXISTS recurring_journeys (
+                              recurring_journey_id bigserial PRIMARY KEY,
+                              user_id bigint NOT NULL,
+                              address text NOT NULL,
+                              description text NOT NULL DEFAULT '',
+                              start_time timestamptz NOT NULL,
+                              end_time timestamptz NOT NULL,
+                              time_zone text NOT NULL DEFAULT '',
+                              latitude double precision,
+                              longitude double precision,
+                              rule text NOT NULL,
+                              revision bigint NOT NULL DEFAULT 1,
+                              CONSTRAINT recurring_journeys_user_id_check CHECK (user_id > 0),
+                              CONSTRAINT recurring_journeys_time_range_check CHECK (start_time <= end_time),
+                              CONSTRAINT recurring_journeys_address_check CHECK (char_length(btrim(address)) > 0 AND char_length(address) <= 512),
+                              CONSTRAINT recurring_journeys_rule_check CHECK (char_length(rule) BETWEEN 1 AND 512)
+);
+CREATE INDEX IF NOT EXISTS "recurring_journeys.user_id_index" ON "recurring_journeys"("user_id");
+
+-- occurrence_time is start time of occurrence by rule, fields of occurrence are ignored if it is cancelled
+CREATE TABLE IF NOT EXISTS recurring_journey_exceptions (
+                              recurring_journey_id bigint NOT NULL REFERENCES recurring_journeys (recurring_journey_id) ON DELETE CASCADE,
+                              occurrence_time timestamptz NOT NULL,
+                              is_cancelled boolean NOT NULL DEFAULT FALSE,
+                              address text NOT NULL DEFAULT '',
+                              description text NOT NULL DEFAULT '',
+                              start_time timestamptz,
+                              end_time timestamptz,
+                              PRIMARY KEY (recurring_journey_id, occurrence_time),
+                              CONSTRAINT recurring_journey_exceptions_time_range_check CHECK (start_time <= end_time)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE recurring_journey_exceptions;
+DROP TABLE recurring_journeys;
+-- +goose StatementEnd
\ No newline at end of file
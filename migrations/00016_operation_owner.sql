@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- user who created operation, operations created before the column was added belong to internal clients
+ALTER TABLE operations ADD COLUMN IF NOT EXISTS user_id bigint NOT NULL DEFAULT 0;
+-- used for listing operations of user, newest first
+CREATE INDEX IF NOT EXISTS "operations.user_id_index" ON "operations"("user_id", "operation_id" DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX "operations.user_id_index";
+ALTER TABLE operations DROP COLUMN user_id;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- acting user of request, keys of different users do not intersect; keys saved before the column was added
+-- and keys of requests without acting user have 0
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS user_id bigint NOT NULL DEFAULT 0;
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (user_id, method, key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- keys of different users may be equal, keys are temporary, so they are deleted instead of merging
+DELETE FROM idempotency_keys;
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys DROP COLUMN user_id;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (method, key);
+-- +goose StatementEnd
\ No newline at end of file
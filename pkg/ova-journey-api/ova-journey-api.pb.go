@@ -11,7 +11,9 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,17 +26,373 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// JourneyStatus - lifecycle stage of journey, new journeys are planned
+type JourneyStatus int32
+
+const (
+	JourneyStatus_JOURNEY_STATUS_UNSPECIFIED JourneyStatus = 0
+	JourneyStatus_JOURNEY_STATUS_PLANNED     JourneyStatus = 1
+	JourneyStatus_JOURNEY_STATUS_IN_PROGRESS JourneyStatus = 2
+	JourneyStatus_JOURNEY_STATUS_COMPLETED   JourneyStatus = 3
+	JourneyStatus_JOURNEY_STATUS_CANCELLED   JourneyStatus = 4
+)
+
+// Enum value maps for JourneyStatus.
+var (
+	JourneyStatus_name = map[int32]string{
+		0: "JOURNEY_STATUS_UNSPECIFIED",
+		1: "JOURNEY_STATUS_PLANNED",
+		2: "JOURNEY_STATUS_IN_PROGRESS",
+		3: "JOURNEY_STATUS_COMPLETED",
+		4: "JOURNEY_STATUS_CANCELLED",
+	}
+	JourneyStatus_value = map[string]int32{
+		"JOURNEY_STATUS_UNSPECIFIED": 0,
+		"JOURNEY_STATUS_PLANNED":     1,
+		"JOURNEY_STATUS_IN_PROGRESS": 2,
+		"JOURNEY_STATUS_COMPLETED":   3,
+		"JOURNEY_STATUS_CANCELLED":   4,
+	}
+)
+
+func (x JourneyStatus) Enum() *JourneyStatus {
+	p := new(JourneyStatus)
+	*p = x
+	return p
+}
+
+func (x JourneyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JourneyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ova_journey_api_proto_enumTypes[0].Descriptor()
+}
+
+func (JourneyStatus) Type() protoreflect.EnumType {
+	return &file_ova_journey_api_proto_enumTypes[0]
+}
+
+func (x JourneyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JourneyStatus.Descriptor instead.
+func (JourneyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{0}
+}
+
+// OverlapPolicy - handling of journeys of the same user overlapping in time on create and update
+type OverlapPolicy int32
+
+const (
+	OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED OverlapPolicy = 0
+	// journey is saved without checks
+	OverlapPolicy_OVERLAP_POLICY_ALLOW OverlapPolicy = 1
+	// journey is saved, ids of overlapping journeys are returned in response
+	OverlapPolicy_OVERLAP_POLICY_WARN OverlapPolicy = 2
+	// journey is not saved if it overlaps other journeys, FAILED_PRECONDITION status is returned
+	OverlapPolicy_OVERLAP_POLICY_REJECT OverlapPolicy = 3
+)
+
+// Enum value maps for OverlapPolicy.
+var (
+	OverlapPolicy_name = map[int32]string{
+		0: "OVERLAP_POLICY_UNSPECIFIED",
+		1: "OVERLAP_POLICY_ALLOW",
+		2: "OVERLAP_POLICY_WARN",
+		3: "OVERLAP_POLICY_REJECT",
+	}
+	OverlapPolicy_value = map[string]int32{
+		"OVERLAP_POLICY_UNSPECIFIED": 0,
+		"OVERLAP_POLICY_ALLOW":       1,
+		"OVERLAP_POLICY_WARN":        2,
+		"OVERLAP_POLICY_REJECT":      3,
+	}
+)
+
+func (x OverlapPolicy) Enum() *OverlapPolicy {
+	p := new(OverlapPolicy)
+	*p = x
+	return p
+}
+
+func (x OverlapPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OverlapPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ova_journey_api_proto_enumTypes[1].Descriptor()
+}
+
+func (OverlapPolicy) Type() protoreflect.EnumType {
+	return &file_ova_journey_api_proto_enumTypes[1]
+}
+
+func (x OverlapPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OverlapPolicy.Descriptor instead.
+func (OverlapPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{1}
+}
+
+// ParticipantRole - defines what user can do with journey, every role includes permissions of previous ones.
+// Roles are checked if acting user is authenticated by bearer token in authorization metadata (Authorization header of gateway):
+// editor is required by UpdateJourneyV1, PatchJourneyV1 and waypoint methods, owner is required
+// by RemoveJourneyV1, changing user_id of journey and managing participants, viewer can list participants
+type ParticipantRole int32
+
+const (
+	ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED ParticipantRole = 0
+	ParticipantRole_PARTICIPANT_ROLE_VIEWER      ParticipantRole = 1
+	ParticipantRole_PARTICIPANT_ROLE_EDITOR      ParticipantRole = 2
+	// user_id of journey
+	ParticipantRole_PARTICIPANT_ROLE_OWNER ParticipantRole = 3
+)
+
+// Enum value maps for ParticipantRole.
+var (
+	ParticipantRole_name = map[int32]string{
+		0: "PARTICIPANT_ROLE_UNSPECIFIED",
+		1: "PARTICIPANT_ROLE_VIEWER",
+		2: "PARTICIPANT_ROLE_EDITOR",
+		3: "PARTICIPANT_ROLE_OWNER",
+	}
+	ParticipantRole_value = map[string]int32{
+		"PARTICIPANT_ROLE_UNSPECIFIED": 0,
+		"PARTICIPANT_ROLE_VIEWER":      1,
+		"PARTICIPANT_ROLE_EDITOR":      2,
+		"PARTICIPANT_ROLE_OWNER":       3,
+	}
+)
+
+func (x ParticipantRole) Enum() *ParticipantRole {
+	p := new(ParticipantRole)
+	*p = x
+	return p
+}
+
+func (x ParticipantRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParticipantRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_ova_journey_api_proto_enumTypes[2].Descriptor()
+}
+
+func (ParticipantRole) Type() protoreflect.EnumType {
+	return &file_ova_journey_api_proto_enumTypes[2]
+}
+
+func (x ParticipantRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParticipantRole.Descriptor instead.
+func (ParticipantRole) EnumDescriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{2}
+}
+
+type JourneyTaskType int32
+
+const (
+	JourneyTaskType_JOURNEY_TASK_TYPE_UNSPECIFIED  JourneyTaskType = 0
+	JourneyTaskType_JOURNEY_TASK_TYPE_CREATE       JourneyTaskType = 1
+	JourneyTaskType_JOURNEY_TASK_TYPE_MULTI_CREATE JourneyTaskType = 2
+	JourneyTaskType_JOURNEY_TASK_TYPE_UPDATE       JourneyTaskType = 3
+	JourneyTaskType_JOURNEY_TASK_TYPE_REMOVE       JourneyTaskType = 4
+	JourneyTaskType_JOURNEY_TASK_TYPE_MULTI_UPDATE JourneyTaskType = 5
+	JourneyTaskType_JOURNEY_TASK_TYPE_MULTI_REMOVE JourneyTaskType = 6
+)
+
+// Enum value maps for JourneyTaskType.
+var (
+	JourneyTaskType_name = map[int32]string{
+		0: "JOURNEY_TASK_TYPE_UNSPECIFIED",
+		1: "JOURNEY_TASK_TYPE_CREATE",
+		2: "JOURNEY_TASK_TYPE_MULTI_CREATE",
+		3: "JOURNEY_TASK_TYPE_UPDATE",
+		4: "JOURNEY_TASK_TYPE_REMOVE",
+		5: "JOURNEY_TASK_TYPE_MULTI_UPDATE",
+		6: "JOURNEY_TASK_TYPE_MULTI_REMOVE",
+	}
+	JourneyTaskType_value = map[string]int32{
+		"JOURNEY_TASK_TYPE_UNSPECIFIED":  0,
+		"JOURNEY_TASK_TYPE_CREATE":       1,
+		"JOURNEY_TASK_TYPE_MULTI_CREATE": 2,
+		"JOURNEY_TASK_TYPE_UPDATE":       3,
+		"JOURNEY_TASK_TYPE_REMOVE":       4,
+		"JOURNEY_TASK_TYPE_MULTI_UPDATE": 5,
+		"JOURNEY_TASK_TYPE_MULTI_REMOVE": 6,
+	}
+)
+
+func (x JourneyTaskType) Enum() *JourneyTaskType {
+	p := new(JourneyTaskType)
+	*p = x
+	return p
+}
+
+func (x JourneyTaskType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JourneyTaskType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ova_journey_api_proto_enumTypes[3].Descriptor()
+}
+
+func (JourneyTaskType) Type() protoreflect.EnumType {
+	return &file_ova_journey_api_proto_enumTypes[3]
+}
+
+func (x JourneyTaskType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JourneyTaskType.Descriptor instead.
+func (JourneyTaskType) EnumDescriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{3}
+}
+
+type JourneyTaskStatus int32
+
+const (
+	JourneyTaskStatus_JOURNEY_TASK_STATUS_UNSPECIFIED JourneyTaskStatus = 0
+	JourneyTaskStatus_JOURNEY_TASK_STATUS_PENDING     JourneyTaskStatus = 1
+	JourneyTaskStatus_JOURNEY_TASK_STATUS_SUCCEEDED   JourneyTaskStatus = 2
+	JourneyTaskStatus_JOURNEY_TASK_STATUS_FAILED      JourneyTaskStatus = 3
+)
+
+// Enum value maps for JourneyTaskStatus.
+var (
+	JourneyTaskStatus_name = map[int32]string{
+		0: "JOURNEY_TASK_STATUS_UNSPECIFIED",
+		1: "JOURNEY_TASK_STATUS_PENDING",
+		2: "JOURNEY_TASK_STATUS_SUCCEEDED",
+		3: "JOURNEY_TASK_STATUS_FAILED",
+	}
+	JourneyTaskStatus_value = map[string]int32{
+		"JOURNEY_TASK_STATUS_UNSPECIFIED": 0,
+		"JOURNEY_TASK_STATUS_PENDING":     1,
+		"JOURNEY_TASK_STATUS_SUCCEEDED":   2,
+		"JOURNEY_TASK_STATUS_FAILED":      3,
+	}
+)
+
+func (x JourneyTaskStatus) Enum() *JourneyTaskStatus {
+	p := new(JourneyTaskStatus)
+	*p = x
+	return p
+}
+
+func (x JourneyTaskStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JourneyTaskStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ova_journey_api_proto_enumTypes[4].Descriptor()
+}
+
+func (JourneyTaskStatus) Type() protoreflect.EnumType {
+	return &file_ova_journey_api_proto_enumTypes[4]
+}
+
+func (x JourneyTaskStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JourneyTaskStatus.Descriptor instead.
+func (JourneyTaskStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{4}
+}
+
+type JourneyEventType int32
+
+const (
+	JourneyEventType_JOURNEY_EVENT_TYPE_UNSPECIFIED JourneyEventType = 0
+	JourneyEventType_JOURNEY_EVENT_TYPE_CREATED     JourneyEventType = 1
+	JourneyEventType_JOURNEY_EVENT_TYPE_UPDATED     JourneyEventType = 2
+	JourneyEventType_JOURNEY_EVENT_TYPE_DELETED     JourneyEventType = 3
+	JourneyEventType_JOURNEY_EVENT_TYPE_RESTORED    JourneyEventType = 4
+)
+
+// Enum value maps for JourneyEventType.
+var (
+	JourneyEventType_name = map[int32]string{
+		0: "JOURNEY_EVENT_TYPE_UNSPECIFIED",
+		1: "JOURNEY_EVENT_TYPE_CREATED",
+		2: "JOURNEY_EVENT_TYPE_UPDATED",
+		3: "JOURNEY_EVENT_TYPE_DELETED",
+		4: "JOURNEY_EVENT_TYPE_RESTORED",
+	}
+	JourneyEventType_value = map[string]int32{
+		"JOURNEY_EVENT_TYPE_UNSPECIFIED": 0,
+		"JOURNEY_EVENT_TYPE_CREATED":     1,
+		"JOURNEY_EVENT_TYPE_UPDATED":     2,
+		"JOURNEY_EVENT_TYPE_DELETED":     3,
+		"JOURNEY_EVENT_TYPE_RESTORED":    4,
+	}
+)
+
+func (x JourneyEventType) Enum() *JourneyEventType {
+	p := new(JourneyEventType)
+	*p = x
+	return p
+}
+
+func (x JourneyEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JourneyEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ova_journey_api_proto_enumTypes[5].Descriptor()
+}
+
+func (JourneyEventType) Type() protoreflect.EnumType {
+	return &file_ova_journey_api_proto_enumTypes[5]
+}
+
+func (x JourneyEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JourneyEventType.Descriptor instead.
+func (JourneyEventType) EnumDescriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{5}
+}
+
 type Journey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JourneyId   uint64                 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
-	UserId      uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Address     string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	StartTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	JourneyId   uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	UserId      uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address     string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// times are stored with microsecond precision
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// incremented on every change of journey, occurrences have revision of their recurring journey, ignored in requests
+	Revision uint64 `protobuf:"varint,7,opt,name=revision,proto3" json:"revision,omitempty"`
+	// IANA time zone of the journey (e.g. "Europe/Moscow"), empty means UTC
+	TimeZone string `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// optional geographic point of the address
+	Coordinates *Coordinates `protobuf:"bytes,9,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	// stops within journey in order of route, they are returned by DescribeJourneyV1 and ListJourneysV1,
+	// update requests with waypoints are rejected with INVALID_ARGUMENT, waypoint methods are used to change them
+	Waypoints []*Waypoint `protobuf:"bytes,10,rep,name=waypoints,proto3" json:"waypoints,omitempty"`
+	// labels like "business" or "vacation" stored in lower case without duplicates and sorted,
+	// they are returned by DescribeJourneyV1 and ListJourneysV1 and replaced by update requests
+	Tags []string `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	// ignored in requests, status methods are used to change it
+	Status JourneyStatus `protobuf:"varint,12,opt,name=status,proto3,enum=ova.journey.api.JourneyStatus" json:"status,omitempty"`
+	// set only for occurrences of recurring journeys returned by ListJourneysV1, journey_id is not set for them,
+	// occurrence_time is start time of occurrence by rule which identifies it in UpdateOccurrenceV1
+	RecurringJourneyId uint64                 `protobuf:"varint,13,opt,name=recurring_journey_id,json=recurringJourneyId,proto3" json:"recurring_journey_id,omitempty"`
+	OccurrenceTime     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
 }
 
 func (x *Journey) Reset() {
@@ -111,20 +469,80 @@ func (x *Journey) GetEndTime() *timestamppb.Timestamp {
 	return nil
 }
 
-type CreateJourneyRequestV1 struct {
+func (x *Journey) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Journey) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Journey) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *Journey) GetWaypoints() []*Waypoint {
+	if x != nil {
+		return x.Waypoints
+	}
+	return nil
+}
+
+func (x *Journey) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Journey) GetStatus() JourneyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JourneyStatus_JOURNEY_STATUS_UNSPECIFIED
+}
+
+func (x *Journey) GetRecurringJourneyId() uint64 {
+	if x != nil {
+		return x.RecurringJourneyId
+	}
+	return 0
+}
+
+func (x *Journey) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+// Waypoint - stop within journey
+type Waypoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Address     string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	StartTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// id of waypoint, ignored in requests
+	WaypointId uint64 `protobuf:"varint,1,opt,name=waypoint_id,json=waypointId,proto3" json:"waypoint_id,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// optional geographic point of the address
+	Coordinates *Coordinates `protobuf:"bytes,3,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	// optional times of the stop
+	ArrivalTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	DepartureTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
 }
 
-func (x *CreateJourneyRequestV1) Reset() {
-	*x = CreateJourneyRequestV1{}
+func (x *Waypoint) Reset() {
+	*x = Waypoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ova_journey_api_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -132,13 +550,13 @@ func (x *CreateJourneyRequestV1) Reset() {
 	}
 }
 
-func (x *CreateJourneyRequestV1) String() string {
+func (x *Waypoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJourneyRequestV1) ProtoMessage() {}
+func (*Waypoint) ProtoMessage() {}
 
-func (x *CreateJourneyRequestV1) ProtoReflect() protoreflect.Message {
+func (x *Waypoint) ProtoReflect() protoreflect.Message {
 	mi := &file_ova_journey_api_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -150,56 +568,58 @@ func (x *CreateJourneyRequestV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJourneyRequestV1.ProtoReflect.Descriptor instead.
-func (*CreateJourneyRequestV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use Waypoint.ProtoReflect.Descriptor instead.
+func (*Waypoint) Descriptor() ([]byte, []int) {
 	return file_ova_journey_api_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateJourneyRequestV1) GetUserId() uint64 {
+func (x *Waypoint) GetWaypointId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.WaypointId
 	}
 	return 0
 }
 
-func (x *CreateJourneyRequestV1) GetAddress() string {
+func (x *Waypoint) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *CreateJourneyRequestV1) GetDescription() string {
+func (x *Waypoint) GetCoordinates() *Coordinates {
 	if x != nil {
-		return x.Description
+		return x.Coordinates
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateJourneyRequestV1) GetStartTime() *timestamppb.Timestamp {
+func (x *Waypoint) GetArrivalTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StartTime
+		return x.ArrivalTime
 	}
 	return nil
 }
 
-func (x *CreateJourneyRequestV1) GetEndTime() *timestamppb.Timestamp {
+func (x *Waypoint) GetDepartureTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndTime
+		return x.DepartureTime
 	}
 	return nil
 }
 
-type CreateJourneyResponseV1 struct {
+// Coordinates - geographic point in degrees (WGS 84)
+type Coordinates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
 }
 
-func (x *CreateJourneyResponseV1) Reset() {
-	*x = CreateJourneyResponseV1{}
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ova_journey_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -207,13 +627,13 @@ func (x *CreateJourneyResponseV1) Reset() {
 	}
 }
 
-func (x *CreateJourneyResponseV1) String() string {
+func (x *Coordinates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJourneyResponseV1) ProtoMessage() {}
+func (*Coordinates) ProtoMessage() {}
 
-func (x *CreateJourneyResponseV1) ProtoReflect() protoreflect.Message {
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
 	mi := &file_ova_journey_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -225,28 +645,53 @@ func (x *CreateJourneyResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJourneyResponseV1.ProtoReflect.Descriptor instead.
-func (*CreateJourneyResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
 	return file_ova_journey_api_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateJourneyResponseV1) GetJourneyId() uint64 {
+func (x *Coordinates) GetLatitude() float64 {
 	if x != nil {
-		return x.JourneyId
+		return x.Latitude
 	}
 	return 0
 }
 
-type DescribeJourneyRequestV1 struct {
+func (x *Coordinates) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type CreateJourneyRequestV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	UserId      uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address     string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// IANA time zone of the journey (e.g. "Europe/Moscow"), empty means UTC
+	TimeZone string `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// key for safe retries of the request: request with the same key returns result of the first one,
+	// the same key with another request fails with ALREADY_EXISTS, keys are stored for configured time
+	// (ignored for journeys of MultiCreateJourneyV1, MultiCreateJourneyTaskV1 and ImportJourneysV1)
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// optional geographic point of the address
+	Coordinates *Coordinates `protobuf:"bytes,8,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	// handling of other journeys of the user overlapping this one, configured policy is used if not set
+	OverlapPolicy OverlapPolicy `protobuf:"varint,9,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=ova.journey.api.OverlapPolicy" json:"overlap_policy,omitempty"`
+	// stops within journey in order of route
+	Waypoints []*Waypoint `protobuf:"bytes,10,rep,name=waypoints,proto3" json:"waypoints,omitempty"`
+	// labels of journey, letters, digits, '-' and '_' are allowed
+	Tags []string `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *DescribeJourneyRequestV1) Reset() {
-	*x = DescribeJourneyRequestV1{}
+func (x *CreateJourneyRequestV1) Reset() {
+	*x = CreateJourneyRequestV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ova_journey_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -254,13 +699,13 @@ func (x *DescribeJourneyRequestV1) Reset() {
 	}
 }
 
-func (x *DescribeJourneyRequestV1) String() string {
+func (x *CreateJourneyRequestV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeJourneyRequestV1) ProtoMessage() {}
+func (*CreateJourneyRequestV1) ProtoMessage() {}
 
-func (x *DescribeJourneyRequestV1) ProtoReflect() protoreflect.Message {
+func (x *CreateJourneyRequestV1) ProtoReflect() protoreflect.Message {
 	mi := &file_ova_journey_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -272,30 +717,203 @@ func (x *DescribeJourneyRequestV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeJourneyRequestV1.ProtoReflect.Descriptor instead.
-func (*DescribeJourneyRequestV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateJourneyRequestV1.ProtoReflect.Descriptor instead.
+func (*CreateJourneyRequestV1) Descriptor() ([]byte, []int) {
 	return file_ova_journey_api_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DescribeJourneyRequestV1) GetJourneyId() uint64 {
+func (x *CreateJourneyRequestV1) GetUserId() uint64 {
 	if x != nil {
-		return x.JourneyId
+		return x.UserId
 	}
 	return 0
 }
 
-type DescribeJourneyResponseV1 struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Journey *Journey `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+func (x *CreateJourneyRequestV1) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
-func (x *DescribeJourneyResponseV1) Reset() {
-	*x = DescribeJourneyResponseV1{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[4]
+func (x *CreateJourneyRequestV1) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateJourneyRequestV1) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateJourneyRequestV1) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateJourneyRequestV1) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateJourneyRequestV1) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateJourneyRequestV1) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *CreateJourneyRequestV1) GetOverlapPolicy() OverlapPolicy {
+	if x != nil {
+		return x.OverlapPolicy
+	}
+	return OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+}
+
+func (x *CreateJourneyRequestV1) GetWaypoints() []*Waypoint {
+	if x != nil {
+		return x.Waypoints
+	}
+	return nil
+}
+
+func (x *CreateJourneyRequestV1) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateJourneyResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	// ids of other journeys of the user overlapping created journey, filled with OVERLAP_POLICY_WARN only
+	OverlappingJourneyIds []uint64 `protobuf:"varint,2,rep,packed,name=overlapping_journey_ids,json=overlappingJourneyIds,proto3" json:"overlapping_journey_ids,omitempty"`
+}
+
+func (x *CreateJourneyResponseV1) Reset() {
+	*x = CreateJourneyResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateJourneyResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJourneyResponseV1) ProtoMessage() {}
+
+func (x *CreateJourneyResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJourneyResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateJourneyResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateJourneyResponseV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+func (x *CreateJourneyResponseV1) GetOverlappingJourneyIds() []uint64 {
+	if x != nil {
+		return x.OverlappingJourneyIds
+	}
+	return nil
+}
+
+type DescribeJourneyRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+}
+
+func (x *DescribeJourneyRequestV1) Reset() {
+	*x = DescribeJourneyRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeJourneyRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeJourneyRequestV1) ProtoMessage() {}
+
+func (x *DescribeJourneyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeJourneyRequestV1.ProtoReflect.Descriptor instead.
+func (*DescribeJourneyRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DescribeJourneyRequestV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+type DescribeJourneyResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journey *Journey `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+}
+
+func (x *DescribeJourneyResponseV1) Reset() {
+	*x = DescribeJourneyResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -308,7 +926,7 @@ func (x *DescribeJourneyResponseV1) String() string {
 func (*DescribeJourneyResponseV1) ProtoMessage() {}
 
 func (x *DescribeJourneyResponseV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[4]
+	mi := &file_ova_journey_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,7 +939,7 @@ func (x *DescribeJourneyResponseV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeJourneyResponseV1.ProtoReflect.Descriptor instead.
 func (*DescribeJourneyResponseV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{4}
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DescribeJourneyResponseV1) GetJourney() *Journey {
@@ -338,12 +956,29 @@ type ListJourneysRequestV1 struct {
 
 	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
 	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// token from previous response to get the next page, cannot be combined with offset,
+	// filters must be the same as in request of the first page
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// optional filters, journeys should match all of them
+	// journeys of users including journeys shared with them
+	UserIds []uint64 `protobuf:"varint,4,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// journeys overlapping time range [from_time, to_time), time range is not bounded on side of time which is not set,
+	// occurrences of recurring journeys are also returned if any of times is set
+	FromTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	// substring of address or description, case insensitive
+	Text string `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
+	// journeys having any of any_tags and all of all_tags, case insensitive
+	AnyTags []string `protobuf:"bytes,8,rep,name=any_tags,json=anyTags,proto3" json:"any_tags,omitempty"`
+	AllTags []string `protobuf:"bytes,9,rep,name=all_tags,json=allTags,proto3" json:"all_tags,omitempty"`
+	// journeys having any of statuses
+	Statuses []JourneyStatus `protobuf:"varint,10,rep,packed,name=statuses,proto3,enum=ova.journey.api.JourneyStatus" json:"statuses,omitempty"`
 }
 
 func (x *ListJourneysRequestV1) Reset() {
 	*x = ListJourneysRequestV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[5]
+		mi := &file_ova_journey_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -356,7 +991,7 @@ func (x *ListJourneysRequestV1) String() string {
 func (*ListJourneysRequestV1) ProtoMessage() {}
 
 func (x *ListJourneysRequestV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[5]
+	mi := &file_ova_journey_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +1004,7 @@ func (x *ListJourneysRequestV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJourneysRequestV1.ProtoReflect.Descriptor instead.
 func (*ListJourneysRequestV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{5}
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListJourneysRequestV1) GetOffset() uint64 {
@@ -386,18 +1021,81 @@ func (x *ListJourneysRequestV1) GetLimit() uint64 {
 	return 0
 }
 
+func (x *ListJourneysRequestV1) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListJourneysRequestV1) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *ListJourneysRequestV1) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *ListJourneysRequestV1) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+func (x *ListJourneysRequestV1) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ListJourneysRequestV1) GetAnyTags() []string {
+	if x != nil {
+		return x.AnyTags
+	}
+	return nil
+}
+
+func (x *ListJourneysRequestV1) GetAllTags() []string {
+	if x != nil {
+		return x.AllTags
+	}
+	return nil
+}
+
+func (x *ListJourneysRequestV1) GetStatuses() []JourneyStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
 type ListJourneysResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Journeys []*Journey `protobuf:"bytes,1,rep,name=journeys,proto3" json:"journeys,omitempty"`
+	// empty if there are no more journeys
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// occurrences of recurring journeys of users overlapping time range sorted by start time, they are not paged
+	// and are returned only in the first page (without page_token and offset) if from_time or to_time is set.
+	// Occurrences have planned status and no tags, so they are filtered by statuses and tags like journeys,
+	// at most 1000 occurrences are returned
+	Occurrences []*Journey `protobuf:"bytes,3,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
 }
 
 func (x *ListJourneysResponseV1) Reset() {
 	*x = ListJourneysResponseV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[6]
+		mi := &file_ova_journey_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -410,7 +1108,7 @@ func (x *ListJourneysResponseV1) String() string {
 func (*ListJourneysResponseV1) ProtoMessage() {}
 
 func (x *ListJourneysResponseV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[6]
+	mi := &file_ova_journey_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,7 +1121,7 @@ func (x *ListJourneysResponseV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJourneysResponseV1.ProtoReflect.Descriptor instead.
 func (*ListJourneysResponseV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{6}
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListJourneysResponseV1) GetJourneys() []*Journey {
@@ -433,18 +1131,34 @@ func (x *ListJourneysResponseV1) GetJourneys() []*Journey {
 	return nil
 }
 
+func (x *ListJourneysResponseV1) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListJourneysResponseV1) GetOccurrences() []*Journey {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
 type RemoveJourneyRequestV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	// journey is removed only if it has this revision, 0 means any revision
+	ExpectedRevision uint64 `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
 }
 
 func (x *RemoveJourneyRequestV1) Reset() {
 	*x = RemoveJourneyRequestV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[7]
+		mi := &file_ova_journey_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -457,7 +1171,7 @@ func (x *RemoveJourneyRequestV1) String() string {
 func (*RemoveJourneyRequestV1) ProtoMessage() {}
 
 func (x *RemoveJourneyRequestV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[7]
+	mi := &file_ova_journey_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +1184,7 @@ func (x *RemoveJourneyRequestV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveJourneyRequestV1.ProtoReflect.Descriptor instead.
 func (*RemoveJourneyRequestV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{7}
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RemoveJourneyRequestV1) GetJourneyId() uint64 {
@@ -480,18 +1194,30 @@ func (x *RemoveJourneyRequestV1) GetJourneyId() uint64 {
 	return 0
 }
 
+func (x *RemoveJourneyRequestV1) GetExpectedRevision() uint64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
 type MultiCreateJourneyRequestV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Journeys []*CreateJourneyRequestV1 `protobuf:"bytes,1,rep,name=journeys,proto3" json:"journeys,omitempty"`
+	// all journeys are created in one transaction, nothing is created if any journey fails
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// key for safe retries of the request: request with the same key returns result of the first one,
+	// the same key with another request fails with ALREADY_EXISTS, keys are stored for configured time
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *MultiCreateJourneyRequestV1) Reset() {
 	*x = MultiCreateJourneyRequestV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[8]
+		mi := &file_ova_journey_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -504,7 +1230,7 @@ func (x *MultiCreateJourneyRequestV1) String() string {
 func (*MultiCreateJourneyRequestV1) ProtoMessage() {}
 
 func (x *MultiCreateJourneyRequestV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[8]
+	mi := &file_ova_journey_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +1243,7 @@ func (x *MultiCreateJourneyRequestV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiCreateJourneyRequestV1.ProtoReflect.Descriptor instead.
 func (*MultiCreateJourneyRequestV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{8}
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MultiCreateJourneyRequestV1) GetJourneys() []*CreateJourneyRequestV1 {
@@ -527,18 +1253,35 @@ func (x *MultiCreateJourneyRequestV1) GetJourneys() []*CreateJourneyRequestV1 {
 	return nil
 }
 
+func (x *MultiCreateJourneyRequestV1) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *MultiCreateJourneyRequestV1) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type MultiCreateJourneyResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// ids of created journeys in order of request
 	JourneyIds []uint64 `protobuf:"varint,1,rep,packed,name=journey_ids,json=journeyIds,proto3" json:"journey_ids,omitempty"`
+	// result for every journey of request, failed journeys can be retried
+	Results []*JourneyResultV1 `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 }
 
 func (x *MultiCreateJourneyResponseV1) Reset() {
 	*x = MultiCreateJourneyResponseV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[9]
+		mi := &file_ova_journey_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -551,7 +1294,7 @@ func (x *MultiCreateJourneyResponseV1) String() string {
 func (*MultiCreateJourneyResponseV1) ProtoMessage() {}
 
 func (x *MultiCreateJourneyResponseV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[9]
+	mi := &file_ova_journey_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +1307,7 @@ func (x *MultiCreateJourneyResponseV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiCreateJourneyResponseV1.ProtoReflect.Descriptor instead.
 func (*MultiCreateJourneyResponseV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{9}
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MultiCreateJourneyResponseV1) GetJourneyIds() []uint64 {
@@ -574,18 +1317,29 @@ func (x *MultiCreateJourneyResponseV1) GetJourneyIds() []uint64 {
 	return nil
 }
 
+func (x *MultiCreateJourneyResponseV1) GetResults() []*JourneyResultV1 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type UpdateJourneyRequestV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Journey *Journey `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+	// journey is updated only if it has this revision, 0 means any revision
+	ExpectedRevision uint64 `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+	// handling of other journeys of the user overlapping updated journey, configured policy is used if not set
+	OverlapPolicy OverlapPolicy `protobuf:"varint,3,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=ova.journey.api.OverlapPolicy" json:"overlap_policy,omitempty"`
 }
 
 func (x *UpdateJourneyRequestV1) Reset() {
 	*x = UpdateJourneyRequestV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[10]
+		mi := &file_ova_journey_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -598,7 +1352,7 @@ func (x *UpdateJourneyRequestV1) String() string {
 func (*UpdateJourneyRequestV1) ProtoMessage() {}
 
 func (x *UpdateJourneyRequestV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[10]
+	mi := &file_ova_journey_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +1365,7 @@ func (x *UpdateJourneyRequestV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateJourneyRequestV1.ProtoReflect.Descriptor instead.
 func (*UpdateJourneyRequestV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{10}
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateJourneyRequestV1) GetJourney() *Journey {
@@ -621,35 +1375,47 @@ func (x *UpdateJourneyRequestV1) GetJourney() *Journey {
 	return nil
 }
 
-type CreateJourneyTaskRequestV1 struct {
+func (x *UpdateJourneyRequestV1) GetExpectedRevision() uint64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+func (x *UpdateJourneyRequestV1) GetOverlapPolicy() OverlapPolicy {
+	if x != nil {
+		return x.OverlapPolicy
+	}
+	return OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+}
+
+type UpdateJourneyResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Address     string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	StartTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// ids of other journeys of the user overlapping updated journey, filled with OVERLAP_POLICY_WARN only
+	OverlappingJourneyIds []uint64 `protobuf:"varint,2,rep,packed,name=overlapping_journey_ids,json=overlappingJourneyIds,proto3" json:"overlapping_journey_ids,omitempty"`
 }
 
-func (x *CreateJourneyTaskRequestV1) Reset() {
-	*x = CreateJourneyTaskRequestV1{}
+func (x *UpdateJourneyResponseV1) Reset() {
+	*x = UpdateJourneyResponseV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[11]
+		mi := &file_ova_journey_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateJourneyTaskRequestV1) String() string {
+func (x *UpdateJourneyResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJourneyTaskRequestV1) ProtoMessage() {}
+func (*UpdateJourneyResponseV1) ProtoMessage() {}
 
-func (x *CreateJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[11]
+func (x *UpdateJourneyResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,71 +1426,127 @@ func (x *CreateJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJourneyTaskRequestV1.ProtoReflect.Descriptor instead.
-func (*CreateJourneyTaskRequestV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UpdateJourneyResponseV1.ProtoReflect.Descriptor instead.
+func (*UpdateJourneyResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateJourneyTaskRequestV1) GetUserId() uint64 {
+func (x *UpdateJourneyResponseV1) GetRevision() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Revision
 	}
 	return 0
 }
 
-func (x *CreateJourneyTaskRequestV1) GetAddress() string {
+func (x *UpdateJourneyResponseV1) GetOverlappingJourneyIds() []uint64 {
 	if x != nil {
-		return x.Address
+		return x.OverlappingJourneyIds
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateJourneyTaskRequestV1) GetDescription() string {
-	if x != nil {
-		return x.Description
+type PatchJourneyRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// journey with id and new values of fields listed in update_mask, other fields are ignored
+	Journey *Journey `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+	// paths of journey fields to update: user_id, address, description, start_time, end_time, time_zone, coordinates
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// journey is updated only if it has this revision, 0 means any revision
+	ExpectedRevision uint64 `protobuf:"varint,3,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+	// handling of other journeys of the user overlapping patched journey, configured policy is used if not set
+	OverlapPolicy OverlapPolicy `protobuf:"varint,4,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=ova.journey.api.OverlapPolicy" json:"overlap_policy,omitempty"`
+}
+
+func (x *PatchJourneyRequestV1) Reset() {
+	*x = PatchJourneyRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CreateJourneyTaskRequestV1) GetStartTime() *timestamppb.Timestamp {
+func (x *PatchJourneyRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchJourneyRequestV1) ProtoMessage() {}
+
+func (x *PatchJourneyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchJourneyRequestV1.ProtoReflect.Descriptor instead.
+func (*PatchJourneyRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PatchJourneyRequestV1) GetJourney() *Journey {
 	if x != nil {
-		return x.StartTime
+		return x.Journey
 	}
 	return nil
 }
 
-func (x *CreateJourneyTaskRequestV1) GetEndTime() *timestamppb.Timestamp {
+func (x *PatchJourneyRequestV1) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.EndTime
+		return x.UpdateMask
 	}
 	return nil
 }
 
-type RemoveJourneyTaskRequestV1 struct {
+func (x *PatchJourneyRequestV1) GetExpectedRevision() uint64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+func (x *PatchJourneyRequestV1) GetOverlapPolicy() OverlapPolicy {
+	if x != nil {
+		return x.OverlapPolicy
+	}
+	return OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+}
+
+type PatchJourneyResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// ids of other journeys of the user overlapping patched journey, filled with OVERLAP_POLICY_WARN only
+	OverlappingJourneyIds []uint64 `protobuf:"varint,2,rep,packed,name=overlapping_journey_ids,json=overlappingJourneyIds,proto3" json:"overlapping_journey_ids,omitempty"`
 }
 
-func (x *RemoveJourneyTaskRequestV1) Reset() {
-	*x = RemoveJourneyTaskRequestV1{}
+func (x *PatchJourneyResponseV1) Reset() {
+	*x = PatchJourneyResponseV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[12]
+		mi := &file_ova_journey_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveJourneyTaskRequestV1) String() string {
+func (x *PatchJourneyResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveJourneyTaskRequestV1) ProtoMessage() {}
+func (*PatchJourneyResponseV1) ProtoMessage() {}
 
-func (x *RemoveJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[12]
+func (x *PatchJourneyResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,43 +1557,50 @@ func (x *RemoveJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveJourneyTaskRequestV1.ProtoReflect.Descriptor instead.
-func (*RemoveJourneyTaskRequestV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PatchJourneyResponseV1.ProtoReflect.Descriptor instead.
+func (*PatchJourneyResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RemoveJourneyTaskRequestV1) GetJourneyId() uint64 {
+func (x *PatchJourneyResponseV1) GetRevision() uint64 {
 	if x != nil {
-		return x.JourneyId
+		return x.Revision
 	}
 	return 0
 }
 
-type MultiCreateJourneyTaskRequestV1 struct {
+func (x *PatchJourneyResponseV1) GetOverlappingJourneyIds() []uint64 {
+	if x != nil {
+		return x.OverlappingJourneyIds
+	}
+	return nil
+}
+
+type RestoreJourneyRequestV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Journeys []*CreateJourneyRequestV1 `protobuf:"bytes,1,rep,name=journeys,proto3" json:"journeys,omitempty"`
+	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
 }
 
-func (x *MultiCreateJourneyTaskRequestV1) Reset() {
-	*x = MultiCreateJourneyTaskRequestV1{}
+func (x *RestoreJourneyRequestV1) Reset() {
+	*x = RestoreJourneyRequestV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[13]
+		mi := &file_ova_journey_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiCreateJourneyTaskRequestV1) String() string {
+func (x *RestoreJourneyRequestV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiCreateJourneyTaskRequestV1) ProtoMessage() {}
+func (*RestoreJourneyRequestV1) ProtoMessage() {}
 
-func (x *MultiCreateJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[13]
+func (x *RestoreJourneyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,43 +1611,43 @@ func (x *MultiCreateJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiCreateJourneyTaskRequestV1.ProtoReflect.Descriptor instead.
-func (*MultiCreateJourneyTaskRequestV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RestoreJourneyRequestV1.ProtoReflect.Descriptor instead.
+func (*RestoreJourneyRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MultiCreateJourneyTaskRequestV1) GetJourneys() []*CreateJourneyRequestV1 {
+func (x *RestoreJourneyRequestV1) GetJourneyId() uint64 {
 	if x != nil {
-		return x.Journeys
+		return x.JourneyId
 	}
-	return nil
+	return 0
 }
 
-type UpdateJourneyTaskRequestV1 struct {
+type RestoreJourneyResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Journey *Journey `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *UpdateJourneyTaskRequestV1) Reset() {
-	*x = UpdateJourneyTaskRequestV1{}
+func (x *RestoreJourneyResponseV1) Reset() {
+	*x = RestoreJourneyResponseV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ova_journey_api_proto_msgTypes[14]
+		mi := &file_ova_journey_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateJourneyTaskRequestV1) String() string {
+func (x *RestoreJourneyResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJourneyTaskRequestV1) ProtoMessage() {}
+func (*RestoreJourneyResponseV1) ProtoMessage() {}
 
-func (x *UpdateJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
-	mi := &file_ova_journey_api_proto_msgTypes[14]
+func (x *RestoreJourneyResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,308 +1658,7101 @@ func (x *UpdateJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJourneyTaskRequestV1.ProtoReflect.Descriptor instead.
-func (*UpdateJourneyTaskRequestV1) Descriptor() ([]byte, []int) {
-	return file_ova_journey_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RestoreJourneyResponseV1.ProtoReflect.Descriptor instead.
+func (*RestoreJourneyResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateJourneyTaskRequestV1) GetJourney() *Journey {
+func (x *RestoreJourneyResponseV1) GetRevision() uint64 {
 	if x != nil {
-		return x.Journey
+		return x.Revision
 	}
-	return nil
+	return 0
 }
 
-var File_ova_journey_api_proto protoreflect.FileDescriptor
+type AddWaypointRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_ova_journey_api_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x6f, 0x76, 0x61, 0x2d, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2d, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf8, 0x01,
-	0x0a, 0x07, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x56, 0x31, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
-	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x22, 0x42, 0x0a,
-	0x18, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49,
-	0x64, 0x22, 0x4f, 0x0a, 0x19, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x32,
-	0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x22, 0x57, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x1f, 0x0a, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4e, 0x0a, 0x16, 0x4c,
-	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x34, 0x0a, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x22, 0x40, 0x0a, 0x16, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02,
-	0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x22, 0x6c, 0x0a,
-	0x1b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x4d, 0x0a, 0x08,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
-	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
-	0x01, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x22, 0x3f, 0x0a, 0x1c, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x6a,
-	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x73, 0x22, 0x56, 0x0a, 0x16,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x3c, 0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x22, 0xec, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a,
-	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x56, 0x31, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
-	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
-	0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x1f, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x4d, 0x0a, 0x08,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
-	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
-	0x01, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x22, 0x5a, 0x0a, 0x1a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x3c, 0x0a, 0x07, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61,
-	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x32, 0x93, 0x0a, 0x0a, 0x0c, 0x4a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x41, 0x70, 0x69, 0x56, 0x31, 0x12, 0x7d, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12, 0x27, 0x2e, 0x6f, 0x76,
-	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x56, 0x31, 0x1a, 0x28, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x17,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x8d, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12, 0x29, 0x2e,
-	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2a, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a,
-	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x56, 0x31, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76,
-	0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x77, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4a,
-	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x56, 0x31, 0x12, 0x26, 0x2e, 0x6f, 0x76, 0x61, 0x2e,
-	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
-	0x31, 0x1a, 0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73,
-	0x12, 0x75, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x56, 0x31, 0x12, 0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
-	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19, 0x2f, 0x76,
-	0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31,
-	0x12, 0x2c, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2d,
-	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x1d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x73, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x3a, 0x01, 0x2a, 0x12, 0x6b, 0x0a, 0x0f,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12,
-	0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x1a, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x13, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x56, 0x31,
-	0x12, 0x2b, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f,
-	0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b,
-	0x3a, 0x01, 0x2a, 0x12, 0x82, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x56, 0x31, 0x12, 0x2b, 0x2e, 0x6f, 0x76,
-	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x2a, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
-	0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x18, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54,
-	0x61, 0x73, 0x6b, 0x56, 0x31, 0x12, 0x30, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
-	0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x56, 0x31, 0x12, 0x2b, 0x2e, 0x6f, 0x76, 0x61,
-	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x1a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x3a, 0x01, 0x2a, 0x42, 0x47, 0x5a,
-	0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e,
-	0x76, 0x61, 0x2f, 0x6f, 0x76, 0x61, 0x2d, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2d, 0x61,
-	0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x76, 0x61, 0x2d, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x2d, 0x61, 0x70, 0x69, 0x3b, 0x6f, 0x76, 0x61, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
-	0x65, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	JourneyId uint64    `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	Waypoint  *Waypoint `protobuf:"bytes,2,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
+	// position of new waypoint starting from 1, next waypoints are moved forward,
+	// waypoint is appended if position is 0 or greater than number of waypoints
+	Position uint64 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-var (
-	file_ova_journey_api_proto_rawDescOnce sync.Once
-	file_ova_journey_api_proto_rawDescData = file_ova_journey_api_proto_rawDesc
-)
+func (x *AddWaypointRequestV1) Reset() {
+	*x = AddWaypointRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_ova_journey_api_proto_rawDescGZIP() []byte {
-	file_ova_journey_api_proto_rawDescOnce.Do(func() {
-		file_ova_journey_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_ova_journey_api_proto_rawDescData)
-	})
-	return file_ova_journey_api_proto_rawDescData
+func (x *AddWaypointRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_ova_journey_api_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_ova_journey_api_proto_goTypes = []interface{}{
-	(*Journey)(nil),                         // 0: ova.journey.api.Journey
-	(*CreateJourneyRequestV1)(nil),          // 1: ova.journey.api.CreateJourneyRequestV1
-	(*CreateJourneyResponseV1)(nil),         // 2: ova.journey.api.CreateJourneyResponseV1
-	(*DescribeJourneyRequestV1)(nil),        // 3: ova.journey.api.DescribeJourneyRequestV1
-	(*DescribeJourneyResponseV1)(nil),       // 4: ova.journey.api.DescribeJourneyResponseV1
-	(*ListJourneysRequestV1)(nil),           // 5: ova.journey.api.ListJourneysRequestV1
-	(*ListJourneysResponseV1)(nil),          // 6: ova.journey.api.ListJourneysResponseV1
-	(*RemoveJourneyRequestV1)(nil),          // 7: ova.journey.api.RemoveJourneyRequestV1
-	(*MultiCreateJourneyRequestV1)(nil),     // 8: ova.journey.api.MultiCreateJourneyRequestV1
-	(*MultiCreateJourneyResponseV1)(nil),    // 9: ova.journey.api.MultiCreateJourneyResponseV1
-	(*UpdateJourneyRequestV1)(nil),          // 10: ova.journey.api.UpdateJourneyRequestV1
-	(*CreateJourneyTaskRequestV1)(nil),      // 11: ova.journey.api.CreateJourneyTaskRequestV1
-	(*RemoveJourneyTaskRequestV1)(nil),      // 12: ova.journey.api.RemoveJourneyTaskRequestV1
-	(*MultiCreateJourneyTaskRequestV1)(nil), // 13: ova.journey.api.MultiCreateJourneyTaskRequestV1
-	(*UpdateJourneyTaskRequestV1)(nil),      // 14: ova.journey.api.UpdateJourneyTaskRequestV1
-	(*timestamppb.Timestamp)(nil),           // 15: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                   // 16: google.protobuf.Empty
+func (*AddWaypointRequestV1) ProtoMessage() {}
+
+func (x *AddWaypointRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_ova_journey_api_proto_depIdxs = []int32{
-	15, // 0: ova.journey.api.Journey.start_time:type_name -> google.protobuf.Timestamp
-	15, // 1: ova.journey.api.Journey.end_time:type_name -> google.protobuf.Timestamp
-	15, // 2: ova.journey.api.CreateJourneyRequestV1.start_time:type_name -> google.protobuf.Timestamp
-	15, // 3: ova.journey.api.CreateJourneyRequestV1.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 4: ova.journey.api.DescribeJourneyResponseV1.journey:type_name -> ova.journey.api.Journey
-	0,  // 5: ova.journey.api.ListJourneysResponseV1.journeys:type_name -> ova.journey.api.Journey
-	1,  // 6: ova.journey.api.MultiCreateJourneyRequestV1.journeys:type_name -> ova.journey.api.CreateJourneyRequestV1
-	0,  // 7: ova.journey.api.UpdateJourneyRequestV1.journey:type_name -> ova.journey.api.Journey
-	15, // 8: ova.journey.api.CreateJourneyTaskRequestV1.start_time:type_name -> google.protobuf.Timestamp
-	15, // 9: ova.journey.api.CreateJourneyTaskRequestV1.end_time:type_name -> google.protobuf.Timestamp
-	1,  // 10: ova.journey.api.MultiCreateJourneyTaskRequestV1.journeys:type_name -> ova.journey.api.CreateJourneyRequestV1
-	0,  // 11: ova.journey.api.UpdateJourneyTaskRequestV1.journey:type_name -> ova.journey.api.Journey
-	1,  // 12: ova.journey.api.JourneyApiV1.CreateJourneyV1:input_type -> ova.journey.api.CreateJourneyRequestV1
-	3,  // 13: ova.journey.api.JourneyApiV1.DescribeJourneyV1:input_type -> ova.journey.api.DescribeJourneyRequestV1
-	5,  // 14: ova.journey.api.JourneyApiV1.ListJourneysV1:input_type -> ova.journey.api.ListJourneysRequestV1
-	7,  // 15: ova.journey.api.JourneyApiV1.RemoveJourneyV1:input_type -> ova.journey.api.RemoveJourneyRequestV1
-	8,  // 16: ova.journey.api.JourneyApiV1.MultiCreateJourneyV1:input_type -> ova.journey.api.MultiCreateJourneyRequestV1
-	10, // 17: ova.journey.api.JourneyApiV1.UpdateJourneyV1:input_type -> ova.journey.api.UpdateJourneyRequestV1
-	11, // 18: ova.journey.api.JourneyApiV1.CreateJourneyTaskV1:input_type -> ova.journey.api.CreateJourneyTaskRequestV1
-	12, // 19: ova.journey.api.JourneyApiV1.RemoveJourneyTaskV1:input_type -> ova.journey.api.RemoveJourneyTaskRequestV1
-	13, // 20: ova.journey.api.JourneyApiV1.MultiCreateJourneyTaskV1:input_type -> ova.journey.api.MultiCreateJourneyTaskRequestV1
-	14, // 21: ova.journey.api.JourneyApiV1.UpdateJourneyTaskV1:input_type -> ova.journey.api.UpdateJourneyTaskRequestV1
-	2,  // 22: ova.journey.api.JourneyApiV1.CreateJourneyV1:output_type -> ova.journey.api.CreateJourneyResponseV1
-	4,  // 23: ova.journey.api.JourneyApiV1.DescribeJourneyV1:output_type -> ova.journey.api.DescribeJourneyResponseV1
-	6,  // 24: ova.journey.api.JourneyApiV1.ListJourneysV1:output_type -> ova.journey.api.ListJourneysResponseV1
-	16, // 25: ova.journey.api.JourneyApiV1.RemoveJourneyV1:output_type -> google.protobuf.Empty
-	9,  // 26: ova.journey.api.JourneyApiV1.MultiCreateJourneyV1:output_type -> ova.journey.api.MultiCreateJourneyResponseV1
-	16, // 27: ova.journey.api.JourneyApiV1.UpdateJourneyV1:output_type -> google.protobuf.Empty
-	16, // 28: ova.journey.api.JourneyApiV1.CreateJourneyTaskV1:output_type -> google.protobuf.Empty
-	16, // 29: ova.journey.api.JourneyApiV1.RemoveJourneyTaskV1:output_type -> google.protobuf.Empty
-	16, // 30: ova.journey.api.JourneyApiV1.MultiCreateJourneyTaskV1:output_type -> google.protobuf.Empty
-	16, // 31: ova.journey.api.JourneyApiV1.UpdateJourneyTaskV1:output_type -> google.protobuf.Empty
-	22, // [22:32] is the sub-list for method output_type
-	12, // [12:22] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+
+// Deprecated: Use AddWaypointRequestV1.ProtoReflect.Descriptor instead.
+func (*AddWaypointRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{18}
 }
 
-func init() { file_ova_journey_api_proto_init() }
-func file_ova_journey_api_proto_init() {
-	if File_ova_journey_api_proto != nil {
-		return
+func (x *AddWaypointRequestV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_ova_journey_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Journey); i {
-			case 0:
-				return &v.state
+	return 0
+}
+
+func (x *AddWaypointRequestV1) GetWaypoint() *Waypoint {
+	if x != nil {
+		return x.Waypoint
+	}
+	return nil
+}
+
+func (x *AddWaypointRequestV1) GetPosition() uint64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type AddWaypointResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WaypointId uint64 `protobuf:"varint,1,opt,name=waypoint_id,json=waypointId,proto3" json:"waypoint_id,omitempty"`
+	Revision   uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *AddWaypointResponseV1) Reset() {
+	*x = AddWaypointResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddWaypointResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWaypointResponseV1) ProtoMessage() {}
+
+func (x *AddWaypointResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWaypointResponseV1.ProtoReflect.Descriptor instead.
+func (*AddWaypointResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AddWaypointResponseV1) GetWaypointId() uint64 {
+	if x != nil {
+		return x.WaypointId
+	}
+	return 0
+}
+
+func (x *AddWaypointResponseV1) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ReorderWaypointsRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	// ids of all waypoints of journey in new order
+	WaypointIds []uint64 `protobuf:"varint,2,rep,packed,name=waypoint_ids,json=waypointIds,proto3" json:"waypoint_ids,omitempty"`
+}
+
+func (x *ReorderWaypointsRequestV1) Reset() {
+	*x = ReorderWaypointsRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderWaypointsRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderWaypointsRequestV1) ProtoMessage() {}
+
+func (x *ReorderWaypointsRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderWaypointsRequestV1.ProtoReflect.Descriptor instead.
+func (*ReorderWaypointsRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReorderWaypointsRequestV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+func (x *ReorderWaypointsRequestV1) GetWaypointIds() []uint64 {
+	if x != nil {
+		return x.WaypointIds
+	}
+	return nil
+}
+
+type ReorderWaypointsResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *ReorderWaypointsResponseV1) Reset() {
+	*x = ReorderWaypointsResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorderWaypointsResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderWaypointsResponseV1) ProtoMessage() {}
+
+func (x *ReorderWaypointsResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderWaypointsResponseV1.ProtoReflect.Descriptor instead.
+func (*ReorderWaypointsResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReorderWaypointsResponseV1) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RemoveWaypointRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId  uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	WaypointId uint64 `protobuf:"varint,2,opt,name=waypoint_id,json=waypointId,proto3" json:"waypoint_id,omitempty"`
+}
+
+func (x *RemoveWaypointRequestV1) Reset() {
+	*x = RemoveWaypointRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWaypointRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWaypointRequestV1) ProtoMessage() {}
+
+func (x *RemoveWaypointRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWaypointRequestV1.ProtoReflect.Descriptor instead.
+func (*RemoveWaypointRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveWaypointRequestV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+func (x *RemoveWaypointRequestV1) GetWaypointId() uint64 {
+	if x != nil {
+		return x.WaypointId
+	}
+	return 0
+}
+
+type RemoveWaypointResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RemoveWaypointResponseV1) Reset() {
+	*x = RemoveWaypointResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWaypointResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWaypointResponseV1) ProtoMessage() {}
+
+func (x *RemoveWaypointResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWaypointResponseV1.ProtoReflect.Descriptor instead.
+func (*RemoveWaypointResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveWaypointResponseV1) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type Participant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64          `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role   ParticipantRole `protobuf:"varint,2,opt,name=role,proto3,enum=ova.journey.api.ParticipantRole" json:"role,omitempty"`
+	// not set for owner
+	InvitedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at,omitempty"`
+}
+
+func (x *Participant) Reset() {
+	*x = Participant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Participant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Participant) ProtoMessage() {}
+
+func (x *Participant) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Participant.ProtoReflect.Descriptor instead.
+func (*Participant) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Participant) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Participant) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED
+}
+
+func (x *Participant) GetInvitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InvitedAt
+	}
+	return nil
+}
+
+type InviteParticipantRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId uint64          `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	UserId    uint64          `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role      ParticipantRole `protobuf:"varint,3,opt,name=role,proto3,enum=ova.journey.api.ParticipantRole" json:"role,omitempty"`
+}
+
+func (x *InviteParticipantRequestV1) Reset() {
+	*x = InviteParticipantRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteParticipantRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteParticipantRequestV1) ProtoMessage() {}
+
+func (x *InviteParticipantRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteParticipantRequestV1.ProtoReflect.Descriptor instead.
+func (*InviteParticipantRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InviteParticipantRequestV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+func (x *InviteParticipantRequestV1) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InviteParticipantRequestV1) GetRole() ParticipantRole {
+	if x != nil {
+		return x.Role
+	}
+	return ParticipantRole_PARTICIPANT_ROLE_UNSPECIFIED
+}
+
+type InviteParticipantResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InviteParticipantResponseV1) Reset() {
+	*x = InviteParticipantResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteParticipantResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteParticipantResponseV1) ProtoMessage() {}
+
+func (x *InviteParticipantResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteParticipantResponseV1.ProtoReflect.Descriptor instead.
+func (*InviteParticipantResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{26}
+}
+
+type RemoveParticipantRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	UserId    uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RemoveParticipantRequestV1) Reset() {
+	*x = RemoveParticipantRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveParticipantRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveParticipantRequestV1) ProtoMessage() {}
+
+func (x *RemoveParticipantRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveParticipantRequestV1.ProtoReflect.Descriptor instead.
+func (*RemoveParticipantRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveParticipantRequestV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+func (x *RemoveParticipantRequestV1) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveParticipantResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveParticipantResponseV1) Reset() {
+	*x = RemoveParticipantResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveParticipantResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveParticipantResponseV1) ProtoMessage() {}
+
+func (x *RemoveParticipantResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveParticipantResponseV1.ProtoReflect.Descriptor instead.
+func (*RemoveParticipantResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{28}
+}
+
+type ListParticipantsRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+}
+
+func (x *ListParticipantsRequestV1) Reset() {
+	*x = ListParticipantsRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListParticipantsRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListParticipantsRequestV1) ProtoMessage() {}
+
+func (x *ListParticipantsRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListParticipantsRequestV1.ProtoReflect.Descriptor instead.
+func (*ListParticipantsRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListParticipantsRequestV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+type ListParticipantsResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner first, then invited users in order of invitation
+	Participants []*Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (x *ListParticipantsResponseV1) Reset() {
+	*x = ListParticipantsResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListParticipantsResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListParticipantsResponseV1) ProtoMessage() {}
+
+func (x *ListParticipantsResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListParticipantsResponseV1.ProtoReflect.Descriptor instead.
+func (*ListParticipantsResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListParticipantsResponseV1) GetParticipants() []*Participant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type DeletedJourney struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journey   *Journey               `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+}
+
+func (x *DeletedJourney) Reset() {
+	*x = DeletedJourney{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletedJourney) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedJourney) ProtoMessage() {}
+
+func (x *DeletedJourney) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedJourney.ProtoReflect.Descriptor instead.
+func (*DeletedJourney) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeletedJourney) GetJourney() *Journey {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+func (x *DeletedJourney) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type ListDeletedJourneysRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListDeletedJourneysRequestV1) Reset() {
+	*x = ListDeletedJourneysRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedJourneysRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedJourneysRequestV1) ProtoMessage() {}
+
+func (x *ListDeletedJourneysRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedJourneysRequestV1.ProtoReflect.Descriptor instead.
+func (*ListDeletedJourneysRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListDeletedJourneysRequestV1) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListDeletedJourneysRequestV1) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeletedJourneysResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recently removed journeys first
+	Journeys []*DeletedJourney `protobuf:"bytes,1,rep,name=journeys,proto3" json:"journeys,omitempty"`
+}
+
+func (x *ListDeletedJourneysResponseV1) Reset() {
+	*x = ListDeletedJourneysResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedJourneysResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedJourneysResponseV1) ProtoMessage() {}
+
+func (x *ListDeletedJourneysResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedJourneysResponseV1.ProtoReflect.Descriptor instead.
+func (*ListDeletedJourneysResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListDeletedJourneysResponseV1) GetJourneys() []*DeletedJourney {
+	if x != nil {
+		return x.Journeys
+	}
+	return nil
+}
+
+type JourneyTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId uint64                 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Type        JourneyTaskType        `protobuf:"varint,2,opt,name=type,proto3,enum=ova.journey.api.JourneyTaskType" json:"type,omitempty"`
+	Status      JourneyTaskStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=ova.journey.api.JourneyTaskStatus" json:"status,omitempty"`
+	JourneyIds  []uint64               `protobuf:"varint,4,rep,packed,name=journey_ids,json=journeyIds,proto3" json:"journey_ids,omitempty"`
+	Error       string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *JourneyTask) Reset() {
+	*x = JourneyTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JourneyTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JourneyTask) ProtoMessage() {}
+
+func (x *JourneyTask) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JourneyTask.ProtoReflect.Descriptor instead.
+func (*JourneyTask) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JourneyTask) GetOperationId() uint64 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+func (x *JourneyTask) GetType() JourneyTaskType {
+	if x != nil {
+		return x.Type
+	}
+	return JourneyTaskType_JOURNEY_TASK_TYPE_UNSPECIFIED
+}
+
+func (x *JourneyTask) GetStatus() JourneyTaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JourneyTaskStatus_JOURNEY_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *JourneyTask) GetJourneyIds() []uint64 {
+	if x != nil {
+		return x.JourneyIds
+	}
+	return nil
+}
+
+func (x *JourneyTask) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JourneyTask) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JourneyTask) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ExportJourneysRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// optional filters, journeys should match all of them
+	UserIds []uint64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// journeys overlapping time range [from_time, to_time)
+	FromTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	// substring of address or description, case insensitive
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *ExportJourneysRequestV1) Reset() {
+	*x = ExportJourneysRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportJourneysRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJourneysRequestV1) ProtoMessage() {}
+
+func (x *ExportJourneysRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJourneysRequestV1.ProtoReflect.Descriptor instead.
+func (*ExportJourneysRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportJourneysRequestV1) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *ExportJourneysRequestV1) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *ExportJourneysRequestV1) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+func (x *ExportJourneysRequestV1) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ExportJourneysResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journey *Journey `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+}
+
+func (x *ExportJourneysResponseV1) Reset() {
+	*x = ExportJourneysResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportJourneysResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJourneysResponseV1) ProtoMessage() {}
+
+func (x *ExportJourneysResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJourneysResponseV1.ProtoReflect.Descriptor instead.
+func (*ExportJourneysResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportJourneysResponseV1) GetJourney() *Journey {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+type ImportJourneysRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journey *CreateJourneyRequestV1 `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+}
+
+func (x *ImportJourneysRequestV1) Reset() {
+	*x = ImportJourneysRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportJourneysRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJourneysRequestV1) ProtoMessage() {}
+
+func (x *ImportJourneysRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJourneysRequestV1.ProtoReflect.Descriptor instead.
+func (*ImportJourneysRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ImportJourneysRequestV1) GetJourney() *CreateJourneyRequestV1 {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+// JourneyResultV1 - result of processing one journey of bulk request
+type JourneyResultV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// position of journey in request starting from 0
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// id of journey, 0 if new journey is not created
+	JourneyId uint64 `protobuf:"varint,2,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	// gRPC status code, 0 (OK) if journey is processed
+	Code uint32 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	// description of error if journey is not processed
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// new revision of updated journey
+	Revision uint64 `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	// ids of other journeys of the user overlapping saved journey, filled with OVERLAP_POLICY_WARN only
+	OverlappingJourneyIds []uint64 `protobuf:"varint,6,rep,packed,name=overlapping_journey_ids,json=overlappingJourneyIds,proto3" json:"overlapping_journey_ids,omitempty"`
+}
+
+func (x *JourneyResultV1) Reset() {
+	*x = JourneyResultV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JourneyResultV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JourneyResultV1) ProtoMessage() {}
+
+func (x *JourneyResultV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JourneyResultV1.ProtoReflect.Descriptor instead.
+func (*JourneyResultV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *JourneyResultV1) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *JourneyResultV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+func (x *JourneyResultV1) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *JourneyResultV1) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JourneyResultV1) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *JourneyResultV1) GetOverlappingJourneyIds() []uint64 {
+	if x != nil {
+		return x.OverlappingJourneyIds
+	}
+	return nil
+}
+
+type MultiUpdateJourneyRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journeys []*UpdateJourneyRequestV1 `protobuf:"bytes,1,rep,name=journeys,proto3" json:"journeys,omitempty"`
+}
+
+func (x *MultiUpdateJourneyRequestV1) Reset() {
+	*x = MultiUpdateJourneyRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiUpdateJourneyRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiUpdateJourneyRequestV1) ProtoMessage() {}
+
+func (x *MultiUpdateJourneyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiUpdateJourneyRequestV1.ProtoReflect.Descriptor instead.
+func (*MultiUpdateJourneyRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MultiUpdateJourneyRequestV1) GetJourneys() []*UpdateJourneyRequestV1 {
+	if x != nil {
+		return x.Journeys
+	}
+	return nil
+}
+
+type MultiUpdateJourneyResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results in order of request
+	Results []*JourneyResultV1 `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MultiUpdateJourneyResponseV1) Reset() {
+	*x = MultiUpdateJourneyResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiUpdateJourneyResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiUpdateJourneyResponseV1) ProtoMessage() {}
+
+func (x *MultiUpdateJourneyResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiUpdateJourneyResponseV1.ProtoReflect.Descriptor instead.
+func (*MultiUpdateJourneyResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MultiUpdateJourneyResponseV1) GetResults() []*JourneyResultV1 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type MultiRemoveJourneyRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyIds []uint64 `protobuf:"varint,1,rep,packed,name=journey_ids,json=journeyIds,proto3" json:"journey_ids,omitempty"`
+}
+
+func (x *MultiRemoveJourneyRequestV1) Reset() {
+	*x = MultiRemoveJourneyRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiRemoveJourneyRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiRemoveJourneyRequestV1) ProtoMessage() {}
+
+func (x *MultiRemoveJourneyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiRemoveJourneyRequestV1.ProtoReflect.Descriptor instead.
+func (*MultiRemoveJourneyRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MultiRemoveJourneyRequestV1) GetJourneyIds() []uint64 {
+	if x != nil {
+		return x.JourneyIds
+	}
+	return nil
+}
+
+type MultiRemoveJourneyResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results in order of request
+	Results []*JourneyResultV1 `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MultiRemoveJourneyResponseV1) Reset() {
+	*x = MultiRemoveJourneyResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiRemoveJourneyResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiRemoveJourneyResponseV1) ProtoMessage() {}
+
+func (x *MultiRemoveJourneyResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiRemoveJourneyResponseV1.ProtoReflect.Descriptor instead.
+func (*MultiRemoveJourneyResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MultiRemoveJourneyResponseV1) GetResults() []*JourneyResultV1 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchGetJourneysRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyIds []uint64 `protobuf:"varint,1,rep,packed,name=journey_ids,json=journeyIds,proto3" json:"journey_ids,omitempty"`
+}
+
+func (x *BatchGetJourneysRequestV1) Reset() {
+	*x = BatchGetJourneysRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetJourneysRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetJourneysRequestV1) ProtoMessage() {}
+
+func (x *BatchGetJourneysRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetJourneysRequestV1.ProtoReflect.Descriptor instead.
+func (*BatchGetJourneysRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BatchGetJourneysRequestV1) GetJourneyIds() []uint64 {
+	if x != nil {
+		return x.JourneyIds
+	}
+	return nil
+}
+
+type BatchGetJourneysResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// found journeys in order of request
+	Journeys []*Journey `protobuf:"bytes,1,rep,name=journeys,proto3" json:"journeys,omitempty"`
+	// ids of journeys which are not found or removed
+	MissingJourneyIds []uint64 `protobuf:"varint,2,rep,packed,name=missing_journey_ids,json=missingJourneyIds,proto3" json:"missing_journey_ids,omitempty"`
+}
+
+func (x *BatchGetJourneysResponseV1) Reset() {
+	*x = BatchGetJourneysResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetJourneysResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetJourneysResponseV1) ProtoMessage() {}
+
+func (x *BatchGetJourneysResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetJourneysResponseV1.ProtoReflect.Descriptor instead.
+func (*BatchGetJourneysResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BatchGetJourneysResponseV1) GetJourneys() []*Journey {
+	if x != nil {
+		return x.Journeys
+	}
+	return nil
+}
+
+func (x *BatchGetJourneysResponseV1) GetMissingJourneyIds() []uint64 {
+	if x != nil {
+		return x.MissingJourneyIds
+	}
+	return nil
+}
+
+type SearchJourneysRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// words to search in web search syntax: "quoted phrase", word or word, -excluded word,
+	// words are matched in Russian and English word forms
+	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// optional filters, journeys should match all of them
+	UserIds []uint64 `protobuf:"varint,4,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// journeys overlapping time range [from_time, to_time)
+	FromTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+}
+
+func (x *SearchJourneysRequestV1) Reset() {
+	*x = SearchJourneysRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchJourneysRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJourneysRequestV1) ProtoMessage() {}
+
+func (x *SearchJourneysRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJourneysRequestV1.ProtoReflect.Descriptor instead.
+func (*SearchJourneysRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SearchJourneysRequestV1) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchJourneysRequestV1) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchJourneysRequestV1) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchJourneysRequestV1) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *SearchJourneysRequestV1) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *SearchJourneysRequestV1) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+type SearchJourneysResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*FoundJourneyV1 `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchJourneysResponseV1) Reset() {
+	*x = SearchJourneysResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchJourneysResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJourneysResponseV1) ProtoMessage() {}
+
+func (x *SearchJourneysResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJourneysResponseV1.ProtoReflect.Descriptor instead.
+func (*SearchJourneysResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SearchJourneysResponseV1) GetResults() []*FoundJourneyV1 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type FoundJourneyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journey *Journey `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+	// relevance of journey to query, greater is better
+	Rank float32 `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// HTML escaped fragments of address and description with matched words wrapped in <b></b>,
+	// it can be inserted into HTML as is
+	Snippet string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+}
+
+func (x *FoundJourneyV1) Reset() {
+	*x = FoundJourneyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FoundJourneyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoundJourneyV1) ProtoMessage() {}
+
+func (x *FoundJourneyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoundJourneyV1.ProtoReflect.Descriptor instead.
+func (*FoundJourneyV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FoundJourneyV1) GetJourney() *Journey {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+func (x *FoundJourneyV1) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *FoundJourneyV1) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type FindJourneysNearRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Point *Coordinates `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	// distance from the point along the Earth surface, up to half of the equator length
+	RadiusMeters float64 `protobuf:"fixed64,2,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	Limit        uint64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// optional filters, journeys should match all of them
+	// journeys of users including journeys shared with them
+	UserIds []uint64 `protobuf:"varint,4,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// journeys overlapping time range [from_time, to_time)
+	FromTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+}
+
+func (x *FindJourneysNearRequestV1) Reset() {
+	*x = FindJourneysNearRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindJourneysNearRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindJourneysNearRequestV1) ProtoMessage() {}
+
+func (x *FindJourneysNearRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindJourneysNearRequestV1.ProtoReflect.Descriptor instead.
+func (*FindJourneysNearRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FindJourneysNearRequestV1) GetPoint() *Coordinates {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *FindJourneysNearRequestV1) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+func (x *FindJourneysNearRequestV1) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FindJourneysNearRequestV1) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *FindJourneysNearRequestV1) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *FindJourneysNearRequestV1) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+type FindJourneysNearResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journeys []*NearJourneyV1 `protobuf:"bytes,1,rep,name=journeys,proto3" json:"journeys,omitempty"`
+}
+
+func (x *FindJourneysNearResponseV1) Reset() {
+	*x = FindJourneysNearResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindJourneysNearResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindJourneysNearResponseV1) ProtoMessage() {}
+
+func (x *FindJourneysNearResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindJourneysNearResponseV1.ProtoReflect.Descriptor instead.
+func (*FindJourneysNearResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FindJourneysNearResponseV1) GetJourneys() []*NearJourneyV1 {
+	if x != nil {
+		return x.Journeys
+	}
+	return nil
+}
+
+type NearJourneyV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journey        *Journey `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+	DistanceMeters float64  `protobuf:"fixed64,2,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+}
+
+func (x *NearJourneyV1) Reset() {
+	*x = NearJourneyV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearJourneyV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearJourneyV1) ProtoMessage() {}
+
+func (x *NearJourneyV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearJourneyV1.ProtoReflect.Descriptor instead.
+func (*NearJourneyV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NearJourneyV1) GetJourney() *Journey {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+func (x *NearJourneyV1) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+type FindOverlappingJourneysRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// optional range, pairs are returned if their overlap intersects [from_time, to_time)
+	FromTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	Limit    uint64                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *FindOverlappingJourneysRequestV1) Reset() {
+	*x = FindOverlappingJourneysRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindOverlappingJourneysRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOverlappingJourneysRequestV1) ProtoMessage() {}
+
+func (x *FindOverlappingJourneysRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOverlappingJourneysRequestV1.ProtoReflect.Descriptor instead.
+func (*FindOverlappingJourneysRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FindOverlappingJourneysRequestV1) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FindOverlappingJourneysRequestV1) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *FindOverlappingJourneysRequestV1) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+func (x *FindOverlappingJourneysRequestV1) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FindOverlappingJourneysResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Overlaps []*JourneyOverlapV1 `protobuf:"bytes,1,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
+}
+
+func (x *FindOverlappingJourneysResponseV1) Reset() {
+	*x = FindOverlappingJourneysResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindOverlappingJourneysResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOverlappingJourneysResponseV1) ProtoMessage() {}
+
+func (x *FindOverlappingJourneysResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOverlappingJourneysResponseV1.ProtoReflect.Descriptor instead.
+func (*FindOverlappingJourneysResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *FindOverlappingJourneysResponseV1) GetOverlaps() []*JourneyOverlapV1 {
+	if x != nil {
+		return x.Overlaps
+	}
+	return nil
+}
+
+type JourneyOverlapV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// overlapping journeys, first has lower id
+	First  *Journey `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second *Journey `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	// time range when both journeys take place
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *JourneyOverlapV1) Reset() {
+	*x = JourneyOverlapV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JourneyOverlapV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JourneyOverlapV1) ProtoMessage() {}
+
+func (x *JourneyOverlapV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JourneyOverlapV1.ProtoReflect.Descriptor instead.
+func (*JourneyOverlapV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *JourneyOverlapV1) GetFirst() *Journey {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *JourneyOverlapV1) GetSecond() *Journey {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *JourneyOverlapV1) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *JourneyOverlapV1) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type GetJourneyStatsRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// statistics of journeys of the user, statistics of all users if zero (only for admins)
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// optional range, statistics of journeys overlapping [from_time, to_time)
+	FromTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	// number of most frequent addresses in response, 10 if zero
+	TopAddressesLimit uint64 `protobuf:"varint,4,opt,name=top_addresses_limit,json=topAddressesLimit,proto3" json:"top_addresses_limit,omitempty"`
+}
+
+func (x *GetJourneyStatsRequestV1) Reset() {
+	*x = GetJourneyStatsRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJourneyStatsRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJourneyStatsRequestV1) ProtoMessage() {}
+
+func (x *GetJourneyStatsRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJourneyStatsRequestV1.ProtoReflect.Descriptor instead.
+func (*GetJourneyStatsRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetJourneyStatsRequestV1) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetJourneyStatsRequestV1) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *GetJourneyStatsRequestV1) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+func (x *GetJourneyStatsRequestV1) GetTopAddressesLimit() uint64 {
+	if x != nil {
+		return x.TopAddressesLimit
+	}
+	return 0
+}
+
+type GetJourneyStatsResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count           uint64               `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	TotalDuration   *durationpb.Duration `protobuf:"bytes,2,opt,name=total_duration,json=totalDuration,proto3" json:"total_duration,omitempty"`
+	AverageDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=average_duration,json=averageDuration,proto3" json:"average_duration,omitempty"`
+	// most frequent addresses in order of decreasing count
+	TopAddresses []*AddressCountV1 `protobuf:"bytes,4,rep,name=top_addresses,json=topAddresses,proto3" json:"top_addresses,omitempty"`
+	// numbers of journeys by month of start in time zone of journey, months without journeys are omitted
+	Months []*MonthCountV1 `protobuf:"bytes,5,rep,name=months,proto3" json:"months,omitempty"`
+	// numbers of journeys by weekday of start in time zone of journey, indexed from Sunday
+	Weekdays []uint64 `protobuf:"varint,6,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+}
+
+func (x *GetJourneyStatsResponseV1) Reset() {
+	*x = GetJourneyStatsResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJourneyStatsResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJourneyStatsResponseV1) ProtoMessage() {}
+
+func (x *GetJourneyStatsResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJourneyStatsResponseV1.ProtoReflect.Descriptor instead.
+func (*GetJourneyStatsResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetJourneyStatsResponseV1) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetJourneyStatsResponseV1) GetTotalDuration() *durationpb.Duration {
+	if x != nil {
+		return x.TotalDuration
+	}
+	return nil
+}
+
+func (x *GetJourneyStatsResponseV1) GetAverageDuration() *durationpb.Duration {
+	if x != nil {
+		return x.AverageDuration
+	}
+	return nil
+}
+
+func (x *GetJourneyStatsResponseV1) GetTopAddresses() []*AddressCountV1 {
+	if x != nil {
+		return x.TopAddresses
+	}
+	return nil
+}
+
+func (x *GetJourneyStatsResponseV1) GetMonths() []*MonthCountV1 {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *GetJourneyStatsResponseV1) GetWeekdays() []uint64 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+type AddressCountV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Count   uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AddressCountV1) Reset() {
+	*x = AddressCountV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressCountV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressCountV1) ProtoMessage() {}
+
+func (x *AddressCountV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressCountV1.ProtoReflect.Descriptor instead.
+func (*AddressCountV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddressCountV1) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressCountV1) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListTagsRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ListTagsRequestV1) Reset() {
+	*x = ListTagsRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequestV1) ProtoMessage() {}
+
+func (x *ListTagsRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequestV1.ProtoReflect.Descriptor instead.
+func (*ListTagsRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListTagsRequestV1) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListTagsResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*TagCountV1 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ListTagsResponseV1) Reset() {
+	*x = ListTagsResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponseV1) ProtoMessage() {}
+
+func (x *ListTagsResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponseV1.ProtoReflect.Descriptor instead.
+func (*ListTagsResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListTagsResponseV1) GetTags() []*TagCountV1 {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagCountV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// number of not removed journeys of the user having the tag
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TagCountV1) Reset() {
+	*x = TagCountV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagCountV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCountV1) ProtoMessage() {}
+
+func (x *TagCountV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCountV1.ProtoReflect.Descriptor instead.
+func (*TagCountV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TagCountV1) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagCountV1) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetCalendarFeedRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCalendarFeedRequestV1) Reset() {
+	*x = GetCalendarFeedRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCalendarFeedRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequestV1) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequestV1.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{60}
+}
+
+type GetCalendarFeedResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// path of feed relative to gateway address, e.g. /v1/users/1/journeys.ics?token=...
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *GetCalendarFeedResponseV1) Reset() {
+	*x = GetCalendarFeedResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCalendarFeedResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedResponseV1) ProtoMessage() {}
+
+func (x *GetCalendarFeedResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedResponseV1.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCalendarFeedResponseV1) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedResponseV1) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ChangeJourneyStatusRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	// status is changed only if revision of journey is equal to it, 0 means no check
+	ExpectedRevision uint64 `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+}
+
+func (x *ChangeJourneyStatusRequestV1) Reset() {
+	*x = ChangeJourneyStatusRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeJourneyStatusRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeJourneyStatusRequestV1) ProtoMessage() {}
+
+func (x *ChangeJourneyStatusRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeJourneyStatusRequestV1.ProtoReflect.Descriptor instead.
+func (*ChangeJourneyStatusRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ChangeJourneyStatusRequestV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+func (x *ChangeJourneyStatusRequestV1) GetExpectedRevision() uint64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+type ChangeJourneyStatusResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision uint64        `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Status   JourneyStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ova.journey.api.JourneyStatus" json:"status,omitempty"`
+}
+
+func (x *ChangeJourneyStatusResponseV1) Reset() {
+	*x = ChangeJourneyStatusResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeJourneyStatusResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeJourneyStatusResponseV1) ProtoMessage() {}
+
+func (x *ChangeJourneyStatusResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeJourneyStatusResponseV1.ProtoReflect.Descriptor instead.
+func (*ChangeJourneyStatusResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ChangeJourneyStatusResponseV1) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ChangeJourneyStatusResponseV1) GetStatus() JourneyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JourneyStatus_JOURNEY_STATUS_UNSPECIFIED
+}
+
+// RecurringJourney - journey repeated by recurrence rule
+type RecurringJourney struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecurringJourneyId uint64 `protobuf:"varint,1,opt,name=recurring_journey_id,json=recurringJourneyId,proto3" json:"recurring_journey_id,omitempty"`
+	UserId             uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address            string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Description        string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// times of the first occurrence, other occurrences have the same duration and start
+	// at the same wall clock time in time_zone
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// IANA time zone of the journey (e.g. "Europe/Moscow"), empty means UTC
+	TimeZone    string       `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Coordinates *Coordinates `protobuf:"bytes,8,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	// RFC 5545 RRULE value like "FREQ=WEEKLY;BYDAY=MO,FR", supported parts are FREQ (DAILY, WEEKLY, MONTHLY or YEARLY),
+	// INTERVAL, COUNT (up to 1000), UNTIL, BYDAY, BYMONTHDAY, BYMONTH and WKST
+	Rule string `protobuf:"bytes,9,opt,name=rule,proto3" json:"rule,omitempty"`
+	// incremented on every change of recurring journey and its occurrences, ignored in requests
+	Revision uint64 `protobuf:"varint,10,opt,name=revision,proto3" json:"revision,omitempty"`
+	// changed and cancelled occurrences sorted by occurrence time, ignored in requests
+	Exceptions []*OccurrenceException `protobuf:"bytes,11,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+}
+
+func (x *RecurringJourney) Reset() {
+	*x = RecurringJourney{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecurringJourney) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringJourney) ProtoMessage() {}
+
+func (x *RecurringJourney) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringJourney.ProtoReflect.Descriptor instead.
+func (*RecurringJourney) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RecurringJourney) GetRecurringJourneyId() uint64 {
+	if x != nil {
+		return x.RecurringJourneyId
+	}
+	return 0
+}
+
+func (x *RecurringJourney) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecurringJourney) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RecurringJourney) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecurringJourney) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *RecurringJourney) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *RecurringJourney) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *RecurringJourney) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *RecurringJourney) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RecurringJourney) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RecurringJourney) GetExceptions() []*OccurrenceException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+// OccurrenceException - change of single occurrence of recurring journey
+type OccurrenceException struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start time of occurrence by rule
+	OccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=occurrence_time,json=occurrenceTime,proto3" json:"occurrence_time,omitempty"`
+	// occurrence is removed, other fields are ignored
+	Cancelled bool `protobuf:"varint,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// new values of occurrence fields, empty fields keep values of occurrence
+	// and occurrence with changed start_time only keeps its duration
+	Address     string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *OccurrenceException) Reset() {
+	*x = OccurrenceException{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OccurrenceException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OccurrenceException) ProtoMessage() {}
+
+func (x *OccurrenceException) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OccurrenceException.ProtoReflect.Descriptor instead.
+func (*OccurrenceException) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *OccurrenceException) GetOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurrenceTime
+	}
+	return nil
+}
+
+func (x *OccurrenceException) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *OccurrenceException) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *OccurrenceException) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OccurrenceException) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *OccurrenceException) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type CreateRecurringJourneyRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecurringJourney *RecurringJourney `protobuf:"bytes,1,opt,name=recurring_journey,json=recurringJourney,proto3" json:"recurring_journey,omitempty"`
+}
+
+func (x *CreateRecurringJourneyRequestV1) Reset() {
+	*x = CreateRecurringJourneyRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRecurringJourneyRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringJourneyRequestV1) ProtoMessage() {}
+
+func (x *CreateRecurringJourneyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringJourneyRequestV1.ProtoReflect.Descriptor instead.
+func (*CreateRecurringJourneyRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateRecurringJourneyRequestV1) GetRecurringJourney() *RecurringJourney {
+	if x != nil {
+		return x.RecurringJourney
+	}
+	return nil
+}
+
+type CreateRecurringJourneyResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecurringJourneyId uint64 `protobuf:"varint,1,opt,name=recurring_journey_id,json=recurringJourneyId,proto3" json:"recurring_journey_id,omitempty"`
+}
+
+func (x *CreateRecurringJourneyResponseV1) Reset() {
+	*x = CreateRecurringJourneyResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRecurringJourneyResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringJourneyResponseV1) ProtoMessage() {}
+
+func (x *CreateRecurringJourneyResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringJourneyResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateRecurringJourneyResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateRecurringJourneyResponseV1) GetRecurringJourneyId() uint64 {
+	if x != nil {
+		return x.RecurringJourneyId
+	}
+	return 0
+}
+
+type DescribeRecurringJourneyRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecurringJourneyId uint64 `protobuf:"varint,1,opt,name=recurring_journey_id,json=recurringJourneyId,proto3" json:"recurring_journey_id,omitempty"`
+}
+
+func (x *DescribeRecurringJourneyRequestV1) Reset() {
+	*x = DescribeRecurringJourneyRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeRecurringJourneyRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeRecurringJourneyRequestV1) ProtoMessage() {}
+
+func (x *DescribeRecurringJourneyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeRecurringJourneyRequestV1.ProtoReflect.Descriptor instead.
+func (*DescribeRecurringJourneyRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DescribeRecurringJourneyRequestV1) GetRecurringJourneyId() uint64 {
+	if x != nil {
+		return x.RecurringJourneyId
+	}
+	return 0
+}
+
+type DescribeRecurringJourneyResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecurringJourney *RecurringJourney `protobuf:"bytes,1,opt,name=recurring_journey,json=recurringJourney,proto3" json:"recurring_journey,omitempty"`
+}
+
+func (x *DescribeRecurringJourneyResponseV1) Reset() {
+	*x = DescribeRecurringJourneyResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeRecurringJourneyResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeRecurringJourneyResponseV1) ProtoMessage() {}
+
+func (x *DescribeRecurringJourneyResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeRecurringJourneyResponseV1.ProtoReflect.Descriptor instead.
+func (*DescribeRecurringJourneyResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DescribeRecurringJourneyResponseV1) GetRecurringJourney() *RecurringJourney {
+	if x != nil {
+		return x.RecurringJourney
+	}
+	return nil
+}
+
+type UpdateRecurringJourneyRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recurring_journey_id is required
+	RecurringJourney *RecurringJourney `protobuf:"bytes,1,opt,name=recurring_journey,json=recurringJourney,proto3" json:"recurring_journey,omitempty"`
+	// recurring journey is updated only if it has this revision, 0 means any revision
+	ExpectedRevision uint64 `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+}
+
+func (x *UpdateRecurringJourneyRequestV1) Reset() {
+	*x = UpdateRecurringJourneyRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRecurringJourneyRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringJourneyRequestV1) ProtoMessage() {}
+
+func (x *UpdateRecurringJourneyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecurringJourneyRequestV1.ProtoReflect.Descriptor instead.
+func (*UpdateRecurringJourneyRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateRecurringJourneyRequestV1) GetRecurringJourney() *RecurringJourney {
+	if x != nil {
+		return x.RecurringJourney
+	}
+	return nil
+}
+
+func (x *UpdateRecurringJourneyRequestV1) GetExpectedRevision() uint64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+type UpdateRecurringJourneyResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *UpdateRecurringJourneyResponseV1) Reset() {
+	*x = UpdateRecurringJourneyResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRecurringJourneyResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecurringJourneyResponseV1) ProtoMessage() {}
+
+func (x *UpdateRecurringJourneyResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecurringJourneyResponseV1.ProtoReflect.Descriptor instead.
+func (*UpdateRecurringJourneyResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateRecurringJourneyResponseV1) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RemoveRecurringJourneyRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecurringJourneyId uint64 `protobuf:"varint,1,opt,name=recurring_journey_id,json=recurringJourneyId,proto3" json:"recurring_journey_id,omitempty"`
+	// recurring journey is removed only if it has this revision, 0 means any revision
+	ExpectedRevision uint64 `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+}
+
+func (x *RemoveRecurringJourneyRequestV1) Reset() {
+	*x = RemoveRecurringJourneyRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRecurringJourneyRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRecurringJourneyRequestV1) ProtoMessage() {}
+
+func (x *RemoveRecurringJourneyRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRecurringJourneyRequestV1.ProtoReflect.Descriptor instead.
+func (*RemoveRecurringJourneyRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RemoveRecurringJourneyRequestV1) GetRecurringJourneyId() uint64 {
+	if x != nil {
+		return x.RecurringJourneyId
+	}
+	return 0
+}
+
+func (x *RemoveRecurringJourneyRequestV1) GetExpectedRevision() uint64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+type UpdateOccurrenceRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecurringJourneyId uint64               `protobuf:"varint,1,opt,name=recurring_journey_id,json=recurringJourneyId,proto3" json:"recurring_journey_id,omitempty"`
+	Exception          *OccurrenceException `protobuf:"bytes,2,opt,name=exception,proto3" json:"exception,omitempty"`
+	// occurrence is changed only if recurring journey has this revision, 0 means any revision
+	ExpectedRevision uint64 `protobuf:"varint,3,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
+}
+
+func (x *UpdateOccurrenceRequestV1) Reset() {
+	*x = UpdateOccurrenceRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOccurrenceRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOccurrenceRequestV1) ProtoMessage() {}
+
+func (x *UpdateOccurrenceRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOccurrenceRequestV1.ProtoReflect.Descriptor instead.
+func (*UpdateOccurrenceRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateOccurrenceRequestV1) GetRecurringJourneyId() uint64 {
+	if x != nil {
+		return x.RecurringJourneyId
+	}
+	return 0
+}
+
+func (x *UpdateOccurrenceRequestV1) GetException() *OccurrenceException {
+	if x != nil {
+		return x.Exception
+	}
+	return nil
+}
+
+func (x *UpdateOccurrenceRequestV1) GetExpectedRevision() uint64 {
+	if x != nil {
+		return x.ExpectedRevision
+	}
+	return 0
+}
+
+type UpdateOccurrenceResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// new revision of recurring journey
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *UpdateOccurrenceResponseV1) Reset() {
+	*x = UpdateOccurrenceResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOccurrenceResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOccurrenceResponseV1) ProtoMessage() {}
+
+func (x *UpdateOccurrenceResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOccurrenceResponseV1.ProtoReflect.Descriptor instead.
+func (*UpdateOccurrenceResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateOccurrenceResponseV1) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ListOccurrencesRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// occurrences of recurring journeys of users, acting user if empty
+	UserIds []uint64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// occurrences overlapping time range [from_time, to_time)
+	FromTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	// substring of address or description, case insensitive
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *ListOccurrencesRequestV1) Reset() {
+	*x = ListOccurrencesRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOccurrencesRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOccurrencesRequestV1) ProtoMessage() {}
+
+func (x *ListOccurrencesRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOccurrencesRequestV1.ProtoReflect.Descriptor instead.
+func (*ListOccurrencesRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListOccurrencesRequestV1) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *ListOccurrencesRequestV1) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *ListOccurrencesRequestV1) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+func (x *ListOccurrencesRequestV1) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ListOccurrencesResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// at most 1000 occurrences
+	Occurrences []*Journey `protobuf:"bytes,1,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+}
+
+func (x *ListOccurrencesResponseV1) Reset() {
+	*x = ListOccurrencesResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOccurrencesResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOccurrencesResponseV1) ProtoMessage() {}
+
+func (x *ListOccurrencesResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOccurrencesResponseV1.ProtoReflect.Descriptor instead.
+func (*ListOccurrencesResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListOccurrencesResponseV1) GetOccurrences() []*Journey {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+type MonthCountV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// month from 1 to 12
+	Month int32  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *MonthCountV1) Reset() {
+	*x = MonthCountV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonthCountV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthCountV1) ProtoMessage() {}
+
+func (x *MonthCountV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthCountV1.ProtoReflect.Descriptor instead.
+func (*MonthCountV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MonthCountV1) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *MonthCountV1) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *MonthCountV1) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type WatchJourneysRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// only changes of journeys of these users including journeys shared with them are sent, all changes if empty
+	UserIds []uint64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// resume_token of the last received response, changes after it are sent first
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchJourneysRequestV1) Reset() {
+	*x = WatchJourneysRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchJourneysRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJourneysRequestV1) ProtoMessage() {}
+
+func (x *WatchJourneysRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJourneysRequestV1.ProtoReflect.Descriptor instead.
+func (*WatchJourneysRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *WatchJourneysRequestV1) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *WatchJourneysRequestV1) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type JourneyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      JourneyEventType `protobuf:"varint,1,opt,name=type,proto3,enum=ova.journey.api.JourneyEventType" json:"type,omitempty"`
+	JourneyId uint64           `protobuf:"varint,2,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+	// journey after change, it is set only for CREATED and UPDATED events,
+	// other events are sent to all subscribers because owner of journey is unknown
+	Journey *Journey `protobuf:"bytes,3,opt,name=journey,proto3" json:"journey,omitempty"`
+}
+
+func (x *JourneyEvent) Reset() {
+	*x = JourneyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JourneyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JourneyEvent) ProtoMessage() {}
+
+func (x *JourneyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JourneyEvent.ProtoReflect.Descriptor instead.
+func (*JourneyEvent) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *JourneyEvent) GetType() JourneyEventType {
+	if x != nil {
+		return x.Type
+	}
+	return JourneyEventType_JOURNEY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *JourneyEvent) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+func (x *JourneyEvent) GetJourney() *Journey {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+type WatchJourneysResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// event is not set in the first response which is sent right after subscribing
+	Event       *JourneyEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ResumeToken string        `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchJourneysResponseV1) Reset() {
+	*x = WatchJourneysResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchJourneysResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJourneysResponseV1) ProtoMessage() {}
+
+func (x *WatchJourneysResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJourneysResponseV1.ProtoReflect.Descriptor instead.
+func (*WatchJourneysResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *WatchJourneysResponseV1) GetEvent() *JourneyEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchJourneysResponseV1) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type ImportJourneysResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imported uint64 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failed   uint64 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// results in order of received journeys
+	Results []*JourneyResultV1 `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ImportJourneysResponseV1) Reset() {
+	*x = ImportJourneysResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportJourneysResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJourneysResponseV1) ProtoMessage() {}
+
+func (x *ImportJourneysResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJourneysResponseV1.ProtoReflect.Descriptor instead.
+func (*ImportJourneysResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ImportJourneysResponseV1) GetImported() uint64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportJourneysResponseV1) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportJourneysResponseV1) GetResults() []*JourneyResultV1 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CreateJourneyTaskRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address     string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// IANA time zone of the journey (e.g. "Europe/Moscow"), empty means UTC
+	TimeZone string `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// key for safe retries of the request: request with the same key returns result of the first one,
+	// the same key with another request fails with ALREADY_EXISTS, keys are stored for configured time
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// optional geographic point of the address
+	Coordinates *Coordinates `protobuf:"bytes,8,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	// stops within journey in order of route
+	Waypoints []*Waypoint `protobuf:"bytes,9,rep,name=waypoints,proto3" json:"waypoints,omitempty"`
+	// labels of journey, letters, digits, '-' and '_' are allowed
+	Tags []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// handling of other journeys of the user overlapping this one, configured policy is used if not set,
+	// journey overlapping other journeys with OVERLAP_POLICY_REJECT fails the operation
+	OverlapPolicy OverlapPolicy `protobuf:"varint,11,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=ova.journey.api.OverlapPolicy" json:"overlap_policy,omitempty"`
+}
+
+func (x *CreateJourneyTaskRequestV1) Reset() {
+	*x = CreateJourneyTaskRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateJourneyTaskRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJourneyTaskRequestV1) ProtoMessage() {}
+
+func (x *CreateJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJourneyTaskRequestV1.ProtoReflect.Descriptor instead.
+func (*CreateJourneyTaskRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateJourneyTaskRequestV1) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateJourneyTaskRequestV1) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateJourneyTaskRequestV1) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateJourneyTaskRequestV1) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateJourneyTaskRequestV1) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateJourneyTaskRequestV1) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateJourneyTaskRequestV1) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateJourneyTaskRequestV1) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+func (x *CreateJourneyTaskRequestV1) GetWaypoints() []*Waypoint {
+	if x != nil {
+		return x.Waypoints
+	}
+	return nil
+}
+
+func (x *CreateJourneyTaskRequestV1) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateJourneyTaskRequestV1) GetOverlapPolicy() OverlapPolicy {
+	if x != nil {
+		return x.OverlapPolicy
+	}
+	return OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+}
+
+type RemoveJourneyTaskRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId uint64 `protobuf:"varint,1,opt,name=journey_id,json=journeyId,proto3" json:"journey_id,omitempty"`
+}
+
+func (x *RemoveJourneyTaskRequestV1) Reset() {
+	*x = RemoveJourneyTaskRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveJourneyTaskRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveJourneyTaskRequestV1) ProtoMessage() {}
+
+func (x *RemoveJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveJourneyTaskRequestV1.ProtoReflect.Descriptor instead.
+func (*RemoveJourneyTaskRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RemoveJourneyTaskRequestV1) GetJourneyId() uint64 {
+	if x != nil {
+		return x.JourneyId
+	}
+	return 0
+}
+
+type MultiCreateJourneyTaskRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journeys []*CreateJourneyRequestV1 `protobuf:"bytes,1,rep,name=journeys,proto3" json:"journeys,omitempty"`
+	// key for safe retries of the request: request with the same key returns result of the first one,
+	// the same key with another request fails with ALREADY_EXISTS, keys are stored for configured time
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *MultiCreateJourneyTaskRequestV1) Reset() {
+	*x = MultiCreateJourneyTaskRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiCreateJourneyTaskRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiCreateJourneyTaskRequestV1) ProtoMessage() {}
+
+func (x *MultiCreateJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiCreateJourneyTaskRequestV1.ProtoReflect.Descriptor instead.
+func (*MultiCreateJourneyTaskRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *MultiCreateJourneyTaskRequestV1) GetJourneys() []*CreateJourneyRequestV1 {
+	if x != nil {
+		return x.Journeys
+	}
+	return nil
+}
+
+func (x *MultiCreateJourneyTaskRequestV1) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type UpdateJourneyTaskRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journey *Journey `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+	// handling of other journeys of the user overlapping updated journey, configured policy is used if not set,
+	// journey overlapping other journeys with OVERLAP_POLICY_REJECT fails the operation
+	OverlapPolicy OverlapPolicy `protobuf:"varint,2,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=ova.journey.api.OverlapPolicy" json:"overlap_policy,omitempty"`
+}
+
+func (x *UpdateJourneyTaskRequestV1) Reset() {
+	*x = UpdateJourneyTaskRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJourneyTaskRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJourneyTaskRequestV1) ProtoMessage() {}
+
+func (x *UpdateJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJourneyTaskRequestV1.ProtoReflect.Descriptor instead.
+func (*UpdateJourneyTaskRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UpdateJourneyTaskRequestV1) GetJourney() *Journey {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+func (x *UpdateJourneyTaskRequestV1) GetOverlapPolicy() OverlapPolicy {
+	if x != nil {
+		return x.OverlapPolicy
+	}
+	return OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+}
+
+type MultiUpdateJourneyTaskRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journeys []*UpdateJourneyRequestV1 `protobuf:"bytes,1,rep,name=journeys,proto3" json:"journeys,omitempty"`
+}
+
+func (x *MultiUpdateJourneyTaskRequestV1) Reset() {
+	*x = MultiUpdateJourneyTaskRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiUpdateJourneyTaskRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiUpdateJourneyTaskRequestV1) ProtoMessage() {}
+
+func (x *MultiUpdateJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiUpdateJourneyTaskRequestV1.ProtoReflect.Descriptor instead.
+func (*MultiUpdateJourneyTaskRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *MultiUpdateJourneyTaskRequestV1) GetJourneys() []*UpdateJourneyRequestV1 {
+	if x != nil {
+		return x.Journeys
+	}
+	return nil
+}
+
+type MultiUpdateJourneyTaskResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *MultiUpdateJourneyTaskResponseV1) Reset() {
+	*x = MultiUpdateJourneyTaskResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiUpdateJourneyTaskResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiUpdateJourneyTaskResponseV1) ProtoMessage() {}
+
+func (x *MultiUpdateJourneyTaskResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiUpdateJourneyTaskResponseV1.ProtoReflect.Descriptor instead.
+func (*MultiUpdateJourneyTaskResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *MultiUpdateJourneyTaskResponseV1) GetOperationId() uint64 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+type MultiRemoveJourneyTaskRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyIds []uint64 `protobuf:"varint,1,rep,packed,name=journey_ids,json=journeyIds,proto3" json:"journey_ids,omitempty"`
+}
+
+func (x *MultiRemoveJourneyTaskRequestV1) Reset() {
+	*x = MultiRemoveJourneyTaskRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiRemoveJourneyTaskRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiRemoveJourneyTaskRequestV1) ProtoMessage() {}
+
+func (x *MultiRemoveJourneyTaskRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiRemoveJourneyTaskRequestV1.ProtoReflect.Descriptor instead.
+func (*MultiRemoveJourneyTaskRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *MultiRemoveJourneyTaskRequestV1) GetJourneyIds() []uint64 {
+	if x != nil {
+		return x.JourneyIds
+	}
+	return nil
+}
+
+type MultiRemoveJourneyTaskResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *MultiRemoveJourneyTaskResponseV1) Reset() {
+	*x = MultiRemoveJourneyTaskResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiRemoveJourneyTaskResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiRemoveJourneyTaskResponseV1) ProtoMessage() {}
+
+func (x *MultiRemoveJourneyTaskResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiRemoveJourneyTaskResponseV1.ProtoReflect.Descriptor instead.
+func (*MultiRemoveJourneyTaskResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *MultiRemoveJourneyTaskResponseV1) GetOperationId() uint64 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+type CreateJourneyTaskResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *CreateJourneyTaskResponseV1) Reset() {
+	*x = CreateJourneyTaskResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateJourneyTaskResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJourneyTaskResponseV1) ProtoMessage() {}
+
+func (x *CreateJourneyTaskResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJourneyTaskResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateJourneyTaskResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CreateJourneyTaskResponseV1) GetOperationId() uint64 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+type RemoveJourneyTaskResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *RemoveJourneyTaskResponseV1) Reset() {
+	*x = RemoveJourneyTaskResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveJourneyTaskResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveJourneyTaskResponseV1) ProtoMessage() {}
+
+func (x *RemoveJourneyTaskResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveJourneyTaskResponseV1.ProtoReflect.Descriptor instead.
+func (*RemoveJourneyTaskResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RemoveJourneyTaskResponseV1) GetOperationId() uint64 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+type MultiCreateJourneyTaskResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *MultiCreateJourneyTaskResponseV1) Reset() {
+	*x = MultiCreateJourneyTaskResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiCreateJourneyTaskResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiCreateJourneyTaskResponseV1) ProtoMessage() {}
+
+func (x *MultiCreateJourneyTaskResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiCreateJourneyTaskResponseV1.ProtoReflect.Descriptor instead.
+func (*MultiCreateJourneyTaskResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *MultiCreateJourneyTaskResponseV1) GetOperationId() uint64 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+type UpdateJourneyTaskResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *UpdateJourneyTaskResponseV1) Reset() {
+	*x = UpdateJourneyTaskResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJourneyTaskResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJourneyTaskResponseV1) ProtoMessage() {}
+
+func (x *UpdateJourneyTaskResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJourneyTaskResponseV1.ProtoReflect.Descriptor instead.
+func (*UpdateJourneyTaskResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UpdateJourneyTaskResponseV1) GetOperationId() uint64 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+type GetJourneyTaskStatusRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *GetJourneyTaskStatusRequestV1) Reset() {
+	*x = GetJourneyTaskStatusRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJourneyTaskStatusRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJourneyTaskStatusRequestV1) ProtoMessage() {}
+
+func (x *GetJourneyTaskStatusRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJourneyTaskStatusRequestV1.ProtoReflect.Descriptor instead.
+func (*GetJourneyTaskStatusRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetJourneyTaskStatusRequestV1) GetOperationId() uint64 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+type GetJourneyTaskStatusResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task *JourneyTask `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *GetJourneyTaskStatusResponseV1) Reset() {
+	*x = GetJourneyTaskStatusResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJourneyTaskStatusResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJourneyTaskStatusResponseV1) ProtoMessage() {}
+
+func (x *GetJourneyTaskStatusResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJourneyTaskStatusResponseV1.ProtoReflect.Descriptor instead.
+func (*GetJourneyTaskStatusResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetJourneyTaskStatusResponseV1) GetTask() *JourneyTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type ListJourneyTasksRequestV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListJourneyTasksRequestV1) Reset() {
+	*x = ListJourneyTasksRequestV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJourneyTasksRequestV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJourneyTasksRequestV1) ProtoMessage() {}
+
+func (x *ListJourneyTasksRequestV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJourneyTasksRequestV1.ProtoReflect.Descriptor instead.
+func (*ListJourneyTasksRequestV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListJourneyTasksRequestV1) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListJourneyTasksRequestV1) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListJourneyTasksResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tasks []*JourneyTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+}
+
+func (x *ListJourneyTasksResponseV1) Reset() {
+	*x = ListJourneyTasksResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ova_journey_api_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJourneyTasksResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJourneyTasksResponseV1) ProtoMessage() {}
+
+func (x *ListJourneyTasksResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_ova_journey_api_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJourneyTasksResponseV1.ProtoReflect.Descriptor instead.
+func (*ListJourneyTasksResponseV1) Descriptor() ([]byte, []int) {
+	return file_ova_journey_api_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListJourneyTasksResponseV1) GetTasks() []*JourneyTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+var File_ova_journey_api_proto protoreflect.FileDescriptor
+
+var file_ova_journey_api_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6f, 0x76, 0x61, 0x2d, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2d, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xff, 0x04, 0x0a, 0x07, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0a, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x09, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92,
+	0x01, 0x0a, 0x10, 0x14, 0x22, 0x06, 0x72, 0x04, 0x18, 0x20, 0x10, 0x01, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0f,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0e, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x87, 0x02, 0x0a, 0x08, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x79, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42,
+	0x14, 0x12, 0x12, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0xc0, 0x19, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x80, 0x56, 0x40, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x35, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80,
+	0x66, 0xc0, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x52, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xb2, 0x04, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x31, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x31,
+	0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
+	0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x76, 0x61, 0x2e,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x41, 0x0a, 0x09, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x09, 0x77, 0x61, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92, 0x01, 0x0a, 0x10, 0x14, 0x22, 0x06, 0x72,
+	0x04, 0x18, 0x20, 0x10, 0x01, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x70, 0x0a, 0x17, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x15, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x73, 0x22, 0x42, 0x0a,
+	0x18, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49,
+	0x64, 0x22, 0x4f, 0x0a, 0x19, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x32,
+	0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x22, 0xc8, 0x03, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x1f, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x42, 0x0c, 0xfa,
+	0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a,
+	0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x23, 0x0a, 0x08, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x14, 0x52, 0x07, 0x61, 0x6e,
+	0x79, 0x54, 0x61, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10,
+	0x14, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x12, 0x51, 0x0a, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f,
+	0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x15, 0xfa, 0x42,
+	0x12, 0x92, 0x01, 0x0f, 0x10, 0x04, 0x22, 0x0b, 0x82, 0x01, 0x08, 0x18, 0x01, 0x18, 0x02, 0x18,
+	0x03, 0x18, 0x04, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xb2, 0x01,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x34, 0x0a, 0x08, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76,
+	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x22, 0x6d, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x1b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x31, 0x12, 0x4d, 0x0a, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x12, 0x31, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x7b, 0x0a, 0x1c, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x56, 0x31, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x31, 0x12, 0x3c, 0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4f,
+	0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0x6d, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x15, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x73, 0x22, 0x9a,
+	0x02, 0x0a, 0x15, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x3c, 0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x2b, 0x0a,
+	0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0e, 0x6f, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x6f, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x6c, 0x0a, 0x16, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x15, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20,
+	0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x18,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x57, 0x61, 0x79, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a,
+	0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x77, 0x61,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x19, 0x52, 0x65, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02,
+	0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x35, 0x0a,
+	0x0c, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x04, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x22, 0x04, 0x32, 0x02, 0x20,
+	0x00, 0x08, 0x01, 0x10, 0x64, 0x18, 0x01, 0x52, 0x0b, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x49, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x1a, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x57,
+	0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6b,
+	0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x0b, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
+	0x0a, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x18, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x76, 0x61,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa8, 0x01,
+	0x0a, 0x1a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x18, 0x01,
+	0x18, 0x02, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x66, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02,
+	0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x43,
+	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x12, 0x40, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x22, 0x7f, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x5e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x31, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x5c, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x3b, 0x0a, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x0b, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73,
+	0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6f, 0x76,
+	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x12, 0x27, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x04, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00,
+	0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x4e, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x12, 0x32, 0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x22, 0x66, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x12, 0x4b, 0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x22, 0xc4, 0x01,
+	0x0a, 0x0f, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x56,
+	0x31, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x15, 0x6f,
+	0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x49, 0x64, 0x73, 0x22, 0x6c, 0x0a, 0x1b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x31, 0x12, 0x4d, 0x0a, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x73, 0x22, 0x5a, 0x0a, 0x1c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x56, 0x31, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x50,
+	0x0a, 0x1b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x31, 0x0a,
+	0x0b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x04, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92, 0x01, 0x0a, 0x08, 0x01, 0x18, 0x01, 0x22, 0x04,
+	0x32, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x73,
+	0x22, 0x5a, 0x0a, 0x1c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x56, 0x31, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x19,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x31, 0x0a, 0x0b, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x10,
+	0xfa, 0x42, 0x0d, 0x92, 0x01, 0x0a, 0x08, 0x01, 0x18, 0x01, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00,
+	0x52, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x73, 0x22, 0x82, 0x01, 0x0a,
+	0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x34, 0x0a, 0x08, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x11,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64,
+	0x73, 0x22, 0x92, 0x02, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x20, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42,
+	0x07, 0x72, 0x05, 0x18, 0x80, 0x02, 0x10, 0x01, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1f, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x27, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x04, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06,
+	0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x56, 0x31, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x72, 0x0a,
+	0x0e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12,
+	0x32, 0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x22, 0xcd, 0x02, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x73, 0x4e, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12,
+	0x3c, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3c, 0x0a,
+	0x0d, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x50,
+	0xf8, 0x1b, 0x73, 0x41, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0c, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32,
+	0x02, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x42, 0x0c, 0xfa, 0x42,
+	0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07,
+	0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x58, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x73, 0x4e, 0x65, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12,
+	0x3a, 0x0a, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56,
+	0x31, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x22, 0x6c, 0x0a, 0x0d, 0x4e,
+	0x65, 0x61, 0x72, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12, 0x32, 0x0a, 0x07,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x20, 0x46, 0x69,
+	0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x20,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x6f, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x62, 0x0a,
+	0x21, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x3d, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x4f, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x70, 0x56, 0x31, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x73, 0x22, 0xe6, 0x01, 0x0a, 0x10, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x4f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x70, 0x56, 0x31, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52,
+	0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x6f, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x37,
+	0x0a, 0x13, 0x74, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x32, 0x02, 0x18, 0x64, 0x52, 0x11, 0x74, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd2, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a,
+	0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x76, 0x61,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x31, 0x52, 0x0c, 0x74, 0x6f, 0x70,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x76, 0x61, 0x2e,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x31, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x22, 0x40, 0x0a, 0x0e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x31, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x31, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x2f, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x76, 0x61, 0x2e,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x56, 0x31, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x36, 0x0a, 0x0a,
+	0x54, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x22, 0x45, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x73, 0x0a, 0x1c, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x32, 0x02, 0x20, 0x00, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x1d,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x76, 0x61, 0x2e,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xf3, 0x03, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02,
+	0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x76, 0x61,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x18, 0x80, 0x04, 0x10, 0x01, 0x52,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb0, 0x02, 0x0a, 0x13, 0x4f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4d, 0x0a, 0x0f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0e,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x7b, 0x0a, 0x1f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x58, 0x0a,
+	0x11, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x10, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x22, 0x54, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x30, 0x0a, 0x14, 0x72,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x22, 0x5e, 0x0a,
+	0x21, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x31, 0x12, 0x39, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x22, 0x74, 0x0a,
+	0x22, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x31, 0x12, 0x4e, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x52, 0x10, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x22, 0xa8, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x58, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x10, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3e,
+	0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x89,
+	0x01, 0x0a, 0x1f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x31, 0x12, 0x39, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x2b, 0x0a,
+	0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd1, 0x01, 0x0a, 0x19, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x39, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
+	0x12, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x09, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x38,
+	0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe3, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x27, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22,
+	0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x41,
+	0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x3d, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x57,
+	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x3a, 0x0a, 0x0b, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x0c, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x66, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x31, 0x12, 0x29, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x04, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x22, 0x04, 0x32, 0x02, 0x20,
+	0x00, 0x18, 0x01, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x98, 0x01, 0x0a, 0x0c, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x22, 0x71, 0x0a, 0x17, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8a, 0x01,
+	0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x56,
+	0x31, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xb6, 0x04, 0x0a, 0x1a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32,
+	0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x09, 0x77, 0x61, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f,
+	0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57,
+	0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10,
+	0x64, 0x52, 0x09, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92,
+	0x01, 0x0a, 0x10, 0x14, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x20, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x76, 0x61,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0x44, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x31, 0x12, 0x26, 0x0a, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x09,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x1f, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x4d, 0x0a,
+	0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
+	0x08, 0x01, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x12, 0x31, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22,
+	0xab, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x3c,
+	0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x0e,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0d,
+	0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x70, 0x0a,
+	0x1f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x12, 0x4d, 0x0a, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x22,
+	0x45, 0x0a, 0x20, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x31, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x1f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x31, 0x0a, 0x0b, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x10,
+	0xfa, 0x42, 0x0d, 0x92, 0x01, 0x0a, 0x08, 0x01, 0x18, 0x01, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00,
+	0x52, 0x0a, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x73, 0x22, 0x45, 0x0a, 0x20,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x31, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x20, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x40,
+	0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x4b, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61,
+	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x31, 0x12, 0x2a, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00,
+	0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x52, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12,
+	0x30, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73,
+	0x6b, 0x22, 0x5b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x12, 0x1f,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x32, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x50,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x32, 0x0a, 0x05,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x76,
+	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x2a, 0xa7, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e,
+	0x0a, 0x1a, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
+	0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x7d, 0x0a, 0x0d, 0x4f, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x4f,
+	0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f,
+	0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x41, 0x4c,
+	0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x02, 0x12, 0x19,
+	0x0a, 0x15, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x03, 0x2a, 0x89, 0x01, 0x0a, 0x0f, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a,
+	0x1c, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e, 0x54, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e, 0x54, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
+	0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x45, 0x44, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x52,
+	0x54, 0x49, 0x43, 0x49, 0x50, 0x41, 0x4e, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x57,
+	0x4e, 0x45, 0x52, 0x10, 0x03, 0x2a, 0xfa, 0x01, 0x0a, 0x0f, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4a, 0x4f, 0x55,
+	0x52, 0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4a, 0x4f,
+	0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
+	0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x4a, 0x4f,
+	0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x22,
+	0x0a, 0x1e, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45,
+	0x10, 0x06, 0x2a, 0x9c, 0x01, 0x0a, 0x11, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61,
+	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x4a, 0x4f, 0x55, 0x52,
+	0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a,
+	0x1b, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x21,
+	0x0a, 0x1d, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x54, 0x41, 0x53,
+	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x03, 0x2a, 0xb7, 0x01, 0x0a, 0x10, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45,
+	0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4a, 0x4f,
+	0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x4a, 0x4f,
+	0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4a, 0x4f,
+	0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x4a, 0x4f,
+	0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x04, 0x32, 0xae, 0x34, 0x0a, 0x0c,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x41, 0x70, 0x69, 0x56, 0x31, 0x12, 0x7d, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12,
+	0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x28, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x8d, 0x01, 0x0a, 0x11,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56,
+	0x31, 0x12, 0x29, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2a, 0x2e, 0x6f,
+	0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x77, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x56, 0x31, 0x12, 0x26, 0x2e,
+	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x14,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x73, 0x12, 0x75, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12, 0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x2a, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x14,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x56, 0x31, 0x12, 0x2c, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x31, 0x1a, 0x2d, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x3a, 0x01, 0x2a,
+	0x12, 0x7d, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x56, 0x31, 0x12, 0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x28, 0x2e, 0x6f,
+	0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x1a, 0x0c,
+	0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0x95, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x56, 0x31, 0x12, 0x26, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x27, 0x2e, 0x6f, 0x76, 0x61,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x31, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x32, 0x21, 0x2f, 0x76, 0x31,
+	0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x07,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x92, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12, 0x28, 0x2e, 0x6f,
+	0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x29, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x8e, 0x01, 0x0a,
+	0x0d, 0x41, 0x64, 0x64, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x56, 0x31, 0x12, 0x25,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x64, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x26, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x57, 0x61, 0x79, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x2e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0xa5, 0x01,
+	0x0a, 0x12, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x56, 0x31, 0x12, 0x2a, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x57, 0x61,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x1a, 0x2b, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x36, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x3a, 0x72, 0x65, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0xa2, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x56, 0x31, 0x12, 0x28, 0x2e, 0x6f, 0x76, 0x61,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x31, 0x1a, 0x29, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22,
+	0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x2a, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x77, 0x61,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x13, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x56, 0x31, 0x12, 0x2b, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a,
+	0x2c, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x31, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0xaa, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x56, 0x31, 0x12, 0x2b, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2c, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x31, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x2a, 0x30, 0x2f, 0x76, 0x31,
+	0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x9d, 0x01,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x56, 0x31, 0x12, 0x2a, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x1a, 0x2b, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x2e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x9a, 0x01,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x73, 0x56, 0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2e, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x86, 0x01, 0x0a, 0x10, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x56, 0x31, 0x12,
+	0x28, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x29, 0x2e, 0x6f, 0x76, 0x61, 0x2e,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x31, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76,
+	0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x3a, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x30, 0x01, 0x12, 0x89, 0x01, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x56, 0x31, 0x12, 0x28, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x31, 0x1a, 0x29, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x3a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x28, 0x01, 0x12,
+	0x92, 0x01, 0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12, 0x2c, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2d, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x1a, 0x12, 0x2f,
+	0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x3a, 0x01, 0x2a, 0x12, 0x99, 0x01, 0x0a, 0x14, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12, 0x2c, 0x2e,
+	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2d, 0x2e, 0x6f, 0x76,
+	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73,
+	0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x3a, 0x01, 0x2a,
+	0x12, 0x89, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x73, 0x56, 0x31, 0x12, 0x2a, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x56, 0x31, 0x1a, 0x2b, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x84, 0x01, 0x0a,
+	0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x56,
+	0x31, 0x12, 0x28, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x29, 0x2e, 0x6f, 0x76,
+	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
+	0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x3a, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x88, 0x01, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x73, 0x4e, 0x65, 0x61, 0x72, 0x56, 0x31, 0x12, 0x2a, 0x2e, 0x6f, 0x76, 0x61,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x4e, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2b, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x73, 0x4e, 0x65, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x31, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31,
+	0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x3a, 0x6e, 0x65, 0x61, 0x72, 0x12, 0xa4,
+	0x01, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x56, 0x31, 0x12, 0x31, 0x2e, 0x6f,
+	0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a,
+	0x32, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x31, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31,
+	0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x3a, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0xb2, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x31, 0x12, 0x29, 0x2e, 0x6f, 0x76,
+	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2a, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5a, 0x1a,
+	0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x77, 0x0a, 0x0a, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x56, 0x31, 0x12, 0x22, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x23, 0x2e, 0x6f,
+	0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x56, 0x31, 0x12, 0x29, 0x2e, 0x6f, 0x76, 0x61, 0x2e,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x31, 0x1a, 0x2a, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2d, 0x66, 0x65, 0x65, 0x64, 0x12, 0x9b, 0x01, 0x0a, 0x0e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12, 0x2d,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2e, 0x2e,
+	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d,
+	0x3a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xa1, 0x01, 0x0a, 0x11, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12,
+	0x2d, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2e,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x2d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x7d, 0x3a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x9d, 0x01,
+	0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56,
+	0x31, 0x12, 0x2d, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x1a, 0x2e, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f,
+	0x69, 0x64, 0x7d, 0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x3a, 0x01, 0x2a, 0x12, 0xa2, 0x01,
+	0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12, 0x30, 0x2e, 0x6f, 0x76, 0x61,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x31, 0x2e, 0x6f,
+	0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22,
+	0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x73, 0x12, 0xbc, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56,
+	0x31, 0x12, 0x32, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x33, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x2d, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0xa2, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x56, 0x31, 0x12, 0x30,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x1a, 0x31, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x31, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x1a, 0x16, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x9b, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x56, 0x31, 0x12, 0x30, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x35, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x2a, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x2d, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x72,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb3, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x56, 0x31, 0x12, 0x2a, 0x2e, 0x6f, 0x76,
+	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2b, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x31, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x22, 0x39, 0x2f, 0x76,
+	0x31, 0x2f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x2d, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x83, 0x01, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x56, 0x31,
+	0x12, 0x29, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2a, 0x2e, 0x6f, 0x76,
+	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12,
+	0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x82, 0x01, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x73, 0x56, 0x31, 0x12, 0x27, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x28, 0x2e,
+	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
+	0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x3a, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0x8e, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x56, 0x31, 0x12, 0x2b, 0x2e,
+	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2c, 0x2e, 0x6f, 0x76, 0x61,
+	0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x98, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x56, 0x31, 0x12, 0x2b,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2c, 0x2e, 0x6f, 0x76,
+	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x2a, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f,
+	0x74, 0x61, 0x73, 0x6b, 0x2f, 0x7b, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x56, 0x31, 0x12, 0x30,
+	0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31,
+	0x1a, 0x31, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x31, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31,
+	0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x3a, 0x01, 0x2a, 0x12, 0x8e, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x56, 0x31, 0x12,
+	0x2b, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2c, 0x2e, 0x6f,
+	0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x12, 0xa3, 0x01, 0x0a, 0x18, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54,
+	0x61, 0x73, 0x6b, 0x56, 0x31, 0x12, 0x30, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x31, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x1a, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x12, 0xaa,
+	0x01, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x56, 0x31, 0x12, 0x30, 0x2e, 0x6f, 0x76,
+	0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x31, 0x2e,
+	0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xa3, 0x01, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x56, 0x31, 0x12, 0x2e, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x31, 0x1a, 0x2f, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
+	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x74, 0x61,
+	0x73, 0x6b, 0x2f, 0x7b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x88, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x56, 0x31, 0x12, 0x2a, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x56, 0x31, 0x1a, 0x2b, 0x2e, 0x6f, 0x76, 0x61, 0x2e, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x42, 0x47, 0x5a, 0x45,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e, 0x76,
+	0x61, 0x2f, 0x6f, 0x76, 0x61, 0x2d, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x2d, 0x61, 0x70,
+	0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x76, 0x61, 0x2d, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x2d, 0x61, 0x70, 0x69, 0x3b, 0x6f, 0x76, 0x61, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ova_journey_api_proto_rawDescOnce sync.Once
+	file_ova_journey_api_proto_rawDescData = file_ova_journey_api_proto_rawDesc
+)
+
+func file_ova_journey_api_proto_rawDescGZIP() []byte {
+	file_ova_journey_api_proto_rawDescOnce.Do(func() {
+		file_ova_journey_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_ova_journey_api_proto_rawDescData)
+	})
+	return file_ova_journey_api_proto_rawDescData
+}
+
+var file_ova_journey_api_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_ova_journey_api_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
+var file_ova_journey_api_proto_goTypes = []interface{}{
+	(JourneyStatus)(0),                         // 0: ova.journey.api.JourneyStatus
+	(OverlapPolicy)(0),                         // 1: ova.journey.api.OverlapPolicy
+	(ParticipantRole)(0),                       // 2: ova.journey.api.ParticipantRole
+	(JourneyTaskType)(0),                       // 3: ova.journey.api.JourneyTaskType
+	(JourneyTaskStatus)(0),                     // 4: ova.journey.api.JourneyTaskStatus
+	(JourneyEventType)(0),                      // 5: ova.journey.api.JourneyEventType
+	(*Journey)(nil),                            // 6: ova.journey.api.Journey
+	(*Waypoint)(nil),                           // 7: ova.journey.api.Waypoint
+	(*Coordinates)(nil),                        // 8: ova.journey.api.Coordinates
+	(*CreateJourneyRequestV1)(nil),             // 9: ova.journey.api.CreateJourneyRequestV1
+	(*CreateJourneyResponseV1)(nil),            // 10: ova.journey.api.CreateJourneyResponseV1
+	(*DescribeJourneyRequestV1)(nil),           // 11: ova.journey.api.DescribeJourneyRequestV1
+	(*DescribeJourneyResponseV1)(nil),          // 12: ova.journey.api.DescribeJourneyResponseV1
+	(*ListJourneysRequestV1)(nil),              // 13: ova.journey.api.ListJourneysRequestV1
+	(*ListJourneysResponseV1)(nil),             // 14: ova.journey.api.ListJourneysResponseV1
+	(*RemoveJourneyRequestV1)(nil),             // 15: ova.journey.api.RemoveJourneyRequestV1
+	(*MultiCreateJourneyRequestV1)(nil),        // 16: ova.journey.api.MultiCreateJourneyRequestV1
+	(*MultiCreateJourneyResponseV1)(nil),       // 17: ova.journey.api.MultiCreateJourneyResponseV1
+	(*UpdateJourneyRequestV1)(nil),             // 18: ova.journey.api.UpdateJourneyRequestV1
+	(*UpdateJourneyResponseV1)(nil),            // 19: ova.journey.api.UpdateJourneyResponseV1
+	(*PatchJourneyRequestV1)(nil),              // 20: ova.journey.api.PatchJourneyRequestV1
+	(*PatchJourneyResponseV1)(nil),             // 21: ova.journey.api.PatchJourneyResponseV1
+	(*RestoreJourneyRequestV1)(nil),            // 22: ova.journey.api.RestoreJourneyRequestV1
+	(*RestoreJourneyResponseV1)(nil),           // 23: ova.journey.api.RestoreJourneyResponseV1
+	(*AddWaypointRequestV1)(nil),               // 24: ova.journey.api.AddWaypointRequestV1
+	(*AddWaypointResponseV1)(nil),              // 25: ova.journey.api.AddWaypointResponseV1
+	(*ReorderWaypointsRequestV1)(nil),          // 26: ova.journey.api.ReorderWaypointsRequestV1
+	(*ReorderWaypointsResponseV1)(nil),         // 27: ova.journey.api.ReorderWaypointsResponseV1
+	(*RemoveWaypointRequestV1)(nil),            // 28: ova.journey.api.RemoveWaypointRequestV1
+	(*RemoveWaypointResponseV1)(nil),           // 29: ova.journey.api.RemoveWaypointResponseV1
+	(*Participant)(nil),                        // 30: ova.journey.api.Participant
+	(*InviteParticipantRequestV1)(nil),         // 31: ova.journey.api.InviteParticipantRequestV1
+	(*InviteParticipantResponseV1)(nil),        // 32: ova.journey.api.InviteParticipantResponseV1
+	(*RemoveParticipantRequestV1)(nil),         // 33: ova.journey.api.RemoveParticipantRequestV1
+	(*RemoveParticipantResponseV1)(nil),        // 34: ova.journey.api.RemoveParticipantResponseV1
+	(*ListParticipantsRequestV1)(nil),          // 35: ova.journey.api.ListParticipantsRequestV1
+	(*ListParticipantsResponseV1)(nil),         // 36: ova.journey.api.ListParticipantsResponseV1
+	(*DeletedJourney)(nil),                     // 37: ova.journey.api.DeletedJourney
+	(*ListDeletedJourneysRequestV1)(nil),       // 38: ova.journey.api.ListDeletedJourneysRequestV1
+	(*ListDeletedJourneysResponseV1)(nil),      // 39: ova.journey.api.ListDeletedJourneysResponseV1
+	(*JourneyTask)(nil),                        // 40: ova.journey.api.JourneyTask
+	(*ExportJourneysRequestV1)(nil),            // 41: ova.journey.api.ExportJourneysRequestV1
+	(*ExportJourneysResponseV1)(nil),           // 42: ova.journey.api.ExportJourneysResponseV1
+	(*ImportJourneysRequestV1)(nil),            // 43: ova.journey.api.ImportJourneysRequestV1
+	(*JourneyResultV1)(nil),                    // 44: ova.journey.api.JourneyResultV1
+	(*MultiUpdateJourneyRequestV1)(nil),        // 45: ova.journey.api.MultiUpdateJourneyRequestV1
+	(*MultiUpdateJourneyResponseV1)(nil),       // 46: ova.journey.api.MultiUpdateJourneyResponseV1
+	(*MultiRemoveJourneyRequestV1)(nil),        // 47: ova.journey.api.MultiRemoveJourneyRequestV1
+	(*MultiRemoveJourneyResponseV1)(nil),       // 48: ova.journey.api.MultiRemoveJourneyResponseV1
+	(*BatchGetJourneysRequestV1)(nil),          // 49: ova.journey.api.BatchGetJourneysRequestV1
+	(*BatchGetJourneysResponseV1)(nil),         // 50: ova.journey.api.BatchGetJourneysResponseV1
+	(*SearchJourneysRequestV1)(nil),            // 51: ova.journey.api.SearchJourneysRequestV1
+	(*SearchJourneysResponseV1)(nil),           // 52: ova.journey.api.SearchJourneysResponseV1
+	(*FoundJourneyV1)(nil),                     // 53: ova.journey.api.FoundJourneyV1
+	(*FindJourneysNearRequestV1)(nil),          // 54: ova.journey.api.FindJourneysNearRequestV1
+	(*FindJourneysNearResponseV1)(nil),         // 55: ova.journey.api.FindJourneysNearResponseV1
+	(*NearJourneyV1)(nil),                      // 56: ova.journey.api.NearJourneyV1
+	(*FindOverlappingJourneysRequestV1)(nil),   // 57: ova.journey.api.FindOverlappingJourneysRequestV1
+	(*FindOverlappingJourneysResponseV1)(nil),  // 58: ova.journey.api.FindOverlappingJourneysResponseV1
+	(*JourneyOverlapV1)(nil),                   // 59: ova.journey.api.JourneyOverlapV1
+	(*GetJourneyStatsRequestV1)(nil),           // 60: ova.journey.api.GetJourneyStatsRequestV1
+	(*GetJourneyStatsResponseV1)(nil),          // 61: ova.journey.api.GetJourneyStatsResponseV1
+	(*AddressCountV1)(nil),                     // 62: ova.journey.api.AddressCountV1
+	(*ListTagsRequestV1)(nil),                  // 63: ova.journey.api.ListTagsRequestV1
+	(*ListTagsResponseV1)(nil),                 // 64: ova.journey.api.ListTagsResponseV1
+	(*TagCountV1)(nil),                         // 65: ova.journey.api.TagCountV1
+	(*GetCalendarFeedRequestV1)(nil),           // 66: ova.journey.api.GetCalendarFeedRequestV1
+	(*GetCalendarFeedResponseV1)(nil),          // 67: ova.journey.api.GetCalendarFeedResponseV1
+	(*ChangeJourneyStatusRequestV1)(nil),       // 68: ova.journey.api.ChangeJourneyStatusRequestV1
+	(*ChangeJourneyStatusResponseV1)(nil),      // 69: ova.journey.api.ChangeJourneyStatusResponseV1
+	(*RecurringJourney)(nil),                   // 70: ova.journey.api.RecurringJourney
+	(*OccurrenceException)(nil),                // 71: ova.journey.api.OccurrenceException
+	(*CreateRecurringJourneyRequestV1)(nil),    // 72: ova.journey.api.CreateRecurringJourneyRequestV1
+	(*CreateRecurringJourneyResponseV1)(nil),   // 73: ova.journey.api.CreateRecurringJourneyResponseV1
+	(*DescribeRecurringJourneyRequestV1)(nil),  // 74: ova.journey.api.DescribeRecurringJourneyRequestV1
+	(*DescribeRecurringJourneyResponseV1)(nil), // 75: ova.journey.api.DescribeRecurringJourneyResponseV1
+	(*UpdateRecurringJourneyRequestV1)(nil),    // 76: ova.journey.api.UpdateRecurringJourneyRequestV1
+	(*UpdateRecurringJourneyResponseV1)(nil),   // 77: ova.journey.api.UpdateRecurringJourneyResponseV1
+	(*RemoveRecurringJourneyRequestV1)(nil),    // 78: ova.journey.api.RemoveRecurringJourneyRequestV1
+	(*UpdateOccurrenceRequestV1)(nil),          // 79: ova.journey.api.UpdateOccurrenceRequestV1
+	(*UpdateOccurrenceResponseV1)(nil),         // 80: ova.journey.api.UpdateOccurrenceResponseV1
+	(*ListOccurrencesRequestV1)(nil),           // 81: ova.journey.api.ListOccurrencesRequestV1
+	(*ListOccurrencesResponseV1)(nil),          // 82: ova.journey.api.ListOccurrencesResponseV1
+	(*MonthCountV1)(nil),                       // 83: ova.journey.api.MonthCountV1
+	(*WatchJourneysRequestV1)(nil),             // 84: ova.journey.api.WatchJourneysRequestV1
+	(*JourneyEvent)(nil),                       // 85: ova.journey.api.JourneyEvent
+	(*WatchJourneysResponseV1)(nil),            // 86: ova.journey.api.WatchJourneysResponseV1
+	(*ImportJourneysResponseV1)(nil),           // 87: ova.journey.api.ImportJourneysResponseV1
+	(*CreateJourneyTaskRequestV1)(nil),         // 88: ova.journey.api.CreateJourneyTaskRequestV1
+	(*RemoveJourneyTaskRequestV1)(nil),         // 89: ova.journey.api.RemoveJourneyTaskRequestV1
+	(*MultiCreateJourneyTaskRequestV1)(nil),    // 90: ova.journey.api.MultiCreateJourneyTaskRequestV1
+	(*UpdateJourneyTaskRequestV1)(nil),         // 91: ova.journey.api.UpdateJourneyTaskRequestV1
+	(*MultiUpdateJourneyTaskRequestV1)(nil),    // 92: ova.journey.api.MultiUpdateJourneyTaskRequestV1
+	(*MultiUpdateJourneyTaskResponseV1)(nil),   // 93: ova.journey.api.MultiUpdateJourneyTaskResponseV1
+	(*MultiRemoveJourneyTaskRequestV1)(nil),    // 94: ova.journey.api.MultiRemoveJourneyTaskRequestV1
+	(*MultiRemoveJourneyTaskResponseV1)(nil),   // 95: ova.journey.api.MultiRemoveJourneyTaskResponseV1
+	(*CreateJourneyTaskResponseV1)(nil),        // 96: ova.journey.api.CreateJourneyTaskResponseV1
+	(*RemoveJourneyTaskResponseV1)(nil),        // 97: ova.journey.api.RemoveJourneyTaskResponseV1
+	(*MultiCreateJourneyTaskResponseV1)(nil),   // 98: ova.journey.api.MultiCreateJourneyTaskResponseV1
+	(*UpdateJourneyTaskResponseV1)(nil),        // 99: ova.journey.api.UpdateJourneyTaskResponseV1
+	(*GetJourneyTaskStatusRequestV1)(nil),      // 100: ova.journey.api.GetJourneyTaskStatusRequestV1
+	(*GetJourneyTaskStatusResponseV1)(nil),     // 101: ova.journey.api.GetJourneyTaskStatusResponseV1
+	(*ListJourneyTasksRequestV1)(nil),          // 102: ova.journey.api.ListJourneyTasksRequestV1
+	(*ListJourneyTasksResponseV1)(nil),         // 103: ova.journey.api.ListJourneyTasksResponseV1
+	(*timestamppb.Timestamp)(nil),              // 104: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),              // 105: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),                // 106: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                      // 107: google.protobuf.Empty
+}
+var file_ova_journey_api_proto_depIdxs = []int32{
+	104, // 0: ova.journey.api.Journey.start_time:type_name -> google.protobuf.Timestamp
+	104, // 1: ova.journey.api.Journey.end_time:type_name -> google.protobuf.Timestamp
+	8,   // 2: ova.journey.api.Journey.coordinates:type_name -> ova.journey.api.Coordinates
+	7,   // 3: ova.journey.api.Journey.waypoints:type_name -> ova.journey.api.Waypoint
+	0,   // 4: ova.journey.api.Journey.status:type_name -> ova.journey.api.JourneyStatus
+	104, // 5: ova.journey.api.Journey.occurrence_time:type_name -> google.protobuf.Timestamp
+	8,   // 6: ova.journey.api.Waypoint.coordinates:type_name -> ova.journey.api.Coordinates
+	104, // 7: ova.journey.api.Waypoint.arrival_time:type_name -> google.protobuf.Timestamp
+	104, // 8: ova.journey.api.Waypoint.departure_time:type_name -> google.protobuf.Timestamp
+	104, // 9: ova.journey.api.CreateJourneyRequestV1.start_time:type_name -> google.protobuf.Timestamp
+	104, // 10: ova.journey.api.CreateJourneyRequestV1.end_time:type_name -> google.protobuf.Timestamp
+	8,   // 11: ova.journey.api.CreateJourneyRequestV1.coordinates:type_name -> ova.journey.api.Coordinates
+	1,   // 12: ova.journey.api.CreateJourneyRequestV1.overlap_policy:type_name -> ova.journey.api.OverlapPolicy
+	7,   // 13: ova.journey.api.CreateJourneyRequestV1.waypoints:type_name -> ova.journey.api.Waypoint
+	6,   // 14: ova.journey.api.DescribeJourneyResponseV1.journey:type_name -> ova.journey.api.Journey
+	104, // 15: ova.journey.api.ListJourneysRequestV1.from_time:type_name -> google.protobuf.Timestamp
+	104, // 16: ova.journey.api.ListJourneysRequestV1.to_time:type_name -> google.protobuf.Timestamp
+	0,   // 17: ova.journey.api.ListJourneysRequestV1.statuses:type_name -> ova.journey.api.JourneyStatus
+	6,   // 18: ova.journey.api.ListJourneysResponseV1.journeys:type_name -> ova.journey.api.Journey
+	6,   // 19: ova.journey.api.ListJourneysResponseV1.occurrences:type_name -> ova.journey.api.Journey
+	9,   // 20: ova.journey.api.MultiCreateJourneyRequestV1.journeys:type_name -> ova.journey.api.CreateJourneyRequestV1
+	44,  // 21: ova.journey.api.MultiCreateJourneyResponseV1.results:type_name -> ova.journey.api.JourneyResultV1
+	6,   // 22: ova.journey.api.UpdateJourneyRequestV1.journey:type_name -> ova.journey.api.Journey
+	1,   // 23: ova.journey.api.UpdateJourneyRequestV1.overlap_policy:type_name -> ova.journey.api.OverlapPolicy
+	6,   // 24: ova.journey.api.PatchJourneyRequestV1.journey:type_name -> ova.journey.api.Journey
+	105, // 25: ova.journey.api.PatchJourneyRequestV1.update_mask:type_name -> google.protobuf.FieldMask
+	1,   // 26: ova.journey.api.PatchJourneyRequestV1.overlap_policy:type_name -> ova.journey.api.OverlapPolicy
+	7,   // 27: ova.journey.api.AddWaypointRequestV1.waypoint:type_name -> ova.journey.api.Waypoint
+	2,   // 28: ova.journey.api.Participant.role:type_name -> ova.journey.api.ParticipantRole
+	104, // 29: ova.journey.api.Participant.invited_at:type_name -> google.protobuf.Timestamp
+	2,   // 30: ova.journey.api.InviteParticipantRequestV1.role:type_name -> ova.journey.api.ParticipantRole
+	30,  // 31: ova.journey.api.ListParticipantsResponseV1.participants:type_name -> ova.journey.api.Participant
+	6,   // 32: ova.journey.api.DeletedJourney.journey:type_name -> ova.journey.api.Journey
+	104, // 33: ova.journey.api.DeletedJourney.deleted_at:type_name -> google.protobuf.Timestamp
+	37,  // 34: ova.journey.api.ListDeletedJourneysResponseV1.journeys:type_name -> ova.journey.api.DeletedJourney
+	3,   // 35: ova.journey.api.JourneyTask.type:type_name -> ova.journey.api.JourneyTaskType
+	4,   // 36: ova.journey.api.JourneyTask.status:type_name -> ova.journey.api.JourneyTaskStatus
+	104, // 37: ova.journey.api.JourneyTask.created_at:type_name -> google.protobuf.Timestamp
+	104, // 38: ova.journey.api.JourneyTask.updated_at:type_name -> google.protobuf.Timestamp
+	104, // 39: ova.journey.api.ExportJourneysRequestV1.from_time:type_name -> google.protobuf.Timestamp
+	104, // 40: ova.journey.api.ExportJourneysRequestV1.to_time:type_name -> google.protobuf.Timestamp
+	6,   // 41: ova.journey.api.ExportJourneysResponseV1.journey:type_name -> ova.journey.api.Journey
+	9,   // 42: ova.journey.api.ImportJourneysRequestV1.journey:type_name -> ova.journey.api.CreateJourneyRequestV1
+	18,  // 43: ova.journey.api.MultiUpdateJourneyRequestV1.journeys:type_name -> ova.journey.api.UpdateJourneyRequestV1
+	44,  // 44: ova.journey.api.MultiUpdateJourneyResponseV1.results:type_name -> ova.journey.api.JourneyResultV1
+	44,  // 45: ova.journey.api.MultiRemoveJourneyResponseV1.results:type_name -> ova.journey.api.JourneyResultV1
+	6,   // 46: ova.journey.api.BatchGetJourneysResponseV1.journeys:type_name -> ova.journey.api.Journey
+	104, // 47: ova.journey.api.SearchJourneysRequestV1.from_time:type_name -> google.protobuf.Timestamp
+	104, // 48: ova.journey.api.SearchJourneysRequestV1.to_time:type_name -> google.protobuf.Timestamp
+	53,  // 49: ova.journey.api.SearchJourneysResponseV1.results:type_name -> ova.journey.api.FoundJourneyV1
+	6,   // 50: ova.journey.api.FoundJourneyV1.journey:type_name -> ova.journey.api.Journey
+	8,   // 51: ova.journey.api.FindJourneysNearRequestV1.point:type_name -> ova.journey.api.Coordinates
+	104, // 52: ova.journey.api.FindJourneysNearRequestV1.from_time:type_name -> google.protobuf.Timestamp
+	104, // 53: ova.journey.api.FindJourneysNearRequestV1.to_time:type_name -> google.protobuf.Timestamp
+	56,  // 54: ova.journey.api.FindJourneysNearResponseV1.journeys:type_name -> ova.journey.api.NearJourneyV1
+	6,   // 55: ova.journey.api.NearJourneyV1.journey:type_name -> ova.journey.api.Journey
+	104, // 56: ova.journey.api.FindOverlappingJourneysRequestV1.from_time:type_name -> google.protobuf.Timestamp
+	104, // 57: ova.journey.api.FindOverlappingJourneysRequestV1.to_time:type_name -> google.protobuf.Timestamp
+	59,  // 58: ova.journey.api.FindOverlappingJourneysResponseV1.overlaps:type_name -> ova.journey.api.JourneyOverlapV1
+	6,   // 59: ova.journey.api.JourneyOverlapV1.first:type_name -> ova.journey.api.Journey
+	6,   // 60: ova.journey.api.JourneyOverlapV1.second:type_name -> ova.journey.api.Journey
+	104, // 61: ova.journey.api.JourneyOverlapV1.start_time:type_name -> google.protobuf.Timestamp
+	104, // 62: ova.journey.api.JourneyOverlapV1.end_time:type_name -> google.protobuf.Timestamp
+	104, // 63: ova.journey.api.GetJourneyStatsRequestV1.from_time:type_name -> google.protobuf.Timestamp
+	104, // 64: ova.journey.api.GetJourneyStatsRequestV1.to_time:type_name -> google.protobuf.Timestamp
+	106, // 65: ova.journey.api.GetJourneyStatsResponseV1.total_duration:type_name -> google.protobuf.Duration
+	106, // 66: ova.journey.api.GetJourneyStatsResponseV1.average_duration:type_name -> google.protobuf.Duration
+	62,  // 67: ova.journey.api.GetJourneyStatsResponseV1.top_addresses:type_name -> ova.journey.api.AddressCountV1
+	83,  // 68: ova.journey.api.GetJourneyStatsResponseV1.months:type_name -> ova.journey.api.MonthCountV1
+	65,  // 69: ova.journey.api.ListTagsResponseV1.tags:type_name -> ova.journey.api.TagCountV1
+	0,   // 70: ova.journey.api.ChangeJourneyStatusResponseV1.status:type_name -> ova.journey.api.JourneyStatus
+	104, // 71: ova.journey.api.RecurringJourney.start_time:type_name -> google.protobuf.Timestamp
+	104, // 72: ova.journey.api.RecurringJourney.end_time:type_name -> google.protobuf.Timestamp
+	8,   // 73: ova.journey.api.RecurringJourney.coordinates:type_name -> ova.journey.api.Coordinates
+	71,  // 74: ova.journey.api.RecurringJourney.exceptions:type_name -> ova.journey.api.OccurrenceException
+	104, // 75: ova.journey.api.OccurrenceException.occurrence_time:type_name -> google.protobuf.Timestamp
+	104, // 76: ova.journey.api.OccurrenceException.start_time:type_name -> google.protobuf.Timestamp
+	104, // 77: ova.journey.api.OccurrenceException.end_time:type_name -> google.protobuf.Timestamp
+	70,  // 78: ova.journey.api.CreateRecurringJourneyRequestV1.recurring_journey:type_name -> ova.journey.api.RecurringJourney
+	70,  // 79: ova.journey.api.DescribeRecurringJourneyResponseV1.recurring_journey:type_name -> ova.journey.api.RecurringJourney
+	70,  // 80: ova.journey.api.UpdateRecurringJourneyRequestV1.recurring_journey:type_name -> ova.journey.api.RecurringJourney
+	71,  // 81: ova.journey.api.UpdateOccurrenceRequestV1.exception:type_name -> ova.journey.api.OccurrenceException
+	104, // 82: ova.journey.api.ListOccurrencesRequestV1.from_time:type_name -> google.protobuf.Timestamp
+	104, // 83: ova.journey.api.ListOccurrencesRequestV1.to_time:type_name -> google.protobuf.Timestamp
+	6,   // 84: ova.journey.api.ListOccurrencesResponseV1.occurrences:type_name -> ova.journey.api.Journey
+	5,   // 85: ova.journey.api.JourneyEvent.type:type_name -> ova.journey.api.JourneyEventType
+	6,   // 86: ova.journey.api.JourneyEvent.journey:type_name -> ova.journey.api.Journey
+	85,  // 87: ova.journey.api.WatchJourneysResponseV1.event:type_name -> ova.journey.api.JourneyEvent
+	44,  // 88: ova.journey.api.ImportJourneysResponseV1.results:type_name -> ova.journey.api.JourneyResultV1
+	104, // 89: ova.journey.api.CreateJourneyTaskRequestV1.start_time:type_name -> google.protobuf.Timestamp
+	104, // 90: ova.journey.api.CreateJourneyTaskRequestV1.end_time:type_name -> google.protobuf.Timestamp
+	8,   // 91: ova.journey.api.CreateJourneyTaskRequestV1.coordinates:type_name -> ova.journey.api.Coordinates
+	7,   // 92: ova.journey.api.CreateJourneyTaskRequestV1.waypoints:type_name -> ova.journey.api.Waypoint
+	1,   // 93: ova.journey.api.CreateJourneyTaskRequestV1.overlap_policy:type_name -> ova.journey.api.OverlapPolicy
+	9,   // 94: ova.journey.api.MultiCreateJourneyTaskRequestV1.journeys:type_name -> ova.journey.api.CreateJourneyRequestV1
+	6,   // 95: ova.journey.api.UpdateJourneyTaskRequestV1.journey:type_name -> ova.journey.api.Journey
+	1,   // 96: ova.journey.api.UpdateJourneyTaskRequestV1.overlap_policy:type_name -> ova.journey.api.OverlapPolicy
+	18,  // 97: ova.journey.api.MultiUpdateJourneyTaskRequestV1.journeys:type_name -> ova.journey.api.UpdateJourneyRequestV1
+	40,  // 98: ova.journey.api.GetJourneyTaskStatusResponseV1.task:type_name -> ova.journey.api.JourneyTask
+	40,  // 99: ova.journey.api.ListJourneyTasksResponseV1.tasks:type_name -> ova.journey.api.JourneyTask
+	9,   // 100: ova.journey.api.JourneyApiV1.CreateJourneyV1:input_type -> ova.journey.api.CreateJourneyRequestV1
+	11,  // 101: ova.journey.api.JourneyApiV1.DescribeJourneyV1:input_type -> ova.journey.api.DescribeJourneyRequestV1
+	13,  // 102: ova.journey.api.JourneyApiV1.ListJourneysV1:input_type -> ova.journey.api.ListJourneysRequestV1
+	15,  // 103: ova.journey.api.JourneyApiV1.RemoveJourneyV1:input_type -> ova.journey.api.RemoveJourneyRequestV1
+	16,  // 104: ova.journey.api.JourneyApiV1.MultiCreateJourneyV1:input_type -> ova.journey.api.MultiCreateJourneyRequestV1
+	18,  // 105: ova.journey.api.JourneyApiV1.UpdateJourneyV1:input_type -> ova.journey.api.UpdateJourneyRequestV1
+	20,  // 106: ova.journey.api.JourneyApiV1.PatchJourneyV1:input_type -> ova.journey.api.PatchJourneyRequestV1
+	22,  // 107: ova.journey.api.JourneyApiV1.RestoreJourneyV1:input_type -> ova.journey.api.RestoreJourneyRequestV1
+	24,  // 108: ova.journey.api.JourneyApiV1.AddWaypointV1:input_type -> ova.journey.api.AddWaypointRequestV1
+	26,  // 109: ova.journey.api.JourneyApiV1.ReorderWaypointsV1:input_type -> ova.journey.api.ReorderWaypointsRequestV1
+	28,  // 110: ova.journey.api.JourneyApiV1.RemoveWaypointV1:input_type -> ova.journey.api.RemoveWaypointRequestV1
+	31,  // 111: ova.journey.api.JourneyApiV1.InviteParticipantV1:input_type -> ova.journey.api.InviteParticipantRequestV1
+	33,  // 112: ova.journey.api.JourneyApiV1.RemoveParticipantV1:input_type -> ova.journey.api.RemoveParticipantRequestV1
+	35,  // 113: ova.journey.api.JourneyApiV1.ListParticipantsV1:input_type -> ova.journey.api.ListParticipantsRequestV1
+	38,  // 114: ova.journey.api.JourneyApiV1.ListDeletedJourneysV1:input_type -> ova.journey.api.ListDeletedJourneysRequestV1
+	41,  // 115: ova.journey.api.JourneyApiV1.ExportJourneysV1:input_type -> ova.journey.api.ExportJourneysRequestV1
+	43,  // 116: ova.journey.api.JourneyApiV1.ImportJourneysV1:input_type -> ova.journey.api.ImportJourneysRequestV1
+	45,  // 117: ova.journey.api.JourneyApiV1.MultiUpdateJourneyV1:input_type -> ova.journey.api.MultiUpdateJourneyRequestV1
+	47,  // 118: ova.journey.api.JourneyApiV1.MultiRemoveJourneyV1:input_type -> ova.journey.api.MultiRemoveJourneyRequestV1
+	49,  // 119: ova.journey.api.JourneyApiV1.BatchGetJourneysV1:input_type -> ova.journey.api.BatchGetJourneysRequestV1
+	51,  // 120: ova.journey.api.JourneyApiV1.SearchJourneysV1:input_type -> ova.journey.api.SearchJourneysRequestV1
+	54,  // 121: ova.journey.api.JourneyApiV1.FindJourneysNearV1:input_type -> ova.journey.api.FindJourneysNearRequestV1
+	57,  // 122: ova.journey.api.JourneyApiV1.FindOverlappingJourneysV1:input_type -> ova.journey.api.FindOverlappingJourneysRequestV1
+	60,  // 123: ova.journey.api.JourneyApiV1.GetJourneyStatsV1:input_type -> ova.journey.api.GetJourneyStatsRequestV1
+	63,  // 124: ova.journey.api.JourneyApiV1.ListTagsV1:input_type -> ova.journey.api.ListTagsRequestV1
+	66,  // 125: ova.journey.api.JourneyApiV1.GetCalendarFeedV1:input_type -> ova.journey.api.GetCalendarFeedRequestV1
+	68,  // 126: ova.journey.api.JourneyApiV1.StartJourneyV1:input_type -> ova.journey.api.ChangeJourneyStatusRequestV1
+	68,  // 127: ova.journey.api.JourneyApiV1.CompleteJourneyV1:input_type -> ova.journey.api.ChangeJourneyStatusRequestV1
+	68,  // 128: ova.journey.api.JourneyApiV1.CancelJourneyV1:input_type -> ova.journey.api.ChangeJourneyStatusRequestV1
+	72,  // 129: ova.journey.api.JourneyApiV1.CreateRecurringJourneyV1:input_type -> ova.journey.api.CreateRecurringJourneyRequestV1
+	74,  // 130: ova.journey.api.JourneyApiV1.DescribeRecurringJourneyV1:input_type -> ova.journey.api.DescribeRecurringJourneyRequestV1
+	76,  // 131: ova.journey.api.JourneyApiV1.UpdateRecurringJourneyV1:input_type -> ova.journey.api.UpdateRecurringJourneyRequestV1
+	78,  // 132: ova.journey.api.JourneyApiV1.RemoveRecurringJourneyV1:input_type -> ova.journey.api.RemoveRecurringJourneyRequestV1
+	79,  // 133: ova.journey.api.JourneyApiV1.UpdateOccurrenceV1:input_type -> ova.journey.api.UpdateOccurrenceRequestV1
+	81,  // 134: ova.journey.api.JourneyApiV1.ListOccurrencesV1:input_type -> ova.journey.api.ListOccurrencesRequestV1
+	84,  // 135: ova.journey.api.JourneyApiV1.WatchJourneysV1:input_type -> ova.journey.api.WatchJourneysRequestV1
+	88,  // 136: ova.journey.api.JourneyApiV1.CreateJourneyTaskV1:input_type -> ova.journey.api.CreateJourneyTaskRequestV1
+	89,  // 137: ova.journey.api.JourneyApiV1.RemoveJourneyTaskV1:input_type -> ova.journey.api.RemoveJourneyTaskRequestV1
+	90,  // 138: ova.journey.api.JourneyApiV1.MultiCreateJourneyTaskV1:input_type -> ova.journey.api.MultiCreateJourneyTaskRequestV1
+	91,  // 139: ova.journey.api.JourneyApiV1.UpdateJourneyTaskV1:input_type -> ova.journey.api.UpdateJourneyTaskRequestV1
+	92,  // 140: ova.journey.api.JourneyApiV1.MultiUpdateJourneyTaskV1:input_type -> ova.journey.api.MultiUpdateJourneyTaskRequestV1
+	94,  // 141: ova.journey.api.JourneyApiV1.MultiRemoveJourneyTaskV1:input_type -> ova.journey.api.MultiRemoveJourneyTaskRequestV1
+	100, // 142: ova.journey.api.JourneyApiV1.GetJourneyTaskStatusV1:input_type -> ova.journey.api.GetJourneyTaskStatusRequestV1
+	102, // 143: ova.journey.api.JourneyApiV1.ListJourneyTasksV1:input_type -> ova.journey.api.ListJourneyTasksRequestV1
+	10,  // 144: ova.journey.api.JourneyApiV1.CreateJourneyV1:output_type -> ova.journey.api.CreateJourneyResponseV1
+	12,  // 145: ova.journey.api.JourneyApiV1.DescribeJourneyV1:output_type -> ova.journey.api.DescribeJourneyResponseV1
+	14,  // 146: ova.journey.api.JourneyApiV1.ListJourneysV1:output_type -> ova.journey.api.ListJourneysResponseV1
+	107, // 147: ova.journey.api.JourneyApiV1.RemoveJourneyV1:output_type -> google.protobuf.Empty
+	17,  // 148: ova.journey.api.JourneyApiV1.MultiCreateJourneyV1:output_type -> ova.journey.api.MultiCreateJourneyResponseV1
+	19,  // 149: ova.journey.api.JourneyApiV1.UpdateJourneyV1:output_type -> ova.journey.api.UpdateJourneyResponseV1
+	21,  // 150: ova.journey.api.JourneyApiV1.PatchJourneyV1:output_type -> ova.journey.api.PatchJourneyResponseV1
+	23,  // 151: ova.journey.api.JourneyApiV1.RestoreJourneyV1:output_type -> ova.journey.api.RestoreJourneyResponseV1
+	25,  // 152: ova.journey.api.JourneyApiV1.AddWaypointV1:output_type -> ova.journey.api.AddWaypointResponseV1
+	27,  // 153: ova.journey.api.JourneyApiV1.ReorderWaypointsV1:output_type -> ova.journey.api.ReorderWaypointsResponseV1
+	29,  // 154: ova.journey.api.JourneyApiV1.RemoveWaypointV1:output_type -> ova.journey.api.RemoveWaypointResponseV1
+	32,  // 155: ova.journey.api.JourneyApiV1.InviteParticipantV1:output_type -> ova.journey.api.InviteParticipantResponseV1
+	34,  // 156: ova.journey.api.JourneyApiV1.RemoveParticipantV1:output_type -> ova.journey.api.RemoveParticipantResponseV1
+	36,  // 157: ova.journey.api.JourneyApiV1.ListParticipantsV1:output_type -> ova.journey.api.ListParticipantsResponseV1
+	39,  // 158: ova.journey.api.JourneyApiV1.ListDeletedJourneysV1:output_type -> ova.journey.api.ListDeletedJourneysResponseV1
+	42,  // 159: ova.journey.api.JourneyApiV1.ExportJourneysV1:output_type -> ova.journey.api.ExportJourneysResponseV1
+	87,  // 160: ova.journey.api.JourneyApiV1.ImportJourneysV1:output_type -> ova.journey.api.ImportJourneysResponseV1
+	46,  // 161: ova.journey.api.JourneyApiV1.MultiUpdateJourneyV1:output_type -> ova.journey.api.MultiUpdateJourneyResponseV1
+	48,  // 162: ova.journey.api.JourneyApiV1.MultiRemoveJourneyV1:output_type -> ova.journey.api.MultiRemoveJourneyResponseV1
+	50,  // 163: ova.journey.api.JourneyApiV1.BatchGetJourneysV1:output_type -> ova.journey.api.BatchGetJourneysResponseV1
+	52,  // 164: ova.journey.api.JourneyApiV1.SearchJourneysV1:output_type -> ova.journey.api.SearchJourneysResponseV1
+	55,  // 165: ova.journey.api.JourneyApiV1.FindJourneysNearV1:output_type -> ova.journey.api.FindJourneysNearResponseV1
+	58,  // 166: ova.journey.api.JourneyApiV1.FindOverlappingJourneysV1:output_type -> ova.journey.api.FindOverlappingJourneysResponseV1
+	61,  // 167: ova.journey.api.JourneyApiV1.GetJourneyStatsV1:output_type -> ova.journey.api.GetJourneyStatsResponseV1
+	64,  // 168: ova.journey.api.JourneyApiV1.ListTagsV1:output_type -> ova.journey.api.ListTagsResponseV1
+	67,  // 169: ova.journey.api.JourneyApiV1.GetCalendarFeedV1:output_type -> ova.journey.api.GetCalendarFeedResponseV1
+	69,  // 170: ova.journey.api.JourneyApiV1.StartJourneyV1:output_type -> ova.journey.api.ChangeJourneyStatusResponseV1
+	69,  // 171: ova.journey.api.JourneyApiV1.CompleteJourneyV1:output_type -> ova.journey.api.ChangeJourneyStatusResponseV1
+	69,  // 172: ova.journey.api.JourneyApiV1.CancelJourneyV1:output_type -> ova.journey.api.ChangeJourneyStatusResponseV1
+	73,  // 173: ova.journey.api.JourneyApiV1.CreateRecurringJourneyV1:output_type -> ova.journey.api.CreateRecurringJourneyResponseV1
+	75,  // 174: ova.journey.api.JourneyApiV1.DescribeRecurringJourneyV1:output_type -> ova.journey.api.DescribeRecurringJourneyResponseV1
+	77,  // 175: ova.journey.api.JourneyApiV1.UpdateRecurringJourneyV1:output_type -> ova.journey.api.UpdateRecurringJourneyResponseV1
+	107, // 176: ova.journey.api.JourneyApiV1.RemoveRecurringJourneyV1:output_type -> google.protobuf.Empty
+	80,  // 177: ova.journey.api.JourneyApiV1.UpdateOccurrenceV1:output_type -> ova.journey.api.UpdateOccurrenceResponseV1
+	82,  // 178: ova.journey.api.JourneyApiV1.ListOccurrencesV1:output_type -> ova.journey.api.ListOccurrencesResponseV1
+	86,  // 179: ova.journey.api.JourneyApiV1.WatchJourneysV1:output_type -> ova.journey.api.WatchJourneysResponseV1
+	96,  // 180: ova.journey.api.JourneyApiV1.CreateJourneyTaskV1:output_type -> ova.journey.api.CreateJourneyTaskResponseV1
+	97,  // 181: ova.journey.api.JourneyApiV1.RemoveJourneyTaskV1:output_type -> ova.journey.api.RemoveJourneyTaskResponseV1
+	98,  // 182: ova.journey.api.JourneyApiV1.MultiCreateJourneyTaskV1:output_type -> ova.journey.api.MultiCreateJourneyTaskResponseV1
+	99,  // 183: ova.journey.api.JourneyApiV1.UpdateJourneyTaskV1:output_type -> ova.journey.api.UpdateJourneyTaskResponseV1
+	93,  // 184: ova.journey.api.JourneyApiV1.MultiUpdateJourneyTaskV1:output_type -> ova.journey.api.MultiUpdateJourneyTaskResponseV1
+	95,  // 185: ova.journey.api.JourneyApiV1.MultiRemoveJourneyTaskV1:output_type -> ova.journey.api.MultiRemoveJourneyTaskResponseV1
+	101, // 186: ova.journey.api.JourneyApiV1.GetJourneyTaskStatusV1:output_type -> ova.journey.api.GetJourneyTaskStatusResponseV1
+	103, // 187: ova.journey.api.JourneyApiV1.ListJourneyTasksV1:output_type -> ova.journey.api.ListJourneyTasksResponseV1
+	144, // [144:188] is the sub-list for method output_type
+	100, // [100:144] is the sub-list for method input_type
+	100, // [100:100] is the sub-list for extension type_name
+	100, // [100:100] is the sub-list for extension extendee
+	0,   // [0:100] is the sub-list for field type_name
+}
+
+func init() { file_ova_journey_api_proto_init() }
+func file_ova_journey_api_proto_init() {
+	if File_ova_journey_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ova_journey_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Journey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Waypoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coordinates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJourneyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJourneyResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeJourneyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeJourneyResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJourneysRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJourneysResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveJourneyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiCreateJourneyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiCreateJourneyResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJourneyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJourneyResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchJourneyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchJourneyResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreJourneyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreJourneyResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWaypointRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWaypointResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderWaypointsRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderWaypointsResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveWaypointRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveWaypointResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Participant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteParticipantRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteParticipantResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveParticipantRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveParticipantResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListParticipantsRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListParticipantsResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedJourney); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedJourneysRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedJourneysResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JourneyTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportJourneysRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportJourneysResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportJourneysRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JourneyResultV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiUpdateJourneyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiUpdateJourneyResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiRemoveJourneyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiRemoveJourneyResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetJourneysRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetJourneysResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchJourneysRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchJourneysResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FoundJourneyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindJourneysNearRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindJourneysNearResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearJourneyV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindOverlappingJourneysRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindOverlappingJourneysResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JourneyOverlapV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJourneyStatsRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJourneyStatsResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressCountV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagCountV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCalendarFeedRequestV1); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -1139,8 +8761,8 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJourneyRequestV1); i {
+		file_ova_journey_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCalendarFeedResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1151,8 +8773,8 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJourneyResponseV1); i {
+		file_ova_journey_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeJourneyStatusRequestV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1163,8 +8785,8 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DescribeJourneyRequestV1); i {
+		file_ova_journey_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeJourneyStatusResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1175,8 +8797,8 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DescribeJourneyResponseV1); i {
+		file_ova_journey_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecurringJourney); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1187,8 +8809,8 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJourneysRequestV1); i {
+		file_ova_journey_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OccurrenceException); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1199,8 +8821,8 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJourneysResponseV1); i {
+		file_ova_journey_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRecurringJourneyRequestV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1211,8 +8833,8 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveJourneyRequestV1); i {
+		file_ova_journey_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRecurringJourneyResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1223,8 +8845,8 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiCreateJourneyRequestV1); i {
+		file_ova_journey_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeRecurringJourneyRequestV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1235,8 +8857,8 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiCreateJourneyResponseV1); i {
+		file_ova_journey_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeRecurringJourneyResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1247,8 +8869,8 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateJourneyRequestV1); i {
+		file_ova_journey_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRecurringJourneyRequestV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1259,7 +8881,139 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_ova_journey_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRecurringJourneyResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRecurringJourneyRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOccurrenceRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOccurrenceResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOccurrencesRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOccurrencesResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonthCountV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchJourneysRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JourneyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchJourneysResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportJourneysResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateJourneyTaskRequestV1); i {
 			case 0:
 				return &v.state
@@ -1271,7 +9025,7 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_ova_journey_api_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveJourneyTaskRequestV1); i {
 			case 0:
 				return &v.state
@@ -1283,7 +9037,7 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_ova_journey_api_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MultiCreateJourneyTaskRequestV1); i {
 			case 0:
 				return &v.state
@@ -1295,7 +9049,7 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
-		file_ova_journey_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_ova_journey_api_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateJourneyTaskRequestV1); i {
 			case 0:
 				return &v.state
@@ -1307,19 +9061,164 @@ func file_ova_journey_api_proto_init() {
 				return nil
 			}
 		}
+		file_ova_journey_api_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiUpdateJourneyTaskRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiUpdateJourneyTaskResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiRemoveJourneyTaskRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiRemoveJourneyTaskResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJourneyTaskResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveJourneyTaskResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiCreateJourneyTaskResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJourneyTaskResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJourneyTaskStatusRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJourneyTaskStatusResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJourneyTasksRequestV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ova_journey_api_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJourneyTasksResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ova_journey_api_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      6,
+			NumMessages:   98,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ova_journey_api_proto_goTypes,
 		DependencyIndexes: file_ova_journey_api_proto_depIdxs,
+		EnumInfos:         file_ova_journey_api_proto_enumTypes,
 		MessageInfos:      file_ova_journey_api_proto_msgTypes,
 	}.Build()
 	File_ova_journey_api_proto = out.File
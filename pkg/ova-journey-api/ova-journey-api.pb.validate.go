@@ -73,6 +73,68 @@ func (m *Journey) Validate() error {
 		}
 	}
 
+	// no validation rules for Revision
+
+	// no validation rules for TimeZone
+
+	if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JourneyValidationError{
+				field:  "Coordinates",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetWaypoints() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JourneyValidationError{
+					field:  fmt.Sprintf("Waypoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetTags()) > 20 {
+		return JourneyValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 32 {
+			return JourneyValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+		}
+
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for RecurringJourneyId
+
+	if v, ok := interface{}(m.GetOccurrenceTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JourneyValidationError{
+				field:  "OccurrenceTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -130,6 +192,183 @@ var _ interface {
 	ErrorName() string
 } = JourneyValidationError{}
 
+// Validate checks the field values on Waypoint with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Waypoint) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for WaypointId
+
+	// no validation rules for Address
+
+	if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WaypointValidationError{
+				field:  "Coordinates",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetArrivalTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WaypointValidationError{
+				field:  "ArrivalTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetDepartureTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WaypointValidationError{
+				field:  "DepartureTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// WaypointValidationError is the validation error returned by
+// Waypoint.Validate if the designated constraints aren't met.
+type WaypointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WaypointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WaypointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WaypointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WaypointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WaypointValidationError) ErrorName() string { return "WaypointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WaypointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWaypoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WaypointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WaypointValidationError{}
+
+// Validate checks the field values on Coordinates with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Coordinates) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if val := m.GetLatitude(); val < -90 || val > 90 {
+		return CoordinatesValidationError{
+			field:  "Latitude",
+			reason: "value must be inside range [-90, 90]",
+		}
+	}
+
+	if val := m.GetLongitude(); val < -180 || val > 180 {
+		return CoordinatesValidationError{
+			field:  "Longitude",
+			reason: "value must be inside range [-180, 180]",
+		}
+	}
+
+	return nil
+}
+
+// CoordinatesValidationError is the validation error returned by
+// Coordinates.Validate if the designated constraints aren't met.
+type CoordinatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CoordinatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CoordinatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CoordinatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CoordinatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CoordinatesValidationError) ErrorName() string { return "CoordinatesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CoordinatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCoordinates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CoordinatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CoordinatesValidationError{}
+
 // Validate checks the field values on CreateJourneyRequestV1 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -169,6 +408,73 @@ func (m *CreateJourneyRequestV1) Validate() error {
 		}
 	}
 
+	// no validation rules for TimeZone
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		return CreateJourneyRequestV1ValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+	}
+
+	if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJourneyRequestV1ValidationError{
+				field:  "Coordinates",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := OverlapPolicy_name[int32(m.GetOverlapPolicy())]; !ok {
+		return CreateJourneyRequestV1ValidationError{
+			field:  "OverlapPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	if len(m.GetWaypoints()) > 100 {
+		return CreateJourneyRequestV1ValidationError{
+			field:  "Waypoints",
+			reason: "value must contain no more than 100 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetWaypoints() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJourneyRequestV1ValidationError{
+					field:  fmt.Sprintf("Waypoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetTags()) > 20 {
+		return CreateJourneyRequestV1ValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 32 {
+			return CreateJourneyRequestV1ValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -470,34 +776,108 @@ func (m *ListJourneysRequestV1) Validate() error {
 		}
 	}
 
-	return nil
-}
+	// no validation rules for PageToken
 
-// ListJourneysRequestV1ValidationError is the validation error returned by
-// ListJourneysRequestV1.Validate if the designated constraints aren't met.
-type ListJourneysRequestV1ValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
 
-// Field function returns field value.
-func (e ListJourneysRequestV1ValidationError) Field() string { return e.field }
+		if item <= 0 {
+			return ListJourneysRequestV1ValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
 
-// Reason function returns reason value.
-func (e ListJourneysRequestV1ValidationError) Reason() string { return e.reason }
+	}
 
-// Cause function returns cause value.
-func (e ListJourneysRequestV1ValidationError) Cause() error { return e.cause }
+	if v, ok := interface{}(m.GetFromTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListJourneysRequestV1ValidationError{
+				field:  "FromTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-// Key function returns key value.
-func (e ListJourneysRequestV1ValidationError) Key() bool { return e.key }
+	if v, ok := interface{}(m.GetToTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListJourneysRequestV1ValidationError{
+				field:  "ToTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-// ErrorName returns error name.
-func (e ListJourneysRequestV1ValidationError) ErrorName() string {
-	return "ListJourneysRequestV1ValidationError"
-}
+	if utf8.RuneCountInString(m.GetText()) > 256 {
+		return ListJourneysRequestV1ValidationError{
+			field:  "Text",
+			reason: "value length must be at most 256 runes",
+		}
+	}
+
+	if len(m.GetAnyTags()) > 20 {
+		return ListJourneysRequestV1ValidationError{
+			field:  "AnyTags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+	}
+
+	if len(m.GetAllTags()) > 20 {
+		return ListJourneysRequestV1ValidationError{
+			field:  "AllTags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+	}
+
+	if len(m.GetStatuses()) > 4 {
+		return ListJourneysRequestV1ValidationError{
+			field:  "Statuses",
+			reason: "value must contain no more than 4 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if _, ok := _ListJourneysRequestV1_Statuses_InLookup[item]; !ok {
+			return ListJourneysRequestV1ValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must be in list [1 2 3 4]",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListJourneysRequestV1ValidationError is the validation error returned by
+// ListJourneysRequestV1.Validate if the designated constraints aren't met.
+type ListJourneysRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListJourneysRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListJourneysRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListJourneysRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListJourneysRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListJourneysRequestV1ValidationError) ErrorName() string {
+	return "ListJourneysRequestV1ValidationError"
+}
 
 // Error satisfies the builtin error interface
 func (e ListJourneysRequestV1ValidationError) Error() string {
@@ -529,6 +909,13 @@ var _ interface {
 	ErrorName() string
 } = ListJourneysRequestV1ValidationError{}
 
+var _ListJourneysRequestV1_Statuses_InLookup = map[JourneyStatus]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+}
+
 // Validate checks the field values on ListJourneysResponseV1 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -552,6 +939,23 @@ func (m *ListJourneysResponseV1) Validate() error {
 
 	}
 
+	// no validation rules for NextPageToken
+
+	for idx, item := range m.GetOccurrences() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListJourneysResponseV1ValidationError{
+					field:  fmt.Sprintf("Occurrences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -609,29 +1013,6954 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListJourneysResponseV1ValidationError{}
+} = ListJourneysResponseV1ValidationError{}
+
+// Validate checks the field values on RemoveJourneyRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourneyId() <= 0 {
+		return RemoveJourneyRequestV1ValidationError{
+			field:  "JourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for ExpectedRevision
+
+	return nil
+}
+
+// RemoveJourneyRequestV1ValidationError is the validation error returned by
+// RemoveJourneyRequestV1.Validate if the designated constraints aren't met.
+type RemoveJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveJourneyRequestV1ValidationError) ErrorName() string {
+	return "RemoveJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on MultiCreateJourneyRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiCreateJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetJourneys()) < 1 {
+		return MultiCreateJourneyRequestV1ValidationError{
+			field:  "Journeys",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetJourneys() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiCreateJourneyRequestV1ValidationError{
+					field:  fmt.Sprintf("Journeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Atomic
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		return MultiCreateJourneyRequestV1ValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+	}
+
+	return nil
+}
+
+// MultiCreateJourneyRequestV1ValidationError is the validation error returned
+// by MultiCreateJourneyRequestV1.Validate if the designated constraints
+// aren't met.
+type MultiCreateJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiCreateJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiCreateJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiCreateJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiCreateJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiCreateJourneyRequestV1ValidationError) ErrorName() string {
+	return "MultiCreateJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiCreateJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiCreateJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiCreateJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiCreateJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on MultiCreateJourneyResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiCreateJourneyResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiCreateJourneyResponseV1ValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiCreateJourneyResponseV1ValidationError is the validation error returned
+// by MultiCreateJourneyResponseV1.Validate if the designated constraints
+// aren't met.
+type MultiCreateJourneyResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiCreateJourneyResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiCreateJourneyResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiCreateJourneyResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiCreateJourneyResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiCreateJourneyResponseV1ValidationError) ErrorName() string {
+	return "MultiCreateJourneyResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiCreateJourneyResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiCreateJourneyResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiCreateJourneyResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiCreateJourneyResponseV1ValidationError{}
+
+// Validate checks the field values on UpdateJourneyRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourney() == nil {
+		return UpdateJourneyRequestV1ValidationError{
+			field:  "Journey",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJourneyRequestV1ValidationError{
+				field:  "Journey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpectedRevision
+
+	if _, ok := OverlapPolicy_name[int32(m.GetOverlapPolicy())]; !ok {
+		return UpdateJourneyRequestV1ValidationError{
+			field:  "OverlapPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	return nil
+}
+
+// UpdateJourneyRequestV1ValidationError is the validation error returned by
+// UpdateJourneyRequestV1.Validate if the designated constraints aren't met.
+type UpdateJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJourneyRequestV1ValidationError) ErrorName() string {
+	return "UpdateJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on UpdateJourneyResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateJourneyResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Revision
+
+	return nil
+}
+
+// UpdateJourneyResponseV1ValidationError is the validation error returned by
+// UpdateJourneyResponseV1.Validate if the designated constraints aren't met.
+type UpdateJourneyResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJourneyResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJourneyResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJourneyResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJourneyResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJourneyResponseV1ValidationError) ErrorName() string {
+	return "UpdateJourneyResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJourneyResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJourneyResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJourneyResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJourneyResponseV1ValidationError{}
+
+// Validate checks the field values on PatchJourneyRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PatchJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourney() == nil {
+		return PatchJourneyRequestV1ValidationError{
+			field:  "Journey",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchJourneyRequestV1ValidationError{
+				field:  "Journey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetUpdateMask() == nil {
+		return PatchJourneyRequestV1ValidationError{
+			field:  "UpdateMask",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchJourneyRequestV1ValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpectedRevision
+
+	if _, ok := OverlapPolicy_name[int32(m.GetOverlapPolicy())]; !ok {
+		return PatchJourneyRequestV1ValidationError{
+			field:  "OverlapPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	return nil
+}
+
+// PatchJourneyRequestV1ValidationError is the validation error returned by
+// PatchJourneyRequestV1.Validate if the designated constraints aren't met.
+type PatchJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchJourneyRequestV1ValidationError) ErrorName() string {
+	return "PatchJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on PatchJourneyResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PatchJourneyResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Revision
+
+	return nil
+}
+
+// PatchJourneyResponseV1ValidationError is the validation error returned by
+// PatchJourneyResponseV1.Validate if the designated constraints aren't met.
+type PatchJourneyResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchJourneyResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchJourneyResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchJourneyResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchJourneyResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchJourneyResponseV1ValidationError) ErrorName() string {
+	return "PatchJourneyResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PatchJourneyResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchJourneyResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchJourneyResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchJourneyResponseV1ValidationError{}
+
+// Validate checks the field values on RestoreJourneyRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RestoreJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourneyId() <= 0 {
+		return RestoreJourneyRequestV1ValidationError{
+			field:  "JourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// RestoreJourneyRequestV1ValidationError is the validation error returned by
+// RestoreJourneyRequestV1.Validate if the designated constraints aren't met.
+type RestoreJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreJourneyRequestV1ValidationError) ErrorName() string {
+	return "RestoreJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on RestoreJourneyResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RestoreJourneyResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Revision
+
+	return nil
+}
+
+// RestoreJourneyResponseV1ValidationError is the validation error returned by
+// RestoreJourneyResponseV1.Validate if the designated constraints aren't met.
+type RestoreJourneyResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreJourneyResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreJourneyResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreJourneyResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreJourneyResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreJourneyResponseV1ValidationError) ErrorName() string {
+	return "RestoreJourneyResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreJourneyResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreJourneyResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreJourneyResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreJourneyResponseV1ValidationError{}
+
+// Validate checks the field values on AddWaypointRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AddWaypointRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourneyId() <= 0 {
+		return AddWaypointRequestV1ValidationError{
+			field:  "JourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetWaypoint() == nil {
+		return AddWaypointRequestV1ValidationError{
+			field:  "Waypoint",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetWaypoint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddWaypointRequestV1ValidationError{
+				field:  "Waypoint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Position
+
+	return nil
+}
+
+// AddWaypointRequestV1ValidationError is the validation error returned by
+// AddWaypointRequestV1.Validate if the designated constraints aren't met.
+type AddWaypointRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddWaypointRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddWaypointRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddWaypointRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddWaypointRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddWaypointRequestV1ValidationError) ErrorName() string {
+	return "AddWaypointRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddWaypointRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddWaypointRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddWaypointRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddWaypointRequestV1ValidationError{}
+
+// Validate checks the field values on AddWaypointResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AddWaypointResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for WaypointId
+
+	// no validation rules for Revision
+
+	return nil
+}
+
+// AddWaypointResponseV1ValidationError is the validation error returned by
+// AddWaypointResponseV1.Validate if the designated constraints aren't met.
+type AddWaypointResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddWaypointResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddWaypointResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddWaypointResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddWaypointResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddWaypointResponseV1ValidationError) ErrorName() string {
+	return "AddWaypointResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddWaypointResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddWaypointResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddWaypointResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddWaypointResponseV1ValidationError{}
+
+// Validate checks the field values on ReorderWaypointsRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReorderWaypointsRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourneyId() <= 0 {
+		return ReorderWaypointsRequestV1ValidationError{
+			field:  "JourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if l := len(m.GetWaypointIds()); l < 1 || l > 100 {
+		return ReorderWaypointsRequestV1ValidationError{
+			field:  "WaypointIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+	}
+
+	_ReorderWaypointsRequestV1_WaypointIds_Unique := make(map[uint64]struct{}, len(m.GetWaypointIds()))
+
+	for idx, item := range m.GetWaypointIds() {
+		_, _ = idx, item
+
+		if _, exists := _ReorderWaypointsRequestV1_WaypointIds_Unique[item]; exists {
+			return ReorderWaypointsRequestV1ValidationError{
+				field:  fmt.Sprintf("WaypointIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_ReorderWaypointsRequestV1_WaypointIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			return ReorderWaypointsRequestV1ValidationError{
+				field:  fmt.Sprintf("WaypointIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ReorderWaypointsRequestV1ValidationError is the validation error returned by
+// ReorderWaypointsRequestV1.Validate if the designated constraints aren't met.
+type ReorderWaypointsRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderWaypointsRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderWaypointsRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderWaypointsRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderWaypointsRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderWaypointsRequestV1ValidationError) ErrorName() string {
+	return "ReorderWaypointsRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderWaypointsRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderWaypointsRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderWaypointsRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderWaypointsRequestV1ValidationError{}
+
+// Validate checks the field values on ReorderWaypointsResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReorderWaypointsResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Revision
+
+	return nil
+}
+
+// ReorderWaypointsResponseV1ValidationError is the validation error returned
+// by ReorderWaypointsResponseV1.Validate if the designated constraints aren't met.
+type ReorderWaypointsResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderWaypointsResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderWaypointsResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderWaypointsResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderWaypointsResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderWaypointsResponseV1ValidationError) ErrorName() string {
+	return "ReorderWaypointsResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderWaypointsResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderWaypointsResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderWaypointsResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderWaypointsResponseV1ValidationError{}
+
+// Validate checks the field values on RemoveWaypointRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveWaypointRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourneyId() <= 0 {
+		return RemoveWaypointRequestV1ValidationError{
+			field:  "JourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetWaypointId() <= 0 {
+		return RemoveWaypointRequestV1ValidationError{
+			field:  "WaypointId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// RemoveWaypointRequestV1ValidationError is the validation error returned by
+// RemoveWaypointRequestV1.Validate if the designated constraints aren't met.
+type RemoveWaypointRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWaypointRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWaypointRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWaypointRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWaypointRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWaypointRequestV1ValidationError) ErrorName() string {
+	return "RemoveWaypointRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWaypointRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWaypointRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWaypointRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWaypointRequestV1ValidationError{}
+
+// Validate checks the field values on RemoveWaypointResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveWaypointResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Revision
+
+	return nil
+}
+
+// RemoveWaypointResponseV1ValidationError is the validation error returned by
+// RemoveWaypointResponseV1.Validate if the designated constraints aren't met.
+type RemoveWaypointResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWaypointResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWaypointResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWaypointResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWaypointResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWaypointResponseV1ValidationError) ErrorName() string {
+	return "RemoveWaypointResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWaypointResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWaypointResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWaypointResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWaypointResponseV1ValidationError{}
+
+// Validate checks the field values on Participant with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Participant) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	if v, ok := interface{}(m.GetInvitedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ParticipantValidationError{
+				field:  "InvitedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ParticipantValidationError is the validation error returned by
+// Participant.Validate if the designated constraints aren't met.
+type ParticipantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ParticipantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ParticipantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ParticipantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ParticipantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ParticipantValidationError) ErrorName() string { return "ParticipantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ParticipantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sParticipant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ParticipantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ParticipantValidationError{}
+
+// Validate checks the field values on InviteParticipantRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *InviteParticipantRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourneyId() <= 0 {
+		return InviteParticipantRequestV1ValidationError{
+			field:  "JourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetUserId() <= 0 {
+		return InviteParticipantRequestV1ValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if _, ok := _InviteParticipantRequestV1_Role_InLookup[m.GetRole()]; !ok {
+		return InviteParticipantRequestV1ValidationError{
+			field:  "Role",
+			reason: "value must be in list [1 2]",
+		}
+	}
+
+	return nil
+}
+
+// InviteParticipantRequestV1ValidationError is the validation error returned
+// by InviteParticipantRequestV1.Validate if the designated constraints aren't met.
+type InviteParticipantRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteParticipantRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteParticipantRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteParticipantRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteParticipantRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteParticipantRequestV1ValidationError) ErrorName() string {
+	return "InviteParticipantRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteParticipantRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteParticipantRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteParticipantRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteParticipantRequestV1ValidationError{}
+
+var _InviteParticipantRequestV1_Role_InLookup = map[ParticipantRole]struct{}{
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on InviteParticipantResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *InviteParticipantResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// InviteParticipantResponseV1ValidationError is the validation error returned
+// by InviteParticipantResponseV1.Validate if the designated constraints
+// aren't met.
+type InviteParticipantResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteParticipantResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteParticipantResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteParticipantResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteParticipantResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteParticipantResponseV1ValidationError) ErrorName() string {
+	return "InviteParticipantResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteParticipantResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteParticipantResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteParticipantResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteParticipantResponseV1ValidationError{}
+
+// Validate checks the field values on RemoveParticipantRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveParticipantRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourneyId() <= 0 {
+		return RemoveParticipantRequestV1ValidationError{
+			field:  "JourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetUserId() <= 0 {
+		return RemoveParticipantRequestV1ValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// RemoveParticipantRequestV1ValidationError is the validation error returned
+// by RemoveParticipantRequestV1.Validate if the designated constraints aren't met.
+type RemoveParticipantRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveParticipantRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveParticipantRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveParticipantRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveParticipantRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveParticipantRequestV1ValidationError) ErrorName() string {
+	return "RemoveParticipantRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveParticipantRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveParticipantRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveParticipantRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveParticipantRequestV1ValidationError{}
+
+// Validate checks the field values on RemoveParticipantResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveParticipantResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// RemoveParticipantResponseV1ValidationError is the validation error returned
+// by RemoveParticipantResponseV1.Validate if the designated constraints
+// aren't met.
+type RemoveParticipantResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveParticipantResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveParticipantResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveParticipantResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveParticipantResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveParticipantResponseV1ValidationError) ErrorName() string {
+	return "RemoveParticipantResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveParticipantResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveParticipantResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveParticipantResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveParticipantResponseV1ValidationError{}
+
+// Validate checks the field values on ListParticipantsRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListParticipantsRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourneyId() <= 0 {
+		return ListParticipantsRequestV1ValidationError{
+			field:  "JourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// ListParticipantsRequestV1ValidationError is the validation error returned by
+// ListParticipantsRequestV1.Validate if the designated constraints aren't met.
+type ListParticipantsRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListParticipantsRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListParticipantsRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListParticipantsRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListParticipantsRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListParticipantsRequestV1ValidationError) ErrorName() string {
+	return "ListParticipantsRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListParticipantsRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListParticipantsRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListParticipantsRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListParticipantsRequestV1ValidationError{}
+
+// Validate checks the field values on ListParticipantsResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListParticipantsResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetParticipants() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListParticipantsResponseV1ValidationError{
+					field:  fmt.Sprintf("Participants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListParticipantsResponseV1ValidationError is the validation error returned
+// by ListParticipantsResponseV1.Validate if the designated constraints aren't met.
+type ListParticipantsResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListParticipantsResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListParticipantsResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListParticipantsResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListParticipantsResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListParticipantsResponseV1ValidationError) ErrorName() string {
+	return "ListParticipantsResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListParticipantsResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListParticipantsResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListParticipantsResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListParticipantsResponseV1ValidationError{}
+
+// Validate checks the field values on DeletedJourney with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *DeletedJourney) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletedJourneyValidationError{
+				field:  "Journey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletedJourneyValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeletedJourneyValidationError is the validation error returned by
+// DeletedJourney.Validate if the designated constraints aren't met.
+type DeletedJourneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletedJourneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletedJourneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletedJourneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletedJourneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletedJourneyValidationError) ErrorName() string { return "DeletedJourneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeletedJourneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletedJourney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletedJourneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletedJourneyValidationError{}
+
+// Validate checks the field values on ListDeletedJourneysRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListDeletedJourneysRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetOffset() < 0 {
+		return ListDeletedJourneysRequestV1ValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	if m.GetLimit() <= 0 {
+		return ListDeletedJourneysRequestV1ValidationError{
+			field:  "Limit",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// ListDeletedJourneysRequestV1ValidationError is the validation error returned
+// by ListDeletedJourneysRequestV1.Validate if the designated constraints
+// aren't met.
+type ListDeletedJourneysRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedJourneysRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedJourneysRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedJourneysRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedJourneysRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedJourneysRequestV1ValidationError) ErrorName() string {
+	return "ListDeletedJourneysRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedJourneysRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedJourneysRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedJourneysRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedJourneysRequestV1ValidationError{}
+
+// Validate checks the field values on ListDeletedJourneysResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListDeletedJourneysResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetJourneys() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletedJourneysResponseV1ValidationError{
+					field:  fmt.Sprintf("Journeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListDeletedJourneysResponseV1ValidationError is the validation error
+// returned by ListDeletedJourneysResponseV1.Validate if the designated
+// constraints aren't met.
+type ListDeletedJourneysResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedJourneysResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedJourneysResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedJourneysResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedJourneysResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedJourneysResponseV1ValidationError) ErrorName() string {
+	return "ListDeletedJourneysResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedJourneysResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedJourneysResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedJourneysResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedJourneysResponseV1ValidationError{}
+
+// Validate checks the field values on JourneyTask with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *JourneyTask) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for OperationId
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Error
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JourneyTaskValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JourneyTaskValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// JourneyTaskValidationError is the validation error returned by
+// JourneyTask.Validate if the designated constraints aren't met.
+type JourneyTaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JourneyTaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JourneyTaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JourneyTaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JourneyTaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JourneyTaskValidationError) ErrorName() string { return "JourneyTaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JourneyTaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJourneyTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JourneyTaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JourneyTaskValidationError{}
+
+// Validate checks the field values on ExportJourneysRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportJourneysRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			return ExportJourneysRequestV1ValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetFromTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportJourneysRequestV1ValidationError{
+				field:  "FromTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetToTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportJourneysRequestV1ValidationError{
+				field:  "ToTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetText()) > 256 {
+		return ExportJourneysRequestV1ValidationError{
+			field:  "Text",
+			reason: "value length must be at most 256 runes",
+		}
+	}
+
+	return nil
+}
+
+// ExportJourneysRequestV1ValidationError is the validation error returned by
+// ExportJourneysRequestV1.Validate if the designated constraints aren't met.
+type ExportJourneysRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportJourneysRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportJourneysRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportJourneysRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportJourneysRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportJourneysRequestV1ValidationError) ErrorName() string {
+	return "ExportJourneysRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportJourneysRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportJourneysRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportJourneysRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportJourneysRequestV1ValidationError{}
+
+// Validate checks the field values on ExportJourneysResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportJourneysResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportJourneysResponseV1ValidationError{
+				field:  "Journey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExportJourneysResponseV1ValidationError is the validation error returned by
+// ExportJourneysResponseV1.Validate if the designated constraints aren't met.
+type ExportJourneysResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportJourneysResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportJourneysResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportJourneysResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportJourneysResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportJourneysResponseV1ValidationError) ErrorName() string {
+	return "ExportJourneysResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportJourneysResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportJourneysResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportJourneysResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportJourneysResponseV1ValidationError{}
+
+// Validate checks the field values on ImportJourneysRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportJourneysRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourney() == nil {
+		return ImportJourneysRequestV1ValidationError{
+			field:  "Journey",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJourneysRequestV1ValidationError{
+				field:  "Journey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ImportJourneysRequestV1ValidationError is the validation error returned by
+// ImportJourneysRequestV1.Validate if the designated constraints aren't met.
+type ImportJourneysRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJourneysRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJourneysRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJourneysRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJourneysRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJourneysRequestV1ValidationError) ErrorName() string {
+	return "ImportJourneysRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportJourneysRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJourneysRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJourneysRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJourneysRequestV1ValidationError{}
+
+// Validate checks the field values on JourneyResultV1 with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *JourneyResultV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Index
+
+	// no validation rules for JourneyId
+
+	// no validation rules for Code
+
+	// no validation rules for Error
+
+	// no validation rules for Revision
+
+	return nil
+}
+
+// JourneyResultV1ValidationError is the validation error returned by
+// JourneyResultV1.Validate if the designated constraints aren't met.
+type JourneyResultV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JourneyResultV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JourneyResultV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JourneyResultV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JourneyResultV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JourneyResultV1ValidationError) ErrorName() string { return "JourneyResultV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JourneyResultV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJourneyResultV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JourneyResultV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JourneyResultV1ValidationError{}
+
+// Validate checks the field values on MultiUpdateJourneyRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiUpdateJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetJourneys()) < 1 {
+		return MultiUpdateJourneyRequestV1ValidationError{
+			field:  "Journeys",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetJourneys() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiUpdateJourneyRequestV1ValidationError{
+					field:  fmt.Sprintf("Journeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiUpdateJourneyRequestV1ValidationError is the validation error returned
+// by MultiUpdateJourneyRequestV1.Validate if the designated constraints
+// aren't met.
+type MultiUpdateJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiUpdateJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiUpdateJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiUpdateJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiUpdateJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiUpdateJourneyRequestV1ValidationError) ErrorName() string {
+	return "MultiUpdateJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiUpdateJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiUpdateJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiUpdateJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiUpdateJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on MultiUpdateJourneyResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiUpdateJourneyResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiUpdateJourneyResponseV1ValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiUpdateJourneyResponseV1ValidationError is the validation error returned
+// by MultiUpdateJourneyResponseV1.Validate if the designated constraints
+// aren't met.
+type MultiUpdateJourneyResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiUpdateJourneyResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiUpdateJourneyResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiUpdateJourneyResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiUpdateJourneyResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiUpdateJourneyResponseV1ValidationError) ErrorName() string {
+	return "MultiUpdateJourneyResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiUpdateJourneyResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiUpdateJourneyResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiUpdateJourneyResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiUpdateJourneyResponseV1ValidationError{}
+
+// Validate checks the field values on MultiRemoveJourneyRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiRemoveJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetJourneyIds()) < 1 {
+		return MultiRemoveJourneyRequestV1ValidationError{
+			field:  "JourneyIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	_MultiRemoveJourneyRequestV1_JourneyIds_Unique := make(map[uint64]struct{}, len(m.GetJourneyIds()))
+
+	for idx, item := range m.GetJourneyIds() {
+		_, _ = idx, item
+
+		if _, exists := _MultiRemoveJourneyRequestV1_JourneyIds_Unique[item]; exists {
+			return MultiRemoveJourneyRequestV1ValidationError{
+				field:  fmt.Sprintf("JourneyIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_MultiRemoveJourneyRequestV1_JourneyIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			return MultiRemoveJourneyRequestV1ValidationError{
+				field:  fmt.Sprintf("JourneyIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiRemoveJourneyRequestV1ValidationError is the validation error returned
+// by MultiRemoveJourneyRequestV1.Validate if the designated constraints
+// aren't met.
+type MultiRemoveJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiRemoveJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiRemoveJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiRemoveJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiRemoveJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiRemoveJourneyRequestV1ValidationError) ErrorName() string {
+	return "MultiRemoveJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiRemoveJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiRemoveJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiRemoveJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiRemoveJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on MultiRemoveJourneyResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiRemoveJourneyResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiRemoveJourneyResponseV1ValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiRemoveJourneyResponseV1ValidationError is the validation error returned
+// by MultiRemoveJourneyResponseV1.Validate if the designated constraints
+// aren't met.
+type MultiRemoveJourneyResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiRemoveJourneyResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiRemoveJourneyResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiRemoveJourneyResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiRemoveJourneyResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiRemoveJourneyResponseV1ValidationError) ErrorName() string {
+	return "MultiRemoveJourneyResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiRemoveJourneyResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiRemoveJourneyResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiRemoveJourneyResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiRemoveJourneyResponseV1ValidationError{}
+
+// Validate checks the field values on BatchGetJourneysRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchGetJourneysRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetJourneyIds()) < 1 {
+		return BatchGetJourneysRequestV1ValidationError{
+			field:  "JourneyIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	_BatchGetJourneysRequestV1_JourneyIds_Unique := make(map[uint64]struct{}, len(m.GetJourneyIds()))
+
+	for idx, item := range m.GetJourneyIds() {
+		_, _ = idx, item
+
+		if _, exists := _BatchGetJourneysRequestV1_JourneyIds_Unique[item]; exists {
+			return BatchGetJourneysRequestV1ValidationError{
+				field:  fmt.Sprintf("JourneyIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_BatchGetJourneysRequestV1_JourneyIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			return BatchGetJourneysRequestV1ValidationError{
+				field:  fmt.Sprintf("JourneyIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// BatchGetJourneysRequestV1ValidationError is the validation error returned by
+// BatchGetJourneysRequestV1.Validate if the designated constraints aren't met.
+type BatchGetJourneysRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetJourneysRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetJourneysRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetJourneysRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetJourneysRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetJourneysRequestV1ValidationError) ErrorName() string {
+	return "BatchGetJourneysRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetJourneysRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetJourneysRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetJourneysRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetJourneysRequestV1ValidationError{}
+
+// Validate checks the field values on BatchGetJourneysResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchGetJourneysResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetJourneys() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetJourneysResponseV1ValidationError{
+					field:  fmt.Sprintf("Journeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// BatchGetJourneysResponseV1ValidationError is the validation error returned
+// by BatchGetJourneysResponseV1.Validate if the designated constraints aren't met.
+type BatchGetJourneysResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetJourneysResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetJourneysResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetJourneysResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetJourneysResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetJourneysResponseV1ValidationError) ErrorName() string {
+	return "BatchGetJourneysResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetJourneysResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetJourneysResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetJourneysResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetJourneysResponseV1ValidationError{}
+
+// Validate checks the field values on SearchJourneysRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SearchJourneysRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 256 {
+		return SearchJourneysRequestV1ValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+	}
+
+	if m.GetOffset() < 0 {
+		return SearchJourneysRequestV1ValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	if m.GetLimit() <= 0 {
+		return SearchJourneysRequestV1ValidationError{
+			field:  "Limit",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			return SearchJourneysRequestV1ValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetFromTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchJourneysRequestV1ValidationError{
+				field:  "FromTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetToTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchJourneysRequestV1ValidationError{
+				field:  "ToTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// SearchJourneysRequestV1ValidationError is the validation error returned by
+// SearchJourneysRequestV1.Validate if the designated constraints aren't met.
+type SearchJourneysRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchJourneysRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchJourneysRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchJourneysRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchJourneysRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchJourneysRequestV1ValidationError) ErrorName() string {
+	return "SearchJourneysRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchJourneysRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchJourneysRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchJourneysRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchJourneysRequestV1ValidationError{}
+
+// Validate checks the field values on SearchJourneysResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SearchJourneysResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchJourneysResponseV1ValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SearchJourneysResponseV1ValidationError is the validation error returned by
+// SearchJourneysResponseV1.Validate if the designated constraints aren't met.
+type SearchJourneysResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchJourneysResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchJourneysResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchJourneysResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchJourneysResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchJourneysResponseV1ValidationError) ErrorName() string {
+	return "SearchJourneysResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchJourneysResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchJourneysResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchJourneysResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchJourneysResponseV1ValidationError{}
+
+// Validate checks the field values on FoundJourneyV1 with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *FoundJourneyV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FoundJourneyV1ValidationError{
+				field:  "Journey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rank
+
+	// no validation rules for Snippet
+
+	return nil
+}
+
+// FoundJourneyV1ValidationError is the validation error returned by
+// FoundJourneyV1.Validate if the designated constraints aren't met.
+type FoundJourneyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FoundJourneyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FoundJourneyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FoundJourneyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FoundJourneyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FoundJourneyV1ValidationError) ErrorName() string { return "FoundJourneyV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FoundJourneyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFoundJourneyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FoundJourneyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FoundJourneyV1ValidationError{}
+
+// Validate checks the field values on FindJourneysNearRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FindJourneysNearRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetPoint() == nil {
+		return FindJourneysNearRequestV1ValidationError{
+			field:  "Point",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetPoint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindJourneysNearRequestV1ValidationError{
+				field:  "Point",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetRadiusMeters(); val <= 0 || val > 2.0037509e+07 {
+		return FindJourneysNearRequestV1ValidationError{
+			field:  "RadiusMeters",
+			reason: "value must be inside range (0, 2.0037509e+07]",
+		}
+	}
+
+	if m.GetLimit() <= 0 {
+		return FindJourneysNearRequestV1ValidationError{
+			field:  "Limit",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			return FindJourneysNearRequestV1ValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetFromTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindJourneysNearRequestV1ValidationError{
+				field:  "FromTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetToTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindJourneysNearRequestV1ValidationError{
+				field:  "ToTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// FindJourneysNearRequestV1ValidationError is the validation error returned by
+// FindJourneysNearRequestV1.Validate if the designated constraints aren't met.
+type FindJourneysNearRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindJourneysNearRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindJourneysNearRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindJourneysNearRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindJourneysNearRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindJourneysNearRequestV1ValidationError) ErrorName() string {
+	return "FindJourneysNearRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindJourneysNearRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindJourneysNearRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindJourneysNearRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindJourneysNearRequestV1ValidationError{}
+
+// Validate checks the field values on FindJourneysNearResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FindJourneysNearResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetJourneys() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FindJourneysNearResponseV1ValidationError{
+					field:  fmt.Sprintf("Journeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// FindJourneysNearResponseV1ValidationError is the validation error returned
+// by FindJourneysNearResponseV1.Validate if the designated constraints aren't met.
+type FindJourneysNearResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindJourneysNearResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindJourneysNearResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindJourneysNearResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindJourneysNearResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindJourneysNearResponseV1ValidationError) ErrorName() string {
+	return "FindJourneysNearResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindJourneysNearResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindJourneysNearResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindJourneysNearResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindJourneysNearResponseV1ValidationError{}
+
+// Validate checks the field values on NearJourneyV1 with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *NearJourneyV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NearJourneyV1ValidationError{
+				field:  "Journey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DistanceMeters
+
+	return nil
+}
+
+// NearJourneyV1ValidationError is the validation error returned by
+// NearJourneyV1.Validate if the designated constraints aren't met.
+type NearJourneyV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NearJourneyV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NearJourneyV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NearJourneyV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NearJourneyV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NearJourneyV1ValidationError) ErrorName() string { return "NearJourneyV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NearJourneyV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNearJourneyV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NearJourneyV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NearJourneyV1ValidationError{}
+
+// Validate checks the field values on FindOverlappingJourneysRequestV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *FindOverlappingJourneysRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetUserId() <= 0 {
+		return FindOverlappingJourneysRequestV1ValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if v, ok := interface{}(m.GetFromTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindOverlappingJourneysRequestV1ValidationError{
+				field:  "FromTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetToTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FindOverlappingJourneysRequestV1ValidationError{
+				field:  "ToTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetLimit() <= 0 {
+		return FindOverlappingJourneysRequestV1ValidationError{
+			field:  "Limit",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// FindOverlappingJourneysRequestV1ValidationError is the validation error
+// returned by FindOverlappingJourneysRequestV1.Validate if the designated
+// constraints aren't met.
+type FindOverlappingJourneysRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindOverlappingJourneysRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindOverlappingJourneysRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindOverlappingJourneysRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindOverlappingJourneysRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindOverlappingJourneysRequestV1ValidationError) ErrorName() string {
+	return "FindOverlappingJourneysRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindOverlappingJourneysRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindOverlappingJourneysRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindOverlappingJourneysRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindOverlappingJourneysRequestV1ValidationError{}
+
+// Validate checks the field values on FindOverlappingJourneysResponseV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *FindOverlappingJourneysResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetOverlaps() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FindOverlappingJourneysResponseV1ValidationError{
+					field:  fmt.Sprintf("Overlaps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// FindOverlappingJourneysResponseV1ValidationError is the validation error
+// returned by FindOverlappingJourneysResponseV1.Validate if the designated
+// constraints aren't met.
+type FindOverlappingJourneysResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindOverlappingJourneysResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindOverlappingJourneysResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindOverlappingJourneysResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindOverlappingJourneysResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindOverlappingJourneysResponseV1ValidationError) ErrorName() string {
+	return "FindOverlappingJourneysResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindOverlappingJourneysResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindOverlappingJourneysResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindOverlappingJourneysResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindOverlappingJourneysResponseV1ValidationError{}
+
+// Validate checks the field values on JourneyOverlapV1 with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *JourneyOverlapV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFirst()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JourneyOverlapV1ValidationError{
+				field:  "First",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetSecond()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JourneyOverlapV1ValidationError{
+				field:  "Second",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JourneyOverlapV1ValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JourneyOverlapV1ValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// JourneyOverlapV1ValidationError is the validation error returned by
+// JourneyOverlapV1.Validate if the designated constraints aren't met.
+type JourneyOverlapV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JourneyOverlapV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JourneyOverlapV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JourneyOverlapV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JourneyOverlapV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JourneyOverlapV1ValidationError) ErrorName() string { return "JourneyOverlapV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JourneyOverlapV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJourneyOverlapV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JourneyOverlapV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JourneyOverlapV1ValidationError{}
+
+// Validate checks the field values on GetJourneyStatsRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetJourneyStatsRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	if v, ok := interface{}(m.GetFromTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJourneyStatsRequestV1ValidationError{
+				field:  "FromTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetToTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJourneyStatsRequestV1ValidationError{
+				field:  "ToTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTopAddressesLimit() > 100 {
+		return GetJourneyStatsRequestV1ValidationError{
+			field:  "TopAddressesLimit",
+			reason: "value must be less than or equal to 100",
+		}
+	}
+
+	return nil
+}
+
+// GetJourneyStatsRequestV1ValidationError is the validation error returned by
+// GetJourneyStatsRequestV1.Validate if the designated constraints aren't met.
+type GetJourneyStatsRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJourneyStatsRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJourneyStatsRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJourneyStatsRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJourneyStatsRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJourneyStatsRequestV1ValidationError) ErrorName() string {
+	return "GetJourneyStatsRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJourneyStatsRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJourneyStatsRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJourneyStatsRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJourneyStatsRequestV1ValidationError{}
+
+// Validate checks the field values on GetJourneyStatsResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetJourneyStatsResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Count
+
+	if v, ok := interface{}(m.GetTotalDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJourneyStatsResponseV1ValidationError{
+				field:  "TotalDuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetAverageDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJourneyStatsResponseV1ValidationError{
+				field:  "AverageDuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTopAddresses() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJourneyStatsResponseV1ValidationError{
+					field:  fmt.Sprintf("TopAddresses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMonths() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJourneyStatsResponseV1ValidationError{
+					field:  fmt.Sprintf("Months[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// GetJourneyStatsResponseV1ValidationError is the validation error returned by
+// GetJourneyStatsResponseV1.Validate if the designated constraints aren't met.
+type GetJourneyStatsResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJourneyStatsResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJourneyStatsResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJourneyStatsResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJourneyStatsResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJourneyStatsResponseV1ValidationError) ErrorName() string {
+	return "GetJourneyStatsResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJourneyStatsResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJourneyStatsResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJourneyStatsResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJourneyStatsResponseV1ValidationError{}
+
+// Validate checks the field values on AddressCountV1 with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AddressCountV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Address
+
+	// no validation rules for Count
+
+	return nil
+}
+
+// AddressCountV1ValidationError is the validation error returned by
+// AddressCountV1.Validate if the designated constraints aren't met.
+type AddressCountV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressCountV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressCountV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressCountV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressCountV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressCountV1ValidationError) ErrorName() string { return "AddressCountV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressCountV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddressCountV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressCountV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressCountV1ValidationError{}
+
+// Validate checks the field values on ListTagsRequestV1 with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListTagsRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetUserId() <= 0 {
+		return ListTagsRequestV1ValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// ListTagsRequestV1ValidationError is the validation error returned by
+// ListTagsRequestV1.Validate if the designated constraints aren't met.
+type ListTagsRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsRequestV1ValidationError) ErrorName() string {
+	return "ListTagsRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTagsRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsRequestV1ValidationError{}
+
+// Validate checks the field values on ListTagsResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListTagsResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTagsResponseV1ValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListTagsResponseV1ValidationError is the validation error returned by
+// ListTagsResponseV1.Validate if the designated constraints aren't met.
+type ListTagsResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsResponseV1ValidationError) ErrorName() string {
+	return "ListTagsResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTagsResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsResponseV1ValidationError{}
+
+// Validate checks the field values on TagCountV1 with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *TagCountV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Count
+
+	return nil
+}
+
+// TagCountV1ValidationError is the validation error returned by
+// TagCountV1.Validate if the designated constraints aren't met.
+type TagCountV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagCountV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagCountV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagCountV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagCountV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagCountV1ValidationError) ErrorName() string { return "TagCountV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagCountV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTagCountV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagCountV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagCountV1ValidationError{}
+
+// Validate checks the field values on GetCalendarFeedRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetCalendarFeedRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// GetCalendarFeedRequestV1ValidationError is the validation error returned by
+// GetCalendarFeedRequestV1.Validate if the designated constraints aren't met.
+type GetCalendarFeedRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCalendarFeedRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCalendarFeedRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCalendarFeedRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCalendarFeedRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCalendarFeedRequestV1ValidationError) ErrorName() string {
+	return "GetCalendarFeedRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCalendarFeedRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCalendarFeedRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCalendarFeedRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCalendarFeedRequestV1ValidationError{}
+
+// Validate checks the field values on GetCalendarFeedResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetCalendarFeedResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Path
+
+	// no validation rules for Token
+
+	return nil
+}
+
+// GetCalendarFeedResponseV1ValidationError is the validation error returned by
+// GetCalendarFeedResponseV1.Validate if the designated constraints aren't met.
+type GetCalendarFeedResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCalendarFeedResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCalendarFeedResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCalendarFeedResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCalendarFeedResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCalendarFeedResponseV1ValidationError) ErrorName() string {
+	return "GetCalendarFeedResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCalendarFeedResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCalendarFeedResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCalendarFeedResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCalendarFeedResponseV1ValidationError{}
+
+// Validate checks the field values on ChangeJourneyStatusRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ChangeJourneyStatusRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourneyId() <= 0 {
+		return ChangeJourneyStatusRequestV1ValidationError{
+			field:  "JourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for ExpectedRevision
+
+	return nil
+}
+
+// ChangeJourneyStatusRequestV1ValidationError is the validation error returned
+// by ChangeJourneyStatusRequestV1.Validate if the designated constraints
+// aren't met.
+type ChangeJourneyStatusRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeJourneyStatusRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeJourneyStatusRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeJourneyStatusRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeJourneyStatusRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeJourneyStatusRequestV1ValidationError) ErrorName() string {
+	return "ChangeJourneyStatusRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeJourneyStatusRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeJourneyStatusRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeJourneyStatusRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeJourneyStatusRequestV1ValidationError{}
+
+// Validate checks the field values on ChangeJourneyStatusResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ChangeJourneyStatusResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Revision
+
+	// no validation rules for Status
+
+	return nil
+}
+
+// ChangeJourneyStatusResponseV1ValidationError is the validation error
+// returned by ChangeJourneyStatusResponseV1.Validate if the designated
+// constraints aren't met.
+type ChangeJourneyStatusResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeJourneyStatusResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeJourneyStatusResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeJourneyStatusResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeJourneyStatusResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeJourneyStatusResponseV1ValidationError) ErrorName() string {
+	return "ChangeJourneyStatusResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeJourneyStatusResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeJourneyStatusResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeJourneyStatusResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeJourneyStatusResponseV1ValidationError{}
+
+// Validate checks the field values on RecurringJourney with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *RecurringJourney) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for RecurringJourneyId
+
+	if m.GetUserId() <= 0 {
+		return RecurringJourneyValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for Address
+
+	// no validation rules for Description
+
+	if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecurringJourneyValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecurringJourneyValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeZone
+
+	if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecurringJourneyValidationError{
+				field:  "Coordinates",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetRule()); l < 1 || l > 512 {
+		return RecurringJourneyValidationError{
+			field:  "Rule",
+			reason: "value length must be between 1 and 512 runes, inclusive",
+		}
+	}
+
+	// no validation rules for Revision
+
+	for idx, item := range m.GetExceptions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecurringJourneyValidationError{
+					field:  fmt.Sprintf("Exceptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// RecurringJourneyValidationError is the validation error returned by
+// RecurringJourney.Validate if the designated constraints aren't met.
+type RecurringJourneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecurringJourneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecurringJourneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecurringJourneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecurringJourneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecurringJourneyValidationError) ErrorName() string { return "RecurringJourneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecurringJourneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecurringJourney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecurringJourneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecurringJourneyValidationError{}
+
+// Validate checks the field values on OccurrenceException with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *OccurrenceException) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetOccurrenceTime() == nil {
+		return OccurrenceExceptionValidationError{
+			field:  "OccurrenceTime",
+			reason: "value is required",
+		}
+	}
+
+	// no validation rules for Cancelled
+
+	// no validation rules for Address
+
+	// no validation rules for Description
+
+	if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OccurrenceExceptionValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OccurrenceExceptionValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// OccurrenceExceptionValidationError is the validation error returned by
+// OccurrenceException.Validate if the designated constraints aren't met.
+type OccurrenceExceptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OccurrenceExceptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OccurrenceExceptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OccurrenceExceptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OccurrenceExceptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OccurrenceExceptionValidationError) ErrorName() string {
+	return "OccurrenceExceptionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OccurrenceExceptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOccurrenceException.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OccurrenceExceptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OccurrenceExceptionValidationError{}
+
+// Validate checks the field values on CreateRecurringJourneyRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateRecurringJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetRecurringJourney() == nil {
+		return CreateRecurringJourneyRequestV1ValidationError{
+			field:  "RecurringJourney",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetRecurringJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRecurringJourneyRequestV1ValidationError{
+				field:  "RecurringJourney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateRecurringJourneyRequestV1ValidationError is the validation error
+// returned by CreateRecurringJourneyRequestV1.Validate if the designated
+// constraints aren't met.
+type CreateRecurringJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRecurringJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRecurringJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRecurringJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRecurringJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRecurringJourneyRequestV1ValidationError) ErrorName() string {
+	return "CreateRecurringJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRecurringJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRecurringJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRecurringJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRecurringJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on CreateRecurringJourneyResponseV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CreateRecurringJourneyResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for RecurringJourneyId
+
+	return nil
+}
+
+// CreateRecurringJourneyResponseV1ValidationError is the validation error
+// returned by CreateRecurringJourneyResponseV1.Validate if the designated
+// constraints aren't met.
+type CreateRecurringJourneyResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRecurringJourneyResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRecurringJourneyResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRecurringJourneyResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRecurringJourneyResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRecurringJourneyResponseV1ValidationError) ErrorName() string {
+	return "CreateRecurringJourneyResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRecurringJourneyResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRecurringJourneyResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRecurringJourneyResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRecurringJourneyResponseV1ValidationError{}
+
+// Validate checks the field values on DescribeRecurringJourneyRequestV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *DescribeRecurringJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetRecurringJourneyId() <= 0 {
+		return DescribeRecurringJourneyRequestV1ValidationError{
+			field:  "RecurringJourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// DescribeRecurringJourneyRequestV1ValidationError is the validation error
+// returned by DescribeRecurringJourneyRequestV1.Validate if the designated
+// constraints aren't met.
+type DescribeRecurringJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeRecurringJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeRecurringJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeRecurringJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeRecurringJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeRecurringJourneyRequestV1ValidationError) ErrorName() string {
+	return "DescribeRecurringJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeRecurringJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeRecurringJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeRecurringJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeRecurringJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on DescribeRecurringJourneyResponseV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *DescribeRecurringJourneyResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetRecurringJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeRecurringJourneyResponseV1ValidationError{
+				field:  "RecurringJourney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// DescribeRecurringJourneyResponseV1ValidationError is the validation error
+// returned by DescribeRecurringJourneyResponseV1.Validate if the designated
+// constraints aren't met.
+type DescribeRecurringJourneyResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeRecurringJourneyResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeRecurringJourneyResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeRecurringJourneyResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeRecurringJourneyResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeRecurringJourneyResponseV1ValidationError) ErrorName() string {
+	return "DescribeRecurringJourneyResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeRecurringJourneyResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeRecurringJourneyResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeRecurringJourneyResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeRecurringJourneyResponseV1ValidationError{}
+
+// Validate checks the field values on UpdateRecurringJourneyRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateRecurringJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetRecurringJourney() == nil {
+		return UpdateRecurringJourneyRequestV1ValidationError{
+			field:  "RecurringJourney",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetRecurringJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRecurringJourneyRequestV1ValidationError{
+				field:  "RecurringJourney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpectedRevision
+
+	return nil
+}
+
+// UpdateRecurringJourneyRequestV1ValidationError is the validation error
+// returned by UpdateRecurringJourneyRequestV1.Validate if the designated
+// constraints aren't met.
+type UpdateRecurringJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRecurringJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRecurringJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRecurringJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRecurringJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRecurringJourneyRequestV1ValidationError) ErrorName() string {
+	return "UpdateRecurringJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRecurringJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRecurringJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRecurringJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRecurringJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on UpdateRecurringJourneyResponseV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *UpdateRecurringJourneyResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Revision
+
+	return nil
+}
+
+// UpdateRecurringJourneyResponseV1ValidationError is the validation error
+// returned by UpdateRecurringJourneyResponseV1.Validate if the designated
+// constraints aren't met.
+type UpdateRecurringJourneyResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRecurringJourneyResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRecurringJourneyResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRecurringJourneyResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRecurringJourneyResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRecurringJourneyResponseV1ValidationError) ErrorName() string {
+	return "UpdateRecurringJourneyResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRecurringJourneyResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRecurringJourneyResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRecurringJourneyResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRecurringJourneyResponseV1ValidationError{}
+
+// Validate checks the field values on RemoveRecurringJourneyRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveRecurringJourneyRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetRecurringJourneyId() <= 0 {
+		return RemoveRecurringJourneyRequestV1ValidationError{
+			field:  "RecurringJourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for ExpectedRevision
+
+	return nil
+}
+
+// RemoveRecurringJourneyRequestV1ValidationError is the validation error
+// returned by RemoveRecurringJourneyRequestV1.Validate if the designated
+// constraints aren't met.
+type RemoveRecurringJourneyRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveRecurringJourneyRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveRecurringJourneyRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveRecurringJourneyRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveRecurringJourneyRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveRecurringJourneyRequestV1ValidationError) ErrorName() string {
+	return "RemoveRecurringJourneyRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveRecurringJourneyRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveRecurringJourneyRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveRecurringJourneyRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveRecurringJourneyRequestV1ValidationError{}
+
+// Validate checks the field values on UpdateOccurrenceRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateOccurrenceRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetRecurringJourneyId() <= 0 {
+		return UpdateOccurrenceRequestV1ValidationError{
+			field:  "RecurringJourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetException() == nil {
+		return UpdateOccurrenceRequestV1ValidationError{
+			field:  "Exception",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetException()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOccurrenceRequestV1ValidationError{
+				field:  "Exception",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpectedRevision
+
+	return nil
+}
+
+// UpdateOccurrenceRequestV1ValidationError is the validation error returned by
+// UpdateOccurrenceRequestV1.Validate if the designated constraints aren't met.
+type UpdateOccurrenceRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOccurrenceRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOccurrenceRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOccurrenceRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOccurrenceRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOccurrenceRequestV1ValidationError) ErrorName() string {
+	return "UpdateOccurrenceRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOccurrenceRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOccurrenceRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOccurrenceRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOccurrenceRequestV1ValidationError{}
+
+// Validate checks the field values on UpdateOccurrenceResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateOccurrenceResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Revision
+
+	return nil
+}
+
+// UpdateOccurrenceResponseV1ValidationError is the validation error returned
+// by UpdateOccurrenceResponseV1.Validate if the designated constraints aren't met.
+type UpdateOccurrenceResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOccurrenceResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOccurrenceResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOccurrenceResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOccurrenceResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOccurrenceResponseV1ValidationError) ErrorName() string {
+	return "UpdateOccurrenceResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOccurrenceResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOccurrenceResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOccurrenceResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOccurrenceResponseV1ValidationError{}
+
+// Validate checks the field values on ListOccurrencesRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListOccurrencesRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			return ListOccurrencesRequestV1ValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	if m.GetFromTime() == nil {
+		return ListOccurrencesRequestV1ValidationError{
+			field:  "FromTime",
+			reason: "value is required",
+		}
+	}
+
+	if m.GetToTime() == nil {
+		return ListOccurrencesRequestV1ValidationError{
+			field:  "ToTime",
+			reason: "value is required",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetText()) > 256 {
+		return ListOccurrencesRequestV1ValidationError{
+			field:  "Text",
+			reason: "value length must be at most 256 runes",
+		}
+	}
+
+	return nil
+}
+
+// ListOccurrencesRequestV1ValidationError is the validation error returned by
+// ListOccurrencesRequestV1.Validate if the designated constraints aren't met.
+type ListOccurrencesRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOccurrencesRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOccurrencesRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOccurrencesRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOccurrencesRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOccurrencesRequestV1ValidationError) ErrorName() string {
+	return "ListOccurrencesRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOccurrencesRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOccurrencesRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOccurrencesRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOccurrencesRequestV1ValidationError{}
+
+// Validate checks the field values on ListOccurrencesResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListOccurrencesResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetOccurrences() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOccurrencesResponseV1ValidationError{
+					field:  fmt.Sprintf("Occurrences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListOccurrencesResponseV1ValidationError is the validation error returned by
+// ListOccurrencesResponseV1.Validate if the designated constraints aren't met.
+type ListOccurrencesResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOccurrencesResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOccurrencesResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOccurrencesResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOccurrencesResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOccurrencesResponseV1ValidationError) ErrorName() string {
+	return "ListOccurrencesResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOccurrencesResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOccurrencesResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOccurrencesResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOccurrencesResponseV1ValidationError{}
+
+// Validate checks the field values on MonthCountV1 with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *MonthCountV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Year
+
+	// no validation rules for Month
+
+	// no validation rules for Count
+
+	return nil
+}
+
+// MonthCountV1ValidationError is the validation error returned by
+// MonthCountV1.Validate if the designated constraints aren't met.
+type MonthCountV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MonthCountV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MonthCountV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MonthCountV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MonthCountV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MonthCountV1ValidationError) ErrorName() string { return "MonthCountV1ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MonthCountV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMonthCountV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MonthCountV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MonthCountV1ValidationError{}
+
+// Validate checks the field values on WatchJourneysRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *WatchJourneysRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	_WatchJourneysRequestV1_UserIds_Unique := make(map[uint64]struct{}, len(m.GetUserIds()))
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if _, exists := _WatchJourneysRequestV1_UserIds_Unique[item]; exists {
+			return WatchJourneysRequestV1ValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_WatchJourneysRequestV1_UserIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			return WatchJourneysRequestV1ValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	// no validation rules for ResumeToken
+
+	return nil
+}
+
+// WatchJourneysRequestV1ValidationError is the validation error returned by
+// WatchJourneysRequestV1.Validate if the designated constraints aren't met.
+type WatchJourneysRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchJourneysRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchJourneysRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchJourneysRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchJourneysRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchJourneysRequestV1ValidationError) ErrorName() string {
+	return "WatchJourneysRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchJourneysRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchJourneysRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchJourneysRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchJourneysRequestV1ValidationError{}
+
+// Validate checks the field values on JourneyEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *JourneyEvent) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Type
+
+	// no validation rules for JourneyId
+
+	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JourneyEventValidationError{
+				field:  "Journey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// JourneyEventValidationError is the validation error returned by
+// JourneyEvent.Validate if the designated constraints aren't met.
+type JourneyEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JourneyEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JourneyEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JourneyEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JourneyEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JourneyEventValidationError) ErrorName() string { return "JourneyEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JourneyEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJourneyEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JourneyEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JourneyEventValidationError{}
+
+// Validate checks the field values on WatchJourneysResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *WatchJourneysResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchJourneysResponseV1ValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResumeToken
+
+	return nil
+}
+
+// WatchJourneysResponseV1ValidationError is the validation error returned by
+// WatchJourneysResponseV1.Validate if the designated constraints aren't met.
+type WatchJourneysResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchJourneysResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchJourneysResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchJourneysResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchJourneysResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchJourneysResponseV1ValidationError) ErrorName() string {
+	return "WatchJourneysResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchJourneysResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchJourneysResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchJourneysResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchJourneysResponseV1ValidationError{}
+
+// Validate checks the field values on ImportJourneysResponseV1 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportJourneysResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Imported
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportJourneysResponseV1ValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ImportJourneysResponseV1ValidationError is the validation error returned by
+// ImportJourneysResponseV1.Validate if the designated constraints aren't met.
+type ImportJourneysResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJourneysResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJourneysResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJourneysResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJourneysResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJourneysResponseV1ValidationError) ErrorName() string {
+	return "ImportJourneysResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportJourneysResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJourneysResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJourneysResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJourneysResponseV1ValidationError{}
+
+// Validate checks the field values on CreateJourneyTaskRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateJourneyTaskRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetUserId() <= 0 {
+		return CreateJourneyTaskRequestV1ValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for Address
+
+	// no validation rules for Description
+
+	if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJourneyTaskRequestV1ValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJourneyTaskRequestV1ValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeZone
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		return CreateJourneyTaskRequestV1ValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+	}
+
+	if v, ok := interface{}(m.GetCoordinates()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJourneyTaskRequestV1ValidationError{
+				field:  "Coordinates",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetWaypoints()) > 100 {
+		return CreateJourneyTaskRequestV1ValidationError{
+			field:  "Waypoints",
+			reason: "value must contain no more than 100 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetWaypoints() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateJourneyTaskRequestV1ValidationError{
+					field:  fmt.Sprintf("Waypoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetTags()) > 20 {
+		return CreateJourneyTaskRequestV1ValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 32 {
+			return CreateJourneyTaskRequestV1ValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+		}
+
+	}
+
+	if _, ok := OverlapPolicy_name[int32(m.GetOverlapPolicy())]; !ok {
+		return CreateJourneyTaskRequestV1ValidationError{
+			field:  "OverlapPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	return nil
+}
+
+// CreateJourneyTaskRequestV1ValidationError is the validation error returned
+// by CreateJourneyTaskRequestV1.Validate if the designated constraints aren't met.
+type CreateJourneyTaskRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJourneyTaskRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJourneyTaskRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJourneyTaskRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJourneyTaskRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJourneyTaskRequestV1ValidationError) ErrorName() string {
+	return "CreateJourneyTaskRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJourneyTaskRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJourneyTaskRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJourneyTaskRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJourneyTaskRequestV1ValidationError{}
+
+// Validate checks the field values on RemoveJourneyTaskRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveJourneyTaskRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourneyId() <= 0 {
+		return RemoveJourneyTaskRequestV1ValidationError{
+			field:  "JourneyId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	return nil
+}
+
+// RemoveJourneyTaskRequestV1ValidationError is the validation error returned
+// by RemoveJourneyTaskRequestV1.Validate if the designated constraints aren't met.
+type RemoveJourneyTaskRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveJourneyTaskRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveJourneyTaskRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveJourneyTaskRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveJourneyTaskRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveJourneyTaskRequestV1ValidationError) ErrorName() string {
+	return "RemoveJourneyTaskRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveJourneyTaskRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveJourneyTaskRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveJourneyTaskRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveJourneyTaskRequestV1ValidationError{}
+
+// Validate checks the field values on MultiCreateJourneyTaskRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiCreateJourneyTaskRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetJourneys()) < 1 {
+		return MultiCreateJourneyTaskRequestV1ValidationError{
+			field:  "Journeys",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetJourneys() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiCreateJourneyTaskRequestV1ValidationError{
+					field:  fmt.Sprintf("Journeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		return MultiCreateJourneyTaskRequestV1ValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+	}
+
+	return nil
+}
+
+// MultiCreateJourneyTaskRequestV1ValidationError is the validation error
+// returned by MultiCreateJourneyTaskRequestV1.Validate if the designated
+// constraints aren't met.
+type MultiCreateJourneyTaskRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiCreateJourneyTaskRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiCreateJourneyTaskRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiCreateJourneyTaskRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiCreateJourneyTaskRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiCreateJourneyTaskRequestV1ValidationError) ErrorName() string {
+	return "MultiCreateJourneyTaskRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiCreateJourneyTaskRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiCreateJourneyTaskRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiCreateJourneyTaskRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiCreateJourneyTaskRequestV1ValidationError{}
+
+// Validate checks the field values on UpdateJourneyTaskRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateJourneyTaskRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetJourney() == nil {
+		return UpdateJourneyTaskRequestV1ValidationError{
+			field:  "Journey",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateJourneyTaskRequestV1ValidationError{
+				field:  "Journey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := OverlapPolicy_name[int32(m.GetOverlapPolicy())]; !ok {
+		return UpdateJourneyTaskRequestV1ValidationError{
+			field:  "OverlapPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	return nil
+}
+
+// UpdateJourneyTaskRequestV1ValidationError is the validation error returned
+// by UpdateJourneyTaskRequestV1.Validate if the designated constraints aren't met.
+type UpdateJourneyTaskRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateJourneyTaskRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateJourneyTaskRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateJourneyTaskRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateJourneyTaskRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateJourneyTaskRequestV1ValidationError) ErrorName() string {
+	return "UpdateJourneyTaskRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateJourneyTaskRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateJourneyTaskRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateJourneyTaskRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateJourneyTaskRequestV1ValidationError{}
+
+// Validate checks the field values on MultiUpdateJourneyTaskRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiUpdateJourneyTaskRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetJourneys()) < 1 {
+		return MultiUpdateJourneyTaskRequestV1ValidationError{
+			field:  "Journeys",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetJourneys() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiUpdateJourneyTaskRequestV1ValidationError{
+					field:  fmt.Sprintf("Journeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiUpdateJourneyTaskRequestV1ValidationError is the validation error
+// returned by MultiUpdateJourneyTaskRequestV1.Validate if the designated
+// constraints aren't met.
+type MultiUpdateJourneyTaskRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiUpdateJourneyTaskRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiUpdateJourneyTaskRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiUpdateJourneyTaskRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiUpdateJourneyTaskRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiUpdateJourneyTaskRequestV1ValidationError) ErrorName() string {
+	return "MultiUpdateJourneyTaskRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiUpdateJourneyTaskRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiUpdateJourneyTaskRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiUpdateJourneyTaskRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiUpdateJourneyTaskRequestV1ValidationError{}
+
+// Validate checks the field values on MultiUpdateJourneyTaskResponseV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MultiUpdateJourneyTaskResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for OperationId
+
+	return nil
+}
+
+// MultiUpdateJourneyTaskResponseV1ValidationError is the validation error
+// returned by MultiUpdateJourneyTaskResponseV1.Validate if the designated
+// constraints aren't met.
+type MultiUpdateJourneyTaskResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiUpdateJourneyTaskResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiUpdateJourneyTaskResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiUpdateJourneyTaskResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiUpdateJourneyTaskResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiUpdateJourneyTaskResponseV1ValidationError) ErrorName() string {
+	return "MultiUpdateJourneyTaskResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiUpdateJourneyTaskResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiUpdateJourneyTaskResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiUpdateJourneyTaskResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiUpdateJourneyTaskResponseV1ValidationError{}
+
+// Validate checks the field values on MultiRemoveJourneyTaskRequestV1 with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MultiRemoveJourneyTaskRequestV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetJourneyIds()) < 1 {
+		return MultiRemoveJourneyTaskRequestV1ValidationError{
+			field:  "JourneyIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	_MultiRemoveJourneyTaskRequestV1_JourneyIds_Unique := make(map[uint64]struct{}, len(m.GetJourneyIds()))
+
+	for idx, item := range m.GetJourneyIds() {
+		_, _ = idx, item
+
+		if _, exists := _MultiRemoveJourneyTaskRequestV1_JourneyIds_Unique[item]; exists {
+			return MultiRemoveJourneyTaskRequestV1ValidationError{
+				field:  fmt.Sprintf("JourneyIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_MultiRemoveJourneyTaskRequestV1_JourneyIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			return MultiRemoveJourneyTaskRequestV1ValidationError{
+				field:  fmt.Sprintf("JourneyIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MultiRemoveJourneyTaskRequestV1ValidationError is the validation error
+// returned by MultiRemoveJourneyTaskRequestV1.Validate if the designated
+// constraints aren't met.
+type MultiRemoveJourneyTaskRequestV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiRemoveJourneyTaskRequestV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiRemoveJourneyTaskRequestV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiRemoveJourneyTaskRequestV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiRemoveJourneyTaskRequestV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiRemoveJourneyTaskRequestV1ValidationError) ErrorName() string {
+	return "MultiRemoveJourneyTaskRequestV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiRemoveJourneyTaskRequestV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiRemoveJourneyTaskRequestV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiRemoveJourneyTaskRequestV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiRemoveJourneyTaskRequestV1ValidationError{}
+
+// Validate checks the field values on MultiRemoveJourneyTaskResponseV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MultiRemoveJourneyTaskResponseV1) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for OperationId
+
+	return nil
+}
+
+// MultiRemoveJourneyTaskResponseV1ValidationError is the validation error
+// returned by MultiRemoveJourneyTaskResponseV1.Validate if the designated
+// constraints aren't met.
+type MultiRemoveJourneyTaskResponseV1ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiRemoveJourneyTaskResponseV1ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiRemoveJourneyTaskResponseV1ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiRemoveJourneyTaskResponseV1ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiRemoveJourneyTaskResponseV1ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiRemoveJourneyTaskResponseV1ValidationError) ErrorName() string {
+	return "MultiRemoveJourneyTaskResponseV1ValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiRemoveJourneyTaskResponseV1ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiRemoveJourneyTaskResponseV1.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiRemoveJourneyTaskResponseV1ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiRemoveJourneyTaskResponseV1ValidationError{}
 
-// Validate checks the field values on RemoveJourneyRequestV1 with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateJourneyTaskResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *RemoveJourneyRequestV1) Validate() error {
+func (m *CreateJourneyTaskResponseV1) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetJourneyId() <= 0 {
-		return RemoveJourneyRequestV1ValidationError{
-			field:  "JourneyId",
-			reason: "value must be greater than 0",
-		}
-	}
+	// no validation rules for OperationId
 
 	return nil
 }
 
-// RemoveJourneyRequestV1ValidationError is the validation error returned by
-// RemoveJourneyRequestV1.Validate if the designated constraints aren't met.
-type RemoveJourneyRequestV1ValidationError struct {
+// CreateJourneyTaskResponseV1ValidationError is the validation error returned
+// by CreateJourneyTaskResponseV1.Validate if the designated constraints
+// aren't met.
+type CreateJourneyTaskResponseV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -639,24 +7968,24 @@ type RemoveJourneyRequestV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveJourneyRequestV1ValidationError) Field() string { return e.field }
+func (e CreateJourneyTaskResponseV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveJourneyRequestV1ValidationError) Reason() string { return e.reason }
+func (e CreateJourneyTaskResponseV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveJourneyRequestV1ValidationError) Cause() error { return e.cause }
+func (e CreateJourneyTaskResponseV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveJourneyRequestV1ValidationError) Key() bool { return e.key }
+func (e CreateJourneyTaskResponseV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveJourneyRequestV1ValidationError) ErrorName() string {
-	return "RemoveJourneyRequestV1ValidationError"
+func (e CreateJourneyTaskResponseV1ValidationError) ErrorName() string {
+	return "CreateJourneyTaskResponseV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveJourneyRequestV1ValidationError) Error() string {
+func (e CreateJourneyTaskResponseV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -668,14 +7997,14 @@ func (e RemoveJourneyRequestV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveJourneyRequestV1.%s: %s%s",
+		"invalid %sCreateJourneyTaskResponseV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveJourneyRequestV1ValidationError{}
+var _ error = CreateJourneyTaskResponseV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -683,45 +8012,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveJourneyRequestV1ValidationError{}
+} = CreateJourneyTaskResponseV1ValidationError{}
 
-// Validate checks the field values on MultiCreateJourneyRequestV1 with the
+// Validate checks the field values on RemoveJourneyTaskResponseV1 with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MultiCreateJourneyRequestV1) Validate() error {
+func (m *RemoveJourneyTaskResponseV1) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if len(m.GetJourneys()) < 1 {
-		return MultiCreateJourneyRequestV1ValidationError{
-			field:  "Journeys",
-			reason: "value must contain at least 1 item(s)",
-		}
-	}
-
-	for idx, item := range m.GetJourneys() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MultiCreateJourneyRequestV1ValidationError{
-					field:  fmt.Sprintf("Journeys[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for OperationId
 
 	return nil
 }
 
-// MultiCreateJourneyRequestV1ValidationError is the validation error returned
-// by MultiCreateJourneyRequestV1.Validate if the designated constraints
+// RemoveJourneyTaskResponseV1ValidationError is the validation error returned
+// by RemoveJourneyTaskResponseV1.Validate if the designated constraints
 // aren't met.
-type MultiCreateJourneyRequestV1ValidationError struct {
+type RemoveJourneyTaskResponseV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -729,24 +8038,24 @@ type MultiCreateJourneyRequestV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e MultiCreateJourneyRequestV1ValidationError) Field() string { return e.field }
+func (e RemoveJourneyTaskResponseV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MultiCreateJourneyRequestV1ValidationError) Reason() string { return e.reason }
+func (e RemoveJourneyTaskResponseV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MultiCreateJourneyRequestV1ValidationError) Cause() error { return e.cause }
+func (e RemoveJourneyTaskResponseV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MultiCreateJourneyRequestV1ValidationError) Key() bool { return e.key }
+func (e RemoveJourneyTaskResponseV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MultiCreateJourneyRequestV1ValidationError) ErrorName() string {
-	return "MultiCreateJourneyRequestV1ValidationError"
+func (e RemoveJourneyTaskResponseV1ValidationError) ErrorName() string {
+	return "RemoveJourneyTaskResponseV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MultiCreateJourneyRequestV1ValidationError) Error() string {
+func (e RemoveJourneyTaskResponseV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -758,14 +8067,14 @@ func (e MultiCreateJourneyRequestV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMultiCreateJourneyRequestV1.%s: %s%s",
+		"invalid %sRemoveJourneyTaskResponseV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MultiCreateJourneyRequestV1ValidationError{}
+var _ error = RemoveJourneyTaskResponseV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -773,23 +8082,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MultiCreateJourneyRequestV1ValidationError{}
+} = RemoveJourneyTaskResponseV1ValidationError{}
 
-// Validate checks the field values on MultiCreateJourneyResponseV1 with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MultiCreateJourneyResponseV1) Validate() error {
+// Validate checks the field values on MultiCreateJourneyTaskResponseV1 with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MultiCreateJourneyTaskResponseV1) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	// no validation rules for OperationId
+
 	return nil
 }
 
-// MultiCreateJourneyResponseV1ValidationError is the validation error returned
-// by MultiCreateJourneyResponseV1.Validate if the designated constraints
-// aren't met.
-type MultiCreateJourneyResponseV1ValidationError struct {
+// MultiCreateJourneyTaskResponseV1ValidationError is the validation error
+// returned by MultiCreateJourneyTaskResponseV1.Validate if the designated
+// constraints aren't met.
+type MultiCreateJourneyTaskResponseV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -797,24 +8108,24 @@ type MultiCreateJourneyResponseV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e MultiCreateJourneyResponseV1ValidationError) Field() string { return e.field }
+func (e MultiCreateJourneyTaskResponseV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MultiCreateJourneyResponseV1ValidationError) Reason() string { return e.reason }
+func (e MultiCreateJourneyTaskResponseV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MultiCreateJourneyResponseV1ValidationError) Cause() error { return e.cause }
+func (e MultiCreateJourneyTaskResponseV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MultiCreateJourneyResponseV1ValidationError) Key() bool { return e.key }
+func (e MultiCreateJourneyTaskResponseV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MultiCreateJourneyResponseV1ValidationError) ErrorName() string {
-	return "MultiCreateJourneyResponseV1ValidationError"
+func (e MultiCreateJourneyTaskResponseV1ValidationError) ErrorName() string {
+	return "MultiCreateJourneyTaskResponseV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MultiCreateJourneyResponseV1ValidationError) Error() string {
+func (e MultiCreateJourneyTaskResponseV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -826,14 +8137,14 @@ func (e MultiCreateJourneyResponseV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMultiCreateJourneyResponseV1.%s: %s%s",
+		"invalid %sMultiCreateJourneyTaskResponseV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MultiCreateJourneyResponseV1ValidationError{}
+var _ error = MultiCreateJourneyTaskResponseV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -841,39 +8152,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MultiCreateJourneyResponseV1ValidationError{}
+} = MultiCreateJourneyTaskResponseV1ValidationError{}
 
-// Validate checks the field values on UpdateJourneyRequestV1 with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateJourneyTaskResponseV1 with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateJourneyRequestV1) Validate() error {
+func (m *UpdateJourneyTaskResponseV1) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetJourney() == nil {
-		return UpdateJourneyRequestV1ValidationError{
-			field:  "Journey",
-			reason: "value is required",
-		}
-	}
-
-	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateJourneyRequestV1ValidationError{
-				field:  "Journey",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for OperationId
 
 	return nil
 }
 
-// UpdateJourneyRequestV1ValidationError is the validation error returned by
-// UpdateJourneyRequestV1.Validate if the designated constraints aren't met.
-type UpdateJourneyRequestV1ValidationError struct {
+// UpdateJourneyTaskResponseV1ValidationError is the validation error returned
+// by UpdateJourneyTaskResponseV1.Validate if the designated constraints
+// aren't met.
+type UpdateJourneyTaskResponseV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -881,24 +8178,24 @@ type UpdateJourneyRequestV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJourneyRequestV1ValidationError) Field() string { return e.field }
+func (e UpdateJourneyTaskResponseV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJourneyRequestV1ValidationError) Reason() string { return e.reason }
+func (e UpdateJourneyTaskResponseV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJourneyRequestV1ValidationError) Cause() error { return e.cause }
+func (e UpdateJourneyTaskResponseV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJourneyRequestV1ValidationError) Key() bool { return e.key }
+func (e UpdateJourneyTaskResponseV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJourneyRequestV1ValidationError) ErrorName() string {
-	return "UpdateJourneyRequestV1ValidationError"
+func (e UpdateJourneyTaskResponseV1ValidationError) ErrorName() string {
+	return "UpdateJourneyTaskResponseV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateJourneyRequestV1ValidationError) Error() string {
+func (e UpdateJourneyTaskResponseV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -910,14 +8207,14 @@ func (e UpdateJourneyRequestV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJourneyRequestV1.%s: %s%s",
+		"invalid %sUpdateJourneyTaskResponseV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJourneyRequestV1ValidationError{}
+var _ error = UpdateJourneyTaskResponseV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -925,53 +8222,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJourneyRequestV1ValidationError{}
+} = UpdateJourneyTaskResponseV1ValidationError{}
 
-// Validate checks the field values on CreateJourneyTaskRequestV1 with the
+// Validate checks the field values on GetJourneyTaskStatusRequestV1 with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CreateJourneyTaskRequestV1) Validate() error {
+func (m *GetJourneyTaskStatusRequestV1) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetUserId() <= 0 {
-		return CreateJourneyTaskRequestV1ValidationError{
-			field:  "UserId",
+	if m.GetOperationId() <= 0 {
+		return GetJourneyTaskStatusRequestV1ValidationError{
+			field:  "OperationId",
 			reason: "value must be greater than 0",
 		}
 	}
 
-	// no validation rules for Address
-
-	// no validation rules for Description
-
-	if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJourneyTaskRequestV1ValidationError{
-				field:  "StartTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateJourneyTaskRequestV1ValidationError{
-				field:  "EndTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	return nil
 }
 
-// CreateJourneyTaskRequestV1ValidationError is the validation error returned
-// by CreateJourneyTaskRequestV1.Validate if the designated constraints aren't met.
-type CreateJourneyTaskRequestV1ValidationError struct {
+// GetJourneyTaskStatusRequestV1ValidationError is the validation error
+// returned by GetJourneyTaskStatusRequestV1.Validate if the designated
+// constraints aren't met.
+type GetJourneyTaskStatusRequestV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -979,24 +8253,24 @@ type CreateJourneyTaskRequestV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJourneyTaskRequestV1ValidationError) Field() string { return e.field }
+func (e GetJourneyTaskStatusRequestV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJourneyTaskRequestV1ValidationError) Reason() string { return e.reason }
+func (e GetJourneyTaskStatusRequestV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJourneyTaskRequestV1ValidationError) Cause() error { return e.cause }
+func (e GetJourneyTaskStatusRequestV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJourneyTaskRequestV1ValidationError) Key() bool { return e.key }
+func (e GetJourneyTaskStatusRequestV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJourneyTaskRequestV1ValidationError) ErrorName() string {
-	return "CreateJourneyTaskRequestV1ValidationError"
+func (e GetJourneyTaskStatusRequestV1ValidationError) ErrorName() string {
+	return "GetJourneyTaskStatusRequestV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJourneyTaskRequestV1ValidationError) Error() string {
+func (e GetJourneyTaskStatusRequestV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1008,14 +8282,14 @@ func (e CreateJourneyTaskRequestV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJourneyTaskRequestV1.%s: %s%s",
+		"invalid %sGetJourneyTaskStatusRequestV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJourneyTaskRequestV1ValidationError{}
+var _ error = GetJourneyTaskStatusRequestV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -1023,29 +8297,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJourneyTaskRequestV1ValidationError{}
+} = GetJourneyTaskStatusRequestV1ValidationError{}
 
-// Validate checks the field values on RemoveJourneyTaskRequestV1 with the
+// Validate checks the field values on GetJourneyTaskStatusResponseV1 with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *RemoveJourneyTaskRequestV1) Validate() error {
+func (m *GetJourneyTaskStatusResponseV1) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetJourneyId() <= 0 {
-		return RemoveJourneyTaskRequestV1ValidationError{
-			field:  "JourneyId",
-			reason: "value must be greater than 0",
+	if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetJourneyTaskStatusResponseV1ValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
 	}
 
 	return nil
 }
 
-// RemoveJourneyTaskRequestV1ValidationError is the validation error returned
-// by RemoveJourneyTaskRequestV1.Validate if the designated constraints aren't met.
-type RemoveJourneyTaskRequestV1ValidationError struct {
+// GetJourneyTaskStatusResponseV1ValidationError is the validation error
+// returned by GetJourneyTaskStatusResponseV1.Validate if the designated
+// constraints aren't met.
+type GetJourneyTaskStatusResponseV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1053,24 +8331,24 @@ type RemoveJourneyTaskRequestV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveJourneyTaskRequestV1ValidationError) Field() string { return e.field }
+func (e GetJourneyTaskStatusResponseV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveJourneyTaskRequestV1ValidationError) Reason() string { return e.reason }
+func (e GetJourneyTaskStatusResponseV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveJourneyTaskRequestV1ValidationError) Cause() error { return e.cause }
+func (e GetJourneyTaskStatusResponseV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveJourneyTaskRequestV1ValidationError) Key() bool { return e.key }
+func (e GetJourneyTaskStatusResponseV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveJourneyTaskRequestV1ValidationError) ErrorName() string {
-	return "RemoveJourneyTaskRequestV1ValidationError"
+func (e GetJourneyTaskStatusResponseV1ValidationError) ErrorName() string {
+	return "GetJourneyTaskStatusResponseV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveJourneyTaskRequestV1ValidationError) Error() string {
+func (e GetJourneyTaskStatusResponseV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1082,14 +8360,14 @@ func (e RemoveJourneyTaskRequestV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveJourneyTaskRequestV1.%s: %s%s",
+		"invalid %sGetJourneyTaskStatusResponseV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveJourneyTaskRequestV1ValidationError{}
+var _ error = GetJourneyTaskStatusResponseV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -1097,45 +8375,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveJourneyTaskRequestV1ValidationError{}
+} = GetJourneyTaskStatusResponseV1ValidationError{}
 
-// Validate checks the field values on MultiCreateJourneyTaskRequestV1 with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListJourneyTasksRequestV1 with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MultiCreateJourneyTaskRequestV1) Validate() error {
+func (m *ListJourneyTasksRequestV1) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if len(m.GetJourneys()) < 1 {
-		return MultiCreateJourneyTaskRequestV1ValidationError{
-			field:  "Journeys",
-			reason: "value must contain at least 1 item(s)",
+	if m.GetOffset() < 0 {
+		return ListJourneyTasksRequestV1ValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
 		}
 	}
 
-	for idx, item := range m.GetJourneys() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MultiCreateJourneyTaskRequestV1ValidationError{
-					field:  fmt.Sprintf("Journeys[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if m.GetLimit() <= 0 {
+		return ListJourneyTasksRequestV1ValidationError{
+			field:  "Limit",
+			reason: "value must be greater than 0",
 		}
-
 	}
 
 	return nil
 }
 
-// MultiCreateJourneyTaskRequestV1ValidationError is the validation error
-// returned by MultiCreateJourneyTaskRequestV1.Validate if the designated
-// constraints aren't met.
-type MultiCreateJourneyTaskRequestV1ValidationError struct {
+// ListJourneyTasksRequestV1ValidationError is the validation error returned by
+// ListJourneyTasksRequestV1.Validate if the designated constraints aren't met.
+type ListJourneyTasksRequestV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1143,24 +8412,24 @@ type MultiCreateJourneyTaskRequestV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e MultiCreateJourneyTaskRequestV1ValidationError) Field() string { return e.field }
+func (e ListJourneyTasksRequestV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MultiCreateJourneyTaskRequestV1ValidationError) Reason() string { return e.reason }
+func (e ListJourneyTasksRequestV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MultiCreateJourneyTaskRequestV1ValidationError) Cause() error { return e.cause }
+func (e ListJourneyTasksRequestV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MultiCreateJourneyTaskRequestV1ValidationError) Key() bool { return e.key }
+func (e ListJourneyTasksRequestV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MultiCreateJourneyTaskRequestV1ValidationError) ErrorName() string {
-	return "MultiCreateJourneyTaskRequestV1ValidationError"
+func (e ListJourneyTasksRequestV1ValidationError) ErrorName() string {
+	return "ListJourneyTasksRequestV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MultiCreateJourneyTaskRequestV1ValidationError) Error() string {
+func (e ListJourneyTasksRequestV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1172,14 +8441,14 @@ func (e MultiCreateJourneyTaskRequestV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMultiCreateJourneyTaskRequestV1.%s: %s%s",
+		"invalid %sListJourneyTasksRequestV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MultiCreateJourneyTaskRequestV1ValidationError{}
+var _ error = ListJourneyTasksRequestV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -1187,39 +8456,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MultiCreateJourneyTaskRequestV1ValidationError{}
+} = ListJourneyTasksRequestV1ValidationError{}
 
-// Validate checks the field values on UpdateJourneyTaskRequestV1 with the
+// Validate checks the field values on ListJourneyTasksResponseV1 with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateJourneyTaskRequestV1) Validate() error {
+func (m *ListJourneyTasksResponseV1) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if m.GetJourney() == nil {
-		return UpdateJourneyTaskRequestV1ValidationError{
-			field:  "Journey",
-			reason: "value is required",
-		}
-	}
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
 
-	if v, ok := interface{}(m.GetJourney()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateJourneyTaskRequestV1ValidationError{
-				field:  "Journey",
-				reason: "embedded message failed validation",
-				cause:  err,
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListJourneyTasksResponseV1ValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	return nil
 }
 
-// UpdateJourneyTaskRequestV1ValidationError is the validation error returned
-// by UpdateJourneyTaskRequestV1.Validate if the designated constraints aren't met.
-type UpdateJourneyTaskRequestV1ValidationError struct {
+// ListJourneyTasksResponseV1ValidationError is the validation error returned
+// by ListJourneyTasksResponseV1.Validate if the designated constraints aren't met.
+type ListJourneyTasksResponseV1ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1227,24 +8494,24 @@ type UpdateJourneyTaskRequestV1ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateJourneyTaskRequestV1ValidationError) Field() string { return e.field }
+func (e ListJourneyTasksResponseV1ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateJourneyTaskRequestV1ValidationError) Reason() string { return e.reason }
+func (e ListJourneyTasksResponseV1ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateJourneyTaskRequestV1ValidationError) Cause() error { return e.cause }
+func (e ListJourneyTasksResponseV1ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateJourneyTaskRequestV1ValidationError) Key() bool { return e.key }
+func (e ListJourneyTasksResponseV1ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateJourneyTaskRequestV1ValidationError) ErrorName() string {
-	return "UpdateJourneyTaskRequestV1ValidationError"
+func (e ListJourneyTasksResponseV1ValidationError) ErrorName() string {
+	return "ListJourneyTasksResponseV1ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateJourneyTaskRequestV1ValidationError) Error() string {
+func (e ListJourneyTasksResponseV1ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1256,14 +8523,14 @@ func (e UpdateJourneyTaskRequestV1ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateJourneyTaskRequestV1.%s: %s%s",
+		"invalid %sListJourneyTasksResponseV1.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateJourneyTaskRequestV1ValidationError{}
+var _ error = ListJourneyTasksResponseV1ValidationError{}
 
 var _ interface {
 	Field() string
@@ -1271,4 +8538,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateJourneyTaskRequestV1ValidationError{}
+} = ListJourneyTasksResponseV1ValidationError{}
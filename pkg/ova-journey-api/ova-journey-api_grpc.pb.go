@@ -24,11 +24,81 @@ type JourneyApiV1Client interface {
 	ListJourneysV1(ctx context.Context, in *ListJourneysRequestV1, opts ...grpc.CallOption) (*ListJourneysResponseV1, error)
 	RemoveJourneyV1(ctx context.Context, in *RemoveJourneyRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	MultiCreateJourneyV1(ctx context.Context, in *MultiCreateJourneyRequestV1, opts ...grpc.CallOption) (*MultiCreateJourneyResponseV1, error)
-	UpdateJourneyV1(ctx context.Context, in *UpdateJourneyRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	CreateJourneyTaskV1(ctx context.Context, in *CreateJourneyTaskRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	RemoveJourneyTaskV1(ctx context.Context, in *RemoveJourneyTaskRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	MultiCreateJourneyTaskV1(ctx context.Context, in *MultiCreateJourneyTaskRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	UpdateJourneyTaskV1(ctx context.Context, in *UpdateJourneyTaskRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateJourneyV1(ctx context.Context, in *UpdateJourneyRequestV1, opts ...grpc.CallOption) (*UpdateJourneyResponseV1, error)
+	PatchJourneyV1(ctx context.Context, in *PatchJourneyRequestV1, opts ...grpc.CallOption) (*PatchJourneyResponseV1, error)
+	RestoreJourneyV1(ctx context.Context, in *RestoreJourneyRequestV1, opts ...grpc.CallOption) (*RestoreJourneyResponseV1, error)
+	// AddWaypointV1 - adds waypoint to journey, returns its id and new revision of journey
+	AddWaypointV1(ctx context.Context, in *AddWaypointRequestV1, opts ...grpc.CallOption) (*AddWaypointResponseV1, error)
+	// ReorderWaypointsV1 - sets order of waypoints of journey, returns new revision of journey
+	ReorderWaypointsV1(ctx context.Context, in *ReorderWaypointsRequestV1, opts ...grpc.CallOption) (*ReorderWaypointsResponseV1, error)
+	// RemoveWaypointV1 - removes waypoint of journey, returns new revision of journey
+	RemoveWaypointV1(ctx context.Context, in *RemoveWaypointRequestV1, opts ...grpc.CallOption) (*RemoveWaypointResponseV1, error)
+	// InviteParticipantV1 - shares journey with user or changes role of invited user
+	InviteParticipantV1(ctx context.Context, in *InviteParticipantRequestV1, opts ...grpc.CallOption) (*InviteParticipantResponseV1, error)
+	// RemoveParticipantV1 - stops sharing journey with user
+	RemoveParticipantV1(ctx context.Context, in *RemoveParticipantRequestV1, opts ...grpc.CallOption) (*RemoveParticipantResponseV1, error)
+	// ListParticipantsV1 - returns owner and invited users of journey
+	ListParticipantsV1(ctx context.Context, in *ListParticipantsRequestV1, opts ...grpc.CallOption) (*ListParticipantsResponseV1, error)
+	// ListDeletedJourneysV1 - admin method for listing removed journeys which are not purged yet
+	ListDeletedJourneysV1(ctx context.Context, in *ListDeletedJourneysRequestV1, opts ...grpc.CallOption) (*ListDeletedJourneysResponseV1, error)
+	// ExportJourneysV1 - streams all journeys matching filter ordered by id
+	ExportJourneysV1(ctx context.Context, in *ExportJourneysRequestV1, opts ...grpc.CallOption) (JourneyApiV1_ExportJourneysV1Client, error)
+	// ImportJourneysV1 - creates journeys from the stream and returns result for every received journey
+	ImportJourneysV1(ctx context.Context, opts ...grpc.CallOption) (JourneyApiV1_ImportJourneysV1Client, error)
+	// MultiUpdateJourneyV1 - updates journeys by chunks and returns result for every journey
+	MultiUpdateJourneyV1(ctx context.Context, in *MultiUpdateJourneyRequestV1, opts ...grpc.CallOption) (*MultiUpdateJourneyResponseV1, error)
+	// MultiRemoveJourneyV1 - removes journeys by chunks and returns result for every journey
+	MultiRemoveJourneyV1(ctx context.Context, in *MultiRemoveJourneyRequestV1, opts ...grpc.CallOption) (*MultiRemoveJourneyResponseV1, error)
+	// BatchGetJourneysV1 - returns journeys by ids in order of request and ids of missing journeys
+	BatchGetJourneysV1(ctx context.Context, in *BatchGetJourneysRequestV1, opts ...grpc.CallOption) (*BatchGetJourneysResponseV1, error)
+	// SearchJourneysV1 - full-text search of journeys by address and description, most relevant journeys first
+	SearchJourneysV1(ctx context.Context, in *SearchJourneysRequestV1, opts ...grpc.CallOption) (*SearchJourneysResponseV1, error)
+	// FindJourneysNearV1 - returns journeys with coordinates within radius of the point, nearest first
+	FindJourneysNearV1(ctx context.Context, in *FindJourneysNearRequestV1, opts ...grpc.CallOption) (*FindJourneysNearResponseV1, error)
+	// FindOverlappingJourneysV1 - returns pairs of journeys of the user overlapping in time, earliest overlaps first
+	FindOverlappingJourneysV1(ctx context.Context, in *FindOverlappingJourneysRequestV1, opts ...grpc.CallOption) (*FindOverlappingJourneysResponseV1, error)
+	// GetJourneyStatsV1 - returns statistics of journeys of the user, admin method returns statistics of all users
+	GetJourneyStatsV1(ctx context.Context, in *GetJourneyStatsRequestV1, opts ...grpc.CallOption) (*GetJourneyStatsResponseV1, error)
+	// ListTagsV1 - returns tags of journeys of the user with number of journeys having each tag, most used first
+	ListTagsV1(ctx context.Context, in *ListTagsRequestV1, opts ...grpc.CallOption) (*ListTagsResponseV1, error)
+	// GetCalendarFeedV1 - returns URL path of iCalendar feed of the acting user with token for calendar apps
+	// which cannot send Authorization header. The token gives access only to the feed and does not expire,
+	// it is revoked by changing the signing secret. Fails with FAILED_PRECONDITION if authentication is disabled,
+	// then the feed is available without token
+	GetCalendarFeedV1(ctx context.Context, in *GetCalendarFeedRequestV1, opts ...grpc.CallOption) (*GetCalendarFeedResponseV1, error)
+	// StartJourneyV1, CompleteJourneyV1, CancelJourneyV1 - change status of journey, allowed transitions are
+	// planned -> in progress -> completed and planned or in progress -> cancelled, others fail with FAILED_PRECONDITION.
+	// Planned journeys are also started and completed automatically by their start and end times
+	StartJourneyV1(ctx context.Context, in *ChangeJourneyStatusRequestV1, opts ...grpc.CallOption) (*ChangeJourneyStatusResponseV1, error)
+	CompleteJourneyV1(ctx context.Context, in *ChangeJourneyStatusRequestV1, opts ...grpc.CallOption) (*ChangeJourneyStatusResponseV1, error)
+	CancelJourneyV1(ctx context.Context, in *ChangeJourneyStatusRequestV1, opts ...grpc.CallOption) (*ChangeJourneyStatusResponseV1, error)
+	// CreateRecurringJourneyV1 - creates journey repeated by RFC 5545 recurrence rule, its occurrences are not stored
+	// and are returned by ListOccurrencesV1 and ListJourneysV1 with time range
+	CreateRecurringJourneyV1(ctx context.Context, in *CreateRecurringJourneyRequestV1, opts ...grpc.CallOption) (*CreateRecurringJourneyResponseV1, error)
+	DescribeRecurringJourneyV1(ctx context.Context, in *DescribeRecurringJourneyRequestV1, opts ...grpc.CallOption) (*DescribeRecurringJourneyResponseV1, error)
+	// UpdateRecurringJourneyV1 - replaces recurring journey, exceptions of occurrences which do not exist by new rule
+	// and start time are removed
+	UpdateRecurringJourneyV1(ctx context.Context, in *UpdateRecurringJourneyRequestV1, opts ...grpc.CallOption) (*UpdateRecurringJourneyResponseV1, error)
+	// RemoveRecurringJourneyV1 - permanently removes recurring journey with all its occurrences
+	RemoveRecurringJourneyV1(ctx context.Context, in *RemoveRecurringJourneyRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UpdateOccurrenceV1 - changes or cancels single occurrence of recurring journey, change is stored as exception
+	// and replaces previous exception of the occurrence
+	UpdateOccurrenceV1(ctx context.Context, in *UpdateOccurrenceRequestV1, opts ...grpc.CallOption) (*UpdateOccurrenceResponseV1, error)
+	// ListOccurrencesV1 - returns occurrences of recurring journeys of users overlapping time range sorted by start time,
+	// time range is required because recurring journeys may repeat endlessly
+	ListOccurrencesV1(ctx context.Context, in *ListOccurrencesRequestV1, opts ...grpc.CallOption) (*ListOccurrencesResponseV1, error)
+	// WatchJourneysV1 - streams changes of journeys made by this instance of service as they happen.
+	// Slow subscriber is disconnected with RESOURCE_EXHAUSTED status and can continue watching with resume_token
+	// of the last received response, OUT_OF_RANGE status means that events after resume_token are lost
+	WatchJourneysV1(ctx context.Context, in *WatchJourneysRequestV1, opts ...grpc.CallOption) (JourneyApiV1_WatchJourneysV1Client, error)
+	CreateJourneyTaskV1(ctx context.Context, in *CreateJourneyTaskRequestV1, opts ...grpc.CallOption) (*CreateJourneyTaskResponseV1, error)
+	RemoveJourneyTaskV1(ctx context.Context, in *RemoveJourneyTaskRequestV1, opts ...grpc.CallOption) (*RemoveJourneyTaskResponseV1, error)
+	MultiCreateJourneyTaskV1(ctx context.Context, in *MultiCreateJourneyTaskRequestV1, opts ...grpc.CallOption) (*MultiCreateJourneyTaskResponseV1, error)
+	UpdateJourneyTaskV1(ctx context.Context, in *UpdateJourneyTaskRequestV1, opts ...grpc.CallOption) (*UpdateJourneyTaskResponseV1, error)
+	MultiUpdateJourneyTaskV1(ctx context.Context, in *MultiUpdateJourneyTaskRequestV1, opts ...grpc.CallOption) (*MultiUpdateJourneyTaskResponseV1, error)
+	MultiRemoveJourneyTaskV1(ctx context.Context, in *MultiRemoveJourneyTaskRequestV1, opts ...grpc.CallOption) (*MultiRemoveJourneyTaskResponseV1, error)
+	GetJourneyTaskStatusV1(ctx context.Context, in *GetJourneyTaskStatusRequestV1, opts ...grpc.CallOption) (*GetJourneyTaskStatusResponseV1, error)
+	ListJourneyTasksV1(ctx context.Context, in *ListJourneyTasksRequestV1, opts ...grpc.CallOption) (*ListJourneyTasksResponseV1, error)
 }
 
 type journeyApiV1Client struct {
@@ -84,8 +154,8 @@ func (c *journeyApiV1Client) MultiCreateJourneyV1(ctx context.Context, in *Multi
 	return out, nil
 }
 
-func (c *journeyApiV1Client) UpdateJourneyV1(ctx context.Context, in *UpdateJourneyRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *journeyApiV1Client) UpdateJourneyV1(ctx context.Context, in *UpdateJourneyRequestV1, opts ...grpc.CallOption) (*UpdateJourneyResponseV1, error) {
+	out := new(UpdateJourneyResponseV1)
 	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/UpdateJourneyV1", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -93,214 +163,1317 @@ func (c *journeyApiV1Client) UpdateJourneyV1(ctx context.Context, in *UpdateJour
 	return out, nil
 }
 
-func (c *journeyApiV1Client) CreateJourneyTaskV1(ctx context.Context, in *CreateJourneyTaskRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *journeyApiV1Client) PatchJourneyV1(ctx context.Context, in *PatchJourneyRequestV1, opts ...grpc.CallOption) (*PatchJourneyResponseV1, error) {
+	out := new(PatchJourneyResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/PatchJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) RestoreJourneyV1(ctx context.Context, in *RestoreJourneyRequestV1, opts ...grpc.CallOption) (*RestoreJourneyResponseV1, error) {
+	out := new(RestoreJourneyResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/RestoreJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) AddWaypointV1(ctx context.Context, in *AddWaypointRequestV1, opts ...grpc.CallOption) (*AddWaypointResponseV1, error) {
+	out := new(AddWaypointResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/AddWaypointV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) ReorderWaypointsV1(ctx context.Context, in *ReorderWaypointsRequestV1, opts ...grpc.CallOption) (*ReorderWaypointsResponseV1, error) {
+	out := new(ReorderWaypointsResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/ReorderWaypointsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) RemoveWaypointV1(ctx context.Context, in *RemoveWaypointRequestV1, opts ...grpc.CallOption) (*RemoveWaypointResponseV1, error) {
+	out := new(RemoveWaypointResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/RemoveWaypointV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) InviteParticipantV1(ctx context.Context, in *InviteParticipantRequestV1, opts ...grpc.CallOption) (*InviteParticipantResponseV1, error) {
+	out := new(InviteParticipantResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/InviteParticipantV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) RemoveParticipantV1(ctx context.Context, in *RemoveParticipantRequestV1, opts ...grpc.CallOption) (*RemoveParticipantResponseV1, error) {
+	out := new(RemoveParticipantResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/RemoveParticipantV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) ListParticipantsV1(ctx context.Context, in *ListParticipantsRequestV1, opts ...grpc.CallOption) (*ListParticipantsResponseV1, error) {
+	out := new(ListParticipantsResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/ListParticipantsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) ListDeletedJourneysV1(ctx context.Context, in *ListDeletedJourneysRequestV1, opts ...grpc.CallOption) (*ListDeletedJourneysResponseV1, error) {
+	out := new(ListDeletedJourneysResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/ListDeletedJourneysV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) ExportJourneysV1(ctx context.Context, in *ExportJourneysRequestV1, opts ...grpc.CallOption) (JourneyApiV1_ExportJourneysV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &JourneyApiV1_ServiceDesc.Streams[0], "/ova.journey.api.JourneyApiV1/ExportJourneysV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &journeyApiV1ExportJourneysV1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JourneyApiV1_ExportJourneysV1Client interface {
+	Recv() (*ExportJourneysResponseV1, error)
+	grpc.ClientStream
+}
+
+type journeyApiV1ExportJourneysV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *journeyApiV1ExportJourneysV1Client) Recv() (*ExportJourneysResponseV1, error) {
+	m := new(ExportJourneysResponseV1)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *journeyApiV1Client) ImportJourneysV1(ctx context.Context, opts ...grpc.CallOption) (JourneyApiV1_ImportJourneysV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &JourneyApiV1_ServiceDesc.Streams[1], "/ova.journey.api.JourneyApiV1/ImportJourneysV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &journeyApiV1ImportJourneysV1Client{stream}
+	return x, nil
+}
+
+type JourneyApiV1_ImportJourneysV1Client interface {
+	Send(*ImportJourneysRequestV1) error
+	CloseAndRecv() (*ImportJourneysResponseV1, error)
+	grpc.ClientStream
+}
+
+type journeyApiV1ImportJourneysV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *journeyApiV1ImportJourneysV1Client) Send(m *ImportJourneysRequestV1) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *journeyApiV1ImportJourneysV1Client) CloseAndRecv() (*ImportJourneysResponseV1, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportJourneysResponseV1)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *journeyApiV1Client) MultiUpdateJourneyV1(ctx context.Context, in *MultiUpdateJourneyRequestV1, opts ...grpc.CallOption) (*MultiUpdateJourneyResponseV1, error) {
+	out := new(MultiUpdateJourneyResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/MultiUpdateJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) MultiRemoveJourneyV1(ctx context.Context, in *MultiRemoveJourneyRequestV1, opts ...grpc.CallOption) (*MultiRemoveJourneyResponseV1, error) {
+	out := new(MultiRemoveJourneyResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/MultiRemoveJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) BatchGetJourneysV1(ctx context.Context, in *BatchGetJourneysRequestV1, opts ...grpc.CallOption) (*BatchGetJourneysResponseV1, error) {
+	out := new(BatchGetJourneysResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/BatchGetJourneysV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) SearchJourneysV1(ctx context.Context, in *SearchJourneysRequestV1, opts ...grpc.CallOption) (*SearchJourneysResponseV1, error) {
+	out := new(SearchJourneysResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/SearchJourneysV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) FindJourneysNearV1(ctx context.Context, in *FindJourneysNearRequestV1, opts ...grpc.CallOption) (*FindJourneysNearResponseV1, error) {
+	out := new(FindJourneysNearResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/FindJourneysNearV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) FindOverlappingJourneysV1(ctx context.Context, in *FindOverlappingJourneysRequestV1, opts ...grpc.CallOption) (*FindOverlappingJourneysResponseV1, error) {
+	out := new(FindOverlappingJourneysResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/FindOverlappingJourneysV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) GetJourneyStatsV1(ctx context.Context, in *GetJourneyStatsRequestV1, opts ...grpc.CallOption) (*GetJourneyStatsResponseV1, error) {
+	out := new(GetJourneyStatsResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/GetJourneyStatsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) ListTagsV1(ctx context.Context, in *ListTagsRequestV1, opts ...grpc.CallOption) (*ListTagsResponseV1, error) {
+	out := new(ListTagsResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/ListTagsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) GetCalendarFeedV1(ctx context.Context, in *GetCalendarFeedRequestV1, opts ...grpc.CallOption) (*GetCalendarFeedResponseV1, error) {
+	out := new(GetCalendarFeedResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/GetCalendarFeedV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) StartJourneyV1(ctx context.Context, in *ChangeJourneyStatusRequestV1, opts ...grpc.CallOption) (*ChangeJourneyStatusResponseV1, error) {
+	out := new(ChangeJourneyStatusResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/StartJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) CompleteJourneyV1(ctx context.Context, in *ChangeJourneyStatusRequestV1, opts ...grpc.CallOption) (*ChangeJourneyStatusResponseV1, error) {
+	out := new(ChangeJourneyStatusResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/CompleteJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) CancelJourneyV1(ctx context.Context, in *ChangeJourneyStatusRequestV1, opts ...grpc.CallOption) (*ChangeJourneyStatusResponseV1, error) {
+	out := new(ChangeJourneyStatusResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/CancelJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) CreateRecurringJourneyV1(ctx context.Context, in *CreateRecurringJourneyRequestV1, opts ...grpc.CallOption) (*CreateRecurringJourneyResponseV1, error) {
+	out := new(CreateRecurringJourneyResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/CreateRecurringJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) DescribeRecurringJourneyV1(ctx context.Context, in *DescribeRecurringJourneyRequestV1, opts ...grpc.CallOption) (*DescribeRecurringJourneyResponseV1, error) {
+	out := new(DescribeRecurringJourneyResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/DescribeRecurringJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) UpdateRecurringJourneyV1(ctx context.Context, in *UpdateRecurringJourneyRequestV1, opts ...grpc.CallOption) (*UpdateRecurringJourneyResponseV1, error) {
+	out := new(UpdateRecurringJourneyResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/UpdateRecurringJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) RemoveRecurringJourneyV1(ctx context.Context, in *RemoveRecurringJourneyRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/RemoveRecurringJourneyV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) UpdateOccurrenceV1(ctx context.Context, in *UpdateOccurrenceRequestV1, opts ...grpc.CallOption) (*UpdateOccurrenceResponseV1, error) {
+	out := new(UpdateOccurrenceResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/UpdateOccurrenceV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) ListOccurrencesV1(ctx context.Context, in *ListOccurrencesRequestV1, opts ...grpc.CallOption) (*ListOccurrencesResponseV1, error) {
+	out := new(ListOccurrencesResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/ListOccurrencesV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) WatchJourneysV1(ctx context.Context, in *WatchJourneysRequestV1, opts ...grpc.CallOption) (JourneyApiV1_WatchJourneysV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &JourneyApiV1_ServiceDesc.Streams[2], "/ova.journey.api.JourneyApiV1/WatchJourneysV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &journeyApiV1WatchJourneysV1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JourneyApiV1_WatchJourneysV1Client interface {
+	Recv() (*WatchJourneysResponseV1, error)
+	grpc.ClientStream
+}
+
+type journeyApiV1WatchJourneysV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *journeyApiV1WatchJourneysV1Client) Recv() (*WatchJourneysResponseV1, error) {
+	m := new(WatchJourneysResponseV1)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *journeyApiV1Client) CreateJourneyTaskV1(ctx context.Context, in *CreateJourneyTaskRequestV1, opts ...grpc.CallOption) (*CreateJourneyTaskResponseV1, error) {
+	out := new(CreateJourneyTaskResponseV1)
 	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/CreateJourneyTaskV1", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *journeyApiV1Client) RemoveJourneyTaskV1(ctx context.Context, in *RemoveJourneyTaskRequestV1, opts ...grpc.CallOption) (*RemoveJourneyTaskResponseV1, error) {
+	out := new(RemoveJourneyTaskResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/RemoveJourneyTaskV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) MultiCreateJourneyTaskV1(ctx context.Context, in *MultiCreateJourneyTaskRequestV1, opts ...grpc.CallOption) (*MultiCreateJourneyTaskResponseV1, error) {
+	out := new(MultiCreateJourneyTaskResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/MultiCreateJourneyTaskV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) UpdateJourneyTaskV1(ctx context.Context, in *UpdateJourneyTaskRequestV1, opts ...grpc.CallOption) (*UpdateJourneyTaskResponseV1, error) {
+	out := new(UpdateJourneyTaskResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/UpdateJourneyTaskV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) MultiUpdateJourneyTaskV1(ctx context.Context, in *MultiUpdateJourneyTaskRequestV1, opts ...grpc.CallOption) (*MultiUpdateJourneyTaskResponseV1, error) {
+	out := new(MultiUpdateJourneyTaskResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/MultiUpdateJourneyTaskV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) MultiRemoveJourneyTaskV1(ctx context.Context, in *MultiRemoveJourneyTaskRequestV1, opts ...grpc.CallOption) (*MultiRemoveJourneyTaskResponseV1, error) {
+	out := new(MultiRemoveJourneyTaskResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/MultiRemoveJourneyTaskV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) GetJourneyTaskStatusV1(ctx context.Context, in *GetJourneyTaskStatusRequestV1, opts ...grpc.CallOption) (*GetJourneyTaskStatusResponseV1, error) {
+	out := new(GetJourneyTaskStatusResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/GetJourneyTaskStatusV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journeyApiV1Client) ListJourneyTasksV1(ctx context.Context, in *ListJourneyTasksRequestV1, opts ...grpc.CallOption) (*ListJourneyTasksResponseV1, error) {
+	out := new(ListJourneyTasksResponseV1)
+	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/ListJourneyTasksV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JourneyApiV1Server is the server API for JourneyApiV1 service.
+// All implementations must embed UnimplementedJourneyApiV1Server
+// for forward compatibility
+type JourneyApiV1Server interface {
+	CreateJourneyV1(context.Context, *CreateJourneyRequestV1) (*CreateJourneyResponseV1, error)
+	DescribeJourneyV1(context.Context, *DescribeJourneyRequestV1) (*DescribeJourneyResponseV1, error)
+	ListJourneysV1(context.Context, *ListJourneysRequestV1) (*ListJourneysResponseV1, error)
+	RemoveJourneyV1(context.Context, *RemoveJourneyRequestV1) (*emptypb.Empty, error)
+	MultiCreateJourneyV1(context.Context, *MultiCreateJourneyRequestV1) (*MultiCreateJourneyResponseV1, error)
+	UpdateJourneyV1(context.Context, *UpdateJourneyRequestV1) (*UpdateJourneyResponseV1, error)
+	PatchJourneyV1(context.Context, *PatchJourneyRequestV1) (*PatchJourneyResponseV1, error)
+	RestoreJourneyV1(context.Context, *RestoreJourneyRequestV1) (*RestoreJourneyResponseV1, error)
+	// AddWaypointV1 - adds waypoint to journey, returns its id and new revision of journey
+	AddWaypointV1(context.Context, *AddWaypointRequestV1) (*AddWaypointResponseV1, error)
+	// ReorderWaypointsV1 - sets order of waypoints of journey, returns new revision of journey
+	ReorderWaypointsV1(context.Context, *ReorderWaypointsRequestV1) (*ReorderWaypointsResponseV1, error)
+	// RemoveWaypointV1 - removes waypoint of journey, returns new revision of journey
+	RemoveWaypointV1(context.Context, *RemoveWaypointRequestV1) (*RemoveWaypointResponseV1, error)
+	// InviteParticipantV1 - shares journey with user or changes role of invited user
+	InviteParticipantV1(context.Context, *InviteParticipantRequestV1) (*InviteParticipantResponseV1, error)
+	// RemoveParticipantV1 - stops sharing journey with user
+	RemoveParticipantV1(context.Context, *RemoveParticipantRequestV1) (*RemoveParticipantResponseV1, error)
+	// ListParticipantsV1 - returns owner and invited users of journey
+	ListParticipantsV1(context.Context, *ListParticipantsRequestV1) (*ListParticipantsResponseV1, error)
+	// ListDeletedJourneysV1 - admin method for listing removed journeys which are not purged yet
+	ListDeletedJourneysV1(context.Context, *ListDeletedJourneysRequestV1) (*ListDeletedJourneysResponseV1, error)
+	// ExportJourneysV1 - streams all journeys matching filter ordered by id
+	ExportJourneysV1(*ExportJourneysRequestV1, JourneyApiV1_ExportJourneysV1Server) error
+	// ImportJourneysV1 - creates journeys from the stream and returns result for every received journey
+	ImportJourneysV1(JourneyApiV1_ImportJourneysV1Server) error
+	// MultiUpdateJourneyV1 - updates journeys by chunks and returns result for every journey
+	MultiUpdateJourneyV1(context.Context, *MultiUpdateJourneyRequestV1) (*MultiUpdateJourneyResponseV1, error)
+	// MultiRemoveJourneyV1 - removes journeys by chunks and returns result for every journey
+	MultiRemoveJourneyV1(context.Context, *MultiRemoveJourneyRequestV1) (*MultiRemoveJourneyResponseV1, error)
+	// BatchGetJourneysV1 - returns journeys by ids in order of request and ids of missing journeys
+	BatchGetJourneysV1(context.Context, *BatchGetJourneysRequestV1) (*BatchGetJourneysResponseV1, error)
+	// SearchJourneysV1 - full-text search of journeys by address and description, most relevant journeys first
+	SearchJourneysV1(context.Context, *SearchJourneysRequestV1) (*SearchJourneysResponseV1, error)
+	// FindJourneysNearV1 - returns journeys with coordinates within radius of the point, nearest first
+	FindJourneysNearV1(context.Context, *FindJourneysNearRequestV1) (*FindJourneysNearResponseV1, error)
+	// FindOverlappingJourneysV1 - returns pairs of journeys of the user overlapping in time, earliest overlaps first
+	FindOverlappingJourneysV1(context.Context, *FindOverlappingJourneysRequestV1) (*FindOverlappingJourneysResponseV1, error)
+	// GetJourneyStatsV1 - returns statistics of journeys of the user, admin method returns statistics of all users
+	GetJourneyStatsV1(context.Context, *GetJourneyStatsRequestV1) (*GetJourneyStatsResponseV1, error)
+	// ListTagsV1 - returns tags of journeys of the user with number of journeys having each tag, most used first
+	ListTagsV1(context.Context, *ListTagsRequestV1) (*ListTagsResponseV1, error)
+	// GetCalendarFeedV1 - returns URL path of iCalendar feed of the acting user with token for calendar apps
+	// which cannot send Authorization header. The token gives access only to the feed and does not expire,
+	// it is revoked by changing the signing secret. Fails with FAILED_PRECONDITION if authentication is disabled,
+	// then the feed is available without token
+	GetCalendarFeedV1(context.Context, *GetCalendarFeedRequestV1) (*GetCalendarFeedResponseV1, error)
+	// StartJourneyV1, CompleteJourneyV1, CancelJourneyV1 - change status of journey, allowed transitions are
+	// planned -> in progress -> completed and planned or in progress -> cancelled, others fail with FAILED_PRECONDITION.
+	// Planned journeys are also started and completed automatically by their start and end times
+	StartJourneyV1(context.Context, *ChangeJourneyStatusRequestV1) (*ChangeJourneyStatusResponseV1, error)
+	CompleteJourneyV1(context.Context, *ChangeJourneyStatusRequestV1) (*ChangeJourneyStatusResponseV1, error)
+	CancelJourneyV1(context.Context, *ChangeJourneyStatusRequestV1) (*ChangeJourneyStatusResponseV1, error)
+	// CreateRecurringJourneyV1 - creates journey repeated by RFC 5545 recurrence rule, its occurrences are not stored
+	// and are returned by ListOccurrencesV1 and ListJourneysV1 with time range
+	CreateRecurringJourneyV1(context.Context, *CreateRecurringJourneyRequestV1) (*CreateRecurringJourneyResponseV1, error)
+	DescribeRecurringJourneyV1(context.Context, *DescribeRecurringJourneyRequestV1) (*DescribeRecurringJourneyResponseV1, error)
+	// UpdateRecurringJourneyV1 - replaces recurring journey, exceptions of occurrences which do not exist by new rule
+	// and start time are removed
+	UpdateRecurringJourneyV1(context.Context, *UpdateRecurringJourneyRequestV1) (*UpdateRecurringJourneyResponseV1, error)
+	// RemoveRecurringJourneyV1 - permanently removes recurring journey with all its occurrences
+	RemoveRecurringJourneyV1(context.Context, *RemoveRecurringJourneyRequestV1) (*emptypb.Empty, error)
+	// UpdateOccurrenceV1 - changes or cancels single occurrence of recurring journey, change is stored as exception
+	// and replaces previous exception of the occurrence
+	UpdateOccurrenceV1(context.Context, *UpdateOccurrenceRequestV1) (*UpdateOccurrenceResponseV1, error)
+	// ListOccurrencesV1 - returns occurrences of recurring journeys of users overlapping time range sorted by start time,
+	// time range is required because recurring journeys may repeat endlessly
+	ListOccurrencesV1(context.Context, *ListOccurrencesRequestV1) (*ListOccurrencesResponseV1, error)
+	// WatchJourneysV1 - streams changes of journeys made by this instance of service as they happen.
+	// Slow subscriber is disconnected with RESOURCE_EXHAUSTED status and can continue watching with resume_token
+	// of the last received response, OUT_OF_RANGE status means that events after resume_token are lost
+	WatchJourneysV1(*WatchJourneysRequestV1, JourneyApiV1_WatchJourneysV1Server) error
+	CreateJourneyTaskV1(context.Context, *CreateJourneyTaskRequestV1) (*CreateJourneyTaskResponseV1, error)
+	RemoveJourneyTaskV1(context.Context, *RemoveJourneyTaskRequestV1) (*RemoveJourneyTaskResponseV1, error)
+	MultiCreateJourneyTaskV1(context.Context, *MultiCreateJourneyTaskRequestV1) (*MultiCreateJourneyTaskResponseV1, error)
+	UpdateJourneyTaskV1(context.Context, *UpdateJourneyTaskRequestV1) (*UpdateJourneyTaskResponseV1, error)
+	MultiUpdateJourneyTaskV1(context.Context, *MultiUpdateJourneyTaskRequestV1) (*MultiUpdateJourneyTaskResponseV1, error)
+	MultiRemoveJourneyTaskV1(context.Context, *MultiRemoveJourneyTaskRequestV1) (*MultiRemoveJourneyTaskResponseV1, error)
+	GetJourneyTaskStatusV1(context.Context, *GetJourneyTaskStatusRequestV1) (*GetJourneyTaskStatusResponseV1, error)
+	ListJourneyTasksV1(context.Context, *ListJourneyTasksRequestV1) (*ListJourneyTasksResponseV1, error)
+	mustEmbedUnimplementedJourneyApiV1Server()
+}
+
+// UnimplementedJourneyApiV1Server must be embedded to have forward compatible implementations.
+type UnimplementedJourneyApiV1Server struct {
+}
+
+func (UnimplementedJourneyApiV1Server) CreateJourneyV1(context.Context, *CreateJourneyRequestV1) (*CreateJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) DescribeJourneyV1(context.Context, *DescribeJourneyRequestV1) (*DescribeJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) ListJourneysV1(context.Context, *ListJourneysRequestV1) (*ListJourneysResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJourneysV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) RemoveJourneyV1(context.Context, *RemoveJourneyRequestV1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) MultiCreateJourneyV1(context.Context, *MultiCreateJourneyRequestV1) (*MultiCreateJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCreateJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) UpdateJourneyV1(context.Context, *UpdateJourneyRequestV1) (*UpdateJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) PatchJourneyV1(context.Context, *PatchJourneyRequestV1) (*PatchJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) RestoreJourneyV1(context.Context, *RestoreJourneyRequestV1) (*RestoreJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) AddWaypointV1(context.Context, *AddWaypointRequestV1) (*AddWaypointResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWaypointV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) ReorderWaypointsV1(context.Context, *ReorderWaypointsRequestV1) (*ReorderWaypointsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderWaypointsV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) RemoveWaypointV1(context.Context, *RemoveWaypointRequestV1) (*RemoveWaypointResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWaypointV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) InviteParticipantV1(context.Context, *InviteParticipantRequestV1) (*InviteParticipantResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteParticipantV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) RemoveParticipantV1(context.Context, *RemoveParticipantRequestV1) (*RemoveParticipantResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveParticipantV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) ListParticipantsV1(context.Context, *ListParticipantsRequestV1) (*ListParticipantsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParticipantsV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) ListDeletedJourneysV1(context.Context, *ListDeletedJourneysRequestV1) (*ListDeletedJourneysResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedJourneysV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) ExportJourneysV1(*ExportJourneysRequestV1, JourneyApiV1_ExportJourneysV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method ExportJourneysV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) ImportJourneysV1(JourneyApiV1_ImportJourneysV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method ImportJourneysV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) MultiUpdateJourneyV1(context.Context, *MultiUpdateJourneyRequestV1) (*MultiUpdateJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiUpdateJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) MultiRemoveJourneyV1(context.Context, *MultiRemoveJourneyRequestV1) (*MultiRemoveJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiRemoveJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) BatchGetJourneysV1(context.Context, *BatchGetJourneysRequestV1) (*BatchGetJourneysResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetJourneysV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) SearchJourneysV1(context.Context, *SearchJourneysRequestV1) (*SearchJourneysResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchJourneysV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) FindJourneysNearV1(context.Context, *FindJourneysNearRequestV1) (*FindJourneysNearResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindJourneysNearV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) FindOverlappingJourneysV1(context.Context, *FindOverlappingJourneysRequestV1) (*FindOverlappingJourneysResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindOverlappingJourneysV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) GetJourneyStatsV1(context.Context, *GetJourneyStatsRequestV1) (*GetJourneyStatsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJourneyStatsV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) ListTagsV1(context.Context, *ListTagsRequestV1) (*ListTagsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTagsV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) GetCalendarFeedV1(context.Context, *GetCalendarFeedRequestV1) (*GetCalendarFeedResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeedV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) StartJourneyV1(context.Context, *ChangeJourneyStatusRequestV1) (*ChangeJourneyStatusResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) CompleteJourneyV1(context.Context, *ChangeJourneyStatusRequestV1) (*ChangeJourneyStatusResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) CancelJourneyV1(context.Context, *ChangeJourneyStatusRequestV1) (*ChangeJourneyStatusResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) CreateRecurringJourneyV1(context.Context, *CreateRecurringJourneyRequestV1) (*CreateRecurringJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) DescribeRecurringJourneyV1(context.Context, *DescribeRecurringJourneyRequestV1) (*DescribeRecurringJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeRecurringJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) UpdateRecurringJourneyV1(context.Context, *UpdateRecurringJourneyRequestV1) (*UpdateRecurringJourneyResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) RemoveRecurringJourneyV1(context.Context, *RemoveRecurringJourneyRequestV1) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRecurringJourneyV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) UpdateOccurrenceV1(context.Context, *UpdateOccurrenceRequestV1) (*UpdateOccurrenceResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOccurrenceV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) ListOccurrencesV1(context.Context, *ListOccurrencesRequestV1) (*ListOccurrencesResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOccurrencesV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) WatchJourneysV1(*WatchJourneysRequestV1, JourneyApiV1_WatchJourneysV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJourneysV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) CreateJourneyTaskV1(context.Context, *CreateJourneyTaskRequestV1) (*CreateJourneyTaskResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJourneyTaskV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) RemoveJourneyTaskV1(context.Context, *RemoveJourneyTaskRequestV1) (*RemoveJourneyTaskResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveJourneyTaskV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) MultiCreateJourneyTaskV1(context.Context, *MultiCreateJourneyTaskRequestV1) (*MultiCreateJourneyTaskResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiCreateJourneyTaskV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) UpdateJourneyTaskV1(context.Context, *UpdateJourneyTaskRequestV1) (*UpdateJourneyTaskResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJourneyTaskV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) MultiUpdateJourneyTaskV1(context.Context, *MultiUpdateJourneyTaskRequestV1) (*MultiUpdateJourneyTaskResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiUpdateJourneyTaskV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) MultiRemoveJourneyTaskV1(context.Context, *MultiRemoveJourneyTaskRequestV1) (*MultiRemoveJourneyTaskResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiRemoveJourneyTaskV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) GetJourneyTaskStatusV1(context.Context, *GetJourneyTaskStatusRequestV1) (*GetJourneyTaskStatusResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJourneyTaskStatusV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) ListJourneyTasksV1(context.Context, *ListJourneyTasksRequestV1) (*ListJourneyTasksResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJourneyTasksV1 not implemented")
+}
+func (UnimplementedJourneyApiV1Server) mustEmbedUnimplementedJourneyApiV1Server() {}
+
+// UnsafeJourneyApiV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JourneyApiV1Server will
+// result in compilation errors.
+type UnsafeJourneyApiV1Server interface {
+	mustEmbedUnimplementedJourneyApiV1Server()
+}
+
+func RegisterJourneyApiV1Server(s grpc.ServiceRegistrar, srv JourneyApiV1Server) {
+	s.RegisterService(&JourneyApiV1_ServiceDesc, srv)
+}
+
+func _JourneyApiV1_CreateJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJourneyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).CreateJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/CreateJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).CreateJourneyV1(ctx, req.(*CreateJourneyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_DescribeJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeJourneyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).DescribeJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/DescribeJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).DescribeJourneyV1(ctx, req.(*DescribeJourneyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_ListJourneysV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJourneysRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).ListJourneysV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/ListJourneysV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).ListJourneysV1(ctx, req.(*ListJourneysRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_RemoveJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveJourneyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).RemoveJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/RemoveJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).RemoveJourneyV1(ctx, req.(*RemoveJourneyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_MultiCreateJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiCreateJourneyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).MultiCreateJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/MultiCreateJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).MultiCreateJourneyV1(ctx, req.(*MultiCreateJourneyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_UpdateJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJourneyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).UpdateJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/UpdateJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).UpdateJourneyV1(ctx, req.(*UpdateJourneyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_PatchJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchJourneyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).PatchJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/PatchJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).PatchJourneyV1(ctx, req.(*PatchJourneyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_RestoreJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreJourneyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).RestoreJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/RestoreJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).RestoreJourneyV1(ctx, req.(*RestoreJourneyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_AddWaypointV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWaypointRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).AddWaypointV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/AddWaypointV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).AddWaypointV1(ctx, req.(*AddWaypointRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_ReorderWaypointsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderWaypointsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).ReorderWaypointsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/ReorderWaypointsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).ReorderWaypointsV1(ctx, req.(*ReorderWaypointsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_RemoveWaypointV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWaypointRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).RemoveWaypointV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/RemoveWaypointV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).RemoveWaypointV1(ctx, req.(*RemoveWaypointRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_InviteParticipantV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteParticipantRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).InviteParticipantV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/InviteParticipantV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).InviteParticipantV1(ctx, req.(*InviteParticipantRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_RemoveParticipantV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveParticipantRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).RemoveParticipantV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/RemoveParticipantV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).RemoveParticipantV1(ctx, req.(*RemoveParticipantRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_ListParticipantsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParticipantsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).ListParticipantsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/ListParticipantsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).ListParticipantsV1(ctx, req.(*ListParticipantsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_ListDeletedJourneysV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedJourneysRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).ListDeletedJourneysV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/ListDeletedJourneysV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).ListDeletedJourneysV1(ctx, req.(*ListDeletedJourneysRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_ExportJourneysV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportJourneysRequestV1)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JourneyApiV1Server).ExportJourneysV1(m, &journeyApiV1ExportJourneysV1Server{stream})
+}
+
+type JourneyApiV1_ExportJourneysV1Server interface {
+	Send(*ExportJourneysResponseV1) error
+	grpc.ServerStream
+}
+
+type journeyApiV1ExportJourneysV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *journeyApiV1ExportJourneysV1Server) Send(m *ExportJourneysResponseV1) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JourneyApiV1_ImportJourneysV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JourneyApiV1Server).ImportJourneysV1(&journeyApiV1ImportJourneysV1Server{stream})
+}
+
+type JourneyApiV1_ImportJourneysV1Server interface {
+	SendAndClose(*ImportJourneysResponseV1) error
+	Recv() (*ImportJourneysRequestV1, error)
+	grpc.ServerStream
+}
+
+type journeyApiV1ImportJourneysV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *journeyApiV1ImportJourneysV1Server) SendAndClose(m *ImportJourneysResponseV1) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *journeyApiV1ImportJourneysV1Server) Recv() (*ImportJourneysRequestV1, error) {
+	m := new(ImportJourneysRequestV1)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _JourneyApiV1_MultiUpdateJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiUpdateJourneyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).MultiUpdateJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/MultiUpdateJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).MultiUpdateJourneyV1(ctx, req.(*MultiUpdateJourneyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_MultiRemoveJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiRemoveJourneyRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).MultiRemoveJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/MultiRemoveJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).MultiRemoveJourneyV1(ctx, req.(*MultiRemoveJourneyRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_BatchGetJourneysV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetJourneysRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).BatchGetJourneysV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/BatchGetJourneysV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).BatchGetJourneysV1(ctx, req.(*BatchGetJourneysRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_SearchJourneysV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchJourneysRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).SearchJourneysV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/SearchJourneysV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).SearchJourneysV1(ctx, req.(*SearchJourneysRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_FindJourneysNearV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindJourneysNearRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).FindJourneysNearV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/FindJourneysNearV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).FindJourneysNearV1(ctx, req.(*FindJourneysNearRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_FindOverlappingJourneysV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOverlappingJourneysRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).FindOverlappingJourneysV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/FindOverlappingJourneysV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).FindOverlappingJourneysV1(ctx, req.(*FindOverlappingJourneysRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_GetJourneyStatsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJourneyStatsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).GetJourneyStatsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/GetJourneyStatsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).GetJourneyStatsV1(ctx, req.(*GetJourneyStatsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_ListTagsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).ListTagsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/ListTagsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).ListTagsV1(ctx, req.(*ListTagsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_GetCalendarFeedV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).GetCalendarFeedV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/GetCalendarFeedV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).GetCalendarFeedV1(ctx, req.(*GetCalendarFeedRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *journeyApiV1Client) RemoveJourneyTaskV1(ctx context.Context, in *RemoveJourneyTaskRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/RemoveJourneyTaskV1", in, out, opts...)
-	if err != nil {
+func _JourneyApiV1_StartJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeJourneyStatusRequestV1)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).StartJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/StartJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).StartJourneyV1(ctx, req.(*ChangeJourneyStatusRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *journeyApiV1Client) MultiCreateJourneyTaskV1(ctx context.Context, in *MultiCreateJourneyTaskRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/MultiCreateJourneyTaskV1", in, out, opts...)
-	if err != nil {
+func _JourneyApiV1_CompleteJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeJourneyStatusRequestV1)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).CompleteJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/CompleteJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).CompleteJourneyV1(ctx, req.(*ChangeJourneyStatusRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *journeyApiV1Client) UpdateJourneyTaskV1(ctx context.Context, in *UpdateJourneyTaskRequestV1, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/ova.journey.api.JourneyApiV1/UpdateJourneyTaskV1", in, out, opts...)
-	if err != nil {
+func _JourneyApiV1_CancelJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeJourneyStatusRequestV1)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// JourneyApiV1Server is the server API for JourneyApiV1 service.
-// All implementations must embed UnimplementedJourneyApiV1Server
-// for forward compatibility
-type JourneyApiV1Server interface {
-	CreateJourneyV1(context.Context, *CreateJourneyRequestV1) (*CreateJourneyResponseV1, error)
-	DescribeJourneyV1(context.Context, *DescribeJourneyRequestV1) (*DescribeJourneyResponseV1, error)
-	ListJourneysV1(context.Context, *ListJourneysRequestV1) (*ListJourneysResponseV1, error)
-	RemoveJourneyV1(context.Context, *RemoveJourneyRequestV1) (*emptypb.Empty, error)
-	MultiCreateJourneyV1(context.Context, *MultiCreateJourneyRequestV1) (*MultiCreateJourneyResponseV1, error)
-	UpdateJourneyV1(context.Context, *UpdateJourneyRequestV1) (*emptypb.Empty, error)
-	CreateJourneyTaskV1(context.Context, *CreateJourneyTaskRequestV1) (*emptypb.Empty, error)
-	RemoveJourneyTaskV1(context.Context, *RemoveJourneyTaskRequestV1) (*emptypb.Empty, error)
-	MultiCreateJourneyTaskV1(context.Context, *MultiCreateJourneyTaskRequestV1) (*emptypb.Empty, error)
-	UpdateJourneyTaskV1(context.Context, *UpdateJourneyTaskRequestV1) (*emptypb.Empty, error)
-	mustEmbedUnimplementedJourneyApiV1Server()
-}
-
-// UnimplementedJourneyApiV1Server must be embedded to have forward compatible implementations.
-type UnimplementedJourneyApiV1Server struct {
-}
-
-func (UnimplementedJourneyApiV1Server) CreateJourneyV1(context.Context, *CreateJourneyRequestV1) (*CreateJourneyResponseV1, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateJourneyV1 not implemented")
-}
-func (UnimplementedJourneyApiV1Server) DescribeJourneyV1(context.Context, *DescribeJourneyRequestV1) (*DescribeJourneyResponseV1, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DescribeJourneyV1 not implemented")
-}
-func (UnimplementedJourneyApiV1Server) ListJourneysV1(context.Context, *ListJourneysRequestV1) (*ListJourneysResponseV1, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListJourneysV1 not implemented")
-}
-func (UnimplementedJourneyApiV1Server) RemoveJourneyV1(context.Context, *RemoveJourneyRequestV1) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveJourneyV1 not implemented")
-}
-func (UnimplementedJourneyApiV1Server) MultiCreateJourneyV1(context.Context, *MultiCreateJourneyRequestV1) (*MultiCreateJourneyResponseV1, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiCreateJourneyV1 not implemented")
-}
-func (UnimplementedJourneyApiV1Server) UpdateJourneyV1(context.Context, *UpdateJourneyRequestV1) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateJourneyV1 not implemented")
-}
-func (UnimplementedJourneyApiV1Server) CreateJourneyTaskV1(context.Context, *CreateJourneyTaskRequestV1) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateJourneyTaskV1 not implemented")
-}
-func (UnimplementedJourneyApiV1Server) RemoveJourneyTaskV1(context.Context, *RemoveJourneyTaskRequestV1) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveJourneyTaskV1 not implemented")
-}
-func (UnimplementedJourneyApiV1Server) MultiCreateJourneyTaskV1(context.Context, *MultiCreateJourneyTaskRequestV1) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiCreateJourneyTaskV1 not implemented")
-}
-func (UnimplementedJourneyApiV1Server) UpdateJourneyTaskV1(context.Context, *UpdateJourneyTaskRequestV1) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateJourneyTaskV1 not implemented")
-}
-func (UnimplementedJourneyApiV1Server) mustEmbedUnimplementedJourneyApiV1Server() {}
-
-// UnsafeJourneyApiV1Server may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to JourneyApiV1Server will
-// result in compilation errors.
-type UnsafeJourneyApiV1Server interface {
-	mustEmbedUnimplementedJourneyApiV1Server()
-}
-
-func RegisterJourneyApiV1Server(s grpc.ServiceRegistrar, srv JourneyApiV1Server) {
-	s.RegisterService(&JourneyApiV1_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).CancelJourneyV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/CancelJourneyV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).CancelJourneyV1(ctx, req.(*ChangeJourneyStatusRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _JourneyApiV1_CreateJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateJourneyRequestV1)
+func _JourneyApiV1_CreateRecurringJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringJourneyRequestV1)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JourneyApiV1Server).CreateJourneyV1(ctx, in)
+		return srv.(JourneyApiV1Server).CreateRecurringJourneyV1(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ova.journey.api.JourneyApiV1/CreateJourneyV1",
+		FullMethod: "/ova.journey.api.JourneyApiV1/CreateRecurringJourneyV1",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JourneyApiV1Server).CreateJourneyV1(ctx, req.(*CreateJourneyRequestV1))
+		return srv.(JourneyApiV1Server).CreateRecurringJourneyV1(ctx, req.(*CreateRecurringJourneyRequestV1))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JourneyApiV1_DescribeJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeJourneyRequestV1)
+func _JourneyApiV1_DescribeRecurringJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRecurringJourneyRequestV1)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JourneyApiV1Server).DescribeJourneyV1(ctx, in)
+		return srv.(JourneyApiV1Server).DescribeRecurringJourneyV1(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ova.journey.api.JourneyApiV1/DescribeJourneyV1",
+		FullMethod: "/ova.journey.api.JourneyApiV1/DescribeRecurringJourneyV1",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JourneyApiV1Server).DescribeJourneyV1(ctx, req.(*DescribeJourneyRequestV1))
+		return srv.(JourneyApiV1Server).DescribeRecurringJourneyV1(ctx, req.(*DescribeRecurringJourneyRequestV1))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JourneyApiV1_ListJourneysV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListJourneysRequestV1)
+func _JourneyApiV1_UpdateRecurringJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringJourneyRequestV1)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JourneyApiV1Server).ListJourneysV1(ctx, in)
+		return srv.(JourneyApiV1Server).UpdateRecurringJourneyV1(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ova.journey.api.JourneyApiV1/ListJourneysV1",
+		FullMethod: "/ova.journey.api.JourneyApiV1/UpdateRecurringJourneyV1",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JourneyApiV1Server).ListJourneysV1(ctx, req.(*ListJourneysRequestV1))
+		return srv.(JourneyApiV1Server).UpdateRecurringJourneyV1(ctx, req.(*UpdateRecurringJourneyRequestV1))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JourneyApiV1_RemoveJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveJourneyRequestV1)
+func _JourneyApiV1_RemoveRecurringJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRecurringJourneyRequestV1)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JourneyApiV1Server).RemoveJourneyV1(ctx, in)
+		return srv.(JourneyApiV1Server).RemoveRecurringJourneyV1(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ova.journey.api.JourneyApiV1/RemoveJourneyV1",
+		FullMethod: "/ova.journey.api.JourneyApiV1/RemoveRecurringJourneyV1",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JourneyApiV1Server).RemoveJourneyV1(ctx, req.(*RemoveJourneyRequestV1))
+		return srv.(JourneyApiV1Server).RemoveRecurringJourneyV1(ctx, req.(*RemoveRecurringJourneyRequestV1))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JourneyApiV1_MultiCreateJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MultiCreateJourneyRequestV1)
+func _JourneyApiV1_UpdateOccurrenceV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOccurrenceRequestV1)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JourneyApiV1Server).MultiCreateJourneyV1(ctx, in)
+		return srv.(JourneyApiV1Server).UpdateOccurrenceV1(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ova.journey.api.JourneyApiV1/MultiCreateJourneyV1",
+		FullMethod: "/ova.journey.api.JourneyApiV1/UpdateOccurrenceV1",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JourneyApiV1Server).MultiCreateJourneyV1(ctx, req.(*MultiCreateJourneyRequestV1))
+		return srv.(JourneyApiV1Server).UpdateOccurrenceV1(ctx, req.(*UpdateOccurrenceRequestV1))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JourneyApiV1_UpdateJourneyV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateJourneyRequestV1)
+func _JourneyApiV1_ListOccurrencesV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOccurrencesRequestV1)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JourneyApiV1Server).UpdateJourneyV1(ctx, in)
+		return srv.(JourneyApiV1Server).ListOccurrencesV1(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ova.journey.api.JourneyApiV1/UpdateJourneyV1",
+		FullMethod: "/ova.journey.api.JourneyApiV1/ListOccurrencesV1",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JourneyApiV1Server).UpdateJourneyV1(ctx, req.(*UpdateJourneyRequestV1))
+		return srv.(JourneyApiV1Server).ListOccurrencesV1(ctx, req.(*ListOccurrencesRequestV1))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JourneyApiV1_WatchJourneysV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJourneysRequestV1)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JourneyApiV1Server).WatchJourneysV1(m, &journeyApiV1WatchJourneysV1Server{stream})
+}
+
+type JourneyApiV1_WatchJourneysV1Server interface {
+	Send(*WatchJourneysResponseV1) error
+	grpc.ServerStream
+}
+
+type journeyApiV1WatchJourneysV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *journeyApiV1WatchJourneysV1Server) Send(m *WatchJourneysResponseV1) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _JourneyApiV1_CreateJourneyTaskV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateJourneyTaskRequestV1)
 	if err := dec(in); err != nil {
@@ -373,6 +1546,78 @@ func _JourneyApiV1_UpdateJourneyTaskV1_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JourneyApiV1_MultiUpdateJourneyTaskV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiUpdateJourneyTaskRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).MultiUpdateJourneyTaskV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/MultiUpdateJourneyTaskV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).MultiUpdateJourneyTaskV1(ctx, req.(*MultiUpdateJourneyTaskRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_MultiRemoveJourneyTaskV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiRemoveJourneyTaskRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).MultiRemoveJourneyTaskV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/MultiRemoveJourneyTaskV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).MultiRemoveJourneyTaskV1(ctx, req.(*MultiRemoveJourneyTaskRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_GetJourneyTaskStatusV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJourneyTaskStatusRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).GetJourneyTaskStatusV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/GetJourneyTaskStatusV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).GetJourneyTaskStatusV1(ctx, req.(*GetJourneyTaskStatusRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JourneyApiV1_ListJourneyTasksV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJourneyTasksRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JourneyApiV1Server).ListJourneyTasksV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ova.journey.api.JourneyApiV1/ListJourneyTasksV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JourneyApiV1Server).ListJourneyTasksV1(ctx, req.(*ListJourneyTasksRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JourneyApiV1_ServiceDesc is the grpc.ServiceDesc for JourneyApiV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -404,6 +1649,114 @@ var JourneyApiV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateJourneyV1",
 			Handler:    _JourneyApiV1_UpdateJourneyV1_Handler,
 		},
+		{
+			MethodName: "PatchJourneyV1",
+			Handler:    _JourneyApiV1_PatchJourneyV1_Handler,
+		},
+		{
+			MethodName: "RestoreJourneyV1",
+			Handler:    _JourneyApiV1_RestoreJourneyV1_Handler,
+		},
+		{
+			MethodName: "AddWaypointV1",
+			Handler:    _JourneyApiV1_AddWaypointV1_Handler,
+		},
+		{
+			MethodName: "ReorderWaypointsV1",
+			Handler:    _JourneyApiV1_ReorderWaypointsV1_Handler,
+		},
+		{
+			MethodName: "RemoveWaypointV1",
+			Handler:    _JourneyApiV1_RemoveWaypointV1_Handler,
+		},
+		{
+			MethodName: "InviteParticipantV1",
+			Handler:    _JourneyApiV1_InviteParticipantV1_Handler,
+		},
+		{
+			MethodName: "RemoveParticipantV1",
+			Handler:    _JourneyApiV1_RemoveParticipantV1_Handler,
+		},
+		{
+			MethodName: "ListParticipantsV1",
+			Handler:    _JourneyApiV1_ListParticipantsV1_Handler,
+		},
+		{
+			MethodName: "ListDeletedJourneysV1",
+			Handler:    _JourneyApiV1_ListDeletedJourneysV1_Handler,
+		},
+		{
+			MethodName: "MultiUpdateJourneyV1",
+			Handler:    _JourneyApiV1_MultiUpdateJourneyV1_Handler,
+		},
+		{
+			MethodName: "MultiRemoveJourneyV1",
+			Handler:    _JourneyApiV1_MultiRemoveJourneyV1_Handler,
+		},
+		{
+			MethodName: "BatchGetJourneysV1",
+			Handler:    _JourneyApiV1_BatchGetJourneysV1_Handler,
+		},
+		{
+			MethodName: "SearchJourneysV1",
+			Handler:    _JourneyApiV1_SearchJourneysV1_Handler,
+		},
+		{
+			MethodName: "FindJourneysNearV1",
+			Handler:    _JourneyApiV1_FindJourneysNearV1_Handler,
+		},
+		{
+			MethodName: "FindOverlappingJourneysV1",
+			Handler:    _JourneyApiV1_FindOverlappingJourneysV1_Handler,
+		},
+		{
+			MethodName: "GetJourneyStatsV1",
+			Handler:    _JourneyApiV1_GetJourneyStatsV1_Handler,
+		},
+		{
+			MethodName: "ListTagsV1",
+			Handler:    _JourneyApiV1_ListTagsV1_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeedV1",
+			Handler:    _JourneyApiV1_GetCalendarFeedV1_Handler,
+		},
+		{
+			MethodName: "StartJourneyV1",
+			Handler:    _JourneyApiV1_StartJourneyV1_Handler,
+		},
+		{
+			MethodName: "CompleteJourneyV1",
+			Handler:    _JourneyApiV1_CompleteJourneyV1_Handler,
+		},
+		{
+			MethodName: "CancelJourneyV1",
+			Handler:    _JourneyApiV1_CancelJourneyV1_Handler,
+		},
+		{
+			MethodName: "CreateRecurringJourneyV1",
+			Handler:    _JourneyApiV1_CreateRecurringJourneyV1_Handler,
+		},
+		{
+			MethodName: "DescribeRecurringJourneyV1",
+			Handler:    _JourneyApiV1_DescribeRecurringJourneyV1_Handler,
+		},
+		{
+			MethodName: "UpdateRecurringJourneyV1",
+			Handler:    _JourneyApiV1_UpdateRecurringJourneyV1_Handler,
+		},
+		{
+			MethodName: "RemoveRecurringJourneyV1",
+			Handler:    _JourneyApiV1_RemoveRecurringJourneyV1_Handler,
+		},
+		{
+			MethodName: "UpdateOccurrenceV1",
+			Handler:    _JourneyApiV1_UpdateOccurrenceV1_Handler,
+		},
+		{
+			MethodName: "ListOccurrencesV1",
+			Handler:    _JourneyApiV1_ListOccurrencesV1_Handler,
+		},
 		{
 			MethodName: "CreateJourneyTaskV1",
 			Handler:    _JourneyApiV1_CreateJourneyTaskV1_Handler,
@@ -420,7 +1773,39 @@ var JourneyApiV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateJourneyTaskV1",
 			Handler:    _JourneyApiV1_UpdateJourneyTaskV1_Handler,
 		},
+		{
+			MethodName: "MultiUpdateJourneyTaskV1",
+			Handler:    _JourneyApiV1_MultiUpdateJourneyTaskV1_Handler,
+		},
+		{
+			MethodName: "MultiRemoveJourneyTaskV1",
+			Handler:    _JourneyApiV1_MultiRemoveJourneyTaskV1_Handler,
+		},
+		{
+			MethodName: "GetJourneyTaskStatusV1",
+			Handler:    _JourneyApiV1_GetJourneyTaskStatusV1_Handler,
+		},
+		{
+			MethodName: "ListJourneyTasksV1",
+			Handler:    _JourneyApiV1_ListJourneyTasksV1_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportJourneysV1",
+			Handler:       _JourneyApiV1_ExportJourneysV1_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportJourneysV1",
+			Handler:       _JourneyApiV1_ImportJourneysV1_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchJourneysV1",
+			Handler:       _JourneyApiV1_WatchJourneysV1_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ova-journey-api.proto",
 }